@@ -6,6 +6,7 @@ import (
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/rshelekhov/merch-store/internal/controller/http/v1/handler"
+	"github.com/rshelekhov/merch-store/internal/domain"
 	"github.com/stretchr/testify/require"
 )
 
@@ -90,3 +91,239 @@ func TestAuthUser_BadRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthExistingUser_WrongPassword(t *testing.T) {
+	e := newTestAPI(t)
+
+	username := gofakeit.Username()
+
+	// Register new user
+	e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: username,
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusOK)
+
+	// Auth with another password
+	e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: username,
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusUnauthorized)
+}
+
+func TestAuthExistingUser_Backoff(t *testing.T) {
+	e := newTestAPI(t)
+
+	username := "e2e_" + gofakeit.LetterN(12)
+
+	e.POST("/api/register").
+		WithJSON(handler.RegisterRequest{
+			Username: username,
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusCreated)
+
+	// The default policy lets two failures through, the third one starts the backoff
+	for range 3 {
+		e.POST("/api/auth").
+			WithJSON(handler.AuthRequest{
+				Username: username,
+				Password: randomFakePassword(),
+			}).
+			Expect().
+			Status(http.StatusUnauthorized)
+	}
+
+	e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: username,
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusTooManyRequests).
+		Header("Retry-After").NotEmpty()
+}
+
+func TestRegister_HappyPath(t *testing.T) {
+	e := newTestAPI(t)
+
+	username := "e2e_" + gofakeit.LetterN(12)
+	password := randomFakePassword()
+
+	resp := e.POST("/api/register").
+		WithJSON(handler.RegisterRequest{
+			Username: username,
+			Password: password,
+		}).
+		Expect().
+		Status(http.StatusCreated).
+		JSON().Object()
+
+	token := resp.Value("token").String().Raw()
+	require.NotEmpty(t, token)
+
+	// Registered user can log in
+	e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: username,
+			Password: password,
+		}).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func TestRegister_UsernameTaken(t *testing.T) {
+	e := newTestAPI(t)
+
+	request := handler.RegisterRequest{
+		Username: "e2e_" + gofakeit.LetterN(12),
+		Password: randomFakePassword(),
+	}
+
+	e.POST("/api/register").
+		WithJSON(request).
+		Expect().
+		Status(http.StatusCreated)
+
+	e.POST("/api/register").
+		WithJSON(request).
+		Expect().
+		Status(http.StatusConflict)
+}
+
+func TestRegister_BadRequest(t *testing.T) {
+	e := newTestAPI(t)
+
+	tests := []struct {
+		name     string
+		username string
+	}{
+		{
+			name:     "Too short",
+			username: "ab",
+		},
+		{
+			name:     "Invalid characters",
+			username: "e2e user",
+		},
+		{
+			name:     "Reserved",
+			username: "admin",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e.POST("/api/register").
+				WithJSON(handler.RegisterRequest{
+					Username: tt.username,
+					Password: randomFakePassword(),
+				}).
+				Expect().
+				Status(http.StatusBadRequest)
+		})
+	}
+}
+
+func TestRegister_WeakPassword(t *testing.T) {
+	e := newTestAPI(t)
+
+	resp := e.POST("/api/register").
+		WithJSON(handler.RegisterRequest{
+			Username: "e2e_" + gofakeit.LetterN(12),
+			Password: "password",
+		}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().Object()
+
+	resp.Value("violations").Array().Value(0).Object().
+		Value("rule").String().IsEqual(string(domain.PasswordRuleCommon))
+}
+
+func TestRefreshTokens_Rotation(t *testing.T) {
+	e := newTestAPI(t)
+
+	resp := e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: gofakeit.Username(),
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object()
+
+	refreshToken := resp.Value("refreshToken").String().Raw()
+	require.NotEmpty(t, refreshToken)
+
+	// Rotate the refresh token
+	resp = e.POST("/api/auth/refresh").
+		WithJSON(handler.RefreshRequest{RefreshToken: refreshToken}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object()
+
+	rotated := resp.Value("refreshToken").String().Raw()
+	require.NotEmpty(t, resp.Value("token").String().Raw())
+	require.NotEqual(t, refreshToken, rotated)
+
+	// Reusing the old token is rejected and revokes the whole family
+	e.POST("/api/auth/refresh").
+		WithJSON(handler.RefreshRequest{RefreshToken: refreshToken}).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	e.POST("/api/auth/refresh").
+		WithJSON(handler.RefreshRequest{RefreshToken: rotated}).
+		Expect().
+		Status(http.StatusUnauthorized)
+}
+
+func TestLogout(t *testing.T) {
+	e := newTestAPI(t)
+
+	resp := e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: gofakeit.Username(),
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object()
+
+	token := resp.Value("token").String().Raw()
+	refreshToken := resp.Value("refreshToken").String().Raw()
+
+	e.POST("/api/logout").
+		WithHeader("Authorization", "Bearer "+token).
+		WithJSON(handler.LogoutRequest{RefreshToken: refreshToken}).
+		Expect().
+		Status(http.StatusOK)
+
+	// Both tokens are no longer accepted
+	e.GET("/api/user").
+		WithHeader("Authorization", "Bearer "+token).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	e.POST("/api/auth/refresh").
+		WithJSON(handler.RefreshRequest{RefreshToken: refreshToken}).
+		Expect().
+		Status(http.StatusUnauthorized)
+}
+
+func TestJWKS(t *testing.T) {
+	e := newTestAPI(t)
+
+	e.GET(handler.JWKSPath).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		ContainsKey("keys")
+}
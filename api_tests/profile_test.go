@@ -0,0 +1,109 @@
+package api_tests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/rshelekhov/merch-store/internal/controller/http/v1/handler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile_HappyPath(t *testing.T) {
+	e := newTestAPI(t)
+
+	// Register user
+	token := e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: gofakeit.Username(),
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("token").String().Raw()
+	require.NotEmpty(t, token)
+
+	// Register receiver and fill in the profile
+	receiverUsername := gofakeit.Username()
+	receiverDisplayName := gofakeit.Name()
+
+	receiverToken := e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: receiverUsername,
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("token").String().Raw()
+
+	e.PATCH("/api/me").
+		WithHeader("Authorization", "Bearer "+receiverToken).
+		WithJSON(map[string]string{
+			"displayName": receiverDisplayName,
+			"department":  "Engineering",
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		HasValue("username", receiverUsername).
+		HasValue("displayName", receiverDisplayName).
+		HasValue("department", "Engineering").
+		HasValue("title", "")
+
+	// Fields missing from the body are kept
+	e.PATCH("/api/me").
+		WithHeader("Authorization", "Bearer "+receiverToken).
+		WithJSON(map[string]string{"title": "Backend Engineer"}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		HasValue("department", "Engineering").
+		HasValue("title", "Backend Engineer")
+
+	e.GET("/api/me").
+		WithHeader("Authorization", "Bearer "+receiverToken).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		HasValue("displayName", receiverDisplayName)
+
+	// The history shows the display name of the receiver
+	e.POST("/api/sendCoin").
+		WithHeader("Authorization", "Bearer "+token).
+		WithJSON(handler.SendCoinRequest{
+			ToUser: receiverUsername,
+			Amount: 10,
+		}).
+		Expect().
+		Status(http.StatusOK)
+
+	sent := e.GET("/api/user").
+		WithHeader("Authorization", "Bearer "+token).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("coinHistory").Object().Value("sent").Array()
+
+	sent.Length().IsEqual(1)
+	sent.Value(0).Object().
+		HasValue("toUser", receiverUsername).
+		HasValue("toDisplayName", receiverDisplayName)
+}
+
+func TestProfile_InvalidAvatarURL(t *testing.T) {
+	e := newTestAPI(t)
+
+	token := e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: gofakeit.Username(),
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("token").String().Raw()
+
+	e.PATCH("/api/me").
+		WithHeader("Authorization", "Bearer "+token).
+		WithJSON(map[string]string{"avatarUrl": "javascript:alert(1)"}).
+		Expect().
+		Status(http.StatusBadRequest)
+}
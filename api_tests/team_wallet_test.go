@@ -0,0 +1,50 @@
+package api_tests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/rshelekhov/merch-store/internal/controller/http/v1/handler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamWallet_NotAMember(t *testing.T) {
+	e := newTestAPI(t)
+
+	token := e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: gofakeit.Username(),
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("token").String().Raw()
+	require.NotEmpty(t, token)
+
+	// A new user isn't a member of any team
+	e.GET("/api/teams").
+		WithHeader("Authorization", "Bearer "+token).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("teams").Array().IsEmpty()
+
+	// Teams of other users are reported as not found
+	e.GET("/api/teams/{teamID}", gofakeit.UUID()).
+		WithHeader("Authorization", "Bearer "+token).
+		Expect().
+		Status(http.StatusNotFound)
+
+	e.POST("/api/teams/{teamID}/contribute", gofakeit.UUID()).
+		WithHeader("Authorization", "Bearer "+token).
+		WithJSON(handler.ContributeRequest{Amount: 10}).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// Only superadmins manage teams
+	e.POST("/api/admin/teams").
+		WithHeader("Authorization", "Bearer "+token).
+		WithJSON(handler.CreateTeamRequest{Name: gofakeit.Company()}).
+		Expect().
+		Status(http.StatusForbidden)
+}
@@ -0,0 +1,87 @@
+package api_tests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/rshelekhov/merch-store/internal/controller/http/v1/handler"
+	"github.com/rshelekhov/merch-store/internal/lib/totp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwoFactor_HappyPath(t *testing.T) {
+	e := newTestAPI(t)
+
+	credentials := handler.AuthRequest{
+		Username: gofakeit.Username(),
+		Password: randomFakePassword(),
+	}
+
+	// Register user
+	token := e.POST("/api/auth").
+		WithJSON(credentials).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("token").String().Raw()
+	require.NotEmpty(t, token)
+
+	// Enroll and confirm the authenticator
+	secret := e.POST("/api/2fa/enroll").
+		WithHeader("Authorization", "Bearer "+token).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("secret").String().Raw()
+	require.NotEmpty(t, secret)
+
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+
+	recoveryCodes := e.POST("/api/2fa/confirm").
+		WithHeader("Authorization", "Bearer "+token).
+		WithJSON(handler.TwoFactorCodeRequest{Code: code}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("recoveryCodes").Array()
+	recoveryCodes.Length().Ge(2)
+
+	// The password alone isn't enough anymore
+	mfaToken := e.POST("/api/auth").
+		WithJSON(credentials).
+		Expect().
+		Status(http.StatusUnauthorized).
+		JSON().Object().Value("mfaToken").String().Raw()
+	require.NotEmpty(t, mfaToken)
+
+	e.POST("/api/auth/2fa").
+		WithJSON(handler.SecondFactorRequest{
+			MfaToken: mfaToken,
+			Code:     "aaaa-bbbb-cccc-dddd",
+		}).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	// The challenge can be retried and is completed with a recovery code
+	e.POST("/api/auth/2fa").
+		WithJSON(handler.SecondFactorRequest{
+			MfaToken: mfaToken,
+			Code:     recoveryCodes.Value(0).String().Raw(),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("token").String().NotEmpty()
+
+	// Disable it with another recovery code, the password is enough again
+	e.POST("/api/2fa/disable").
+		WithHeader("Authorization", "Bearer "+token).
+		WithJSON(handler.TwoFactorCodeRequest{Code: recoveryCodes.Value(1).String().Raw()}).
+		Expect().
+		Status(http.StatusOK)
+
+	e.POST("/api/auth").
+		WithJSON(credentials).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("token").String().NotEmpty()
+}
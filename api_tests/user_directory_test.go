@@ -0,0 +1,101 @@
+package api_tests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/rshelekhov/merch-store/internal/controller/http/v1/handler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserDirectory_HappyPath(t *testing.T) {
+	e := newTestAPI(t)
+
+	// Register the sender
+	token := e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: gofakeit.Username(),
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("token").String().Raw()
+	require.NotEmpty(t, token)
+
+	// Register the receiver with a display name in a department of its own
+	receiverUsername := "dir" + gofakeit.LetterN(10)
+	department := "Directory " + gofakeit.LetterN(8)
+
+	receiverToken := e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: receiverUsername,
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("token").String().Raw()
+
+	e.PATCH("/api/me").
+		WithHeader("Authorization", "Bearer "+receiverToken).
+		WithJSON(map[string]string{
+			"displayName": "Directory Receiver",
+			"department":  department,
+		}).
+		Expect().
+		Status(http.StatusOK)
+
+	// Find the receiver by a username prefix within the department
+	users := e.GET("/api/users").
+		WithHeader("Authorization", "Bearer "+token).
+		WithQuery("q", receiverUsername[:6]).
+		WithQuery("department", department).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		HasValue("total", 1).
+		Value("users").Array()
+
+	users.Length().IsEqual(1)
+	users.Value(0).Object().
+		HasValue("username", receiverUsername).
+		HasValue("displayName", "Directory Receiver").
+		NotContainsKey("email")
+
+	// A typo still finds the receiver
+	e.GET("/api/users").
+		WithHeader("Authorization", "Bearer "+token).
+		WithQuery("q", receiverUsername[:len(receiverUsername)-1]+"x").
+		WithQuery("department", department).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		HasValue("total", 1)
+}
+
+func TestUserDirectory_InvalidPagination(t *testing.T) {
+	e := newTestAPI(t)
+
+	token := e.POST("/api/auth").
+		WithJSON(handler.AuthRequest{
+			Username: gofakeit.Username(),
+			Password: randomFakePassword(),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("token").String().Raw()
+
+	e.GET("/api/users").
+		WithHeader("Authorization", "Bearer "+token).
+		WithQuery("limit", 1000).
+		Expect().
+		Status(http.StatusBadRequest)
+}
+
+func TestUserDirectory_Unauthorized(t *testing.T) {
+	e := newTestAPI(t)
+
+	e.GET("/api/users").
+		Expect().
+		Status(http.StatusUnauthorized)
+}
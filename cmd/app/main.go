@@ -34,6 +34,8 @@ func main() {
 		application.HTTPServer.MustRun()
 	}()
 
+	go application.Worker.Run()
+
 	// Graceful shutdown
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
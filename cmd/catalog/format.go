@@ -0,0 +1,192 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+const (
+	formatCSV  = "csv"
+	formatYAML = "yaml"
+)
+
+var csvHeader = []string{"name", "price", "category", "stock"}
+
+// catalogRecord is the representation of a catalog item in YAML files
+type catalogRecord struct {
+	Name     string `yaml:"name"`
+	Price    int    `yaml:"price"`
+	Category string `yaml:"category,omitempty"`
+	Stock    *int   `yaml:"stock,omitempty"`
+}
+
+func detectFormat(path, format string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	switch format {
+	case formatCSV:
+		return formatCSV, nil
+	case formatYAML, "yml":
+		return formatYAML, nil
+	default:
+		return "", fmt.Errorf("unsupported catalog format %q, use -format csv or -format yaml", format)
+	}
+}
+
+func readCatalog(r io.Reader, format string) ([]entity.CatalogItem, error) {
+	if format == formatCSV {
+		return readCSV(r)
+	}
+
+	return readYAML(r)
+}
+
+func writeCatalog(w io.Writer, format string, items []entity.CatalogItem) error {
+	if format == formatCSV {
+		return writeCSV(w, items)
+	}
+
+	return writeYAML(w, items)
+}
+
+// readCSV reads a CSV file with a header row. Columns are matched by name,
+// so their order doesn't matter, and only name and price are required
+func readCSV(r io.Reader) ([]entity.CatalogItem, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("file is empty")
+		}
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range []string{"name", "price"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing %q column", name)
+		}
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var items []entity.CatalogItem
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		price, err := strconv.Atoi(value(record, "price"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid price: %w", line, err)
+		}
+
+		item := entity.CatalogItem{
+			Name:     value(record, "name"),
+			Price:    price,
+			Category: value(record, "category"),
+		}
+
+		if raw := value(record, "stock"); raw != "" {
+			stock, err := strconv.Atoi(raw)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid stock: %w", line, err)
+			}
+			item.Stock = &stock
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func writeCSV(w io.Writer, items []entity.CatalogItem) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		stock := ""
+		if item.Stock != nil {
+			stock = strconv.Itoa(*item.Stock)
+		}
+
+		if err := writer.Write([]string{item.Name, strconv.Itoa(item.Price), item.Category, stock}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func readYAML(r io.Reader) ([]entity.CatalogItem, error) {
+	var records []catalogRecord
+
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&records); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("file is empty")
+		}
+		return nil, err
+	}
+
+	items := make([]entity.CatalogItem, len(records))
+	for i, record := range records {
+		items[i] = entity.CatalogItem(record)
+	}
+
+	return items, nil
+}
+
+func writeYAML(w io.Writer, items []entity.CatalogItem) error {
+	records := make([]catalogRecord, len(items))
+	for i, item := range items {
+		records[i] = catalogRecord(item)
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(records); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
@@ -0,0 +1,133 @@
+package main
+
+//
+// A CLI utility for importing and exporting the merch catalog.
+//
+// Import makes the catalog match the file: items are matched by name,
+// new ones are created, changed ones are updated and the ones missing
+// from the file are retired. Use -dry-run to see the diff without applying it.
+//
+//	catalog -config ./config/.env -import ./catalog.csv -dry-run
+//	catalog -config ./config/.env -export ./catalog.yaml
+//
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rshelekhov/merch-store/internal/config"
+	"github.com/rshelekhov/merch-store/internal/config/settings"
+	merchService "github.com/rshelekhov/merch-store/internal/domain/service/merch"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/catalog"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	merchDB "github.com/rshelekhov/merch-store/internal/infrastructure/storage/merch"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/transaction"
+	"github.com/rshelekhov/merch-store/internal/lib/logger"
+)
+
+func main() {
+	var importPath, exportPath, format string
+	var dryRun bool
+
+	// Flags must be registered before config.MustLoad, since it parses the command line
+	flag.StringVar(&importPath, "import", "", "path to the CSV or YAML file to import")
+	flag.StringVar(&exportPath, "export", "", "path to export the catalog to, use - for stdout")
+	flag.StringVar(&format, "format", "", "file format: csv or yaml (detected by the file extension by default)")
+	flag.BoolVar(&dryRun, "dry-run", false, "print the diff without applying the import")
+
+	cfg := config.MustLoad()
+
+	if (importPath == "") == (exportPath == "") {
+		fmt.Fprintln(os.Stderr, "exactly one of -import or -export is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	log := logger.SetupLogger(cfg.AppEnv)
+
+	dbConn, err := storage.NewDBConnection(settings.ToStorageConfig(cfg.Postgres))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to init database connection: %v\n", err)
+		os.Exit(1)
+	}
+	defer dbConn.Close()
+
+	txMgr := transaction.NewManager(dbConn)
+	merchStorage := merchDB.NewStorage(dbConn.Postgres.Pool, txMgr)
+	merchMgr := merchService.New(merchStorage)
+	catalogUsecase := catalog.NewUsecase(log, merchMgr, txMgr)
+
+	ctx := context.Background()
+
+	if importPath != "" {
+		err = runImport(ctx, catalogUsecase, importPath, format, dryRun)
+	} else {
+		err = runExport(ctx, catalogUsecase, exportPath, format)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		dbConn.Close()
+		os.Exit(1)
+	}
+}
+
+func runImport(ctx context.Context, usecase *catalog.Usecase, path, format string, dryRun bool) error {
+	f, err := detectFormat(path, format)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer file.Close()
+
+	items, err := readCatalog(file, f)
+	if err != nil {
+		return fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	report, err := usecase.ImportCatalog(ctx, items, dryRun)
+	if err != nil {
+		return fmt.Errorf("failed to import catalog: %w", err)
+	}
+
+	printReport(os.Stdout, report)
+
+	return nil
+}
+
+func runExport(ctx context.Context, usecase *catalog.Usecase, path, format string) error {
+	f, err := detectFormat(path, format)
+	if err != nil {
+		return err
+	}
+
+	items, err := usecase.ExportCatalog(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to export catalog: %w", err)
+	}
+
+	var w io.Writer = os.Stdout
+
+	if path != "-" {
+		file, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create export file: %w", err)
+		}
+		defer file.Close()
+
+		w = file
+	}
+
+	if err = writeCatalog(w, f, items); err != nil {
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+func printReport(w io.Writer, report entity.CatalogReport) {
+	for _, item := range report.Created {
+		fmt.Fprintf(w, "+ %s: price=%d category=%s stock=%s\n",
+			item.Name, item.Price, formatCategory(item.Category), formatStock(item.Stock))
+	}
+
+	for _, update := range report.Updated {
+		fmt.Fprintf(w, "~ %s: %s\n", update.After.Name, strings.Join(describeChanges(update), ", "))
+	}
+
+	for _, item := range report.Retired {
+		fmt.Fprintf(w, "- %s\n", item.Name)
+	}
+
+	summary := fmt.Sprintf("created: %d, updated: %d, retired: %d, unchanged: %d",
+		len(report.Created), len(report.Updated), len(report.Retired), report.Unchanged)
+
+	if report.DryRun {
+		summary += " (dry run, nothing was changed)"
+	}
+
+	fmt.Fprintln(w, summary)
+}
+
+func describeChanges(update entity.CatalogUpdate) []string {
+	var changes []string
+
+	before, after := update.Before, update.After
+
+	if before.Price != after.Price {
+		changes = append(changes, fmt.Sprintf("price %d -> %d", before.Price, after.Price))
+	}
+
+	if before.Category != after.Category {
+		changes = append(changes, fmt.Sprintf("category %s -> %s",
+			formatCategory(before.Category), formatCategory(after.Category)))
+	}
+
+	if formatStock(before.Stock) != formatStock(after.Stock) {
+		changes = append(changes, fmt.Sprintf("stock %s -> %s", formatStock(before.Stock), formatStock(after.Stock)))
+	}
+
+	return changes
+}
+
+func formatCategory(category string) string {
+	if category == "" {
+		return "none"
+	}
+	return category
+}
+
+func formatStock(stock *int) string {
+	if stock == nil {
+		return "untracked"
+	}
+	return strconv.Itoa(*stock)
+}
@@ -0,0 +1,123 @@
+package main
+
+//
+// A CLI utility for creating the first superadmin.
+//
+// A new user is created with the password. An existing user is only promoted with -promote-existing,
+// and the password must be the current password of that user.
+// Nothing is changed if there is a superadmin already, use the admin API then.
+// The flags default to BOOTSTRAP_SUPERADMIN_USERNAME and BOOTSTRAP_SUPERADMIN_PASSWORD.
+//
+//	superadmin -config ./config/.env -username store-owner -password 'long-password'
+//	superadmin -config ./config/.env -username alice -password 'alice-password' -promote-existing
+//
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/rshelekhov/merch-store/internal/config"
+	"github.com/rshelekhov/merch-store/internal/config/settings"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	lockoutService "github.com/rshelekhov/merch-store/internal/domain/service/lockout"
+	sessionService "github.com/rshelekhov/merch-store/internal/domain/service/session"
+	"github.com/rshelekhov/merch-store/internal/domain/service/token"
+	userService "github.com/rshelekhov/merch-store/internal/domain/service/user"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/auth"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	sessionDB "github.com/rshelekhov/merch-store/internal/infrastructure/storage/session"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/transaction"
+	userDB "github.com/rshelekhov/merch-store/internal/infrastructure/storage/user"
+	"github.com/rshelekhov/merch-store/internal/lib/logger"
+)
+
+func main() {
+	var username, password string
+	var promoteExisting bool
+
+	// Flags must be registered before config.MustLoad, since it parses the command line
+	flag.StringVar(&username, "username", "", "username of the superadmin")
+	flag.StringVar(&password, "password", "", "password of the new user, or the current password of an existing one")
+	flag.BoolVar(&promoteExisting, "promote-existing", false, "promote the user if it already exists")
+
+	cfg := config.MustLoad()
+
+	if username == "" {
+		username = cfg.Bootstrap.SuperadminUsername
+	}
+
+	if password == "" {
+		password = cfg.Bootstrap.SuperadminPassword
+	}
+
+	if username == "" {
+		fmt.Fprintln(os.Stderr, "-username is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	log := logger.SetupLogger(cfg.AppEnv)
+
+	dbConn, err := storage.NewDBConnection(settings.ToStorageConfig(cfg.Postgres))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to init database connection: %v\n", err)
+		os.Exit(1)
+	}
+	defer dbConn.Close()
+
+	txMgr := transaction.NewManager(dbConn)
+	userMgr := userService.New(userDB.NewStorage(dbConn.Postgres.Pool, txMgr))
+	sessionStorage := sessionDB.NewStorage(dbConn.Postgres.Pool, txMgr)
+	sessionMgr := sessionService.New(sessionStorage, cfg.JWT.TTL)
+	lockoutMgr := lockoutService.New(sessionStorage, settings.ToLockoutConfig(cfg.Lockout))
+
+	passwordHash, err := settings.ToPasswordHashConfig(cfg.PasswordHash)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load password peppers: %v\n", err)
+		os.Exit(1)
+	}
+
+	// No tokens are issued here, so the signing keys aren't loaded
+	tokenService := token.NewService(token.Config{
+		JWT:            settings.ToJWTConfig(cfg.JWT),
+		PasswordHash:   passwordHash,
+		PasswordPolicy: settings.ToPasswordPolicyConfig(cfg.PasswordPolicy),
+	})
+
+	authUsecase := auth.NewUsecase(
+		log,
+		tokenService,
+		userMgr,
+		sessionMgr,
+		tokenService,
+		tokenService,
+		lockoutMgr,
+		nil,
+		nil,
+		txMgr,
+		settings.ToRegistrationConfig(cfg.Registration),
+	)
+
+	err = authUsecase.BootstrapSuperadmin(context.Background(), entity.UserCredentials{
+		Username: username,
+		Password: password,
+	}, promoteExisting)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrSuperadminAlreadyExists):
+			fmt.Fprintln(os.Stderr, "a superadmin already exists, use the admin API to grant roles")
+		case errors.Is(err, domain.ErrBootstrapUserExists):
+			fmt.Fprintf(os.Stderr, "%s already exists, pass -promote-existing with the password of the user to promote it\n", username)
+		default:
+			fmt.Fprintln(os.Stderr, err)
+		}
+		dbConn.Close()
+		os.Exit(1)
+	}
+
+	fmt.Printf("%s is a superadmin now\n", username)
+}
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -8,25 +10,56 @@ import (
 
 	validator "github.com/go-playground/validator/v10"
 	"github.com/rshelekhov/merch-store/internal/app/http"
+	"github.com/rshelekhov/merch-store/internal/app/worker"
 	"github.com/rshelekhov/merch-store/internal/config"
 	"github.com/rshelekhov/merch-store/internal/config/settings"
 	v1 "github.com/rshelekhov/merch-store/internal/controller/http/v1"
 	"github.com/rshelekhov/merch-store/internal/controller/http/v1/handler"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
 	coinsService "github.com/rshelekhov/merch-store/internal/domain/service/coins"
+	imageService "github.com/rshelekhov/merch-store/internal/domain/service/image"
+	lockoutService "github.com/rshelekhov/merch-store/internal/domain/service/lockout"
 	merchService "github.com/rshelekhov/merch-store/internal/domain/service/merch"
+	serviceAccountService "github.com/rshelekhov/merch-store/internal/domain/service/serviceaccount"
+	sessionService "github.com/rshelekhov/merch-store/internal/domain/service/session"
+	ssoService "github.com/rshelekhov/merch-store/internal/domain/service/sso"
+	teamService "github.com/rshelekhov/merch-store/internal/domain/service/team"
 	"github.com/rshelekhov/merch-store/internal/domain/service/token"
+	twoFactorService "github.com/rshelekhov/merch-store/internal/domain/service/twofactor"
 	userService "github.com/rshelekhov/merch-store/internal/domain/service/user"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/auction"
 	"github.com/rshelekhov/merch-store/internal/domain/usecase/auth"
 	"github.com/rshelekhov/merch-store/internal/domain/usecase/coins"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/inventory"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/merch"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/offboarding"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/password"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/profile"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/raffle"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/returns"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/serviceaccount"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/team"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/twofactor"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/waitlist"
 	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/blob"
 	coinsDB "github.com/rshelekhov/merch-store/internal/infrastructure/storage/coins"
 	merchDB "github.com/rshelekhov/merch-store/internal/infrastructure/storage/merch"
+	serviceAccountDB "github.com/rshelekhov/merch-store/internal/infrastructure/storage/serviceaccount"
+	sessionDB "github.com/rshelekhov/merch-store/internal/infrastructure/storage/session"
+	teamDB "github.com/rshelekhov/merch-store/internal/infrastructure/storage/team"
 	userDB "github.com/rshelekhov/merch-store/internal/infrastructure/storage/user"
+	"github.com/rshelekhov/merch-store/internal/lib/jwk"
+	"github.com/rshelekhov/merch-store/internal/lib/middleware/apikey"
 	"github.com/rshelekhov/merch-store/internal/lib/middleware/jwt"
+	"github.com/rshelekhov/merch-store/internal/lib/middleware/rbac"
+	"github.com/rshelekhov/merch-store/internal/lib/oidc"
 )
 
 type App struct {
 	HTTPServer *http.App
+	Worker     *worker.App
 	dbConn     *storage.DBConnection
 }
 
@@ -42,33 +75,175 @@ func New(cfg *config.ServerSettings, log *slog.Logger) (*App, error) {
 	// Init storages
 	coinsStorage := coinsDB.NewStorage(dbConn.Postgres.Pool, txMgr)
 	merchStorage := merchDB.NewStorage(dbConn.Postgres.Pool, txMgr)
-	userStorage := userDB.NewStorage(dbConn.Postgres.Pool)
+	userStorage := userDB.NewStorage(dbConn.Postgres.Pool, txMgr)
+	sessionStorage := sessionDB.NewStorage(dbConn.Postgres.Pool, txMgr)
+	serviceAccountStorage := serviceAccountDB.NewStorage(dbConn.Postgres.Pool, txMgr)
+	teamStorage := teamDB.NewStorage(dbConn.Postgres.Pool, txMgr)
+
+	blobStorage, err := blob.NewLocalStorage(cfg.Images.StorageDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init blob storage: %w", err)
+	}
+
+	keys, err := jwk.New(settings.ToKeySetConfig(cfg.JWT))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load jwt keys: %w", err)
+	}
 
 	// Init managers
 	coinsMgr := coinsService.New(coinsStorage)
 	merchMgr := merchService.New(merchStorage)
 	userMgr := userService.New(userStorage)
-	tokenService := newTokenService(cfg.JWT, cfg.PasswordHash)
+	sessionMgr := sessionService.New(sessionStorage, cfg.JWT.TTL)
+	serviceAccountMgr := serviceAccountService.New(serviceAccountStorage)
+	teamMgr := teamService.New(teamStorage)
+	lockoutMgr := lockoutService.New(sessionStorage, settings.ToLockoutConfig(cfg.Lockout))
+	tokenService, err := newTokenService(cfg, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load password peppers: %w", err)
+	}
+	imageMgr := imageService.New(blobStorage, settings.ToImageConfig(cfg.Images))
+	ssoMgr, err := newSSOService(cfg.OIDC, sessionStorage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init single sign-on: %w", err)
+	}
+	twoFactorMgr, err := newTwoFactorService(cfg.TwoFactor, userStorage, sessionStorage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load totp encryption keys: %w", err)
+	}
+
+	// Revoked tokens must be rejected right after a restart, not after the first sync,
+	// so the service doesn't start serving requests without them
+	if err = sessionMgr.SyncRevocations(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to load token revocations: %w", err)
+	}
 
 	// Init usecases
-	authUsecase := auth.NewUsecase(log, userMgr, tokenService, tokenService)
-	coinsUsecase := coins.NewUsecase(log, tokenService, userMgr, coinsMgr, merchMgr, txMgr)
+	authUsecase := auth.NewUsecase(
+		log,
+		tokenService,
+		userMgr,
+		sessionMgr,
+		tokenService,
+		tokenService,
+		lockoutMgr,
+		ssoMgr,
+		twoFactorMgr,
+		txMgr,
+		settings.ToRegistrationConfig(cfg.Registration),
+	)
+	passwordUsecase := password.NewUsecase(log, tokenService, userMgr, sessionMgr, tokenService, tokenService, lockoutMgr, txMgr)
+	coinsUsecase := coins.NewUsecase(
+		log,
+		tokenService,
+		userMgr,
+		coinsMgr,
+		merchMgr,
+		twoFactorMgr,
+		lockoutMgr,
+		txMgr,
+		cfg.TwoFactor.SendCoinThreshold,
+	)
+	merchUsecase := merch.NewUsecase(log, merchMgr, imageMgr)
+	inventoryUsecase := inventory.NewUsecase(log, tokenService, userMgr, merchMgr, txMgr)
+	returnsUsecase := returns.NewUsecase(log, tokenService, userMgr, coinsMgr, merchMgr, txMgr, cfg.Merch.ReturnWindow)
+	auctionUsecase := auction.NewUsecase(log, tokenService, coinsMgr, merchMgr, txMgr)
+	raffleUsecase := raffle.NewUsecase(log, tokenService, coinsMgr, merchMgr, txMgr)
+	waitlistUsecase := waitlist.NewUsecase(log, tokenService, coinsMgr, merchMgr, txMgr, cfg.Merch.ReservationTTL)
+	serviceAccountUsecase := serviceaccount.NewUsecase(log, tokenService, serviceAccountMgr, tokenService)
+	profileUsecase := profile.NewUsecase(log, tokenService, userMgr)
+	offboardingUsecase := offboarding.NewUsecase(log, tokenService, userMgr, sessionMgr, coinsMgr, merchMgr, txMgr)
+	twoFactorUsecase := twofactor.NewUsecase(log, tokenService, userMgr, twoFactorMgr, lockoutMgr, txMgr)
+	teamUsecase := team.NewUsecase(log, tokenService, userMgr, teamMgr, coinsMgr, merchMgr, txMgr)
+
+	if err = bootstrapSuperadmin(log, authUsecase, cfg.Bootstrap); err != nil {
+		return nil, err
+	}
 
 	validate := validator.New()
 
 	// Init handlers
 	authHandler := handler.NewAuthHandler(log, validate, authUsecase)
 	coinsHandler := handler.NewCoinsHandler(log, validate, coinsUsecase)
+	merchHandler := handler.NewMerchHandler(log, validate, merchUsecase)
+	returnsHandler := handler.NewReturnsHandler(log, validate, returnsUsecase)
+	inventoryHandler := handler.NewInventoryHandler(log, validate, inventoryUsecase)
+	waitlistHandler := handler.NewWaitlistHandler(log, validate, waitlistUsecase)
+	auctionsHandler := handler.NewAuctionsHandler(log, validate, auctionUsecase)
+	rafflesHandler := handler.NewRafflesHandler(log, validate, raffleUsecase)
+	imagesHandler := handler.NewImagesHandler(log, merchUsecase, cfg.Images.MaxUploadSize, cfg.Images.CacheMaxAge)
+	jwksHandler := handler.NewJWKSHandler(keys, cfg.JWT.JWKSCacheMaxAge)
+	passwordHandler := handler.NewPasswordHandler(log, validate, passwordUsecase)
+	serviceAccountsHandler := handler.NewServiceAccountsHandler(log, validate, serviceAccountUsecase)
+	profileHandler := handler.NewProfileHandler(log, profileUsecase)
+	offboardingHandler := handler.NewOffboardingHandler(log, validate, offboardingUsecase)
+	twoFactorHandler := handler.NewTwoFactorHandler(log, validate, twoFactorUsecase)
+	teamsHandler := handler.NewTeamsHandler(log, validate, teamUsecase)
 
 	// Init managers
-	jwtMgr := jwt.NewManager(cfg.JWT.Secret)
+	jwtMgr := jwt.NewManager(keys, sessionMgr)
+	apiKeyMgr := apikey.NewManager(serviceAccountUsecase)
+	rbacMgr := rbac.NewManager(log)
 
 	// Init HTTP server
-	router := v1.NewRouter(log, jwtMgr, authHandler, coinsHandler)
+	router := v1.NewRouter(
+		log,
+		jwtMgr,
+		apiKeyMgr,
+		rbacMgr,
+		authHandler,
+		coinsHandler,
+		merchHandler,
+		returnsHandler,
+		imagesHandler,
+		inventoryHandler,
+		waitlistHandler,
+		auctionsHandler,
+		rafflesHandler,
+		jwksHandler,
+		passwordHandler,
+		serviceAccountsHandler,
+		profileHandler,
+		offboardingHandler,
+		twoFactorHandler,
+		teamsHandler,
+	)
 	httpServer := http.New(cfg.HTTPServer, log, router)
 
+	// Init background worker
+	backgroundWorker := worker.New(log,
+		worker.Job{
+			Name:     "waitlist",
+			Interval: cfg.Worker.WaitlistInterval,
+			Run:      waitlistUsecase.ProcessWaitlists,
+		},
+		worker.Job{
+			Name:     "auctions",
+			Interval: cfg.Worker.AuctionsInterval,
+			Run:      auctionUsecase.CloseDueAuctions,
+		},
+		worker.Job{
+			Name:     "raffles",
+			Interval: cfg.Worker.RafflesInterval,
+			Run:      raffleUsecase.DrawDueRaffles,
+		},
+		worker.Job{
+			Name:     "revocations",
+			Interval: cfg.Worker.RevocationsInterval,
+			Run:      authUsecase.SyncRevocations,
+			// Catches up with revocations made while the rest of the application was starting
+			RunOnStart: true,
+		},
+		worker.Job{
+			Name:     "sessions_purge",
+			Interval: cfg.Worker.SessionsPurgeInterval,
+			Run:      authUsecase.PurgeExpiredSessions,
+		},
+	)
+
 	return &App{
 		HTTPServer: httpServer,
+		Worker:     backgroundWorker,
 		dbConn:     dbConn,
 	}, nil
 }
@@ -81,6 +256,9 @@ func (a *App) Stop() error {
 		return fmt.Errorf("%s:failed to stop http server: %w", method, err)
 	}
 
+	// Wait for background jobs before the database connection goes away
+	a.Worker.Stop()
+
 	// Close database connection
 	a.dbConn.Close()
 
@@ -93,13 +271,81 @@ func newDBConnection(cfg settings.Postgres) (*storage.DBConnection, error) {
 	return storage.NewDBConnection(storageConfig)
 }
 
-func newTokenService(jwt settings.JWT, passwordHash settings.PasswordHash) *token.Service {
-	jwtConfig := settings.ToJWTConfig(jwt)
+func bootstrapSuperadmin(log *slog.Logger, authUsecase *auth.Usecase, params settings.Bootstrap) error {
+	if params.SuperadminUsername == "" {
+		return nil
+	}
+
+	err := authUsecase.BootstrapSuperadmin(context.Background(), entity.UserCredentials{
+		Username: params.SuperadminUsername,
+		Password: params.SuperadminPassword,
+	}, false)
+	if err == nil || errors.Is(err, domain.ErrSuperadminAlreadyExists) {
+		return nil
+	}
+
+	// Someone may have signed up with the name first, they must not become the superadmin
+	if errors.Is(err, domain.ErrBootstrapUserExists) {
+		log.Warn("superadmin not bootstrapped, the username is taken, use cmd/superadmin -promote-existing",
+			slog.String("username", params.SuperadminUsername),
+		)
+		return nil
+	}
+
+	return fmt.Errorf("failed to bootstrap superadmin: %w", err)
+}
 
-	passwordHashConfig := settings.ToPasswordHashConfig(passwordHash)
+func newTokenService(cfg *config.ServerSettings, signer token.Signer) (*token.Service, error) {
+	passwordHash, err := settings.ToPasswordHashConfig(cfg.PasswordHash)
+	if err != nil {
+		return nil, err
+	}
 
 	return token.NewService(token.Config{
-		JWT:          jwtConfig,
-		PasswordHash: passwordHashConfig,
-	})
+		JWT:            settings.ToJWTConfig(cfg.JWT),
+		Signer:         signer,
+		PasswordHash:   passwordHash,
+		PasswordReset:  settings.ToPasswordResetConfig(cfg.PasswordReset),
+		PasswordPolicy: settings.ToPasswordPolicyConfig(cfg.PasswordPolicy),
+	}), nil
+}
+
+// newSSOService returns nil if single sign-on isn't configured. The interface is returned,
+// so a disabled service isn't passed to the usecase as a typed nil
+func newSSOService(params settings.OIDC, storage ssoService.Storage) (auth.SSOManager, error) {
+	if !params.Enabled() {
+		return nil, nil
+	}
+
+	provider, err := oidc.New(settings.ToOIDCConfig(params))
+	if err != nil {
+		return nil, err
+	}
+
+	ssoMgr, err := ssoService.New(provider, storage, settings.ToSSOConfig(params))
+	if err != nil {
+		return nil, err
+	}
+
+	return ssoMgr, nil
+}
+
+// newTwoFactorService leaves the cipher nil if no encryption keys are configured, users can't enroll then.
+// The keyring isn't passed when it's nil, so the service doesn't get a typed nil
+func newTwoFactorService(
+	params settings.TwoFactor,
+	storage twoFactorService.Storage,
+	challenges twoFactorService.ChallengeStorage,
+) (*twoFactorService.Service, error) {
+	keyring, err := settings.ToKeyring(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var cipher twoFactorService.Cipher
+	if keyring != nil {
+		cipher = keyring
+	}
+
+	return twoFactorService.New(storage, challenges, cipher, settings.ToTwoFactorConfig(params)), nil
 }
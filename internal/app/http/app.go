@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/rshelekhov/merch-store/internal/config/settings"
 )
 
@@ -18,16 +19,19 @@ type App struct {
 	address     string
 	timeout     time.Duration
 	idleTimeout time.Duration
-	server      *http.Server
+	// trustProxyHeaders takes the client IP from X-Forwarded-For or X-Real-IP
+	trustProxyHeaders bool
+	server            *http.Server
 }
 
 func New(cfg settings.HTTPServer, log *slog.Logger, router *chi.Mux) *App {
 	return &App{
-		log:         log,
-		router:      router,
-		address:     cfg.Address,
-		timeout:     cfg.Timeout,
-		idleTimeout: cfg.IdleTimeout,
+		log:               log,
+		router:            router,
+		address:           cfg.Address,
+		timeout:           cfg.Timeout,
+		idleTimeout:       cfg.IdleTimeout,
+		trustProxyHeaders: cfg.TrustProxyHeaders,
 	}
 }
 
@@ -45,9 +49,14 @@ func (a *App) Run() error {
 		slog.String("address", a.address),
 	)
 
+	var handler http.Handler = a.router
+	if a.trustProxyHeaders {
+		handler = middleware.RealIP(handler)
+	}
+
 	a.server = &http.Server{
 		Addr:         a.address,
-		Handler:      a.router,
+		Handler:      handler,
 		ReadTimeout:  a.timeout,
 		WriteTimeout: a.timeout,
 		IdleTimeout:  a.idleTimeout,
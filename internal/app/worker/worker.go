@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Job is a task the worker runs periodically until the application stops
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+	// RunOnStart runs the job as soon as the worker starts instead of after the first interval
+	RunOnStart bool
+}
+
+type App struct {
+	log    *slog.Logger
+	jobs   []Job
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func New(log *slog.Logger, jobs ...Job) *App {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &App{
+		log:    log,
+		jobs:   jobs,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Run starts every job in its own goroutine and blocks until Stop is called
+func (a *App) Run() {
+	const method = "worker.App.Run"
+
+	log := a.log.With(slog.String("method", method))
+	log.Info("worker is starting", slog.Int("jobs", len(a.jobs)))
+
+	for _, job := range a.jobs {
+		a.wg.Add(1)
+
+		go func(job Job) {
+			defer a.wg.Done()
+			a.runJob(job)
+		}(job)
+	}
+
+	a.wg.Wait()
+}
+
+// Stop cancels running jobs and waits for them to finish
+func (a *App) Stop() {
+	const method = "worker.App.Stop"
+
+	log := a.log.With(slog.String("method", method))
+	log.Info("stopping worker")
+
+	a.cancel()
+	a.wg.Wait()
+}
+
+func (a *App) runJob(job Job) {
+	log := a.log.With(slog.String("job", job.Name))
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	if job.RunOnStart {
+		a.run(job, log)
+	}
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			a.run(job, log)
+		}
+	}
+}
+
+func (a *App) run(job Job, log *slog.Logger) {
+	// Errors are logged by the job itself, the next tick simply retries
+	if err := job.Run(a.ctx); err != nil {
+		log.Debug("job failed", slog.String("error", err.Error()))
+	}
+}
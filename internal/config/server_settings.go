@@ -3,9 +3,19 @@ package config
 import "github.com/rshelekhov/merch-store/internal/config/settings"
 
 type ServerSettings struct {
-	AppEnv       string                `mapstructure:"APP_ENV"`
-	HTTPServer   settings.HTTPServer   `mapstructure:",squash"`
-	Postgres     settings.Postgres     `mapstructure:",squash"`
-	JWT          settings.JWT          `mapstructure:",squash"`
-	PasswordHash settings.PasswordHash `mapstructure:",squash"`
+	AppEnv         string                  `mapstructure:"APP_ENV"`
+	HTTPServer     settings.HTTPServer     `mapstructure:",squash"`
+	Postgres       settings.Postgres       `mapstructure:",squash"`
+	JWT            settings.JWT            `mapstructure:",squash"`
+	PasswordHash   settings.PasswordHash   `mapstructure:",squash"`
+	PasswordReset  settings.PasswordReset  `mapstructure:",squash"`
+	PasswordPolicy settings.PasswordPolicy `mapstructure:",squash"`
+	Lockout        settings.Lockout        `mapstructure:",squash"`
+	Merch          settings.Merch          `mapstructure:",squash"`
+	Bootstrap      settings.Bootstrap      `mapstructure:",squash"`
+	Registration   settings.Registration   `mapstructure:",squash"`
+	OIDC           settings.OIDC           `mapstructure:",squash"`
+	TwoFactor      settings.TwoFactor      `mapstructure:",squash"`
+	Images         settings.Images         `mapstructure:",squash"`
+	Worker         settings.Worker         `mapstructure:",squash"`
 }
@@ -0,0 +1,8 @@
+package settings
+
+// Bootstrap creates the first superadmin on startup if there is none yet
+type Bootstrap struct {
+	SuperadminUsername string `mapstructure:"BOOTSTRAP_SUPERADMIN_USERNAME"`
+	// SuperadminPassword is only used if the user doesn't exist, an existing user is promoted
+	SuperadminPassword string `mapstructure:"BOOTSTRAP_SUPERADMIN_PASSWORD"`
+}
@@ -6,4 +6,7 @@ type HTTPServer struct {
 	Address     string        `mapstructure:"HTTP_SERVER_ADDRESS" envDefault:"localhost:8081"`
 	Timeout     time.Duration `mapstructure:"HTTP_SERVER_TIMEOUT" envDefault:"10s"`
 	IdleTimeout time.Duration `mapstructure:"HTTP_SERVER_IDLE_TIMEOUT" envDefault:"60s"`
+	// TrustProxyHeaders takes the client IP from X-Forwarded-For or X-Real-IP.
+	// Only enable it behind a reverse proxy that sets these headers, clients can forge them
+	TrustProxyHeaders bool `mapstructure:"HTTP_SERVER_TRUST_PROXY_HEADERS" envDefault:"false"`
 }
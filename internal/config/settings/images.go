@@ -0,0 +1,21 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain/service/image"
+)
+
+type Images struct {
+	StorageDir    string        `mapstructure:"IMAGES_STORAGE_DIR" envDefault:"./data/images"`
+	MaxUploadSize int64         `mapstructure:"IMAGES_MAX_UPLOAD_SIZE" envDefault:"5242880"`
+	ThumbnailSize int           `mapstructure:"IMAGES_THUMBNAIL_SIZE" envDefault:"256"`
+	CacheMaxAge   time.Duration `mapstructure:"IMAGES_CACHE_MAX_AGE" envDefault:"8760h"`
+}
+
+func ToImageConfig(params Images) image.Config {
+	return image.Config{
+		MaxUploadSize: params.MaxUploadSize,
+		ThumbnailSize: params.ThumbnailSize,
+	}
+}
@@ -4,16 +4,33 @@ import (
 	"time"
 
 	"github.com/rshelekhov/merch-store/internal/domain/service/token"
+	"github.com/rshelekhov/merch-store/internal/lib/jwk"
 )
 
 type JWT struct {
-	Secret string        `mapstructure:"JWT_SECRET" envDefault:"secret"`
-	TTL    time.Duration `mapstructure:"JWT_TTL" envDefault:"24h"`
+	SigningMethod   string        `mapstructure:"JWT_SIGNING_METHOD" envDefault:"HS256"`
+	Secret          string        `mapstructure:"JWT_SECRET" envDefault:"secret"`
+	SigningKeyID    string        `mapstructure:"JWT_SIGNING_KEY_ID"`
+	PrivateKeys     []string      `mapstructure:"JWT_PRIVATE_KEYS"`
+	PublicKeys      []string      `mapstructure:"JWT_PUBLIC_KEYS"`
+	JWKSCacheMaxAge time.Duration `mapstructure:"JWT_JWKS_CACHE_MAX_AGE" envDefault:"5m"`
+	TTL             time.Duration `mapstructure:"JWT_TTL" envDefault:"15m"`
+	RefreshTTL      time.Duration `mapstructure:"JWT_REFRESH_TTL" envDefault:"720h"`
 }
 
 func ToJWTConfig(params JWT) token.JWT {
 	return token.JWT{
-		Secret: params.Secret,
-		TTL:    params.TTL,
+		TTL:        params.TTL,
+		RefreshTTL: params.RefreshTTL,
+	}
+}
+
+func ToKeySetConfig(params JWT) jwk.Config {
+	return jwk.Config{
+		Algorithm:    params.SigningMethod,
+		Secret:       params.Secret,
+		SigningKeyID: params.SigningKeyID,
+		PrivateKeys:  params.PrivateKeys,
+		PublicKeys:   params.PublicKeys,
 	}
 }
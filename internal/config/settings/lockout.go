@@ -0,0 +1,29 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain/service/lockout"
+)
+
+type Lockout struct {
+	MaxFailuresPerUsername int           `mapstructure:"LOCKOUT_MAX_FAILURES_PER_USERNAME" envDefault:"5"`
+	MaxFailuresPerIP       int           `mapstructure:"LOCKOUT_MAX_FAILURES_PER_IP" envDefault:"50"`
+	BackoffAfter           int           `mapstructure:"LOCKOUT_BACKOFF_AFTER" envDefault:"2"`
+	BackoffBase            time.Duration `mapstructure:"LOCKOUT_BACKOFF_BASE" envDefault:"1s"`
+	BackoffMax             time.Duration `mapstructure:"LOCKOUT_BACKOFF_MAX" envDefault:"1m"`
+	Duration               time.Duration `mapstructure:"LOCKOUT_DURATION" envDefault:"15m"`
+	FailureWindow          time.Duration `mapstructure:"LOCKOUT_FAILURE_WINDOW" envDefault:"15m"`
+}
+
+func ToLockoutConfig(params Lockout) lockout.Config {
+	return lockout.Config{
+		MaxFailuresPerUsername: params.MaxFailuresPerUsername,
+		MaxFailuresPerIP:       params.MaxFailuresPerIP,
+		BackoffAfter:           params.BackoffAfter,
+		BackoffBase:            params.BackoffBase,
+		BackoffMax:             params.BackoffMax,
+		LockoutDuration:        params.Duration,
+		FailureWindow:          params.FailureWindow,
+	}
+}
@@ -0,0 +1,8 @@
+package settings
+
+import "time"
+
+type Merch struct {
+	ReturnWindow   time.Duration `mapstructure:"MERCH_RETURN_WINDOW" envDefault:"336h"`
+	ReservationTTL time.Duration `mapstructure:"MERCH_RESERVATION_TTL" envDefault:"24h"`
+}
@@ -0,0 +1,44 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain/service/sso"
+	"github.com/rshelekhov/merch-store/internal/lib/oidc"
+)
+
+type OIDC struct {
+	// IssuerURL enables single sign-on, it's disabled when empty
+	IssuerURL     string        `mapstructure:"OIDC_ISSUER_URL"`
+	ClientID      string        `mapstructure:"OIDC_CLIENT_ID"`
+	ClientSecret  string        `mapstructure:"OIDC_CLIENT_SECRET"`
+	RedirectURL   string        `mapstructure:"OIDC_REDIRECT_URL"`
+	Scopes        []string      `mapstructure:"OIDC_SCOPES" envDefault:"email,profile"`
+	UsernameClaim string        `mapstructure:"OIDC_USERNAME_CLAIM" envDefault:"email"`
+	LoginTTL      time.Duration `mapstructure:"OIDC_LOGIN_TTL" envDefault:"10m"`
+	// AllowedEmailDomains are required with the email username claim
+	AllowedEmailDomains []string `mapstructure:"OIDC_ALLOWED_EMAIL_DOMAINS"`
+}
+
+func (params OIDC) Enabled() bool {
+	return params.IssuerURL != ""
+}
+
+func ToOIDCConfig(params OIDC) oidc.Config {
+	return oidc.Config{
+		IssuerURL:    params.IssuerURL,
+		ClientID:     params.ClientID,
+		ClientSecret: params.ClientSecret,
+		RedirectURL:  params.RedirectURL,
+		Scopes:       params.Scopes,
+	}
+}
+
+func ToSSOConfig(params OIDC) sso.Config {
+	return sso.Config{
+		Issuer:              params.IssuerURL,
+		LoginTTL:            params.LoginTTL,
+		UsernameClaim:       params.UsernameClaim,
+		AllowedEmailDomains: params.AllowedEmailDomains,
+	}
+}
@@ -1,15 +1,62 @@
 package settings
 
-import "github.com/rshelekhov/merch-store/internal/domain/service/token"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rshelekhov/merch-store/internal/domain/service/token"
+)
 
 type PasswordHash struct {
-	Pepper     string `mapstructure:"PASSWORD_HASH_PEPPER"`
-	BcryptCost int    `mapstructure:"PASSWORD_HASH_BCRYPT_COST" envDefault:"10"`
+	// Pepper is the unversioned pepper of hashes made before peppers were versioned
+	Pepper string `mapstructure:"PASSWORD_HASH_PEPPER"`
+	// Peppers are "version=pepper" entries
+	Peppers           []string `mapstructure:"PASSWORD_HASH_PEPPERS"`
+	PepperVersion     string   `mapstructure:"PASSWORD_HASH_PEPPER_VERSION"`
+	Argon2Memory      uint32   `mapstructure:"PASSWORD_HASH_ARGON2_MEMORY" envDefault:"65536"`
+	Argon2Iterations  uint32   `mapstructure:"PASSWORD_HASH_ARGON2_ITERATIONS" envDefault:"3"`
+	Argon2Parallelism uint8    `mapstructure:"PASSWORD_HASH_ARGON2_PARALLELISM" envDefault:"2"`
 }
 
-func ToPasswordHashConfig(params PasswordHash) token.PasswordHash {
-	return token.PasswordHash{
-		Pepper:     params.Pepper,
-		BcryptCost: params.BcryptCost,
+func ToPasswordHashConfig(params PasswordHash) (token.PasswordHash, error) {
+	peppers := make(map[string]string, len(params.Peppers))
+
+	for _, entry := range params.Peppers {
+		version, pepper, ok := strings.Cut(entry, "=")
+		version = strings.TrimSpace(version)
+		if !ok || version == "" || pepper == "" {
+			return token.PasswordHash{}, fmt.Errorf("invalid pepper entry for version %q, expected version=pepper", version)
+		}
+
+		if _, ok = peppers[version]; ok {
+			return token.PasswordHash{}, fmt.Errorf("duplicate pepper version %q", version)
+		}
+
+		peppers[version] = pepper
+	}
+
+	pepperVersion := params.PepperVersion
+	if pepperVersion == "" && len(peppers) == 1 {
+		for version := range peppers {
+			pepperVersion = version
+		}
 	}
+
+	if _, ok := peppers[pepperVersion]; pepperVersion != "" && !ok {
+		return token.PasswordHash{}, fmt.Errorf("current pepper version %q is not among the peppers", pepperVersion)
+	}
+
+	if pepperVersion == "" && len(peppers) > 0 {
+		return token.PasswordHash{}, errors.New("PASSWORD_HASH_PEPPER_VERSION must be set when there are several peppers")
+	}
+
+	return token.PasswordHash{
+		Pepper:            params.Pepper,
+		Peppers:           peppers,
+		PepperVersion:     pepperVersion,
+		Argon2Memory:      params.Argon2Memory,
+		Argon2Iterations:  params.Argon2Iterations,
+		Argon2Parallelism: params.Argon2Parallelism,
+	}, nil
 }
@@ -0,0 +1,25 @@
+package settings
+
+import "github.com/rshelekhov/merch-store/internal/domain/service/token"
+
+type PasswordPolicy struct {
+	MinLength        int  `mapstructure:"PASSWORD_POLICY_MIN_LENGTH" envDefault:"8"`
+	MaxLength        int  `mapstructure:"PASSWORD_POLICY_MAX_LENGTH" envDefault:"128"`
+	RequireLowercase bool `mapstructure:"PASSWORD_POLICY_REQUIRE_LOWERCASE" envDefault:"false"`
+	RequireUppercase bool `mapstructure:"PASSWORD_POLICY_REQUIRE_UPPERCASE" envDefault:"false"`
+	RequireDigit     bool `mapstructure:"PASSWORD_POLICY_REQUIRE_DIGIT" envDefault:"false"`
+	RequireSymbol    bool `mapstructure:"PASSWORD_POLICY_REQUIRE_SYMBOL" envDefault:"false"`
+	RejectCommon     bool `mapstructure:"PASSWORD_POLICY_REJECT_COMMON" envDefault:"true"`
+}
+
+func ToPasswordPolicyConfig(params PasswordPolicy) token.PasswordPolicy {
+	return token.PasswordPolicy{
+		MinLength:        params.MinLength,
+		MaxLength:        params.MaxLength,
+		RequireLowercase: params.RequireLowercase,
+		RequireUppercase: params.RequireUppercase,
+		RequireDigit:     params.RequireDigit,
+		RequireSymbol:    params.RequireSymbol,
+		RejectCommon:     params.RejectCommon,
+	}
+}
@@ -0,0 +1,17 @@
+package settings
+
+import (
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain/service/token"
+)
+
+type PasswordReset struct {
+	TTL time.Duration `mapstructure:"PASSWORD_RESET_TTL" envDefault:"24h"`
+}
+
+func ToPasswordResetConfig(params PasswordReset) token.PasswordReset {
+	return token.PasswordReset{
+		TTL: params.TTL,
+	}
+}
@@ -0,0 +1,17 @@
+package settings
+
+import "github.com/rshelekhov/merch-store/internal/domain/usecase/auth"
+
+type Registration struct {
+	AutoRegister     bool     `mapstructure:"REGISTRATION_AUTO_REGISTER" envDefault:"true"`
+	AllowedUsernames []string `mapstructure:"REGISTRATION_ALLOWED_USERNAMES"`
+	InviteCodes      []string `mapstructure:"REGISTRATION_INVITE_CODES"`
+}
+
+func ToRegistrationConfig(params Registration) auth.RegistrationConfig {
+	return auth.RegistrationConfig{
+		AutoRegister:     params.AutoRegister,
+		AllowedUsernames: params.AllowedUsernames,
+		InviteCodes:      params.InviteCodes,
+	}
+}
@@ -0,0 +1,76 @@
+package settings
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain/service/twofactor"
+	"github.com/rshelekhov/merch-store/internal/lib/encryption"
+)
+
+type TwoFactor struct {
+	// Issuer is shown next to the account in authenticator apps
+	Issuer string `mapstructure:"TOTP_ISSUER" envDefault:"Merch Store"`
+	// EncryptionKeys are "version=key" entries with base64 encoded 32-byte keys. Without them
+	// users can't enroll authenticators
+	EncryptionKeys       []string      `mapstructure:"TOTP_ENCRYPTION_KEYS"`
+	EncryptionKeyVersion string        `mapstructure:"TOTP_ENCRYPTION_KEY_VERSION"`
+	Skew                 int           `mapstructure:"TOTP_SKEW" envDefault:"1"`
+	ChallengeTTL         time.Duration `mapstructure:"TOTP_CHALLENGE_TTL" envDefault:"5m"`
+	RecoveryCodes        int           `mapstructure:"TOTP_RECOVERY_CODES" envDefault:"10"`
+	// SendCoinThreshold is the amount above which sending coins needs a TOTP code, 0 disables the check
+	SendCoinThreshold int `mapstructure:"TOTP_SEND_COIN_THRESHOLD" envDefault:"0"`
+}
+
+// ToKeyring returns nil if no encryption keys are configured
+func ToKeyring(params TwoFactor) (*encryption.Keyring, error) {
+	if len(params.EncryptionKeys) == 0 {
+		return nil, nil
+	}
+
+	keys := make(map[string][]byte, len(params.EncryptionKeys))
+
+	for _, entry := range params.EncryptionKeys {
+		version, encodedKey, ok := strings.Cut(entry, "=")
+		version = strings.TrimSpace(version)
+		if !ok || version == "" || encodedKey == "" {
+			return nil, fmt.Errorf("invalid encryption key entry for version %q, expected version=key", version)
+		}
+
+		if _, ok = keys[version]; ok {
+			return nil, fmt.Errorf("duplicate encryption key version %q", version)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %q is not valid base64: %w", version, err)
+		}
+
+		keys[version] = key
+	}
+
+	keyVersion := params.EncryptionKeyVersion
+	if keyVersion == "" && len(keys) == 1 {
+		for version := range keys {
+			keyVersion = version
+		}
+	}
+
+	if keyVersion == "" {
+		return nil, errors.New("TOTP_ENCRYPTION_KEY_VERSION must be set when there are several encryption keys")
+	}
+
+	return encryption.NewKeyring(keys, keyVersion)
+}
+
+func ToTwoFactorConfig(params TwoFactor) twofactor.Config {
+	return twofactor.Config{
+		Issuer:        params.Issuer,
+		Skew:          params.Skew,
+		ChallengeTTL:  params.ChallengeTTL,
+		RecoveryCodes: params.RecoveryCodes,
+	}
+}
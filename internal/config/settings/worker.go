@@ -0,0 +1,11 @@
+package settings
+
+import "time"
+
+type Worker struct {
+	WaitlistInterval      time.Duration `mapstructure:"WORKER_WAITLIST_INTERVAL" envDefault:"1m"`
+	AuctionsInterval      time.Duration `mapstructure:"WORKER_AUCTIONS_INTERVAL" envDefault:"30s"`
+	RafflesInterval       time.Duration `mapstructure:"WORKER_RAFFLES_INTERVAL" envDefault:"1m"`
+	RevocationsInterval   time.Duration `mapstructure:"WORKER_REVOCATIONS_INTERVAL" envDefault:"15s"`
+	SessionsPurgeInterval time.Duration `mapstructure:"WORKER_SESSIONS_PURGE_INTERVAL" envDefault:"1h"`
+}
@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type AuctionsHandler struct {
+	log      *slog.Logger
+	validate *validator.Validate
+	usecase  AuctionUsecase
+}
+
+type AuctionUsecase interface {
+	CreateAuction(ctx context.Context, itemName string, reservePrice int, startsAt, endsAt time.Time) (entity.Auction, error)
+	ListAuctions(ctx context.Context, status entity.AuctionStatus) ([]entity.Auction, error)
+	GetAuction(ctx context.Context, auctionID string) (entity.Auction, []entity.AuctionBid, error)
+	PlaceBid(ctx context.Context, auctionID string, amount int) (entity.AuctionBid, error)
+}
+
+func NewAuctionsHandler(log *slog.Logger, validate *validator.Validate, usecase AuctionUsecase) *AuctionsHandler {
+	return &AuctionsHandler{
+		log:      log,
+		validate: validate,
+		usecase:  usecase,
+	}
+}
+
+type (
+	CreateAuctionRequest struct {
+		Item         string    `json:"item" validate:"required"`
+		ReservePrice int       `json:"reservePrice" validate:"required"`
+		StartsAt     time.Time `json:"startsAt"`
+		EndsAt       time.Time `json:"endsAt" validate:"required"`
+	}
+
+	PlaceBidRequest struct {
+		Amount int `json:"amount" validate:"required"`
+	}
+
+	AuctionResponse struct {
+		ID            string     `json:"id"`
+		Item          string     `json:"item"`
+		ReservePrice  int        `json:"reservePrice"`
+		Status        string     `json:"status"`
+		HighestBid    int        `json:"highestBid"`
+		HighestBidder string     `json:"highestBidder,omitempty"`
+		StartsAt      time.Time  `json:"startsAt"`
+		EndsAt        time.Time  `json:"endsAt"`
+		ClosedAt      *time.Time `json:"closedAt,omitempty"`
+	}
+
+	AuctionsResponse struct {
+		Auctions []AuctionResponse `json:"auctions"`
+	}
+
+	AuctionBidResponse struct {
+		ID     string    `json:"id"`
+		User   string    `json:"user,omitempty"`
+		Amount int       `json:"amount"`
+		Status string    `json:"status"`
+		Date   time.Time `json:"date"`
+	}
+
+	AuctionDetailsResponse struct {
+		AuctionResponse
+		Bids []AuctionBidResponse `json:"bids"`
+	}
+)
+
+func (h *AuctionsHandler) CreateAuction() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.CreateAuction"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &CreateAuctionRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		// Auctions without an explicit start open right away
+		if request.StartsAt.IsZero() {
+			request.StartsAt = time.Now()
+		}
+
+		ctx := r.Context()
+
+		auction, err := h.usecase.CreateAuction(ctx, request.Item, request.ReservePrice, request.StartsAt, request.EndsAt)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to create auction: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to create auction: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("auction created",
+			slog.String("auctionID", auction.ID),
+			slog.String("item", request.Item),
+		)
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, toAuctionResponse(auction))
+	}
+}
+
+func (h *AuctionsHandler) ListAuctions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListAuctions"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+		status := entity.AuctionStatus(r.URL.Query().Get("status"))
+
+		auctions, err := h.usecase.ListAuctions(ctx, status)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to list auctions: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to list auctions: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toAuctionsResponse(auctions))
+	}
+}
+
+func (h *AuctionsHandler) GetAuction() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.GetAuction"
+
+		log := h.log.With(slog.String("op", op))
+
+		auctionID := chi.URLParam(r, "auctionID")
+		if auctionID == "" {
+			err := fmt.Errorf("%s: auction id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		auction, bids, err := h.usecase.GetAuction(ctx, auctionID)
+		if err != nil {
+			if errors.Is(err, domain.ErrAuctionNotFound) {
+				err = fmt.Errorf("%s: failed to get auction: %w", op, err)
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to get auction: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toAuctionDetailsResponse(auction, bids))
+	}
+}
+
+func (h *AuctionsHandler) PlaceBid() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.PlaceBid"
+
+		log := h.log.With(slog.String("op", op))
+
+		auctionID := chi.URLParam(r, "auctionID")
+		if auctionID == "" {
+			err := fmt.Errorf("%s: auction id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		request := &PlaceBidRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		bid, err := h.usecase.PlaceBid(ctx, auctionID, request.Amount)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to place bid: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to place bid: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("bid placed",
+			slog.String("auctionID", auctionID),
+			slog.Int("amount", request.Amount),
+		)
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, toAuctionBidResponse(bid))
+	}
+}
@@ -2,12 +2,16 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
 	"github.com/rshelekhov/merch-store/internal/domain/entity"
 )
 
@@ -18,7 +22,17 @@ type AuthHandler struct {
 }
 
 type AuthUsecase interface {
-	Authenticate(ctx context.Context, credentials entity.UserCredentials) (string, error)
+	Authenticate(ctx context.Context, credentials entity.UserCredentials, clientIP string) (entity.TokenPair, error)
+	CompleteSecondFactor(ctx context.Context, challengeToken, code, clientIP string) (entity.TokenPair, error)
+	Register(ctx context.Context, credentials entity.UserCredentials, inviteCode string) (entity.TokenPair, error)
+	StartOIDCLogin(ctx context.Context) (string, error)
+	AuthenticateOIDC(ctx context.Context, code, state string) (entity.TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (entity.TokenPair, error)
+	Logout(ctx context.Context, refreshToken string) error
+	RevokeAllSessions(ctx context.Context, userID string) error
+	SetUserRole(ctx context.Context, userID string, role entity.Role) error
+	UnlockUser(ctx context.Context, userID string) error
+	LinkUserIdentity(ctx context.Context, userID, subject string) error
 }
 
 func NewAuthHandler(log *slog.Logger, validate *validator.Validate, usecase AuthUsecase) *AuthHandler {
@@ -34,8 +48,39 @@ type AuthRequest struct {
 	Password string `json:"password" validate:"required"`
 }
 
+// SecondFactorRequest completes a login Auth answered with a second factor challenge.
+// Code is a code of the authenticator or a recovery code
+type SecondFactorRequest struct {
+	MfaToken string `json:"mfaToken" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+}
+
+type RegisterRequest struct {
+	Username   string `json:"username" validate:"required"`
+	Password   string `json:"password" validate:"required"`
+	InviteCode string `json:"inviteCode,omitempty"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refreshToken,omitempty"`
+}
+
+type SetUserRoleRequest struct {
+	Role string `json:"role" validate:"required"`
+}
+
+// LinkUserIdentityRequest has the sub claim of the account at the configured identity provider
+type LinkUserIdentityRequest struct {
+	Subject string `json:"subject" validate:"required"`
+}
+
 type AuthResponse struct {
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
 }
 
 func (h *AuthHandler) Auth() http.HandlerFunc {
@@ -59,8 +104,28 @@ func (h *AuthHandler) Auth() http.HandlerFunc {
 		ctx := r.Context()
 		user := toUserCredentials(request)
 
-		token, err := h.usecase.Authenticate(ctx, user)
+		tokens, err := h.usecase.Authenticate(ctx, user, clientIP(r))
 		if err != nil {
+			if handleLoginThrottledError(w, r, fmt.Errorf("failed to authenticate user: %w", err), log) {
+				return
+			}
+
+			if handleSecondFactorRequiredError(w, r, err, log) {
+				return
+			}
+
+			// The password of an implicit sign-up is checked against the policy,
+			// the user should know why the account wasn't created
+			if handlePasswordPolicyError(w, r, fmt.Errorf("failed to register user: %w", err), log) {
+				return
+			}
+
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("failed to authenticate user: %w", domain.ErrInvalidCredentials)
+				handleUnauthorizedError(w, r, err, log)
+				return
+			}
+
 			err = fmt.Errorf("failed to authenticate user: %w", err)
 			handleInternalError(w, r, err, log)
 			return
@@ -69,6 +134,402 @@ func (h *AuthHandler) Auth() http.HandlerFunc {
 		log.Info("user authenticated", slog.String("Username", user.Username))
 
 		render.Status(r, http.StatusOK)
-		render.JSON(w, r, AuthResponse{Token: token})
+		render.JSON(w, r, toAuthResponse(tokens))
+	}
+}
+
+// SecondFactor completes the login with a code of the authenticator and responds with the same tokens as Auth
+func (h *AuthHandler) SecondFactor() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.SecondFactor"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &SecondFactorRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("failed to decode request: %w", err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		tokens, err := h.usecase.CompleteSecondFactor(r.Context(), request.MfaToken, request.Code, clientIP(r))
+		if err != nil {
+			if handleLoginThrottledError(w, r, fmt.Errorf("failed to authenticate user: %w", err), log) {
+				return
+			}
+
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("failed to authenticate user: %w", err)
+				handleUnauthorizedError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("failed to authenticate user: %w", err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("user authenticated with second factor")
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toAuthResponse(tokens))
+	}
+}
+
+func (h *AuthHandler) Register() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.Register"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &RegisterRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("failed to decode request: %w", err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+		user := toRegisterCredentials(request)
+
+		tokens, err := h.usecase.Register(ctx, user, request.InviteCode)
+		if err != nil {
+			if handlePasswordPolicyError(w, r, fmt.Errorf("failed to register user: %w", err), log) {
+				return
+			}
+
+			switch {
+			case errors.Is(err, domain.ErrBadRequest):
+				err = fmt.Errorf("failed to register user: %w", err)
+				handleBadRequestError(w, r, err, log)
+			case errors.Is(err, domain.ErrRegistrationNotAllowed):
+				err = fmt.Errorf("failed to register user: %w", err)
+				handleForbiddenError(w, r, err, log)
+			case errors.Is(err, domain.ErrUserAlreadyExists):
+				err = fmt.Errorf("failed to register user: %w", err)
+				handleConflictError(w, r, err, log)
+			default:
+				err = fmt.Errorf("failed to register user: %w", err)
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		log.Info("user registered", slog.String("Username", user.Username))
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, toAuthResponse(tokens))
+	}
+}
+
+// OIDCLogin redirects the user to the identity provider
+func (h *AuthHandler) OIDCLogin() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.OIDCLogin"
+
+		log := h.log.With(slog.String("op", op))
+
+		authURL, err := h.usecase.StartOIDCLogin(r.Context())
+		if err != nil {
+			if errors.Is(err, domain.ErrOIDCNotConfigured) {
+				err = fmt.Errorf("failed to start login: %w", err)
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("failed to start login: %w", err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		http.Redirect(w, r, authURL, http.StatusFound)
+	}
+}
+
+// OIDCCallback is where the identity provider sends the user back to. It responds
+// with the same tokens as Auth
+func (h *AuthHandler) OIDCCallback() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.OIDCCallback"
+
+		log := h.log.With(slog.String("op", op))
+
+		query := r.URL.Query()
+
+		// The user may have cancelled the login, or the provider refused it
+		if providerErr := query.Get("error"); providerErr != "" {
+			err := fmt.Errorf("failed to authenticate user: %w: %s %s",
+				domain.ErrOIDCLoginRejected, providerErr, query.Get("error_description"))
+			handleUnauthorizedError(w, r, err, log)
+			return
+		}
+
+		code, state := query.Get("code"), query.Get("state")
+		if code == "" || state == "" {
+			err := fmt.Errorf("%s: code and state are required", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		tokens, err := h.usecase.AuthenticateOIDC(r.Context(), code, state)
+		if err != nil {
+			if handleSecondFactorRequiredError(w, r, err, log) {
+				return
+			}
+
+			switch {
+			case errors.Is(err, domain.ErrOIDCNotConfigured):
+				err = fmt.Errorf("failed to authenticate user: %w", err)
+				handleNotFoundError(w, r, err, log)
+			case errors.Is(err, domain.ErrBadRequest):
+				err = fmt.Errorf("failed to authenticate user: %w", err)
+				handleUnauthorizedError(w, r, err, log)
+			case errors.Is(err, domain.ErrUserIdentityAlreadyExists), errors.Is(err, domain.ErrUserAlreadyExists):
+				err = fmt.Errorf("failed to authenticate user: %w", err)
+				handleConflictError(w, r, err, log)
+			case errors.Is(err, domain.ErrOIDCLinkRequired):
+				err = fmt.Errorf("failed to authenticate user: %w", err)
+				handleForbiddenError(w, r, err, log)
+			default:
+				err = fmt.Errorf("failed to authenticate user: %w", err)
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		log.Info("user authenticated with single sign-on")
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toAuthResponse(tokens))
+	}
+}
+
+func (h *AuthHandler) Refresh() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.Refresh"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &RefreshRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("failed to decode request: %w", err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		tokens, err := h.usecase.Refresh(ctx, request.RefreshToken)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("failed to refresh tokens: %w", domain.ErrInvalidRefreshToken)
+				handleUnauthorizedError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("failed to refresh tokens: %w", err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toAuthResponse(tokens))
+	}
+}
+
+func (h *AuthHandler) Logout() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.Logout"
+
+		log := h.log.With(slog.String("op", op))
+
+		// The body is optional, without it only the access token is revoked
+		request := &LogoutRequest{}
+		if err := render.Decode(r, request); err != nil && !errors.Is(err, io.EOF) {
+			err = fmt.Errorf("failed to decode request: %w", err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.Logout(ctx, request.RefreshToken); err != nil {
+			err = fmt.Errorf("failed to logout: %w", err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+func (h *AuthHandler) RevokeUserSessions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.RevokeUserSessions"
+
+		log := h.log.With(slog.String("op", op))
+
+		userID := chi.URLParam(r, "userID")
+		if userID == "" {
+			err := fmt.Errorf("%s: user id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.RevokeAllSessions(ctx, userID); err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				err = fmt.Errorf("%s: failed to revoke sessions: %w", op, err)
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to revoke sessions: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("user sessions revoked", slog.String("userID", userID))
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+func (h *AuthHandler) SetUserRole() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.SetUserRole"
+
+		log := h.log.With(slog.String("op", op))
+
+		userID := chi.URLParam(r, "userID")
+		if userID == "" {
+			err := fmt.Errorf("%s: user id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		request := &SetUserRoleRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.SetUserRole(ctx, userID, entity.Role(request.Role)); err != nil {
+			switch {
+			case errors.Is(err, domain.ErrBadRequest):
+				err = fmt.Errorf("%s: failed to set user role: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+			case errors.Is(err, domain.ErrUserNotFound):
+				err = fmt.Errorf("%s: failed to set user role: %w", op, err)
+				handleNotFoundError(w, r, err, log)
+			default:
+				err = fmt.Errorf("%s: failed to set user role: %w", op, err)
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+// LinkUserIdentity lets the user sign in with an account at the identity provider
+func (h *AuthHandler) LinkUserIdentity() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.LinkUserIdentity"
+
+		log := h.log.With(slog.String("op", op))
+
+		userID := chi.URLParam(r, "userID")
+		if userID == "" {
+			err := fmt.Errorf("%s: user id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		request := &LinkUserIdentityRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.LinkUserIdentity(ctx, userID, request.Subject); err != nil {
+			err = fmt.Errorf("%s: failed to link user identity: %w", op, err)
+
+			switch {
+			case errors.Is(err, domain.ErrOIDCNotConfigured), errors.Is(err, domain.ErrUserNotFound):
+				handleNotFoundError(w, r, err, log)
+			case errors.Is(err, domain.ErrUserIdentityAlreadyExists):
+				handleConflictError(w, r, err, log)
+			default:
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+	}
+}
+
+func (h *AuthHandler) UnlockUser() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.UnlockUser"
+
+		log := h.log.With(slog.String("op", op))
+
+		userID := chi.URLParam(r, "userID")
+		if userID == "" {
+			err := fmt.Errorf("%s: user id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.UnlockUser(ctx, userID); err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				err = fmt.Errorf("%s: failed to unlock user: %w", op, err)
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to unlock user: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
 	}
 }
@@ -22,8 +22,9 @@ type CoinsHandler struct {
 
 type CoinsUsecase interface {
 	GetUserInfo(ctx context.Context) (entity.UserInfo, error)
-	SendCoin(ctx context.Context, toUser string, amount int) error
+	SendCoin(ctx context.Context, toUser string, amount int, totpCode, clientIP string) error
 	BuyMerch(ctx context.Context, itemName string) error
+	GrantCoins(ctx context.Context, toUser string, amount int) error
 }
 
 func NewCoinsHandler(log *slog.Logger, validate *validator.Validate, usecase CoinsUsecase) *CoinsHandler {
@@ -75,6 +76,8 @@ func (h *CoinsHandler) GetInfo() http.HandlerFunc {
 type SendCoinRequest struct {
 	ToUser string `json:"toUser" validate:"required"`
 	Amount int    `json:"amount" validate:"required"`
+	// TotpCode is required for amounts above the configured threshold
+	TotpCode string `json:"totpCode,omitempty"`
 }
 
 func (h *CoinsHandler) SendCoin() http.HandlerFunc {
@@ -97,8 +100,20 @@ func (h *CoinsHandler) SendCoin() http.HandlerFunc {
 
 		ctx := r.Context()
 
-		err := h.usecase.SendCoin(ctx, request.ToUser, request.Amount)
+		err := h.usecase.SendCoin(ctx, request.ToUser, request.Amount, request.TotpCode, clientIP(r))
 		if err != nil {
+			if handleLoginThrottledError(w, r, fmt.Errorf("%s: failed to send coin: %w", op, err), log) {
+				return
+			}
+
+			if errors.Is(err, domain.ErrTwoFactorNotEnabled) ||
+				errors.Is(err, domain.ErrTOTPCodeRequired) ||
+				errors.Is(err, domain.ErrInvalidTOTPCode) {
+				err = fmt.Errorf("%s: failed to send coin: %w", op, err)
+				handleForbiddenError(w, r, err, log)
+				return
+			}
+
 			if errors.Is(err, domain.ErrBadRequest) {
 				err = fmt.Errorf("%s: failed to send coin: %w", op, err)
 				handleBadRequestError(w, r, err, log)
@@ -152,3 +167,44 @@ func (h *CoinsHandler) BuyMerch() http.HandlerFunc {
 		render.Status(r, http.StatusOK)
 	}
 }
+
+type GrantCoinsRequest struct {
+	ToUser string `json:"toUser" validate:"required"`
+	Amount int    `json:"amount" validate:"required"`
+}
+
+func (h *CoinsHandler) GrantCoins() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.GrantCoins"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &GrantCoinsRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.GrantCoins(ctx, request.ToUser, request.Amount); err != nil {
+			err = fmt.Errorf("%s: failed to grant coins: %w", op, err)
+
+			if errors.Is(err, domain.ErrBadRequest) {
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+	}
+}
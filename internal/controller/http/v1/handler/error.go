@@ -4,16 +4,38 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/render"
 	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
 )
 
-type ErrorResponse struct {
-	Error string `json:"error"`
-}
+type (
+	ErrorResponse struct {
+		Error string `json:"error"`
+	}
+
+	PasswordPolicyErrorResponse struct {
+		Error      string                      `json:"error"`
+		Violations []PasswordViolationResponse `json:"violations"`
+	}
+
+	PasswordViolationResponse struct {
+		Rule    string `json:"rule"`
+		Message string `json:"message"`
+	}
+
+	SecondFactorRequiredResponse struct {
+		Error     string    `json:"error"`
+		MfaToken  string    `json:"mfaToken"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+)
 
 func handleValidationErrors(w http.ResponseWriter, r *http.Request, err error, log *slog.Logger) {
 	var validationErrors validator.ValidationErrors
@@ -49,3 +71,63 @@ func processValidationErrors(errors validator.ValidationErrors) string {
 
 	return strings.Join(errMsgs, "; ")
 }
+
+// handlePasswordPolicyError responds with the rules the password breaks.
+// It returns false if err is not a password policy error
+func handlePasswordPolicyError(w http.ResponseWriter, r *http.Request, err error, log *slog.Logger) bool {
+	var policyErr *domain.PasswordPolicyError
+	if !errors.As(err, &policyErr) {
+		return false
+	}
+
+	log.Error(err.Error())
+
+	render.Status(r, http.StatusBadRequest)
+	render.JSON(w, r, toPasswordPolicyErrorResponse(policyErr))
+
+	return true
+}
+
+// handleLoginThrottledError responds with 423 for a locked account and 429 otherwise,
+// Retry-After tells when the next attempt is allowed. It returns false if err is not a throttling error
+func handleLoginThrottledError(w http.ResponseWriter, r *http.Request, err error, log *slog.Logger) bool {
+	var throttledErr *domain.LoginThrottledError
+	if !errors.As(err, &throttledErr) {
+		return false
+	}
+
+	log.Warn(err.Error())
+
+	retryAfter := max(int(math.Ceil(throttledErr.RetryAfter.Seconds())), 1)
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+
+	status := http.StatusTooManyRequests
+	if errors.Is(err, domain.ErrAccountLocked) {
+		status = http.StatusLocked
+	}
+
+	render.Status(r, status)
+	render.JSON(w, r, ErrorResponse{Error: throttledErr.Error()})
+
+	return true
+}
+
+// handleSecondFactorRequiredError responds with 401 and the token to complete the login with at /api/auth/2fa.
+// It returns false if err doesn't ask for the second factor
+func handleSecondFactorRequiredError(w http.ResponseWriter, r *http.Request, err error, log *slog.Logger) bool {
+	var secondFactorErr *domain.SecondFactorRequiredError
+	if !errors.As(err, &secondFactorErr) {
+		return false
+	}
+
+	log.Info(secondFactorErr.Error())
+
+	render.Status(r, http.StatusUnauthorized)
+	render.JSON(w, r, SecondFactorRequiredResponse{
+		Error:     secondFactorErr.Error(),
+		MfaToken:  secondFactorErr.ChallengeToken,
+		ExpiresAt: secondFactorErr.ExpiresAt,
+	})
+
+	return true
+}
@@ -2,7 +2,9 @@ package handler
 
 import (
 	"log/slog"
+	"net"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/render"
 )
@@ -20,3 +22,63 @@ func handleBadRequestError(w http.ResponseWriter, r *http.Request, err error, lo
 	render.Status(r, http.StatusBadRequest)
 	render.JSON(w, r, ErrorResponse{Error: err.Error()})
 }
+
+func handleUnauthorizedError(w http.ResponseWriter, r *http.Request, err error, log *slog.Logger) {
+	log.Error(err.Error())
+
+	render.Status(r, http.StatusUnauthorized)
+	render.JSON(w, r, ErrorResponse{Error: err.Error()})
+}
+
+func handleForbiddenError(w http.ResponseWriter, r *http.Request, err error, log *slog.Logger) {
+	log.Error(err.Error())
+
+	render.Status(r, http.StatusForbidden)
+	render.JSON(w, r, ErrorResponse{Error: err.Error()})
+}
+
+func handleConflictError(w http.ResponseWriter, r *http.Request, err error, log *slog.Logger) {
+	log.Error(err.Error())
+
+	render.Status(r, http.StatusConflict)
+	render.JSON(w, r, ErrorResponse{Error: err.Error()})
+}
+
+func handleNotFoundError(w http.ResponseWriter, r *http.Request, err error, log *slog.Logger) {
+	log.Error(err.Error())
+
+	render.Status(r, http.StatusNotFound)
+	render.JSON(w, r, ErrorResponse{Error: err.Error()})
+}
+
+func parseOptionalInt(value string) (*int, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func parseIntOrZero(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(value)
+}
+
+// clientIP is the address the request came from. Behind a proxy it relies on RemoteAddr
+// being rewritten from the forwarding headers, see HTTP_SERVER_TRUST_PROXY_HEADERS
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
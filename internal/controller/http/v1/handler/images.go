@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+// ImagesPath is the public route prefix images are served from
+const ImagesPath = "/api/images/"
+
+// multipartOverhead is the room left for multipart headers and boundaries on top of the image itself
+const multipartOverhead = 64 << 10
+
+type ImagesHandler struct {
+	log           *slog.Logger
+	usecase       ImagesUsecase
+	maxUploadSize int64
+	cacheMaxAge   time.Duration
+}
+
+type ImagesUsecase interface {
+	UploadMerchImage(ctx context.Context, itemName string, data []byte) (entity.MerchImage, error)
+	DeleteMerchImage(ctx context.Context, imageID string) error
+	GetImage(ctx context.Context, key string) (entity.Blob, error)
+}
+
+func NewImagesHandler(
+	log *slog.Logger,
+	usecase ImagesUsecase,
+	maxUploadSize int64,
+	cacheMaxAge time.Duration,
+) *ImagesHandler {
+	return &ImagesHandler{
+		log:           log,
+		usecase:       usecase,
+		maxUploadSize: maxUploadSize,
+		cacheMaxAge:   cacheMaxAge,
+	}
+}
+
+type MerchImageResponse struct {
+	ID           string `json:"id"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnailUrl"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+}
+
+func (h *ImagesHandler) UploadMerchImage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.UploadMerchImage"
+
+		log := h.log.With(slog.String("op", op))
+
+		item := chi.URLParam(r, "item")
+		if item == "" {
+			err := fmt.Errorf("%s: item is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, h.maxUploadSize+multipartOverhead)
+
+		file, _, err := r.FormFile("image")
+		if err != nil {
+			err = fmt.Errorf("%s: failed to read image from form: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+		defer file.Close()
+
+		// Read one byte more than allowed, so the usecase can tell an oversized image apart
+		data, err := io.ReadAll(io.LimitReader(file, h.maxUploadSize+1))
+		if err != nil {
+			err = fmt.Errorf("%s: failed to read image: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		image, err := h.usecase.UploadMerchImage(ctx, item, data)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to upload image: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to upload image: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("merch image uploaded", slog.String("item", item), slog.String("imageID", image.ID))
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, toMerchImageResponse(image))
+	}
+}
+
+func (h *ImagesHandler) DeleteMerchImage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.DeleteMerchImage"
+
+		log := h.log.With(slog.String("op", op))
+
+		imageID := chi.URLParam(r, "imageID")
+		if imageID == "" {
+			err := fmt.Errorf("%s: image id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.DeleteMerchImage(ctx, imageID); err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to delete image: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to delete image: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("merch image deleted", slog.String("imageID", imageID))
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+// ServeImage serves stored images. Image keys are never reused, so responses can be cached
+// by browsers and proxies for a long time and revalidated by the key as ETag
+func (h *ImagesHandler) ServeImage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ServeImage"
+
+		log := h.log.With(slog.String("op", op))
+
+		key := chi.URLParam(r, "*")
+		etag := strconv.Quote(key)
+
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d, immutable", int(h.cacheMaxAge.Seconds())))
+		w.Header().Set("ETag", etag)
+
+		if match := r.Header.Get("If-None-Match"); match != "" && strings.Contains(match, etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		ctx := r.Context()
+
+		blob, err := h.usecase.GetImage(ctx, key)
+		if err != nil {
+			w.Header().Del("Cache-Control")
+			w.Header().Del("ETag")
+
+			if errors.Is(err, domain.ErrImageNotFound) {
+				err = fmt.Errorf("%s: failed to get image: %w", op, err)
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to get image: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+		defer blob.Content.Close()
+
+		w.Header().Set("Content-Type", blob.ContentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(blob.Size, 10))
+		w.Header().Set("Last-Modified", blob.ModTime.UTC().Format(http.TimeFormat))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+
+		if _, err = io.Copy(w, blob.Content); err != nil {
+			log.Error("failed to write image", slog.String("error", err.Error()))
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type InventoryHandler struct {
+	log      *slog.Logger
+	validate *validator.Validate
+	usecase  InventoryUsecase
+}
+
+type InventoryUsecase interface {
+	TransferItems(ctx context.Context, toUsername, itemName string, quantity int) error
+	ListItemTransfers(ctx context.Context) ([]entity.ItemTransfer, error)
+}
+
+func NewInventoryHandler(log *slog.Logger, validate *validator.Validate, usecase InventoryUsecase) *InventoryHandler {
+	return &InventoryHandler{
+		log:      log,
+		validate: validate,
+		usecase:  usecase,
+	}
+}
+
+type (
+	SendItemRequest struct {
+		ToUser   string `json:"toUser" validate:"required"`
+		Item     string `json:"item" validate:"required"`
+		Quantity int    `json:"quantity" validate:"required"`
+	}
+
+	ItemTransferResponse struct {
+		ID       string    `json:"id"`
+		FromUser string    `json:"fromUser"`
+		ToUser   string    `json:"toUser"`
+		Item     string    `json:"item"`
+		Quantity int       `json:"quantity"`
+		Date     time.Time `json:"date"`
+	}
+
+	ItemTransfersResponse struct {
+		Transfers []ItemTransferResponse `json:"transfers"`
+	}
+)
+
+func (h *InventoryHandler) SendItem() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.SendItem"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &SendItemRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		err := h.usecase.TransferItems(ctx, request.ToUser, request.Item, request.Quantity)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to send item: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to send item: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("item sent",
+			slog.String("toUser", request.ToUser),
+			slog.String("item", request.Item),
+			slog.Int("quantity", request.Quantity),
+		)
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+func (h *InventoryHandler) ListItemTransfers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListItemTransfers"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+
+		transfers, err := h.usecase.ListItemTransfers(ctx)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to list item transfers: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toItemTransfersResponse(transfers))
+	}
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/rshelekhov/merch-store/internal/lib/jwk"
+)
+
+// JWKSPath is where other services fetch the keys to verify access tokens
+const JWKSPath = "/.well-known/jwks.json"
+
+type JWKSHandler struct {
+	keys        KeySet
+	cacheMaxAge time.Duration
+}
+
+type KeySet interface {
+	PublicKeys() []jwk.PublicKey
+}
+
+func NewJWKSHandler(keys KeySet, cacheMaxAge time.Duration) *JWKSHandler {
+	return &JWKSHandler{
+		keys:        keys,
+		cacheMaxAge: cacheMaxAge,
+	}
+}
+
+type JWKSResponse struct {
+	Keys []jwk.PublicKey `json:"keys"`
+}
+
+func (h *JWKSHandler) GetJWKS() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Verifiers refetch the set after max-age, so it bounds how long a new key
+		// has to be published before it can be used for signing
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.cacheMaxAge.Seconds())))
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, JWKSResponse{Keys: h.keys.PublicKeys()})
+	}
+}
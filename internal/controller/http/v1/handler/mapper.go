@@ -1,6 +1,9 @@
 package handler
 
-import "github.com/rshelekhov/merch-store/internal/domain/entity"
+import (
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
 
 func toUserCredentials(request *AuthRequest) entity.UserCredentials {
 	return entity.UserCredentials{
@@ -8,3 +11,487 @@ func toUserCredentials(request *AuthRequest) entity.UserCredentials {
 		Password: request.Password,
 	}
 }
+
+func toAuthResponse(tokens entity.TokenPair) AuthResponse {
+	return AuthResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
+func toRegisterCredentials(request *RegisterRequest) entity.UserCredentials {
+	return entity.UserCredentials{
+		Username: request.Username,
+		Password: request.Password,
+	}
+}
+
+func toSearchMerchResponse(page entity.MerchPage, filter entity.MerchFilter) SearchMerchResponse {
+	items := make([]MerchResponse, len(page.Items))
+	for i, merch := range page.Items {
+		items[i] = MerchResponse{
+			Name:        merch.Name,
+			Description: merch.Description,
+			Category:    merch.Category,
+			Tags:        merch.Tags,
+			Price:       merch.Price,
+			Images:      toMerchImagesResponse(merch.Images),
+		}
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = entity.DefaultMerchPageLimit
+	}
+
+	return SearchMerchResponse{
+		Items:  items,
+		Total:  page.Total,
+		Limit:  limit,
+		Offset: filter.Offset,
+	}
+}
+
+func toMerchImagesResponse(images []entity.MerchImage) []MerchImageResponse {
+	items := make([]MerchImageResponse, len(images))
+	for i, image := range images {
+		items[i] = toMerchImageResponse(image)
+	}
+
+	return items
+}
+
+func toMerchImageResponse(image entity.MerchImage) MerchImageResponse {
+	return MerchImageResponse{
+		ID:           image.ID,
+		URL:          ImagesPath + image.Key,
+		ThumbnailURL: ImagesPath + image.ThumbnailKey,
+		Width:        image.Width,
+		Height:       image.Height,
+	}
+}
+
+func toPurchasesResponse(purchases []entity.Purchase) PurchasesResponse {
+	items := make([]PurchaseResponse, len(purchases))
+	for i, purchase := range purchases {
+		items[i] = PurchaseResponse{
+			ID:       purchase.ID,
+			Item:     purchase.Item,
+			Price:    purchase.Price,
+			Date:     purchase.CreatedAt,
+			Returned: purchase.IsReturned(),
+		}
+	}
+
+	return PurchasesResponse{Purchases: items}
+}
+
+func toMerchReturnResponse(merchReturn entity.MerchReturn) MerchReturnResponse {
+	response := MerchReturnResponse{
+		ID:           merchReturn.ID,
+		PurchaseID:   merchReturn.PurchaseID,
+		UserID:       merchReturn.UserID,
+		Item:         merchReturn.Item,
+		RefundAmount: merchReturn.RefundAmount,
+		Reason:       merchReturn.Reason,
+		Status:       merchReturn.Status.String(),
+		CreatedAt:    merchReturn.CreatedAt,
+	}
+
+	if !merchReturn.ResolvedAt.IsZero() {
+		response.ResolvedAt = &merchReturn.ResolvedAt
+	}
+
+	return response
+}
+
+func toMerchReturnsResponse(returns []entity.MerchReturn) MerchReturnsResponse {
+	items := make([]MerchReturnResponse, len(returns))
+	for i, merchReturn := range returns {
+		items[i] = toMerchReturnResponse(merchReturn)
+	}
+
+	return MerchReturnsResponse{Returns: items}
+}
+
+func toItemTransfersResponse(transfers []entity.ItemTransfer) ItemTransfersResponse {
+	items := make([]ItemTransferResponse, len(transfers))
+	for i, transfer := range transfers {
+		items[i] = ItemTransferResponse{
+			ID:       transfer.ID,
+			FromUser: transfer.FromUser,
+			ToUser:   transfer.ToUser,
+			Item:     transfer.Item,
+			Quantity: transfer.Quantity,
+			Date:     transfer.CreatedAt,
+		}
+	}
+
+	return ItemTransfersResponse{Transfers: items}
+}
+
+func toWaitlistResponse(entries []entity.WaitlistEntry) WaitlistResponse {
+	items := make([]WaitlistEntryResponse, len(entries))
+	for i, entry := range entries {
+		items[i] = WaitlistEntryResponse{
+			Item:     entry.Item,
+			Position: entry.Position,
+			JoinedAt: entry.CreatedAt,
+		}
+	}
+
+	return WaitlistResponse{Waitlist: items}
+}
+
+func toReservationsResponse(reservations []entity.Reservation) ReservationsResponse {
+	items := make([]ReservationResponse, len(reservations))
+	for i, reservation := range reservations {
+		items[i] = ReservationResponse{
+			ID:        reservation.ID,
+			Item:      reservation.Item,
+			Price:     reservation.Price,
+			Status:    reservation.Status.String(),
+			CreatedAt: reservation.CreatedAt,
+			ExpiresAt: reservation.ExpiresAt,
+		}
+
+		if !reservation.ResolvedAt.IsZero() {
+			items[i].ResolvedAt = &reservation.ResolvedAt
+		}
+	}
+
+	return ReservationsResponse{Reservations: items}
+}
+
+func toAuctionResponse(auction entity.Auction) AuctionResponse {
+	response := AuctionResponse{
+		ID:            auction.ID,
+		Item:          auction.Item,
+		ReservePrice:  auction.ReservePrice,
+		Status:        auction.Status.String(),
+		HighestBid:    auction.HighestBid.Amount,
+		HighestBidder: auction.HighestBid.Username,
+		StartsAt:      auction.StartsAt,
+		EndsAt:        auction.EndsAt,
+	}
+
+	if !auction.ClosedAt.IsZero() {
+		response.ClosedAt = &auction.ClosedAt
+	}
+
+	return response
+}
+
+func toAuctionsResponse(auctions []entity.Auction) AuctionsResponse {
+	items := make([]AuctionResponse, len(auctions))
+	for i, auction := range auctions {
+		items[i] = toAuctionResponse(auction)
+	}
+
+	return AuctionsResponse{Auctions: items}
+}
+
+func toAuctionBidResponse(bid entity.AuctionBid) AuctionBidResponse {
+	return AuctionBidResponse{
+		ID:     bid.ID,
+		User:   bid.Username,
+		Amount: bid.Amount,
+		Status: bid.Status.String(),
+		Date:   bid.CreatedAt,
+	}
+}
+
+func toAuctionDetailsResponse(auction entity.Auction, bids []entity.AuctionBid) AuctionDetailsResponse {
+	items := make([]AuctionBidResponse, len(bids))
+	for i, bid := range bids {
+		items[i] = toAuctionBidResponse(bid)
+	}
+
+	return AuctionDetailsResponse{
+		AuctionResponse: toAuctionResponse(auction),
+		Bids:            items,
+	}
+}
+
+func toRaffleResponse(raffle entity.Raffle) RaffleResponse {
+	response := RaffleResponse{
+		ID:                raffle.ID,
+		Item:              raffle.Item,
+		TicketPrice:       raffle.TicketPrice,
+		MaxTicketsPerUser: raffle.MaxTicketsPerUser,
+		Winners:           raffle.WinnersCount,
+		Status:            raffle.Status.String(),
+		TicketsSold:       raffle.TicketsSold,
+		SeedCommitment:    raffle.SeedCommitment,
+		Seed:              raffle.Seed,
+		DrawAt:            raffle.DrawAt,
+	}
+
+	if !raffle.DrawnAt.IsZero() {
+		response.DrawnAt = &raffle.DrawnAt
+	}
+
+	return response
+}
+
+func toRafflesResponse(raffles []entity.Raffle) RafflesResponse {
+	items := make([]RaffleResponse, len(raffles))
+	for i, raffle := range raffles {
+		items[i] = toRaffleResponse(raffle)
+	}
+
+	return RafflesResponse{Raffles: items}
+}
+
+func toRaffleTicketResponses(tickets []entity.RaffleTicket) []RaffleTicketResponse {
+	items := make([]RaffleTicketResponse, len(tickets))
+	for i, ticket := range tickets {
+		items[i] = RaffleTicketResponse{
+			ID:     ticket.ID,
+			Number: ticket.Number,
+			User:   ticket.Username,
+		}
+	}
+
+	return items
+}
+
+func toRaffleTicketsResponse(tickets []entity.RaffleTicket) RaffleTicketsResponse {
+	return RaffleTicketsResponse{Tickets: toRaffleTicketResponses(tickets)}
+}
+
+func toRaffleDetailsResponse(
+	raffle entity.Raffle,
+	winners []entity.RaffleWinner,
+	tickets []entity.RaffleTicket,
+) RaffleDetailsResponse {
+	items := make([]RaffleWinnerResponse, len(winners))
+	for i, winner := range winners {
+		items[i] = RaffleWinnerResponse{
+			Position:     winner.Position,
+			User:         winner.Username,
+			TicketNumber: winner.TicketNumber,
+		}
+	}
+
+	return RaffleDetailsResponse{
+		RaffleResponse: toRaffleResponse(raffle),
+		WinnersList:    items,
+		Tickets:        toRaffleTicketResponses(tickets),
+	}
+}
+
+func toPasswordResetResponse(resetToken string, token entity.PasswordResetToken) PasswordResetResponse {
+	return PasswordResetResponse{
+		ResetToken: resetToken,
+		ExpiresAt:  token.ExpiresAt,
+	}
+}
+
+func toPasswordPolicyErrorResponse(err *domain.PasswordPolicyError) PasswordPolicyErrorResponse {
+	violations := make([]PasswordViolationResponse, 0, len(err.Violations))
+	for _, v := range err.Violations {
+		violations = append(violations, PasswordViolationResponse{
+			Rule:    string(v.Rule),
+			Message: v.Message,
+		})
+	}
+
+	return PasswordPolicyErrorResponse{
+		Error:      domain.ErrPasswordPolicyViolation.Error(),
+		Violations: violations,
+	}
+}
+
+func toServiceAccountResponse(account entity.ServiceAccount) ServiceAccountResponse {
+	return ServiceAccountResponse{
+		ID:          account.ID,
+		Name:        account.Name,
+		Description: account.Description,
+		CreatedBy:   account.CreatedBy,
+		CreatedAt:   account.CreatedAt,
+	}
+}
+
+func toServiceAccountsResponse(accounts []entity.ServiceAccount) ServiceAccountsResponse {
+	items := make([]ServiceAccountResponse, len(accounts))
+	for i, account := range accounts {
+		items[i] = toServiceAccountResponse(account)
+	}
+
+	return ServiceAccountsResponse{ServiceAccounts: items}
+}
+
+func toScopes(scopes []string) []entity.Scope {
+	result := make([]entity.Scope, len(scopes))
+	for i, scope := range scopes {
+		result[i] = entity.Scope(scope)
+	}
+
+	return result
+}
+
+func toAPIKeyResponse(key entity.APIKey) APIKeyResponse {
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	response := APIKeyResponse{
+		ID:        key.ID,
+		Scopes:    scopes,
+		CreatedBy: key.CreatedBy,
+		CreatedAt: key.CreatedAt,
+	}
+
+	if !key.ExpiresAt.IsZero() {
+		response.ExpiresAt = &key.ExpiresAt
+	}
+
+	if !key.LastUsedAt.IsZero() {
+		response.LastUsedAt = &key.LastUsedAt
+	}
+
+	if !key.RevokedAt.IsZero() {
+		response.RevokedAt = &key.RevokedAt
+	}
+
+	return response
+}
+
+func toAPIKeysResponse(keys []entity.APIKey) APIKeysResponse {
+	items := make([]APIKeyResponse, len(keys))
+	for i, key := range keys {
+		items[i] = toAPIKeyResponse(key)
+	}
+
+	return APIKeysResponse{Keys: items}
+}
+
+func toUserProfileUpdate(request *UpdateProfileRequest) entity.UserProfileUpdate {
+	return entity.UserProfileUpdate{
+		DisplayName: request.DisplayName,
+		Email:       request.Email,
+		Department:  request.Department,
+		Title:       request.Title,
+		AvatarURL:   request.AvatarURL,
+	}
+}
+
+func toProfileResponse(profile entity.UserProfile) ProfileResponse {
+	return ProfileResponse{
+		ID:          profile.UserID,
+		Username:    profile.Username,
+		DisplayName: profile.DisplayName,
+		Email:       profile.Email,
+		Department:  profile.Department,
+		Title:       profile.Title,
+		AvatarURL:   profile.AvatarURL,
+		UpdatedAt:   profile.UpdatedAt,
+	}
+}
+
+func toSearchUsersResponse(page entity.UserPage, filter entity.UserFilter) SearchUsersResponse {
+	users := make([]DirectoryUserResponse, len(page.Users))
+	for i, user := range page.Users {
+		users[i] = DirectoryUserResponse{
+			ID:          user.UserID,
+			Username:    user.Username,
+			DisplayName: user.DisplayName,
+			Department:  user.Department,
+			Title:       user.Title,
+			AvatarURL:   user.AvatarURL,
+		}
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = entity.DefaultUserPageLimit
+	}
+
+	return SearchUsersResponse{
+		Users:  users,
+		Total:  page.Total,
+		Limit:  limit,
+		Offset: filter.Offset,
+	}
+}
+
+func toDeactivation(userID string, request *DeactivateUserRequest) entity.Deactivation {
+	return entity.Deactivation{
+		UserID:        userID,
+		BalancePolicy: entity.BalancePolicy(request.BalancePolicy),
+		TransferTo:    request.TransferTo,
+	}
+}
+
+func toDeactivateUserResponse(result entity.DeactivationResult) DeactivateUserResponse {
+	return DeactivateUserResponse{
+		UserID:        result.UserID,
+		BalancePolicy: string(result.BalancePolicy),
+		Balance:       result.Balance,
+		TransferredTo: result.TransferredTo,
+		DeactivatedAt: result.DeactivatedAt,
+	}
+}
+
+func toTwoFactorEnrollResponse(enrollment entity.TOTPEnrollment) TwoFactorEnrollResponse {
+	return TwoFactorEnrollResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+	}
+}
+
+func toTeamResponse(team entity.Team) TeamResponse {
+	return TeamResponse{
+		ID:         team.ID,
+		Name:       team.Name,
+		Department: team.Department,
+		Balance:    team.Balance,
+		CreatedAt:  team.CreatedAt,
+	}
+}
+
+func toTeamsResponse(teams []entity.Team) TeamsResponse {
+	response := make([]TeamResponse, len(teams))
+	for i, team := range teams {
+		response[i] = toTeamResponse(team)
+	}
+
+	return TeamsResponse{Teams: response}
+}
+
+func toTeamDetailsResponse(details entity.TeamDetails) TeamDetailsResponse {
+	members := make([]TeamMemberResponse, len(details.Members))
+	for i, member := range details.Members {
+		members[i] = TeamMemberResponse{
+			Username:    member.Username,
+			DisplayName: member.DisplayName,
+			Role:        string(member.Role),
+			JoinedAt:    member.JoinedAt,
+		}
+	}
+
+	transactions := make([]TeamTransactionResponse, len(details.Transactions))
+	for i, transaction := range details.Transactions {
+		amount := transaction.Amount
+		if !transaction.IsIncoming() {
+			amount = -amount
+		}
+
+		transactions[i] = TeamTransactionResponse{
+			ID:              transaction.ID,
+			Type:            string(transaction.Type),
+			User:            transaction.Username,
+			UserDisplayName: transaction.DisplayName,
+			Amount:          amount,
+			Date:            transaction.Date,
+		}
+	}
+
+	return TeamDetailsResponse{
+		TeamResponse: toTeamResponse(details.Team),
+		Members:      members,
+		Transactions: transactions,
+	}
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type MerchHandler struct {
+	log      *slog.Logger
+	validate *validator.Validate
+	usecase  MerchUsecase
+}
+
+type MerchUsecase interface {
+	SearchMerch(ctx context.Context, filter entity.MerchFilter) (entity.MerchPage, error)
+	ListCategories(ctx context.Context) ([]string, error)
+}
+
+func NewMerchHandler(log *slog.Logger, validate *validator.Validate, usecase MerchUsecase) *MerchHandler {
+	return &MerchHandler{
+		log:      log,
+		validate: validate,
+		usecase:  usecase,
+	}
+}
+
+type (
+	MerchResponse struct {
+		Name        string               `json:"name"`
+		Description string               `json:"description"`
+		Category    string               `json:"category"`
+		Tags        []string             `json:"tags"`
+		Price       int                  `json:"price"`
+		Images      []MerchImageResponse `json:"images"`
+	}
+
+	SearchMerchResponse struct {
+		Items  []MerchResponse `json:"items"`
+		Total  int             `json:"total"`
+		Limit  int             `json:"limit"`
+		Offset int             `json:"offset"`
+	}
+
+	CategoriesResponse struct {
+		Categories []string `json:"categories"`
+	}
+)
+
+func (h *MerchHandler) SearchMerch() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.SearchMerch"
+
+		log := h.log.With(slog.String("op", op))
+
+		filter, err := toMerchFilter(r)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to parse query parameters: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		page, err := h.usecase.SearchMerch(ctx, filter)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to search merch: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to search merch: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("merch searched", slog.String("query", filter.Query), slog.Int("total", page.Total))
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toSearchMerchResponse(page, filter))
+	}
+}
+
+func (h *MerchHandler) ListCategories() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListCategories"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+
+		categories, err := h.usecase.ListCategories(ctx)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to list categories: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, CategoriesResponse{Categories: categories})
+	}
+}
+
+func toMerchFilter(r *http.Request) (entity.MerchFilter, error) {
+	query := r.URL.Query()
+
+	filter := entity.MerchFilter{
+		Query:    query.Get("q"),
+		Category: query.Get("category"),
+		Tag:      query.Get("tag"),
+	}
+
+	var err error
+
+	if filter.MinPrice, err = parseOptionalInt(query.Get("minPrice")); err != nil {
+		return entity.MerchFilter{}, fmt.Errorf("invalid minPrice: %w", err)
+	}
+
+	if filter.MaxPrice, err = parseOptionalInt(query.Get("maxPrice")); err != nil {
+		return entity.MerchFilter{}, fmt.Errorf("invalid maxPrice: %w", err)
+	}
+
+	if filter.Limit, err = parseIntOrZero(query.Get("limit")); err != nil {
+		return entity.MerchFilter{}, fmt.Errorf("invalid limit: %w", err)
+	}
+
+	if filter.Offset, err = parseIntOrZero(query.Get("offset")); err != nil {
+		return entity.MerchFilter{}, fmt.Errorf("invalid offset: %w", err)
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type OffboardingHandler struct {
+	log      *slog.Logger
+	validate *validator.Validate
+	usecase  OffboardingUsecase
+}
+
+type OffboardingUsecase interface {
+	DeactivateUser(ctx context.Context, deactivation entity.Deactivation) (entity.DeactivationResult, error)
+}
+
+func NewOffboardingHandler(log *slog.Logger, validate *validator.Validate, usecase OffboardingUsecase) *OffboardingHandler {
+	return &OffboardingHandler{
+		log:      log,
+		validate: validate,
+		usecase:  usecase,
+	}
+}
+
+type (
+	// DeactivateUserRequest tells what to do with the remaining balance: forfeit, transfer or freeze.
+	// TransferTo is the username receiving the coins with the transfer policy
+	DeactivateUserRequest struct {
+		BalancePolicy string `json:"balancePolicy" validate:"required"`
+		TransferTo    string `json:"transferTo"`
+	}
+
+	DeactivateUserResponse struct {
+		UserID        string    `json:"userId"`
+		BalancePolicy string    `json:"balancePolicy"`
+		Balance       int       `json:"balance"`
+		TransferredTo string    `json:"transferredTo,omitempty"`
+		DeactivatedAt time.Time `json:"deactivatedAt"`
+	}
+)
+
+func (h *OffboardingHandler) DeactivateUser() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.DeactivateUser"
+
+		log := h.log.With(slog.String("op", op))
+
+		userID := chi.URLParam(r, "userID")
+		if userID == "" {
+			err := fmt.Errorf("%s: user id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		request := &DeactivateUserRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		result, err := h.usecase.DeactivateUser(ctx, toDeactivation(userID, request))
+		if err != nil {
+			err = fmt.Errorf("%s: failed to deactivate user: %w", op, err)
+
+			switch {
+			case errors.Is(err, domain.ErrBadRequest):
+				handleBadRequestError(w, r, err, log)
+			case errors.Is(err, domain.ErrUserNotFound):
+				handleNotFoundError(w, r, err, log)
+			default:
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toDeactivateUserResponse(result))
+	}
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type PasswordHandler struct {
+	log      *slog.Logger
+	validate *validator.Validate
+	usecase  PasswordUsecase
+}
+
+type PasswordUsecase interface {
+	ChangePassword(ctx context.Context, oldPassword, newPassword, clientIP string) error
+	IssuePasswordReset(ctx context.Context, userID string) (string, entity.PasswordResetToken, error)
+	ResetPassword(ctx context.Context, resetToken, newPassword string) error
+}
+
+func NewPasswordHandler(log *slog.Logger, validate *validator.Validate, usecase PasswordUsecase) *PasswordHandler {
+	return &PasswordHandler{
+		log:      log,
+		validate: validate,
+		usecase:  usecase,
+	}
+}
+
+type ChangePasswordRequest struct {
+	OldPassword string `json:"oldPassword" validate:"required"`
+	NewPassword string `json:"newPassword" validate:"required"`
+}
+
+type ResetPasswordRequest struct {
+	ResetToken  string `json:"resetToken" validate:"required"`
+	NewPassword string `json:"newPassword" validate:"required"`
+}
+
+type PasswordResetResponse struct {
+	ResetToken string    `json:"resetToken"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+func (h *PasswordHandler) ChangePassword() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ChangePassword"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &ChangePasswordRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.ChangePassword(ctx, request.OldPassword, request.NewPassword, clientIP(r)); err != nil {
+			err = fmt.Errorf("%s: failed to change password: %w", op, err)
+
+			if handleLoginThrottledError(w, r, err, log) {
+				return
+			}
+
+			if handlePasswordPolicyError(w, r, err, log) {
+				return
+			}
+
+			if errors.Is(err, domain.ErrBadRequest) {
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+func (h *PasswordHandler) IssuePasswordReset() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.IssuePasswordReset"
+
+		log := h.log.With(slog.String("op", op))
+
+		userID := chi.URLParam(r, "userID")
+		if userID == "" {
+			err := fmt.Errorf("%s: user id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		resetToken, token, err := h.usecase.IssuePasswordReset(ctx, userID)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				err = fmt.Errorf("%s: failed to issue password reset: %w", op, err)
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to issue password reset: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, toPasswordResetResponse(resetToken, token))
+	}
+}
+
+func (h *PasswordHandler) ResetPassword() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ResetPassword"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &ResetPasswordRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.ResetPassword(ctx, request.ResetToken, request.NewPassword); err != nil {
+			err = fmt.Errorf("%s: failed to reset password: %w", op, err)
+
+			if handlePasswordPolicyError(w, r, err, log) {
+				return
+			}
+
+			if errors.Is(err, domain.ErrBadRequest) {
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+	}
+}
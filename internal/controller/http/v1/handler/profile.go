@@ -0,0 +1,256 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type ProfileHandler struct {
+	log     *slog.Logger
+	usecase ProfileUsecase
+}
+
+type ProfileUsecase interface {
+	GetProfile(ctx context.Context) (entity.UserProfile, error)
+	UpdateProfile(ctx context.Context, update entity.UserProfileUpdate) (entity.UserProfile, error)
+	GetUserProfile(ctx context.Context, userID string) (entity.UserProfile, error)
+	UpdateUserProfile(ctx context.Context, userID string, update entity.UserProfileUpdate) (entity.UserProfile, error)
+	SearchUsers(ctx context.Context, filter entity.UserFilter) (entity.UserPage, error)
+}
+
+func NewProfileHandler(log *slog.Logger, usecase ProfileUsecase) *ProfileHandler {
+	return &ProfileHandler{
+		log:     log,
+		usecase: usecase,
+	}
+}
+
+type (
+	// UpdateProfileRequest changes only the fields present in the body, an empty string clears a field
+	UpdateProfileRequest struct {
+		DisplayName *string `json:"displayName"`
+		Email       *string `json:"email"`
+		Department  *string `json:"department"`
+		Title       *string `json:"title"`
+		AvatarURL   *string `json:"avatarUrl"`
+	}
+
+	ProfileResponse struct {
+		ID          string    `json:"id"`
+		Username    string    `json:"username"`
+		DisplayName string    `json:"displayName"`
+		Email       string    `json:"email"`
+		Department  string    `json:"department"`
+		Title       string    `json:"title"`
+		AvatarURL   string    `json:"avatarUrl"`
+		UpdatedAt   time.Time `json:"updatedAt"`
+	}
+
+	// DirectoryUserResponse leaves out the email, the directory is visible to every employee
+	DirectoryUserResponse struct {
+		ID          string `json:"id"`
+		Username    string `json:"username"`
+		DisplayName string `json:"displayName"`
+		Department  string `json:"department"`
+		Title       string `json:"title"`
+		AvatarURL   string `json:"avatarUrl"`
+	}
+
+	SearchUsersResponse struct {
+		Users  []DirectoryUserResponse `json:"users"`
+		Total  int                     `json:"total"`
+		Limit  int                     `json:"limit"`
+		Offset int                     `json:"offset"`
+	}
+)
+
+func (h *ProfileHandler) GetProfile() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.GetProfile"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+
+		profile, err := h.usecase.GetProfile(ctx)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to get profile: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toProfileResponse(profile))
+	}
+}
+
+func (h *ProfileHandler) UpdateProfile() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.UpdateProfile"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &UpdateProfileRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		profile, err := h.usecase.UpdateProfile(ctx, toUserProfileUpdate(request))
+		if err != nil {
+			err = fmt.Errorf("%s: failed to update profile: %w", op, err)
+
+			if errors.Is(err, domain.ErrBadRequest) {
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toProfileResponse(profile))
+	}
+}
+
+func (h *ProfileHandler) GetUserProfile() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.GetUserProfile"
+
+		log := h.log.With(slog.String("op", op))
+
+		userID := chi.URLParam(r, "userID")
+		if userID == "" {
+			err := fmt.Errorf("%s: user id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		profile, err := h.usecase.GetUserProfile(ctx, userID)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to get user profile: %w", op, err)
+
+			if errors.Is(err, domain.ErrUserNotFound) {
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toProfileResponse(profile))
+	}
+}
+
+func (h *ProfileHandler) UpdateUserProfile() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.UpdateUserProfile"
+
+		log := h.log.With(slog.String("op", op))
+
+		userID := chi.URLParam(r, "userID")
+		if userID == "" {
+			err := fmt.Errorf("%s: user id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		request := &UpdateProfileRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		profile, err := h.usecase.UpdateUserProfile(ctx, userID, toUserProfileUpdate(request))
+		if err != nil {
+			err = fmt.Errorf("%s: failed to update user profile: %w", op, err)
+
+			switch {
+			case errors.Is(err, domain.ErrUserNotFound):
+				handleNotFoundError(w, r, err, log)
+			case errors.Is(err, domain.ErrBadRequest):
+				handleBadRequestError(w, r, err, log)
+			default:
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toProfileResponse(profile))
+	}
+}
+
+func (h *ProfileHandler) SearchUsers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.SearchUsers"
+
+		log := h.log.With(slog.String("op", op))
+
+		filter, err := toUserFilter(r)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to parse query parameters: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		page, err := h.usecase.SearchUsers(ctx, filter)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to search users: %w", op, err)
+
+			if errors.Is(err, domain.ErrBadRequest) {
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toSearchUsersResponse(page, filter))
+	}
+}
+
+func toUserFilter(r *http.Request) (entity.UserFilter, error) {
+	query := r.URL.Query()
+
+	filter := entity.UserFilter{
+		Query:      query.Get("q"),
+		Department: query.Get("department"),
+	}
+
+	var err error
+
+	if filter.Limit, err = parseIntOrZero(query.Get("limit")); err != nil {
+		return entity.UserFilter{}, fmt.Errorf("invalid limit: %w", err)
+	}
+
+	if filter.Offset, err = parseIntOrZero(query.Get("offset")); err != nil {
+		return entity.UserFilter{}, fmt.Errorf("invalid offset: %w", err)
+	}
+
+	return filter, nil
+}
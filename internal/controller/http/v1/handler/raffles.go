@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type RafflesHandler struct {
+	log      *slog.Logger
+	validate *validator.Validate
+	usecase  RaffleUsecase
+}
+
+type RaffleUsecase interface {
+	CreateRaffle(ctx context.Context, itemName string, ticketPrice, maxTicketsPerUser, winnersCount int, drawAt time.Time) (entity.Raffle, error)
+	ListRaffles(ctx context.Context, status entity.RaffleStatus) ([]entity.Raffle, error)
+	GetRaffle(ctx context.Context, raffleID string) (entity.Raffle, []entity.RaffleWinner, []entity.RaffleTicket, error)
+	BuyTickets(ctx context.Context, raffleID string, quantity int) ([]entity.RaffleTicket, error)
+}
+
+func NewRafflesHandler(log *slog.Logger, validate *validator.Validate, usecase RaffleUsecase) *RafflesHandler {
+	return &RafflesHandler{
+		log:      log,
+		validate: validate,
+		usecase:  usecase,
+	}
+}
+
+type (
+	CreateRaffleRequest struct {
+		Item              string    `json:"item" validate:"required"`
+		TicketPrice       int       `json:"ticketPrice" validate:"required"`
+		MaxTicketsPerUser int       `json:"maxTicketsPerUser" validate:"required"`
+		Winners           int       `json:"winners" validate:"required"`
+		DrawAt            time.Time `json:"drawAt" validate:"required"`
+	}
+
+	BuyTicketsRequest struct {
+		Quantity int `json:"quantity" validate:"required"`
+	}
+
+	RaffleResponse struct {
+		ID                string     `json:"id"`
+		Item              string     `json:"item"`
+		TicketPrice       int        `json:"ticketPrice"`
+		MaxTicketsPerUser int        `json:"maxTicketsPerUser"`
+		Winners           int        `json:"winners"`
+		Status            string     `json:"status"`
+		TicketsSold       int        `json:"ticketsSold"`
+		SeedCommitment    string     `json:"seedCommitment"`
+		Seed              string     `json:"seed,omitempty"`
+		DrawAt            time.Time  `json:"drawAt"`
+		DrawnAt           *time.Time `json:"drawnAt,omitempty"`
+	}
+
+	RafflesResponse struct {
+		Raffles []RaffleResponse `json:"raffles"`
+	}
+
+	RaffleTicketResponse struct {
+		ID     string `json:"id"`
+		Number int    `json:"number"`
+		User   string `json:"user,omitempty"`
+	}
+
+	RaffleTicketsResponse struct {
+		Tickets []RaffleTicketResponse `json:"tickets"`
+	}
+
+	RaffleWinnerResponse struct {
+		Position     int    `json:"position"`
+		User         string `json:"user"`
+		TicketNumber int    `json:"ticketNumber"`
+	}
+
+	RaffleDetailsResponse struct {
+		RaffleResponse
+		WinnersList []RaffleWinnerResponse `json:"winnersList,omitempty"`
+		Tickets     []RaffleTicketResponse `json:"tickets,omitempty"`
+	}
+)
+
+func (h *RafflesHandler) CreateRaffle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.CreateRaffle"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &CreateRaffleRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		raffle, err := h.usecase.CreateRaffle(
+			ctx,
+			request.Item,
+			request.TicketPrice,
+			request.MaxTicketsPerUser,
+			request.Winners,
+			request.DrawAt,
+		)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to create raffle: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to create raffle: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("raffle created",
+			slog.String("raffleID", raffle.ID),
+			slog.String("item", request.Item),
+		)
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, toRaffleResponse(raffle))
+	}
+}
+
+func (h *RafflesHandler) ListRaffles() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListRaffles"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+		status := entity.RaffleStatus(r.URL.Query().Get("status"))
+
+		raffles, err := h.usecase.ListRaffles(ctx, status)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to list raffles: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to list raffles: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toRafflesResponse(raffles))
+	}
+}
+
+func (h *RafflesHandler) GetRaffle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.GetRaffle"
+
+		log := h.log.With(slog.String("op", op))
+
+		raffleID := chi.URLParam(r, "raffleID")
+		if raffleID == "" {
+			err := fmt.Errorf("%s: raffle id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		raffle, winners, tickets, err := h.usecase.GetRaffle(ctx, raffleID)
+		if err != nil {
+			if errors.Is(err, domain.ErrRaffleNotFound) {
+				err = fmt.Errorf("%s: failed to get raffle: %w", op, err)
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to get raffle: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toRaffleDetailsResponse(raffle, winners, tickets))
+	}
+}
+
+func (h *RafflesHandler) BuyTickets() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.BuyTickets"
+
+		log := h.log.With(slog.String("op", op))
+
+		raffleID := chi.URLParam(r, "raffleID")
+		if raffleID == "" {
+			err := fmt.Errorf("%s: raffle id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		request := &BuyTicketsRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		tickets, err := h.usecase.BuyTickets(ctx, raffleID, request.Quantity)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to buy tickets: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to buy tickets: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("raffle tickets bought",
+			slog.String("raffleID", raffleID),
+			slog.Int("quantity", request.Quantity),
+		)
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, toRaffleTicketsResponse(tickets))
+	}
+}
@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type ReturnsHandler struct {
+	log      *slog.Logger
+	validate *validator.Validate
+	usecase  ReturnsUsecase
+}
+
+type ReturnsUsecase interface {
+	ListPurchases(ctx context.Context) ([]entity.Purchase, error)
+	RequestReturn(ctx context.Context, purchaseID, reason string) (entity.MerchReturn, error)
+	ListUserReturns(ctx context.Context) ([]entity.MerchReturn, error)
+	ListReturns(ctx context.Context, status entity.ReturnStatus) ([]entity.MerchReturn, error)
+	ApproveReturn(ctx context.Context, returnID string) error
+	RejectReturn(ctx context.Context, returnID string) error
+}
+
+func NewReturnsHandler(log *slog.Logger, validate *validator.Validate, usecase ReturnsUsecase) *ReturnsHandler {
+	return &ReturnsHandler{
+		log:      log,
+		validate: validate,
+		usecase:  usecase,
+	}
+}
+
+type (
+	PurchaseResponse struct {
+		ID       string    `json:"id"`
+		Item     string    `json:"item"`
+		Price    int       `json:"price"`
+		Date     time.Time `json:"date"`
+		Returned bool      `json:"returned"`
+	}
+
+	PurchasesResponse struct {
+		Purchases []PurchaseResponse `json:"purchases"`
+	}
+
+	RequestReturnRequest struct {
+		Reason string `json:"reason" validate:"max=500"`
+	}
+
+	MerchReturnResponse struct {
+		ID           string     `json:"id"`
+		PurchaseID   string     `json:"purchaseId"`
+		UserID       string     `json:"userId"`
+		Item         string     `json:"item"`
+		RefundAmount int        `json:"refundAmount"`
+		Reason       string     `json:"reason"`
+		Status       string     `json:"status"`
+		CreatedAt    time.Time  `json:"createdAt"`
+		ResolvedAt   *time.Time `json:"resolvedAt,omitempty"`
+	}
+
+	MerchReturnsResponse struct {
+		Returns []MerchReturnResponse `json:"returns"`
+	}
+)
+
+func (h *ReturnsHandler) ListPurchases() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListPurchases"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+
+		purchases, err := h.usecase.ListPurchases(ctx)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to list purchases: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toPurchasesResponse(purchases))
+	}
+}
+
+func (h *ReturnsHandler) RequestReturn() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.RequestReturn"
+
+		log := h.log.With(slog.String("op", op))
+
+		purchaseID := chi.URLParam(r, "purchaseID")
+		if purchaseID == "" {
+			err := fmt.Errorf("%s: purchase id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		request := &RequestReturnRequest{}
+		if r.ContentLength != 0 {
+			if err := render.Decode(r, request); err != nil {
+				err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		merchReturn, err := h.usecase.RequestReturn(ctx, purchaseID, request.Reason)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to request return: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to request return: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("return requested",
+			slog.String("purchaseID", purchaseID),
+			slog.String("returnID", merchReturn.ID),
+		)
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, toMerchReturnResponse(merchReturn))
+	}
+}
+
+func (h *ReturnsHandler) ListUserReturns() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListUserReturns"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+
+		returns, err := h.usecase.ListUserReturns(ctx)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to list returns: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toMerchReturnsResponse(returns))
+	}
+}
+
+func (h *ReturnsHandler) ListReturns() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListReturns"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+		status := entity.ReturnStatus(r.URL.Query().Get("status"))
+
+		returns, err := h.usecase.ListReturns(ctx, status)
+		if err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to list returns: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to list returns: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toMerchReturnsResponse(returns))
+	}
+}
+
+func (h *ReturnsHandler) ApproveReturn() http.HandlerFunc {
+	return h.resolveReturn("handler.ApproveReturn", "return approved", h.usecase.ApproveReturn)
+}
+
+func (h *ReturnsHandler) RejectReturn() http.HandlerFunc {
+	return h.resolveReturn("handler.RejectReturn", "return rejected", h.usecase.RejectReturn)
+}
+
+func (h *ReturnsHandler) resolveReturn(
+	op, message string,
+	resolve func(ctx context.Context, returnID string) error,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := h.log.With(slog.String("op", op))
+
+		returnID := chi.URLParam(r, "returnID")
+		if returnID == "" {
+			err := fmt.Errorf("%s: return id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := resolve(ctx, returnID); err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to resolve return: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to resolve return: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info(message, slog.String("returnID", returnID))
+
+		render.Status(r, http.StatusOK)
+	}
+}
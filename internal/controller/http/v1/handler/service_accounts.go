@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type ServiceAccountsHandler struct {
+	log      *slog.Logger
+	validate *validator.Validate
+	usecase  ServiceAccountUsecase
+}
+
+type ServiceAccountUsecase interface {
+	CreateServiceAccount(ctx context.Context, name, description string) (entity.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context) ([]entity.ServiceAccount, error)
+	CreateAPIKey(ctx context.Context, serviceAccountID string, scopes []entity.Scope, expiresAt time.Time) (string, entity.APIKey, error)
+	ListAPIKeys(ctx context.Context, serviceAccountID string) ([]entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, serviceAccountID, keyID string) error
+}
+
+func NewServiceAccountsHandler(log *slog.Logger, validate *validator.Validate, usecase ServiceAccountUsecase) *ServiceAccountsHandler {
+	return &ServiceAccountsHandler{
+		log:      log,
+		validate: validate,
+		usecase:  usecase,
+	}
+}
+
+type (
+	CreateServiceAccountRequest struct {
+		Name        string `json:"name" validate:"required"`
+		Description string `json:"description"`
+	}
+
+	CreateAPIKeyRequest struct {
+		Scopes []string `json:"scopes" validate:"required"`
+		// ExpiresAt is optional, keys without it don't expire
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+
+	ServiceAccountResponse struct {
+		ID          string    `json:"id"`
+		Name        string    `json:"name"`
+		Description string    `json:"description,omitempty"`
+		CreatedBy   string    `json:"createdBy"`
+		CreatedAt   time.Time `json:"createdAt"`
+	}
+
+	ServiceAccountsResponse struct {
+		ServiceAccounts []ServiceAccountResponse `json:"serviceAccounts"`
+	}
+
+	APIKeyResponse struct {
+		ID         string     `json:"id"`
+		Scopes     []string   `json:"scopes"`
+		CreatedBy  string     `json:"createdBy"`
+		CreatedAt  time.Time  `json:"createdAt"`
+		ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+		LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+		RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	}
+
+	// CreatedAPIKeyResponse is the only response with the plain key
+	CreatedAPIKeyResponse struct {
+		APIKeyResponse
+		Key string `json:"key"`
+	}
+
+	APIKeysResponse struct {
+		Keys []APIKeyResponse `json:"keys"`
+	}
+)
+
+func (h *ServiceAccountsHandler) CreateServiceAccount() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.CreateServiceAccount"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &CreateServiceAccountRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		account, err := h.usecase.CreateServiceAccount(ctx, request.Name, request.Description)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to create service account: %w", op, err)
+
+			switch {
+			case errors.Is(err, domain.ErrServiceAccountAlreadyExists):
+				handleConflictError(w, r, err, log)
+			case errors.Is(err, domain.ErrBadRequest):
+				handleBadRequestError(w, r, err, log)
+			default:
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, toServiceAccountResponse(account))
+	}
+}
+
+func (h *ServiceAccountsHandler) ListServiceAccounts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListServiceAccounts"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+
+		accounts, err := h.usecase.ListServiceAccounts(ctx)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to list service accounts: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toServiceAccountsResponse(accounts))
+	}
+}
+
+func (h *ServiceAccountsHandler) CreateAPIKey() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.CreateAPIKey"
+
+		log := h.log.With(slog.String("op", op))
+
+		serviceAccountID := chi.URLParam(r, "serviceAccountID")
+		if serviceAccountID == "" {
+			err := fmt.Errorf("%s: service account id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		request := &CreateAPIKeyRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		apiKey, key, err := h.usecase.CreateAPIKey(ctx, serviceAccountID, toScopes(request.Scopes), request.ExpiresAt)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to create api key: %w", op, err)
+
+			switch {
+			case errors.Is(err, domain.ErrServiceAccountNotFound):
+				handleNotFoundError(w, r, err, log)
+			case errors.Is(err, domain.ErrBadRequest):
+				handleBadRequestError(w, r, err, log)
+			default:
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, CreatedAPIKeyResponse{
+			APIKeyResponse: toAPIKeyResponse(key),
+			Key:            apiKey,
+		})
+	}
+}
+
+func (h *ServiceAccountsHandler) ListAPIKeys() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListAPIKeys"
+
+		log := h.log.With(slog.String("op", op))
+
+		serviceAccountID := chi.URLParam(r, "serviceAccountID")
+		if serviceAccountID == "" {
+			err := fmt.Errorf("%s: service account id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		keys, err := h.usecase.ListAPIKeys(ctx, serviceAccountID)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to list api keys: %w", op, err)
+
+			if errors.Is(err, domain.ErrServiceAccountNotFound) {
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toAPIKeysResponse(keys))
+	}
+}
+
+func (h *ServiceAccountsHandler) RevokeAPIKey() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.RevokeAPIKey"
+
+		log := h.log.With(slog.String("op", op))
+
+		serviceAccountID := chi.URLParam(r, "serviceAccountID")
+		keyID := chi.URLParam(r, "keyID")
+		if serviceAccountID == "" || keyID == "" {
+			err := fmt.Errorf("%s: service account id or key id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.RevokeAPIKey(ctx, serviceAccountID, keyID); err != nil {
+			err = fmt.Errorf("%s: failed to revoke api key: %w", op, err)
+
+			if errors.Is(err, domain.ErrAPIKeyNotFound) {
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+	}
+}
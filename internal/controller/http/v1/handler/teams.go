@@ -0,0 +1,409 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type TeamsHandler struct {
+	log      *slog.Logger
+	validate *validator.Validate
+	usecase  TeamUsecase
+}
+
+type TeamUsecase interface {
+	CreateTeam(ctx context.Context, name, department string) (entity.Team, error)
+	ListTeams(ctx context.Context) ([]entity.Team, error)
+	SetTeamMember(ctx context.Context, teamID, userID string, role entity.TeamRole) error
+	RemoveTeamMember(ctx context.Context, teamID, userID string) error
+	ListUserTeams(ctx context.Context) ([]entity.Team, error)
+	GetTeam(ctx context.Context, teamID string) (entity.TeamDetails, error)
+	Contribute(ctx context.Context, teamID string, amount int) error
+	Payout(ctx context.Context, teamID, toUsername string, amount int) error
+	BuyMerch(ctx context.Context, teamID, itemName string) error
+}
+
+func NewTeamsHandler(log *slog.Logger, validate *validator.Validate, usecase TeamUsecase) *TeamsHandler {
+	return &TeamsHandler{
+		log:      log,
+		validate: validate,
+		usecase:  usecase,
+	}
+}
+
+type (
+	CreateTeamRequest struct {
+		Name       string `json:"name" validate:"required"`
+		Department string `json:"department"`
+	}
+
+	SetTeamMemberRequest struct {
+		Role string `json:"role" validate:"required"`
+	}
+
+	ContributeRequest struct {
+		Amount int `json:"amount" validate:"required"`
+	}
+
+	TeamPayoutRequest struct {
+		ToUser string `json:"toUser" validate:"required"`
+		Amount int    `json:"amount" validate:"required"`
+	}
+
+	TeamResponse struct {
+		ID         string    `json:"id"`
+		Name       string    `json:"name"`
+		Department string    `json:"department,omitempty"`
+		Balance    int       `json:"balance"`
+		CreatedAt  time.Time `json:"createdAt"`
+	}
+
+	TeamsResponse struct {
+		Teams []TeamResponse `json:"teams"`
+	}
+
+	TeamMemberResponse struct {
+		Username    string    `json:"username"`
+		DisplayName string    `json:"displayName,omitempty"`
+		Role        string    `json:"role"`
+		JoinedAt    time.Time `json:"joinedAt"`
+	}
+
+	// TeamTransactionResponse is a movement of the team wallet. Amount is negative
+	// when coins left the wallet, User is empty for purchases
+	TeamTransactionResponse struct {
+		ID              string    `json:"id"`
+		Type            string    `json:"type"`
+		User            string    `json:"user,omitempty"`
+		UserDisplayName string    `json:"userDisplayName,omitempty"`
+		Amount          int       `json:"amount"`
+		Date            time.Time `json:"date"`
+	}
+
+	TeamDetailsResponse struct {
+		TeamResponse
+		Members      []TeamMemberResponse      `json:"members"`
+		Transactions []TeamTransactionResponse `json:"transactions"`
+	}
+)
+
+func (h *TeamsHandler) CreateTeam() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.CreateTeam"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &CreateTeamRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		team, err := h.usecase.CreateTeam(ctx, request.Name, request.Department)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to create team: %w", op, err)
+
+			switch {
+			case errors.Is(err, domain.ErrTeamAlreadyExists):
+				handleConflictError(w, r, err, log)
+			case errors.Is(err, domain.ErrBadRequest):
+				handleBadRequestError(w, r, err, log)
+			default:
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, toTeamResponse(team))
+	}
+}
+
+func (h *TeamsHandler) ListTeams() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListTeams"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+
+		teams, err := h.usecase.ListTeams(ctx)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to list teams: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toTeamsResponse(teams))
+	}
+}
+
+func (h *TeamsHandler) SetTeamMember() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.SetTeamMember"
+
+		log := h.log.With(slog.String("op", op))
+
+		teamID := chi.URLParam(r, "teamID")
+		userID := chi.URLParam(r, "userID")
+		if teamID == "" || userID == "" {
+			err := fmt.Errorf("%s: team id or user id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		request := &SetTeamMemberRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.SetTeamMember(ctx, teamID, userID, entity.TeamRole(request.Role)); err != nil {
+			err = fmt.Errorf("%s: failed to set team member: %w", op, err)
+
+			switch {
+			case errors.Is(err, domain.ErrTeamNotFound),
+				errors.Is(err, domain.ErrUserNotFound):
+				handleNotFoundError(w, r, err, log)
+			case errors.Is(err, domain.ErrBadRequest):
+				handleBadRequestError(w, r, err, log)
+			default:
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+func (h *TeamsHandler) RemoveTeamMember() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.RemoveTeamMember"
+
+		log := h.log.With(slog.String("op", op))
+
+		teamID := chi.URLParam(r, "teamID")
+		userID := chi.URLParam(r, "userID")
+		if teamID == "" || userID == "" {
+			err := fmt.Errorf("%s: team id or user id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.RemoveTeamMember(ctx, teamID, userID); err != nil {
+			err = fmt.Errorf("%s: failed to remove team member: %w", op, err)
+
+			if errors.Is(err, domain.ErrTeamMemberNotFound) {
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+func (h *TeamsHandler) ListUserTeams() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListUserTeams"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+
+		teams, err := h.usecase.ListUserTeams(ctx)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to list teams: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toTeamsResponse(teams))
+	}
+}
+
+func (h *TeamsHandler) GetTeam() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.GetTeam"
+
+		log := h.log.With(slog.String("op", op))
+
+		teamID := chi.URLParam(r, "teamID")
+		if teamID == "" {
+			err := fmt.Errorf("%s: team id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		team, err := h.usecase.GetTeam(ctx, teamID)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to get team: %w", op, err)
+
+			if errors.Is(err, domain.ErrTeamNotFound) {
+				handleNotFoundError(w, r, err, log)
+				return
+			}
+
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toTeamDetailsResponse(team))
+	}
+}
+
+func (h *TeamsHandler) Contribute() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.Contribute"
+
+		log := h.log.With(slog.String("op", op))
+
+		teamID := chi.URLParam(r, "teamID")
+		if teamID == "" {
+			err := fmt.Errorf("%s: team id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		request := &ContributeRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.Contribute(ctx, teamID, request.Amount); err != nil {
+			err = fmt.Errorf("%s: failed to contribute: %w", op, err)
+			handleTeamWalletError(w, r, err, log)
+			return
+		}
+
+		log.Info("coins contributed",
+			slog.String("teamID", teamID),
+			slog.Int("amount", request.Amount),
+		)
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+func (h *TeamsHandler) Payout() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.Payout"
+
+		log := h.log.With(slog.String("op", op))
+
+		teamID := chi.URLParam(r, "teamID")
+		if teamID == "" {
+			err := fmt.Errorf("%s: team id is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		request := &TeamPayoutRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.Payout(ctx, teamID, request.ToUser, request.Amount); err != nil {
+			err = fmt.Errorf("%s: failed to pay out team coins: %w", op, err)
+			handleTeamWalletError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+func (h *TeamsHandler) BuyMerch() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.BuyTeamMerch"
+
+		log := h.log.With(slog.String("op", op))
+
+		teamID := chi.URLParam(r, "teamID")
+		itemName := chi.URLParam(r, "item")
+		if teamID == "" || itemName == "" {
+			err := fmt.Errorf("%s: team id or item name is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.BuyMerch(ctx, teamID, itemName); err != nil {
+			err = fmt.Errorf("%s: failed to buy merch for team: %w", op, err)
+			handleTeamWalletError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+// handleTeamWalletError maps errors of the wallet operations, which are shared by all of them
+func handleTeamWalletError(w http.ResponseWriter, r *http.Request, err error, log *slog.Logger) {
+	switch {
+	case errors.Is(err, domain.ErrTeamNotFound):
+		handleNotFoundError(w, r, err, log)
+	case errors.Is(err, domain.ErrNotTeamManager):
+		handleForbiddenError(w, r, err, log)
+	case errors.Is(err, domain.ErrBadRequest):
+		handleBadRequestError(w, r, err, log)
+	default:
+		handleInternalError(w, r, err, log)
+	}
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type TwoFactorHandler struct {
+	log      *slog.Logger
+	validate *validator.Validate
+	usecase  TwoFactorUsecase
+}
+
+type TwoFactorUsecase interface {
+	Enroll(ctx context.Context) (entity.TOTPEnrollment, error)
+	Confirm(ctx context.Context, code string) ([]string, error)
+	Disable(ctx context.Context, code, clientIP string) error
+}
+
+func NewTwoFactorHandler(log *slog.Logger, validate *validator.Validate, usecase TwoFactorUsecase) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		log:      log,
+		validate: validate,
+		usecase:  usecase,
+	}
+}
+
+type (
+	// TwoFactorEnrollResponse is shown once. ProvisioningURI is the otpauth:// URI to render as a QR code,
+	// Secret is for entering it into the authenticator by hand
+	TwoFactorEnrollResponse struct {
+		Secret          string `json:"secret"`
+		ProvisioningURI string `json:"provisioningUri"`
+	}
+
+	TwoFactorCodeRequest struct {
+		Code string `json:"code" validate:"required"`
+	}
+
+	TwoFactorConfirmResponse struct {
+		RecoveryCodes []string `json:"recoveryCodes"`
+	}
+)
+
+func (h *TwoFactorHandler) Enroll() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.EnrollTwoFactor"
+
+		log := h.log.With(slog.String("op", op))
+
+		enrollment, err := h.usecase.Enroll(r.Context())
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrTwoFactorNotConfigured):
+				err = fmt.Errorf("%s: failed to enroll authenticator: %w", op, err)
+				handleNotFoundError(w, r, err, log)
+			case errors.Is(err, domain.ErrTwoFactorAlreadyEnabled):
+				err = fmt.Errorf("%s: failed to enroll authenticator: %w", op, err)
+				handleConflictError(w, r, err, log)
+			default:
+				err = fmt.Errorf("%s: failed to enroll authenticator: %w", op, err)
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toTwoFactorEnrollResponse(enrollment))
+	}
+}
+
+func (h *TwoFactorHandler) Confirm() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ConfirmTwoFactor"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &TwoFactorCodeRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		recoveryCodes, err := h.usecase.Confirm(r.Context(), request.Code)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrBadRequest):
+				err = fmt.Errorf("%s: failed to confirm authenticator: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+			case errors.Is(err, domain.ErrTwoFactorAlreadyEnabled):
+				err = fmt.Errorf("%s: failed to confirm authenticator: %w", op, err)
+				handleConflictError(w, r, err, log)
+			default:
+				err = fmt.Errorf("%s: failed to confirm authenticator: %w", op, err)
+				handleInternalError(w, r, err, log)
+			}
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, TwoFactorConfirmResponse{RecoveryCodes: recoveryCodes})
+	}
+}
+
+func (h *TwoFactorHandler) Disable() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.DisableTwoFactor"
+
+		log := h.log.With(slog.String("op", op))
+
+		request := &TwoFactorCodeRequest{}
+		if err := render.Decode(r, request); err != nil {
+			err = fmt.Errorf("%s: failed to decode request: %w", op, err)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		if err := h.validate.Struct(request); err != nil {
+			handleValidationErrors(w, r, err, log)
+			return
+		}
+
+		if err := h.usecase.Disable(r.Context(), request.Code, clientIP(r)); err != nil {
+			err = fmt.Errorf("%s: failed to disable two-factor authentication: %w", op, err)
+
+			if handleLoginThrottledError(w, r, err, log) {
+				return
+			}
+
+			if errors.Is(err, domain.ErrBadRequest) {
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+	}
+}
@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type WaitlistHandler struct {
+	log      *slog.Logger
+	validate *validator.Validate
+	usecase  WaitlistUsecase
+}
+
+type WaitlistUsecase interface {
+	JoinWaitlist(ctx context.Context, itemName string) error
+	LeaveWaitlist(ctx context.Context, itemName string) error
+	ListWaitlist(ctx context.Context) ([]entity.WaitlistEntry, error)
+	ListReservations(ctx context.Context) ([]entity.Reservation, error)
+	PurchaseReservation(ctx context.Context, reservationID string) error
+}
+
+func NewWaitlistHandler(log *slog.Logger, validate *validator.Validate, usecase WaitlistUsecase) *WaitlistHandler {
+	return &WaitlistHandler{
+		log:      log,
+		validate: validate,
+		usecase:  usecase,
+	}
+}
+
+type (
+	WaitlistEntryResponse struct {
+		Item     string    `json:"item"`
+		Position int       `json:"position"`
+		JoinedAt time.Time `json:"joinedAt"`
+	}
+
+	WaitlistResponse struct {
+		Waitlist []WaitlistEntryResponse `json:"waitlist"`
+	}
+
+	ReservationResponse struct {
+		ID         string     `json:"id"`
+		Item       string     `json:"item"`
+		Price      int        `json:"price"`
+		Status     string     `json:"status"`
+		CreatedAt  time.Time  `json:"createdAt"`
+		ExpiresAt  time.Time  `json:"expiresAt"`
+		ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+	}
+
+	ReservationsResponse struct {
+		Reservations []ReservationResponse `json:"reservations"`
+	}
+)
+
+func (h *WaitlistHandler) JoinWaitlist() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.JoinWaitlist"
+
+		log := h.log.With(slog.String("op", op))
+
+		itemName := chi.URLParam(r, "item")
+		if itemName == "" {
+			err := fmt.Errorf("%s: item name is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.JoinWaitlist(ctx, itemName); err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to join waitlist: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to join waitlist: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("joined waitlist", slog.String("item", itemName))
+
+		render.Status(r, http.StatusCreated)
+	}
+}
+
+func (h *WaitlistHandler) LeaveWaitlist() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.LeaveWaitlist"
+
+		log := h.log.With(slog.String("op", op))
+
+		itemName := chi.URLParam(r, "item")
+		if itemName == "" {
+			err := fmt.Errorf("%s: item name is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.LeaveWaitlist(ctx, itemName); err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to leave waitlist: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to leave waitlist: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("left waitlist", slog.String("item", itemName))
+
+		render.Status(r, http.StatusOK)
+	}
+}
+
+func (h *WaitlistHandler) ListWaitlist() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListWaitlist"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+
+		entries, err := h.usecase.ListWaitlist(ctx)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to list waitlist: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toWaitlistResponse(entries))
+	}
+}
+
+func (h *WaitlistHandler) ListReservations() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.ListReservations"
+
+		log := h.log.With(slog.String("op", op))
+
+		ctx := r.Context()
+
+		reservations, err := h.usecase.ListReservations(ctx)
+		if err != nil {
+			err = fmt.Errorf("%s: failed to list reservations: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, toReservationsResponse(reservations))
+	}
+}
+
+func (h *WaitlistHandler) PurchaseReservation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.PurchaseReservation"
+
+		log := h.log.With(slog.String("op", op))
+
+		reservationID := chi.URLParam(r, "reservationID")
+		if reservationID == "" {
+			err := fmt.Errorf("%s: reservation ID is empty in request", op)
+			handleBadRequestError(w, r, err, log)
+			return
+		}
+
+		ctx := r.Context()
+
+		if err := h.usecase.PurchaseReservation(ctx, reservationID); err != nil {
+			if errors.Is(err, domain.ErrBadRequest) {
+				err = fmt.Errorf("%s: failed to purchase reservation: %w", op, err)
+				handleBadRequestError(w, r, err, log)
+				return
+			}
+
+			err = fmt.Errorf("%s: failed to purchase reservation: %w", op, err)
+			handleInternalError(w, r, err, log)
+			return
+		}
+
+		log.Info("reservation purchased", slog.String("reservationID", reservationID))
+
+		render.Status(r, http.StatusOK)
+	}
+}
@@ -5,39 +5,195 @@ import (
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/rshelekhov/merch-store/internal/lib/middleware/apikey"
 	"github.com/rshelekhov/merch-store/internal/lib/middleware/jwt"
+	"github.com/rshelekhov/merch-store/internal/lib/middleware/rbac"
 )
 
 type Router struct {
-	log          *slog.Logger
-	jwtMgr       jwt.Manager
-	authHandler  AuthHandler
-	coinsHandler CoinsHandler
+	log                    *slog.Logger
+	jwtMgr                 jwt.Manager
+	apiKeyMgr              apikey.Manager
+	rbacMgr                rbac.Manager
+	authHandler            AuthHandler
+	coinsHandler           CoinsHandler
+	merchHandler           MerchHandler
+	returnsHandler         ReturnsHandler
+	imagesHandler          ImagesHandler
+	inventoryHandler       InventoryHandler
+	waitlistHandler        WaitlistHandler
+	auctionsHandler        AuctionsHandler
+	rafflesHandler         RafflesHandler
+	jwksHandler            JWKSHandler
+	passwordHandler        PasswordHandler
+	serviceAccountsHandler ServiceAccountsHandler
+	profileHandler         ProfileHandler
+	offboardingHandler     OffboardingHandler
+	twoFactorHandler       TwoFactorHandler
+	teamsHandler           TeamsHandler
 }
 
 type (
 	AuthHandler interface {
 		Auth() http.HandlerFunc
+		SecondFactor() http.HandlerFunc
+		Register() http.HandlerFunc
+		Refresh() http.HandlerFunc
+		OIDCLogin() http.HandlerFunc
+		OIDCCallback() http.HandlerFunc
+		Logout() http.HandlerFunc
+		RevokeUserSessions() http.HandlerFunc
+		SetUserRole() http.HandlerFunc
+		UnlockUser() http.HandlerFunc
+		LinkUserIdentity() http.HandlerFunc
 	}
 
 	CoinsHandler interface {
 		GetInfo() http.HandlerFunc
 		SendCoin() http.HandlerFunc
 		BuyMerch() http.HandlerFunc
+		GrantCoins() http.HandlerFunc
+	}
+
+	MerchHandler interface {
+		SearchMerch() http.HandlerFunc
+		ListCategories() http.HandlerFunc
+	}
+
+	ReturnsHandler interface {
+		ListPurchases() http.HandlerFunc
+		RequestReturn() http.HandlerFunc
+		ListUserReturns() http.HandlerFunc
+		ListReturns() http.HandlerFunc
+		ApproveReturn() http.HandlerFunc
+		RejectReturn() http.HandlerFunc
+	}
+
+	ImagesHandler interface {
+		UploadMerchImage() http.HandlerFunc
+		DeleteMerchImage() http.HandlerFunc
+		ServeImage() http.HandlerFunc
+	}
+
+	InventoryHandler interface {
+		SendItem() http.HandlerFunc
+		ListItemTransfers() http.HandlerFunc
+	}
+
+	WaitlistHandler interface {
+		JoinWaitlist() http.HandlerFunc
+		LeaveWaitlist() http.HandlerFunc
+		ListWaitlist() http.HandlerFunc
+		ListReservations() http.HandlerFunc
+		PurchaseReservation() http.HandlerFunc
+	}
+
+	AuctionsHandler interface {
+		CreateAuction() http.HandlerFunc
+		ListAuctions() http.HandlerFunc
+		GetAuction() http.HandlerFunc
+		PlaceBid() http.HandlerFunc
+	}
+
+	RafflesHandler interface {
+		CreateRaffle() http.HandlerFunc
+		ListRaffles() http.HandlerFunc
+		GetRaffle() http.HandlerFunc
+		BuyTickets() http.HandlerFunc
+	}
+
+	JWKSHandler interface {
+		GetJWKS() http.HandlerFunc
+	}
+
+	PasswordHandler interface {
+		ChangePassword() http.HandlerFunc
+		IssuePasswordReset() http.HandlerFunc
+		ResetPassword() http.HandlerFunc
+	}
+
+	ServiceAccountsHandler interface {
+		CreateServiceAccount() http.HandlerFunc
+		ListServiceAccounts() http.HandlerFunc
+		CreateAPIKey() http.HandlerFunc
+		ListAPIKeys() http.HandlerFunc
+		RevokeAPIKey() http.HandlerFunc
+	}
+
+	ProfileHandler interface {
+		GetProfile() http.HandlerFunc
+		UpdateProfile() http.HandlerFunc
+		GetUserProfile() http.HandlerFunc
+		UpdateUserProfile() http.HandlerFunc
+		SearchUsers() http.HandlerFunc
+	}
+
+	OffboardingHandler interface {
+		DeactivateUser() http.HandlerFunc
+	}
+
+	TwoFactorHandler interface {
+		Enroll() http.HandlerFunc
+		Confirm() http.HandlerFunc
+		Disable() http.HandlerFunc
+	}
+
+	TeamsHandler interface {
+		CreateTeam() http.HandlerFunc
+		ListTeams() http.HandlerFunc
+		SetTeamMember() http.HandlerFunc
+		RemoveTeamMember() http.HandlerFunc
+		ListUserTeams() http.HandlerFunc
+		GetTeam() http.HandlerFunc
+		Contribute() http.HandlerFunc
+		Payout() http.HandlerFunc
+		BuyMerch() http.HandlerFunc
 	}
 )
 
 func NewRouter(
 	log *slog.Logger,
 	jwtMgr jwt.Manager,
+	apiKeyMgr apikey.Manager,
+	rbacMgr rbac.Manager,
 	authHandler AuthHandler,
 	coinsHandler CoinsHandler,
+	merchHandler MerchHandler,
+	returnsHandler ReturnsHandler,
+	imagesHandler ImagesHandler,
+	inventoryHandler InventoryHandler,
+	waitlistHandler WaitlistHandler,
+	auctionsHandler AuctionsHandler,
+	rafflesHandler RafflesHandler,
+	jwksHandler JWKSHandler,
+	passwordHandler PasswordHandler,
+	serviceAccountsHandler ServiceAccountsHandler,
+	profileHandler ProfileHandler,
+	offboardingHandler OffboardingHandler,
+	twoFactorHandler TwoFactorHandler,
+	teamsHandler TeamsHandler,
 ) *chi.Mux {
 	ar := &Router{
-		log:          log,
-		jwtMgr:       jwtMgr,
-		authHandler:  authHandler,
-		coinsHandler: coinsHandler,
+		log:                    log,
+		jwtMgr:                 jwtMgr,
+		apiKeyMgr:              apiKeyMgr,
+		rbacMgr:                rbacMgr,
+		authHandler:            authHandler,
+		coinsHandler:           coinsHandler,
+		merchHandler:           merchHandler,
+		returnsHandler:         returnsHandler,
+		imagesHandler:          imagesHandler,
+		inventoryHandler:       inventoryHandler,
+		waitlistHandler:        waitlistHandler,
+		auctionsHandler:        auctionsHandler,
+		rafflesHandler:         rafflesHandler,
+		jwksHandler:            jwksHandler,
+		passwordHandler:        passwordHandler,
+		serviceAccountsHandler: serviceAccountsHandler,
+		profileHandler:         profileHandler,
+		offboardingHandler:     offboardingHandler,
+		twoFactorHandler:       twoFactorHandler,
+		teamsHandler:           teamsHandler,
 	}
 
 	return ar.initRoutes()
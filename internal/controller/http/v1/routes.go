@@ -1,9 +1,14 @@
 package v1
 
 import (
+	"net/http"
+
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
+	"github.com/rshelekhov/merch-store/internal/controller/http/v1/handler"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/middleware/apikey"
 	mwlogger "github.com/rshelekhov/merch-store/internal/lib/middleware/logger"
 )
 
@@ -25,17 +30,147 @@ func (ar *Router) initRoutes() *chi.Mux {
 
 	r.Head("/health", HealthCheck())
 
+	r.Get(handler.JWKSPath, ar.jwksHandler.GetJWKS())
+
 	r.Post("/api/auth", ar.authHandler.Auth())
+	r.Post("/api/auth/2fa", ar.authHandler.SecondFactor())
+	r.Post("/api/auth/refresh", ar.authHandler.Refresh())
+	r.Get("/api/auth/oidc/login", ar.authHandler.OIDCLogin())
+	r.Get("/api/auth/oidc/callback", ar.authHandler.OIDCCallback())
+	r.Post("/api/register", ar.authHandler.Register())
+	r.Post("/api/password/reset", ar.passwordHandler.ResetPassword())
+
+	// Images are public, so they can be used directly in <img> tags
+	r.Get(handler.ImagesPath+"*", ar.imagesHandler.ServeImage())
+
+	r.Route("/api", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(ar.jwtMgr.HTTPMiddleware)
+
+			r.Post("/logout", ar.authHandler.Logout())
+			r.Post("/password/change", ar.passwordHandler.ChangePassword())
 
-	r.Group(func(r chi.Router) {
-		r.Use(ar.jwtMgr.HTTPMiddleware)
+			r.Post("/2fa/enroll", ar.twoFactorHandler.Enroll())
+			r.Post("/2fa/confirm", ar.twoFactorHandler.Confirm())
+			r.Post("/2fa/disable", ar.twoFactorHandler.Disable())
+
+			r.Get("/me", ar.profileHandler.GetProfile())
+			r.Patch("/me", ar.profileHandler.UpdateProfile())
+			r.Get("/users", ar.profileHandler.SearchUsers())
 
-		r.Route("/api", func(r chi.Router) {
 			r.Get("/user", ar.coinsHandler.GetInfo())
 			r.Post("/sendCoin", ar.coinsHandler.SendCoin())
 			r.Get("/buy/{item}", ar.coinsHandler.BuyMerch())
+
+			r.Post("/sendItem", ar.inventoryHandler.SendItem())
+			r.Get("/itemTransfers", ar.inventoryHandler.ListItemTransfers())
+
+			r.Post("/merch/{item}/waitlist", ar.waitlistHandler.JoinWaitlist())
+			r.Delete("/merch/{item}/waitlist", ar.waitlistHandler.LeaveWaitlist())
+			r.Get("/waitlist", ar.waitlistHandler.ListWaitlist())
+			r.Get("/reservations", ar.waitlistHandler.ListReservations())
+			r.Post("/reservations/{reservationID}/purchase", ar.waitlistHandler.PurchaseReservation())
+
+			r.Get("/auctions", ar.auctionsHandler.ListAuctions())
+			r.Get("/auctions/{auctionID}", ar.auctionsHandler.GetAuction())
+			r.Post("/auctions/{auctionID}/bids", ar.auctionsHandler.PlaceBid())
+
+			r.Get("/raffles", ar.rafflesHandler.ListRaffles())
+			r.Get("/raffles/{raffleID}", ar.rafflesHandler.GetRaffle())
+			r.Post("/raffles/{raffleID}/tickets", ar.rafflesHandler.BuyTickets())
+
+			r.Get("/purchases", ar.returnsHandler.ListPurchases())
+			r.Post("/purchases/{purchaseID}/return", ar.returnsHandler.RequestReturn())
+			r.Get("/returns", ar.returnsHandler.ListUserReturns())
+
+			r.Get("/teams", ar.teamsHandler.ListUserTeams())
+			r.Get("/teams/{teamID}", ar.teamsHandler.GetTeam())
+			r.Post("/teams/{teamID}/contribute", ar.teamsHandler.Contribute())
+			r.Post("/teams/{teamID}/sendCoin", ar.teamsHandler.Payout())
+			r.Post("/teams/{teamID}/buy/{item}", ar.teamsHandler.BuyMerch())
+		})
+
+		// Routes open to service accounts as well, they are authorized by the scopes of the key
+		r.Group(func(r chi.Router) {
+			r.Use(ar.authenticate)
+			r.Use(ar.rbacMgr.RequireScope(entity.ScopeMerchRead))
+
+			r.Get("/merch", ar.merchHandler.SearchMerch())
+			r.Get("/merch/categories", ar.merchHandler.ListCategories())
+		})
+
+		r.Route("/admin", func(r chi.Router) {
+			r.Group(func(r chi.Router) {
+				r.Use(ar.jwtMgr.HTTPMiddleware)
+
+				r.Group(func(r chi.Router) {
+					r.Use(ar.rbacMgr.Require(entity.RoleStoreAdmin))
+
+					r.Post("/merch/{item}/images", ar.imagesHandler.UploadMerchImage())
+					r.Delete("/merch/images/{imageID}", ar.imagesHandler.DeleteMerchImage())
+
+					r.Post("/auctions", ar.auctionsHandler.CreateAuction())
+					r.Post("/raffles", ar.rafflesHandler.CreateRaffle())
+				})
+
+				// Returns move coins back to users, so finance can handle them too
+				r.Group(func(r chi.Router) {
+					r.Use(ar.rbacMgr.Require(entity.RoleStoreAdmin, entity.RoleFinance))
+
+					r.Get("/returns", ar.returnsHandler.ListReturns())
+					r.Post("/returns/{returnID}/approve", ar.returnsHandler.ApproveReturn())
+					r.Post("/returns/{returnID}/reject", ar.returnsHandler.RejectReturn())
+				})
+
+				r.Group(func(r chi.Router) {
+					r.Use(ar.rbacMgr.Require(entity.RoleSuperadmin))
+
+					r.Put("/users/{userID}/role", ar.authHandler.SetUserRole())
+					r.Post("/users/{userID}/sessions/revoke", ar.authHandler.RevokeUserSessions())
+					r.Post("/users/{userID}/password/reset", ar.passwordHandler.IssuePasswordReset())
+					r.Post("/users/{userID}/unlock", ar.authHandler.UnlockUser())
+					r.Post("/users/{userID}/identities", ar.authHandler.LinkUserIdentity())
+					r.Get("/users/{userID}/profile", ar.profileHandler.GetUserProfile())
+					r.Patch("/users/{userID}/profile", ar.profileHandler.UpdateUserProfile())
+					r.Post("/users/{userID}/deactivate", ar.offboardingHandler.DeactivateUser())
+
+					r.Post("/service-accounts", ar.serviceAccountsHandler.CreateServiceAccount())
+					r.Get("/service-accounts", ar.serviceAccountsHandler.ListServiceAccounts())
+					r.Post("/service-accounts/{serviceAccountID}/keys", ar.serviceAccountsHandler.CreateAPIKey())
+					r.Get("/service-accounts/{serviceAccountID}/keys", ar.serviceAccountsHandler.ListAPIKeys())
+					r.Delete("/service-accounts/{serviceAccountID}/keys/{keyID}", ar.serviceAccountsHandler.RevokeAPIKey())
+
+					r.Post("/teams", ar.teamsHandler.CreateTeam())
+					r.Get("/teams", ar.teamsHandler.ListTeams())
+					r.Put("/teams/{teamID}/members/{userID}", ar.teamsHandler.SetTeamMember())
+					r.Delete("/teams/{teamID}/members/{userID}", ar.teamsHandler.RemoveTeamMember())
+				})
+			})
+
+			// Grants are made by finance or by bots, e.g. HR tooling rewarding anniversaries
+			r.Group(func(r chi.Router) {
+				r.Use(ar.authenticate)
+				r.Use(ar.rbacMgr.RequireScope(entity.ScopeCoinsGrant, entity.RoleFinance))
+
+				r.Post("/coins/grant", ar.coinsHandler.GrantCoins())
+			})
 		})
 	})
 
 	return r
 }
+
+// authenticate accepts both API keys of service accounts and access tokens of users
+func (ar *Router) authenticate(next http.Handler) http.Handler {
+	withAPIKey := ar.apiKeyMgr.HTTPMiddleware(next)
+	withToken := ar.jwtMgr.HTTPMiddleware(next)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if apikey.HasAPIKey(r) {
+			withAPIKey.ServeHTTP(w, r)
+			return
+		}
+
+		withToken.ServeHTTP(w, r)
+	})
+}
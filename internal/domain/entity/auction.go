@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/segmentio/ksuid"
+)
+
+type AuctionStatus string
+
+const (
+	AuctionStatusOpen   AuctionStatus = "open"
+	AuctionStatusSold   AuctionStatus = "sold"
+	AuctionStatusUnsold AuctionStatus = "unsold"
+)
+
+func (s AuctionStatus) String() string {
+	return string(s)
+}
+
+func (s AuctionStatus) IsValid() bool {
+	switch s {
+	case AuctionStatusOpen, AuctionStatusSold, AuctionStatusUnsold:
+		return true
+	default:
+		return false
+	}
+}
+
+type BidStatus string
+
+const (
+	BidStatusHeld     BidStatus = "held"
+	BidStatusRefunded BidStatus = "refunded"
+	BidStatusCaptured BidStatus = "captured"
+)
+
+func (s BidStatus) String() string {
+	return string(s)
+}
+
+type (
+	Auction struct {
+		ID           string
+		MerchID      string
+		Item         string
+		CreatedBy    string
+		ReservePrice int
+		Status       AuctionStatus
+		HighestBid   AuctionBid
+		StartsAt     time.Time
+		EndsAt       time.Time
+		CreatedAt    time.Time
+		ClosedAt     time.Time
+	}
+
+	AuctionBid struct {
+		ID         string
+		AuctionID  string
+		UserID     string
+		Username   string
+		Amount     int
+		Status     BidStatus
+		CreatedAt  time.Time
+		ResolvedAt time.Time
+	}
+
+	AuctionFilter struct {
+		Status AuctionStatus
+	}
+)
+
+func NewAuction(createdBy string, merch Merch, reservePrice int, startsAt, endsAt time.Time) Auction {
+	return Auction{
+		ID:           ksuid.New().String(),
+		MerchID:      merch.ID,
+		Item:         merch.Name,
+		CreatedBy:    createdBy,
+		ReservePrice: reservePrice,
+		Status:       AuctionStatusOpen,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+		CreatedAt:    time.Now(),
+	}
+}
+
+// NewAuctionBid creates a bid whose coins are held in escrow until it is outbid or wins
+func NewAuctionBid(auctionID, userID string, amount int) AuctionBid {
+	return AuctionBid{
+		ID:        ksuid.New().String(),
+		AuctionID: auctionID,
+		UserID:    userID,
+		Amount:    amount,
+		Status:    BidStatusHeld,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (a Auction) HasBids() bool {
+	return a.HighestBid.ID != ""
+}
+
+// AcceptsBids reports whether the auction is open and the bidding window includes now
+func (a Auction) AcceptsBids(now time.Time) bool {
+	return a.Status == AuctionStatusOpen && !now.Before(a.StartsAt) && now.Before(a.EndsAt)
+}
+
+// ReserveMet reports whether the highest bid is enough to sell the item
+func (a Auction) ReserveMet() bool {
+	return a.HasBids() && a.HighestBid.Amount >= a.ReservePrice
+}
@@ -0,0 +1,41 @@
+package entity
+
+type (
+	// CatalogItem is a merch item as it is described in catalog import and export files
+	CatalogItem struct {
+		Name     string
+		Price    int
+		Category string
+		// Stock is nil when the stock is not tracked for the item
+		Stock *int
+	}
+
+	CatalogUpdate struct {
+		Before CatalogItem
+		After  CatalogItem
+	}
+
+	CatalogReport struct {
+		Created   []CatalogItem
+		Updated   []CatalogUpdate
+		Retired   []CatalogItem
+		Unchanged int
+		DryRun    bool
+	}
+)
+
+func (i CatalogItem) Equal(other CatalogItem) bool {
+	if i.Name != other.Name || i.Price != other.Price || i.Category != other.Category {
+		return false
+	}
+
+	if i.Stock == nil || other.Stock == nil {
+		return i.Stock == nil && other.Stock == nil
+	}
+
+	return *i.Stock == *other.Stock
+}
+
+func (r CatalogReport) HasChanges() bool {
+	return len(r.Created) > 0 || len(r.Updated) > 0 || len(r.Retired) > 0
+}
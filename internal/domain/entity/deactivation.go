@@ -0,0 +1,45 @@
+package entity
+
+import "time"
+
+// DeactivatedUsername is shown in the coin history instead of the username of a deactivated counterparty
+const DeactivatedUsername = "deactivated user"
+
+// BalancePolicy decides what happens to the coins left on a deactivated account
+type BalancePolicy string
+
+const (
+	// BalancePolicyForfeit returns the coins to the store
+	BalancePolicyForfeit BalancePolicy = "forfeit"
+	// BalancePolicyTransfer sends the coins to another user, as a regular coin transfer
+	BalancePolicyTransfer BalancePolicy = "transfer"
+	// BalancePolicyFreeze keeps the coins on the account, where nobody can spend them
+	BalancePolicyFreeze BalancePolicy = "freeze"
+)
+
+func (p BalancePolicy) IsValid() bool {
+	switch p {
+	case BalancePolicyForfeit, BalancePolicyTransfer, BalancePolicyFreeze:
+		return true
+	default:
+		return false
+	}
+}
+
+// Deactivation is an admin request to offboard a user. TransferTo is the username
+// receiving the balance, it's only used with BalancePolicyTransfer
+type Deactivation struct {
+	UserID        string
+	BalancePolicy BalancePolicy
+	TransferTo    string
+}
+
+// DeactivationResult tells what happened to the account. Balance is the amount
+// that was forfeited, transferred or frozen
+type DeactivationResult struct {
+	UserID        string
+	BalancePolicy BalancePolicy
+	Balance       int
+	TransferredTo string
+	DeactivatedAt time.Time
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/segmentio/ksuid"
+)
+
+type ItemTransfer struct {
+	ID         string
+	SenderID   string
+	ReceiverID string
+	MerchID    string
+	Item       string
+	FromUser   string
+	ToUser     string
+	Quantity   int
+	CreatedAt  time.Time
+}
+
+func NewItemTransfer(senderID, receiverID string, merch Merch, quantity int) ItemTransfer {
+	return ItemTransfer{
+		ID:         ksuid.New().String(),
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+		MerchID:    merch.ID,
+		Item:       merch.Name,
+		Quantity:   quantity,
+		CreatedAt:  time.Now(),
+	}
+}
@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+type LoginScope string
+
+const (
+	LoginScopeUsername LoginScope = "username"
+	LoginScopeIP       LoginScope = "ip"
+)
+
+// LoginFailures counts failed logins of a username or from a client IP within the failure window.
+// Logins are blocked until BlockedUntil, which is a short backoff or, once Locked is set, a lockout
+type LoginFailures struct {
+	Scope        LoginScope
+	Subject      string
+	Failures     int
+	Locked       bool
+	BlockedUntil time.Time
+	LastFailedAt time.Time
+}
+
+func (f LoginFailures) IsBlocked(now time.Time) bool {
+	return now.Before(f.BlockedUntil)
+}
@@ -1,7 +1,39 @@
 package entity
 
 type Merch struct {
-	ID    string
-	Name  string
-	Price int
+	ID          string
+	Name        string
+	Description string
+	Category    string
+	Tags        []string
+	Price       int
+	Stock       *int
+	Images      []MerchImage
 }
+
+// IsStockTracked reports whether the item has a limited stock. Items without one are always available
+func (m Merch) IsStockTracked() bool {
+	return m.Stock != nil
+}
+
+type (
+	MerchFilter struct {
+		Query    string
+		Category string
+		Tag      string
+		MinPrice *int
+		MaxPrice *int
+		Limit    int
+		Offset   int
+	}
+
+	MerchPage struct {
+		Items []Merch
+		Total int
+	}
+)
+
+const (
+	DefaultMerchPageLimit = 20
+	MaxMerchPageLimit     = 100
+)
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"io"
+	"time"
+)
+
+type (
+	MerchImage struct {
+		ID           string
+		MerchID      string
+		ContentType  string
+		Key          string
+		ThumbnailKey string
+		Width        int
+		Height       int
+		CreatedAt    time.Time
+	}
+
+	// Blob is a stored binary object, the caller is responsible for closing its content
+	Blob struct {
+		Content     io.ReadCloser
+		ContentType string
+		Size        int64
+		ModTime     time.Time
+	}
+)
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/segmentio/ksuid"
+)
+
+type ReturnStatus string
+
+const (
+	ReturnStatusPending  ReturnStatus = "pending"
+	ReturnStatusApproved ReturnStatus = "approved"
+	ReturnStatusRejected ReturnStatus = "rejected"
+)
+
+func (s ReturnStatus) String() string {
+	return string(s)
+}
+
+func (s ReturnStatus) IsValid() bool {
+	switch s {
+	case ReturnStatusPending, ReturnStatusApproved, ReturnStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+type (
+	MerchReturn struct {
+		ID           string
+		PurchaseID   string
+		UserID       string
+		MerchID      string
+		Item         string
+		RefundAmount int
+		Reason       string
+		Status       ReturnStatus
+		ResolvedBy   string
+		CreatedAt    time.Time
+		ResolvedAt   time.Time
+	}
+
+	MerchReturnFilter struct {
+		Status ReturnStatus
+		UserID string
+	}
+)
+
+// NewMerchReturn creates a pending return request that refunds the price actually paid for the purchase
+func NewMerchReturn(purchase Purchase, reason string) MerchReturn {
+	return MerchReturn{
+		ID:           ksuid.New().String(),
+		PurchaseID:   purchase.ID,
+		UserID:       purchase.UserID,
+		MerchID:      purchase.MerchID,
+		Item:         purchase.Item,
+		RefundAmount: purchase.Price,
+		Reason:       reason,
+		Status:       ReturnStatusPending,
+		CreatedAt:    time.Now(),
+	}
+}
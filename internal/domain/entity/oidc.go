@@ -0,0 +1,54 @@
+package entity
+
+import "time"
+
+// OIDCLoginState is kept between the redirect to the identity provider and the callback.
+// The state ties the callback to the login, the nonce ties the ID token to it and the code verifier
+// proves to the provider that the code is redeemed by whoever started the login (PKCE)
+type OIDCLoginState struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+func NewOIDCLoginState(state, nonce, codeVerifier string, ttl time.Duration) OIDCLoginState {
+	now := time.Now()
+
+	return OIDCLoginState{
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    now.Add(ttl),
+		CreatedAt:    now,
+	}
+}
+
+// OIDCIdentity is an account at the identity provider, as asserted by a verified ID token.
+// Username is the local username derived from the configured claim
+type OIDCIdentity struct {
+	Issuer   string
+	Subject  string
+	Email    string
+	Username string
+}
+
+// UserIdentity links an account at the identity provider to a local user
+type UserIdentity struct {
+	Issuer    string
+	Subject   string
+	UserID    string
+	Email     string
+	CreatedAt time.Time
+}
+
+func NewUserIdentity(identity OIDCIdentity, userID string) UserIdentity {
+	return UserIdentity{
+		Issuer:    identity.Issuer,
+		Subject:   identity.Subject,
+		UserID:    userID,
+		Email:     identity.Email,
+		CreatedAt: time.Now(),
+	}
+}
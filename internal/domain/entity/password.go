@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/segmentio/ksuid"
+)
+
+// PasswordResetToken lets a user set a new password once, without knowing the current one.
+// It's issued by an admin and handed to the user out of band
+type PasswordResetToken struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	CreatedBy string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func NewPasswordResetToken(userID, createdBy, tokenHash string, ttl time.Duration) PasswordResetToken {
+	now := time.Now()
+
+	return PasswordResetToken{
+		ID:        ksuid.New().String(),
+		UserID:    userID,
+		TokenHash: tokenHash,
+		CreatedBy: createdBy,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+}
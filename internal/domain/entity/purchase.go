@@ -3,8 +3,21 @@ package entity
 import "time"
 
 type Purchase struct {
-	ID        string
-	UserID    string
-	MerchID   string
-	CreatedAt time.Time
+	ID         string
+	UserID     string
+	MerchID    string
+	Item       string
+	Price      int
+	CreatedAt  time.Time
+	ReturnedAt time.Time
+	// TransferID is the last item transfer that moved the purchase to its current owner
+	TransferID string
+}
+
+func (p Purchase) IsReturned() bool {
+	return !p.ReturnedAt.IsZero()
+}
+
+func (p Purchase) IsTransferred() bool {
+	return p.TransferID != ""
 }
@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/segmentio/ksuid"
+)
+
+type RaffleStatus string
+
+const (
+	RaffleStatusOpen  RaffleStatus = "open"
+	RaffleStatusDrawn RaffleStatus = "drawn"
+)
+
+func (s RaffleStatus) String() string {
+	return string(s)
+}
+
+func (s RaffleStatus) IsValid() bool {
+	switch s {
+	case RaffleStatusOpen, RaffleStatusDrawn:
+		return true
+	default:
+		return false
+	}
+}
+
+type (
+	Raffle struct {
+		ID                string
+		MerchID           string
+		Item              string
+		CreatedBy         string
+		TicketPrice       int
+		MaxTicketsPerUser int
+		WinnersCount      int
+		Status            RaffleStatus
+		SeedCommitment    string
+		Seed              string
+		TicketsSold       int
+		DrawAt            time.Time
+		CreatedAt         time.Time
+		DrawnAt           time.Time
+	}
+
+	RaffleTicket struct {
+		ID        string
+		RaffleID  string
+		UserID    string
+		Username  string
+		Number    int
+		CreatedAt time.Time
+	}
+
+	RaffleWinner struct {
+		RaffleID     string
+		TicketID     string
+		TicketNumber int
+		UserID       string
+		Username     string
+		Position     int
+	}
+
+	RaffleFilter struct {
+		Status RaffleStatus
+	}
+)
+
+func NewRaffle(
+	createdBy string,
+	merch Merch,
+	ticketPrice, maxTicketsPerUser, winnersCount int,
+	drawAt time.Time,
+	seed, seedCommitment string,
+) Raffle {
+	return Raffle{
+		ID:                ksuid.New().String(),
+		MerchID:           merch.ID,
+		Item:              merch.Name,
+		CreatedBy:         createdBy,
+		TicketPrice:       ticketPrice,
+		MaxTicketsPerUser: maxTicketsPerUser,
+		WinnersCount:      winnersCount,
+		Status:            RaffleStatusOpen,
+		SeedCommitment:    seedCommitment,
+		Seed:              seed,
+		DrawAt:            drawAt,
+		CreatedAt:         time.Now(),
+	}
+}
+
+// NewRaffleTickets creates quantity tickets numbered right after the ones already sold
+func NewRaffleTickets(raffleID, userID string, sold, quantity int) []RaffleTicket {
+	now := time.Now()
+
+	tickets := make([]RaffleTicket, quantity)
+	for i := range tickets {
+		tickets[i] = RaffleTicket{
+			ID:        ksuid.New().String(),
+			RaffleID:  raffleID,
+			UserID:    userID,
+			Number:    sold + i + 1,
+			CreatedAt: now,
+		}
+	}
+
+	return tickets
+}
+
+// AcceptsTickets reports whether tickets can still be bought
+func (r Raffle) AcceptsTickets(now time.Time) bool {
+	return r.Status == RaffleStatusOpen && now.Before(r.DrawAt)
+}
+
+// IsDrawn reports whether the draw happened, so the seed can be revealed
+func (r Raffle) IsDrawn() bool {
+	return r.Status == RaffleStatusDrawn
+}
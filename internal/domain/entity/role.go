@@ -0,0 +1,35 @@
+package entity
+
+type Role string
+
+const (
+	RoleEmployee   Role = "employee"
+	RoleStoreAdmin Role = "store-admin"
+	RoleFinance    Role = "finance"
+	// RoleSuperadmin is allowed everything, including managing roles of other users
+	RoleSuperadmin Role = "superadmin"
+)
+
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleEmployee, RoleStoreAdmin, RoleFinance, RoleSuperadmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// HasAnyOf reports whether the role grants access to a route that requires one of the roles
+func (r Role) HasAnyOf(roles ...Role) bool {
+	if r == RoleSuperadmin {
+		return true
+	}
+
+	for _, role := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
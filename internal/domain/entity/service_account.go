@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/segmentio/ksuid"
+)
+
+// Scope restricts what an API key can be used for
+type Scope string
+
+const (
+	ScopeMerchRead  Scope = "merch:read"
+	ScopeCoinsGrant Scope = "coins:grant"
+)
+
+func (s Scope) IsValid() bool {
+	switch s {
+	case ScopeMerchRead, ScopeCoinsGrant:
+		return true
+	default:
+		return false
+	}
+}
+
+// ServiceAccount is the identity of a bot or another system, it calls the API with API keys
+type ServiceAccount struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedBy   string
+	CreatedAt   time.Time
+}
+
+func NewServiceAccount(name, description, createdBy string) ServiceAccount {
+	return ServiceAccount{
+		ID:          ksuid.New().String(),
+		Name:        name,
+		Description: description,
+		CreatedBy:   createdBy,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// APIKey is stored by the hash only, the plain key is returned once when it's created.
+// A zero ExpiresAt means the key doesn't expire
+type APIKey struct {
+	ID               string
+	ServiceAccountID string
+	KeyHash          string
+	Scopes           []Scope
+	CreatedBy        string
+	ExpiresAt        time.Time
+	LastUsedAt       time.Time
+	CreatedAt        time.Time
+	RevokedAt        time.Time
+}
+
+func NewAPIKey(serviceAccountID, createdBy, keyHash string, scopes []Scope, expiresAt time.Time) APIKey {
+	return APIKey{
+		ID:               ksuid.New().String(),
+		ServiceAccountID: serviceAccountID,
+		KeyHash:          keyHash,
+		Scopes:           scopes,
+		CreatedBy:        createdBy,
+		ExpiresAt:        expiresAt,
+		CreatedAt:        time.Now(),
+	}
+}
+
+func (k APIKey) IsRevoked() bool {
+	return !k.RevokedAt.IsZero()
+}
+
+func (k APIKey) IsExpired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)
+}
+
+func (k APIKey) HasScope(scope Scope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
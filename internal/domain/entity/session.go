@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/segmentio/ksuid"
+)
+
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// AccessToken describes an issued JWT by its claims
+type AccessToken struct {
+	ID        string
+	UserID    string
+	Role      Role
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// SessionRevocation invalidates every access token of the user issued up to RevokedBefore
+type SessionRevocation struct {
+	UserID        string
+	RevokedBefore time.Time
+	ExpiresAt     time.Time
+}
+
+// Revocations is the set of revocations that still matter, i.e. that cover unexpired tokens
+type Revocations struct {
+	Tokens   []AccessToken
+	Sessions []SessionRevocation
+}
+
+// NewSessionRevocation is kept for accessTTL, after that every token it covers has expired anyway
+func NewSessionRevocation(userID string, accessTTL time.Duration) SessionRevocation {
+	now := time.Now()
+
+	return SessionRevocation{
+		UserID:        userID,
+		RevokedBefore: now,
+		ExpiresAt:     now.Add(accessTTL),
+	}
+}
+
+// RefreshToken is stored by the hash only, the plain token is returned to the client once
+type RefreshToken struct {
+	ID        string
+	UserID    string
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	RotatedAt time.Time
+	RevokedAt time.Time
+}
+
+// NewRefreshToken starts a new token family when familyID is empty, which happens on login.
+// Rotated tokens stay in the family of the token they replace
+func NewRefreshToken(userID, familyID, tokenHash string, ttl time.Duration) RefreshToken {
+	now := time.Now()
+	id := ksuid.New().String()
+
+	if familyID == "" {
+		familyID = id
+	}
+
+	return RefreshToken{
+		ID:        id,
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+}
+
+func (t RefreshToken) IsRotated() bool {
+	return !t.RotatedAt.IsZero()
+}
+
+func (t RefreshToken) IsRevoked() bool {
+	return !t.RevokedAt.IsZero()
+}
+
+func (t RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/segmentio/ksuid"
+)
+
+// TeamRole is the role of a user within a team. Managers spend the team wallet, members contribute to it
+type TeamRole string
+
+const (
+	TeamRoleMember  TeamRole = "member"
+	TeamRoleManager TeamRole = "manager"
+)
+
+func (r TeamRole) IsValid() bool {
+	switch r {
+	case TeamRoleMember, TeamRoleManager:
+		return true
+	default:
+		return false
+	}
+}
+
+// Team has a shared wallet, Balance is the number of coins in it
+type Team struct {
+	ID         string
+	Name       string
+	Department string
+	Balance    int
+	CreatedBy  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewTeam(name, department, createdBy string) Team {
+	now := time.Now()
+
+	return Team{
+		ID:         ksuid.New().String(),
+		Name:       name,
+		Department: department,
+		CreatedBy:  createdBy,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+type TeamMember struct {
+	TeamID      string
+	UserID      string
+	Username    string
+	DisplayName string
+	Role        TeamRole
+	JoinedAt    time.Time
+}
+
+func NewTeamMember(teamID, userID string, role TeamRole) TeamMember {
+	return TeamMember{
+		TeamID:   teamID,
+		UserID:   userID,
+		Role:     role,
+		JoinedAt: time.Now(),
+	}
+}
+
+func (m TeamMember) IsManager() bool {
+	return m.Role == TeamRoleManager
+}
+
+// TeamDetails is a team with its members and the latest movements of its wallet
+type TeamDetails struct {
+	Team
+	Members      []TeamMember
+	Transactions []TeamTransaction
+}
+
+// TeamTransaction is a movement of the team wallet. Username is the member who contributed
+// or was paid out, it's empty for purchases, which are paid to the store
+type TeamTransaction struct {
+	ID          string
+	Type        TransactionType
+	Username    string
+	DisplayName string
+	Amount      int
+	Date        time.Time
+}
+
+// IsIncoming reports whether the coins were added to the wallet
+func (t TeamTransaction) IsIncoming() bool {
+	return t.Type == TransactionTypeTeamContribution
+}
@@ -7,10 +7,12 @@ import (
 )
 
 type Transaction struct {
-	FromUser string    `json:"fromUser,omitempty"`
-	ToUser   string    `json:"toUser,omitempty"`
-	Amount   int       `json:"amount"`
-	Date     time.Time `json:"date"`
+	FromUser        string    `json:"fromUser,omitempty"`
+	FromDisplayName string    `json:"fromDisplayName,omitempty"`
+	ToUser          string    `json:"toUser,omitempty"`
+	ToDisplayName   string    `json:"toDisplayName,omitempty"`
+	Amount          int       `json:"amount"`
+	Date            time.Time `json:"date"`
 }
 type CoinHistory struct {
 	Received []Transaction `json:"received"`
@@ -22,16 +24,30 @@ type TransactionType string
 const (
 	TransactionTypeTransferCoins TransactionType = "transfer_coins"
 	TransactionTypePurchaseMerch TransactionType = "purchase_merch"
+	TransactionTypeRefundMerch   TransactionType = "refund_merch"
+	TransactionTypeAuctionHold   TransactionType = "auction_hold"
+	TransactionTypeAuctionRefund TransactionType = "auction_refund"
+	TransactionTypeRaffleTicket  TransactionType = "raffle_ticket"
+	TransactionTypeGrantCoins    TransactionType = "grant_coins"
+	TransactionTypeForfeitCoins  TransactionType = "forfeit_coins"
+
+	TransactionTypeTeamContribution TransactionType = "team_contribution"
+	TransactionTypeTeamPayout       TransactionType = "team_payout"
+	TransactionTypeTeamPurchase     TransactionType = "team_purchase"
 )
 
 func (t TransactionType) String() string {
 	return string(t)
 }
 
+// CoinTransfer is a recorded movement of coins. A party is either a user or a team wallet,
+// the store is not a party, so it leaves the side of the transfer empty
 type CoinTransfer struct {
 	ID              string
 	SenderID        string
+	SenderTeamID    string
 	ReceiverID      string
+	ReceiverTeamID  string
 	TransactionType TransactionType
 	Amount          int32
 	Date            time.Time
@@ -47,3 +63,27 @@ func NewCoinTransfer(senderID, receiverID string, tt TransactionType, amount int
 		Date:            date,
 	}
 }
+
+// NewTeamContribution moves coins from a member to the team wallet
+func NewTeamContribution(userID, teamID string, amount int, date time.Time) CoinTransfer {
+	ct := NewCoinTransfer(userID, "", TransactionTypeTeamContribution, amount, date)
+	ct.ReceiverTeamID = teamID
+
+	return ct
+}
+
+// NewTeamPayout moves coins from the team wallet to a member
+func NewTeamPayout(teamID, userID string, amount int, date time.Time) CoinTransfer {
+	ct := NewCoinTransfer("", userID, TransactionTypeTeamPayout, amount, date)
+	ct.SenderTeamID = teamID
+
+	return ct
+}
+
+// NewTeamPurchase pays the store from the team wallet
+func NewTeamPurchase(teamID string, amount int, date time.Time) CoinTransfer {
+	ct := NewCoinTransfer("", "", TransactionTypeTeamPurchase, amount, date)
+	ct.SenderTeamID = teamID
+
+	return ct
+}
@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/segmentio/ksuid"
+)
+
+// TOTPAuthenticator is the TOTP secret of a user, encrypted at rest. It only protects the account
+// once it's confirmed with a code, an unconfirmed one is replaced by the next enrollment.
+// LastUsedStep is the time step of the last accepted code, codes of this step or earlier are rejected
+type TOTPAuthenticator struct {
+	UserID          string
+	SecretEncrypted string
+	LastUsedStep    int64
+	ConfirmedAt     time.Time
+	CreatedAt       time.Time
+}
+
+func NewTOTPAuthenticator(userID, secretEncrypted string) TOTPAuthenticator {
+	return TOTPAuthenticator{
+		UserID:          userID,
+		SecretEncrypted: secretEncrypted,
+		CreatedAt:       time.Now(),
+	}
+}
+
+func (a TOTPAuthenticator) IsConfirmed() bool {
+	return !a.ConfirmedAt.IsZero()
+}
+
+// TOTPEnrollment is shown to the user once, to be added to an authenticator app.
+// ProvisioningURI is the otpauth:// URI to render as a QR code
+type TOTPEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// RecoveryCode lets a user sign in once without the authenticator. Only the hash is stored,
+// the codes are shown when two-factor authentication is enabled
+type RecoveryCode struct {
+	ID        string
+	UserID    string
+	CodeHash  string
+	CreatedAt time.Time
+}
+
+func NewRecoveryCode(userID, codeHash string) RecoveryCode {
+	return RecoveryCode{
+		ID:        ksuid.New().String(),
+		UserID:    userID,
+		CodeHash:  codeHash,
+		CreatedAt: time.Now(),
+	}
+}
+
+// MFAChallenge is a login that passed the password check and waits for the second factor.
+// Only the hash of the challenge token is stored
+type MFAChallenge struct {
+	TokenHash string
+	UserID    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func NewMFAChallenge(userID, tokenHash string, ttl time.Duration) MFAChallenge {
+	now := time.Now()
+
+	return MFAChallenge{
+		TokenHash: tokenHash,
+		UserID:    userID,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+}
@@ -16,6 +16,7 @@ type (
 		ID           string
 		Username     string
 		PasswordHash string
+		Role         Role
 		Balance      int
 		CreatedAt    time.Time
 		UpdatedAt    time.Time
@@ -38,6 +39,7 @@ func NewUser(credentials UserCredentials, passwordHash string) User {
 		ID:           ksuid.New().String(),
 		Username:     credentials.Username,
 		PasswordHash: passwordHash,
+		Role:         RoleEmployee,
 		Balance:      DefaultBalance,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
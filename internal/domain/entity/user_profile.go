@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+type UserProfile struct {
+	UserID      string
+	Username    string
+	DisplayName string
+	Email       string
+	Department  string
+	Title       string
+	AvatarURL   string
+	UpdatedAt   time.Time
+}
+
+// UserProfileUpdate holds the profile fields to change, nil fields are left as they are
+// and an empty string clears the field
+type UserProfileUpdate struct {
+	DisplayName *string
+	Email       *string
+	Department  *string
+	Title       *string
+	AvatarURL   *string
+}
+
+func (u UserProfileUpdate) IsEmpty() bool {
+	return u.DisplayName == nil && u.Email == nil && u.Department == nil && u.Title == nil && u.AvatarURL == nil
+}
+
+type (
+	// UserFilter narrows down the user directory. Query matches usernames and display names
+	// by prefix and by similarity, an empty query lists everyone
+	UserFilter struct {
+		Query      string
+		Department string
+		Limit      int
+		Offset     int
+	}
+
+	UserPage struct {
+		Users []UserProfile
+		Total int
+	}
+)
+
+const (
+	DefaultUserPageLimit = 20
+	MaxUserPageLimit     = 100
+)
@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/segmentio/ksuid"
+)
+
+type WaitlistEntry struct {
+	ID        string
+	MerchID   string
+	UserID    string
+	Item      string
+	Position  int
+	CreatedAt time.Time
+}
+
+func NewWaitlistEntry(userID string, merch Merch) WaitlistEntry {
+	return WaitlistEntry{
+		ID:        ksuid.New().String(),
+		MerchID:   merch.ID,
+		UserID:    userID,
+		Item:      merch.Name,
+		CreatedAt: time.Now(),
+	}
+}
+
+type ReservationStatus string
+
+const (
+	ReservationStatusActive    ReservationStatus = "active"
+	ReservationStatusPurchased ReservationStatus = "purchased"
+	ReservationStatusExpired   ReservationStatus = "expired"
+)
+
+func (s ReservationStatus) String() string {
+	return string(s)
+}
+
+type Reservation struct {
+	ID         string
+	MerchID    string
+	UserID     string
+	Item       string
+	Price      int
+	Status     ReservationStatus
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	ResolvedAt time.Time
+}
+
+// NewReservation holds one unit of restocked merch for the user at the current price until the TTL passes
+func NewReservation(entry WaitlistEntry, price int, ttl time.Duration) Reservation {
+	now := time.Now()
+
+	return Reservation{
+		ID:        ksuid.New().String(),
+		MerchID:   entry.MerchID,
+		UserID:    entry.UserID,
+		Item:      entry.Item,
+		Price:     price,
+		Status:    ReservationStatusActive,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+}
+
+func (r Reservation) IsActive(now time.Time) bool {
+	return r.Status == ReservationStatusActive && now.Before(r.ExpiresAt)
+}
+
+// RestockedMerch is a stock-tracked item that has units on hand and users waiting for them
+type RestockedMerch struct {
+	MerchID string
+	Price   int
+	Stock   int
+}
@@ -6,15 +6,52 @@ var (
 	ErrBadRequest                       = errors.New("bad request")
 	ErrUserIDNotFoundInContext          = errors.New("user id not found in context")
 	ErrFailedToExtractUserIDFromContext = errors.New("failed to extract user id from context")
+	ErrAccessTokenNotFoundInContext     = errors.New("access token not found in context")
 	ErrUserNotFound                     = errors.New("user not found")
 	ErrFailedToGetUser                  = errors.New("failed to get user")
 	ErrFailedToCreateUser               = errors.New("failed to create user")
+	ErrUserAlreadyExists                = errors.New("user already exists")
+	ErrUsernameTooShort                 = errors.New("username is too short")
+	ErrUsernameTooLong                  = errors.New("username is too long")
+	ErrUsernameInvalidCharacters        = errors.New("username must start with a letter and contain only letters, digits, dots, dashes and underscores")
+	ErrUsernameReserved                 = errors.New("username is reserved")
+	ErrRegistrationNotAllowed           = errors.New("registration is not allowed")
+	ErrInvalidCredentials               = errors.New("invalid credentials")
+	ErrInvalidRefreshToken              = errors.New("invalid refresh token")
+	ErrRefreshTokenNotFound             = errors.New("refresh token not found")
+	ErrRefreshTokenNotActive            = errors.New("refresh token is not active")
+	ErrRefreshTokenExpired              = errors.New("refresh token expired")
+	ErrRefreshTokenReused               = errors.New("refresh token reuse detected")
+	ErrFailedToIssueRefreshToken        = errors.New("failed to issue refresh token")
+	ErrFailedToRefreshTokens            = errors.New("failed to refresh tokens")
+	ErrFailedToLogout                   = errors.New("failed to logout")
+	ErrFailedToRevokeSessions           = errors.New("failed to revoke sessions")
+	ErrFailedToSyncRevocations          = errors.New("failed to sync revocations")
+	ErrFailedToPurgeSessions            = errors.New("failed to purge expired sessions")
+	ErrPasswordResetTokenInvalid        = errors.New("password reset token is invalid or expired")
+	ErrFailedToChangePassword           = errors.New("failed to change password")
+	ErrFailedToIssuePasswordReset       = errors.New("failed to issue password reset")
+	ErrFailedToResetPassword            = errors.New("failed to reset password")
+	ErrFailedToCheckLoginThrottle       = errors.New("failed to check login throttle")
+	ErrFailedToRecordLoginFailure       = errors.New("failed to record login failure")
+	ErrFailedToResetLoginFailures       = errors.New("failed to reset login failures")
+	ErrFailedToUnlockUser               = errors.New("failed to unlock user")
+	ErrFailedToPurgeLoginFailures       = errors.New("failed to purge login failures")
+	ErrInvalidRole                      = errors.New("invalid role")
+	ErrFailedToGetUserRole              = errors.New("failed to get user role")
+	ErrFailedToSetUserRole              = errors.New("failed to set user role")
+	ErrCannotChangeOwnRole              = errors.New("cannot change own role")
+	ErrSuperadminAlreadyExists          = errors.New("superadmin already exists")
+	ErrFailedToBootstrapSuperadmin      = errors.New("failed to bootstrap superadmin")
 	ErrFailedToGenerateToken            = errors.New("failed to generate token")
 	ErrInvalidPassword                  = errors.New("invalid password")
 	ErrFailedToValidatePassword         = errors.New("failed to validate password")
 	ErrFailedToGeneratePasswordHash     = errors.New("failed to generate password hash")
 	ErrPasswordIsNotAllowed             = errors.New("password is not allowed")
 	ErrPasswordHashIsNotAllowed         = errors.New("password hash is not allowed")
+	ErrUnsupportedPasswordHash          = errors.New("unsupported password hash format")
+	ErrFailedToRehashPassword           = errors.New("failed to rehash password")
+	ErrUnknownPepperVersion             = errors.New("unknown password pepper version")
 	ErrFailedToGetUserInfo              = errors.New("failed to get user info")
 	ErrAmountMustBePositive             = errors.New("amount must be positive")
 	ErrInsufficientCoins                = errors.New("insufficient coins")
@@ -26,4 +63,155 @@ var (
 	ErrFailedToGetMerch                 = errors.New("failed to get merch")
 	ErrFailedToAddMerchToInventory      = errors.New("failed to add merch to inventory")
 	ErrFailedToCommitTransaction        = errors.New("failed to commit transaction")
+	ErrInvalidPagination                = errors.New("invalid pagination parameters")
+	ErrInvalidPriceRange                = errors.New("invalid price range")
+	ErrFailedToSearchMerch              = errors.New("failed to search merch")
+	ErrFailedToListCategories           = errors.New("failed to list categories")
+	ErrMerchOutOfStock                  = errors.New("merch is out of stock")
+	ErrFailedToTakeMerchFromStock       = errors.New("failed to take merch from stock")
+	ErrFailedToRestockMerch             = errors.New("failed to restock merch")
+	ErrPurchaseNotFound                 = errors.New("purchase not found")
+	ErrFailedToGetPurchase              = errors.New("failed to get purchase")
+	ErrFailedToListPurchases            = errors.New("failed to list purchases")
+	ErrPurchaseAlreadyReturned          = errors.New("purchase already returned")
+	ErrFailedToMarkPurchaseReturned     = errors.New("failed to mark purchase returned")
+	ErrReturnWindowExpired              = errors.New("return window expired")
+	ErrReturnAlreadyRequested           = errors.New("return already requested")
+	ErrFailedToRequestReturn            = errors.New("failed to request return")
+	ErrMerchReturnNotFound              = errors.New("merch return not found")
+	ErrFailedToGetMerchReturn           = errors.New("failed to get merch return")
+	ErrFailedToListMerchReturns         = errors.New("failed to list merch returns")
+	ErrInvalidReturnStatus              = errors.New("invalid return status")
+	ErrReturnAlreadyResolved            = errors.New("return already resolved")
+	ErrFailedToResolveReturn            = errors.New("failed to resolve return")
+	ErrInvalidCatalogItem               = errors.New("invalid catalog item")
+	ErrDuplicateCatalogItem             = errors.New("duplicate catalog item")
+	ErrFailedToListCatalog              = errors.New("failed to list catalog")
+	ErrFailedToUpsertCatalogItem        = errors.New("failed to upsert catalog item")
+	ErrFailedToRetireMerch              = errors.New("failed to retire merch")
+	ErrImageTooLarge                    = errors.New("image is too large")
+	ErrUnsupportedImageType             = errors.New("unsupported image type")
+	ErrInvalidImage                     = errors.New("invalid image")
+	ErrImageNotFound                    = errors.New("image not found")
+	ErrFailedToSaveImage                = errors.New("failed to save image")
+	ErrFailedToGetImage                 = errors.New("failed to get image")
+	ErrFailedToDeleteImage              = errors.New("failed to delete image")
+	ErrMerchImageNotFound               = errors.New("merch image not found")
+	ErrFailedToGetMerchImage            = errors.New("failed to get merch image")
+	ErrFailedToListMerchImages          = errors.New("failed to list merch images")
+	ErrQuantityMustBePositive           = errors.New("quantity must be positive")
+	ErrCannotTransferToYourself         = errors.New("cannot transfer items to yourself")
+	ErrNotEnoughItems                   = errors.New("not enough items to transfer")
+	ErrFailedToTransferItems            = errors.New("failed to transfer items")
+	ErrFailedToListItemTransfers        = errors.New("failed to list item transfers")
+	ErrPurchaseTransferred              = errors.New("transferred items can't be returned")
+	ErrMerchNotStockTracked             = errors.New("merch is not stock-tracked, it is always available")
+	ErrAlreadyInWaitlist                = errors.New("already in the waitlist for this item")
+	ErrWaitlistEntryNotFound            = errors.New("not in the waitlist for this item")
+	ErrFailedToJoinWaitlist             = errors.New("failed to join waitlist")
+	ErrFailedToLeaveWaitlist            = errors.New("failed to leave waitlist")
+	ErrFailedToListWaitlist             = errors.New("failed to list waitlist")
+	ErrReservationNotFound              = errors.New("reservation not found")
+	ErrReservationNotActive             = errors.New("reservation has expired or was already used")
+	ErrFailedToGetReservation           = errors.New("failed to get reservation")
+	ErrFailedToListReservations         = errors.New("failed to list reservations")
+	ErrFailedToCompleteReservation      = errors.New("failed to complete reservation")
+	ErrFailedToProcessWaitlists         = errors.New("failed to process waitlists")
+	ErrAuctionNotFound                  = errors.New("auction not found")
+	ErrAuctionNotOpen                   = errors.New("auction is not accepting bids")
+	ErrInvalidAuctionStatus             = errors.New("invalid auction status")
+	ErrInvalidAuctionWindow             = errors.New("auction must end after it starts and in the future")
+	ErrReservePriceMustBePositive       = errors.New("reserve price must be positive")
+	ErrBidTooLow                        = errors.New("bid must be higher than the current highest bid")
+	ErrAuctionBidNotHeld                = errors.New("auction bid is not held")
+	ErrFailedToCreateAuction            = errors.New("failed to create auction")
+	ErrFailedToGetAuction               = errors.New("failed to get auction")
+	ErrFailedToListAuctions             = errors.New("failed to list auctions")
+	ErrFailedToListAuctionBids          = errors.New("failed to list auction bids")
+	ErrFailedToPlaceBid                 = errors.New("failed to place bid")
+	ErrFailedToCloseAuctions            = errors.New("failed to close auctions")
+	ErrRaffleNotFound                   = errors.New("raffle not found")
+	ErrRaffleNotOpen                    = errors.New("raffle is not selling tickets")
+	ErrInvalidRaffleStatus              = errors.New("invalid raffle status")
+	ErrInvalidRaffleSettings            = errors.New("ticket price, ticket limit and number of winners must be positive")
+	ErrDrawTimeMustBeInFuture           = errors.New("draw time must be in the future")
+	ErrTicketLimitExceeded              = errors.New("ticket limit per user exceeded")
+	ErrFailedToCreateRaffle             = errors.New("failed to create raffle")
+	ErrFailedToGetRaffle                = errors.New("failed to get raffle")
+	ErrFailedToListRaffles              = errors.New("failed to list raffles")
+	ErrFailedToListRaffleTickets        = errors.New("failed to list raffle tickets")
+	ErrFailedToListRaffleWinners        = errors.New("failed to list raffle winners")
+	ErrFailedToBuyRaffleTickets         = errors.New("failed to buy raffle tickets")
+	ErrFailedToDrawRaffles              = errors.New("failed to draw raffles")
+	ErrFailedToGrantCoins               = errors.New("failed to grant coins")
+	ErrServiceAccountNotFound           = errors.New("service account not found")
+	ErrServiceAccountAlreadyExists      = errors.New("service account already exists")
+	ErrServiceAccountNameRequired       = errors.New("service account name is required")
+	ErrScopesRequired                   = errors.New("at least one scope is required")
+	ErrInvalidScope                     = errors.New("unknown scope")
+	ErrAPIKeyExpiryInPast               = errors.New("api key expiry must be in the future")
+	ErrAPIKeyNotFound                   = errors.New("api key not found")
+	ErrInvalidAPIKey                    = errors.New("api key is invalid, expired or revoked")
+	ErrAPIKeyNotFoundInContext          = errors.New("api key not found in context")
+	ErrFailedToCreateServiceAccount     = errors.New("failed to create service account")
+	ErrFailedToListServiceAccounts      = errors.New("failed to list service accounts")
+	ErrFailedToCreateAPIKey             = errors.New("failed to create api key")
+	ErrFailedToListAPIKeys              = errors.New("failed to list api keys")
+	ErrFailedToRevokeAPIKey             = errors.New("failed to revoke api key")
+	ErrFailedToAuthenticateAPIKey       = errors.New("failed to authenticate api key")
+	ErrOIDCNotConfigured                = errors.New("single sign-on is not configured")
+	ErrInvalidOIDCState                 = errors.New("single sign-on login is invalid or expired")
+	ErrOIDCLoginRejected                = errors.New("identity provider login was rejected")
+	ErrOIDCUsernameUnavailable          = errors.New("identity provider didn't assert a usable username")
+	ErrUserIdentityNotFound             = errors.New("user identity not found")
+	ErrUserIdentityAlreadyExists        = errors.New("user is already linked to another account of the identity provider")
+	ErrPasswordNotSet                   = errors.New("account signs in with single sign-on and has no password")
+	ErrFailedToStartOIDCLogin           = errors.New("failed to start single sign-on login")
+	ErrFailedToCompleteOIDCLogin        = errors.New("failed to complete single sign-on login")
+	ErrFailedToGetUserIdentity          = errors.New("failed to get user identity")
+	ErrFailedToLinkUserIdentity         = errors.New("failed to link user identity")
+	ErrProfileUpdateEmpty               = errors.New("no profile fields to update")
+	ErrProfileFieldTooLong              = errors.New("profile field is too long")
+	ErrInvalidEmail                     = errors.New("invalid email")
+	ErrInvalidAvatarURL                 = errors.New("avatar url must be an absolute http or https url")
+	ErrFailedToGetUserProfile           = errors.New("failed to get user profile")
+	ErrFailedToUpdateUserProfile        = errors.New("failed to update user profile")
+	ErrFailedToSearchUsers              = errors.New("failed to search users")
+	ErrInvalidBalancePolicy             = errors.New("balance policy must be forfeit, transfer or freeze")
+	ErrTransferRecipientRequired        = errors.New("transfer policy needs a user to receive the balance")
+	ErrUnexpectedTransferRecipient      = errors.New("balance is only sent to another user with the transfer policy")
+	ErrInvalidTransferRecipient         = errors.New("balance can't be transferred to the deactivated user")
+	ErrCannotDeactivateSelf             = errors.New("cannot deactivate own account")
+	ErrFailedToDeactivateUser           = errors.New("failed to deactivate user")
+	ErrTwoFactorNotConfigured           = errors.New("two-factor authentication is not configured")
+	ErrTwoFactorAlreadyEnabled          = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnrolled             = errors.New("no authenticator is waiting for confirmation")
+	ErrTwoFactorNotEnabled              = errors.New("two-factor authentication is not enabled")
+	ErrInvalidTOTPCode                  = errors.New("invalid or already used authentication code")
+	ErrTOTPCodeRequired                 = errors.New("authentication code is required for this transfer")
+	ErrInvalidMFAToken                  = errors.New("second factor login is invalid or expired")
+	ErrFailedToEnrollTwoFactor          = errors.New("failed to enroll authenticator")
+	ErrFailedToConfirmTwoFactor         = errors.New("failed to confirm authenticator")
+	ErrFailedToDisableTwoFactor         = errors.New("failed to disable two-factor authentication")
+	ErrFailedToCheckTwoFactor           = errors.New("failed to check two-factor authentication")
+	ErrFailedToVerifySecondFactor       = errors.New("failed to verify second factor")
+	ErrFailedToCreateMFAChallenge       = errors.New("failed to start second factor login")
+	ErrTeamNotFound                     = errors.New("team not found")
+	ErrTeamAlreadyExists                = errors.New("team already exists")
+	ErrTeamNameRequired                 = errors.New("team name is required")
+	ErrInvalidTeamRole                  = errors.New("team role must be member or manager")
+	ErrTeamMemberNotFound               = errors.New("user is not a member of the team")
+	ErrNotTeamMember                    = errors.New("only team members can do this")
+	ErrNotTeamManager                   = errors.New("only team managers can spend the team wallet")
+	ErrFailedToCreateTeam               = errors.New("failed to create team")
+	ErrFailedToGetTeam                  = errors.New("failed to get team")
+	ErrFailedToListTeams                = errors.New("failed to list teams")
+	ErrFailedToSaveTeamMember           = errors.New("failed to save team member")
+	ErrFailedToRemoveTeamMember         = errors.New("failed to remove team member")
+	ErrFailedToGetTeamMember            = errors.New("failed to get team member")
+	ErrFailedToUpdateTeamCoins          = errors.New("failed to update team coins")
+	ErrBootstrapUserExists              = errors.New("user already exists and can only be promoted explicitly")
+	ErrOIDCLinkRequired                 = errors.New("account must be linked to the identity provider by an admin")
+	ErrMerchReservedForWaitlist         = errors.New("merch is reserved for users in the waitlist")
+	ErrFailedToRefundBids               = errors.New("failed to refund auction bids")
 )
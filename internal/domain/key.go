@@ -4,4 +4,12 @@ const (
 	UserIDKey     = "userID"
 	ExpirationKey = "exp"
 	IssuedAtKey   = "iat"
+	TokenIDKey    = "jti"
+	RoleKey       = "role"
+	// AccessTokenKey is the context key of the entity.AccessToken the request was authenticated with
+	AccessTokenKey = "accessToken"
+	// ServiceAccountIDKey is the context key of the service account that called with an API key
+	ServiceAccountIDKey = "serviceAccountID"
+	// APIKeyKey is the context key of the entity.APIKey the request was authenticated with
+	APIKeyKey = "apiKey"
 )
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrTooManyLoginAttempts = errors.New("too many login attempts")
+	ErrAccountLocked        = errors.New("account is temporarily locked")
+)
+
+// LoginThrottledError rejects a login before the password is checked.
+// Err is ErrAccountLocked or ErrTooManyLoginAttempts, it's matched with errors.Is
+type LoginThrottledError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return e.Err.Error() + ", retry after " + e.RetryAfter.Round(time.Second).String()
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrPasswordPolicyViolation = errors.New("password does not meet the password policy")
+
+// PasswordRule identifies a password policy rule, so clients can show their own messages
+type PasswordRule string
+
+const (
+	PasswordRuleMinLength        PasswordRule = "min_length"
+	PasswordRuleMaxLength        PasswordRule = "max_length"
+	PasswordRuleLowercase        PasswordRule = "lowercase"
+	PasswordRuleUppercase        PasswordRule = "uppercase"
+	PasswordRuleDigit            PasswordRule = "digit"
+	PasswordRuleSymbol           PasswordRule = "symbol"
+	PasswordRuleContainsUsername PasswordRule = "contains_username"
+	PasswordRuleCommon           PasswordRule = "common"
+)
+
+type PasswordViolation struct {
+	Rule    PasswordRule
+	Message string
+}
+
+// PasswordPolicyError lists every rule the password breaks, not only the first one.
+// It matches ErrPasswordPolicyViolation with errors.Is
+type PasswordPolicyError struct {
+	Violations []PasswordViolation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		messages = append(messages, v.Message)
+	}
+
+	return ErrPasswordPolicyViolation.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrPasswordPolicyViolation
+}
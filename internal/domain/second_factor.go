@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrSecondFactorRequired = errors.New("second factor is required")
+
+// SecondFactorRequiredError is returned instead of tokens when the password was right but the user
+// has two-factor authentication enabled. The login is completed with the challenge token and a code
+type SecondFactorRequiredError struct {
+	ChallengeToken string
+	ExpiresAt      time.Time
+}
+
+func (e *SecondFactorRequiredError) Error() string {
+	return ErrSecondFactorRequired.Error()
+}
+
+func (e *SecondFactorRequiredError) Unwrap() error {
+	return ErrSecondFactorRequired
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/rshelekhov/merch-store/internal/domain"
 
 	"github.com/rshelekhov/merch-store/internal/domain/service/coins/mocks"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -124,3 +125,135 @@ func TestCoinsService_RegisterCoinTransfer(t *testing.T) {
 		})
 	}
 }
+
+func TestCoinsService_AdjustUserCoins(t *testing.T) {
+	ctx := context.Background()
+	userID := "test-user-id"
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(coinsStorage *mocks.Storage)
+		amount        int
+		expectedError error
+	}{
+		{
+			name: "Success – Credit",
+			mockBehavior: func(coinsStorage *mocks.Storage) {
+				coinsStorage.EXPECT().AdjustUserCoins(ctx, userID, int32(10)).
+					Once().
+					Return(nil)
+			},
+			amount:        10,
+			expectedError: nil,
+		},
+		{
+			name: "Success – Debit",
+			mockBehavior: func(coinsStorage *mocks.Storage) {
+				coinsStorage.EXPECT().AdjustUserCoins(ctx, userID, int32(-10)).
+					Once().
+					Return(nil)
+			},
+			amount:        -10,
+			expectedError: nil,
+		},
+		{
+			name:          "Error – Zero amount",
+			mockBehavior:  func(coinsStorage *mocks.Storage) {},
+			amount:        0,
+			expectedError: domain.ErrAmountMustBePositive,
+		},
+		{
+			name: "Error – Insufficient coins",
+			mockBehavior: func(coinsStorage *mocks.Storage) {
+				coinsStorage.EXPECT().AdjustUserCoins(ctx, userID, int32(-10)).
+					Once().
+					Return(storage.ErrInsufficientCoins)
+			},
+			amount:        -10,
+			expectedError: domain.ErrInsufficientCoins,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coinsStorage := mocks.NewStorage(t)
+			tt.mockBehavior(coinsStorage)
+
+			coinsService := New(coinsStorage)
+			err := coinsService.AdjustUserCoins(ctx, userID, tt.amount)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCoinsService_AdjustTeamCoins(t *testing.T) {
+	ctx := context.Background()
+	teamID := "test-team-id"
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(coinsStorage *mocks.Storage)
+		amount        int
+		expectedError error
+	}{
+		{
+			name: "Success – Contribution",
+			mockBehavior: func(coinsStorage *mocks.Storage) {
+				coinsStorage.EXPECT().AdjustTeamCoins(ctx, teamID, int32(10)).
+					Once().
+					Return(nil)
+			},
+			amount:        10,
+			expectedError: nil,
+		},
+		{
+			name: "Success – Spending",
+			mockBehavior: func(coinsStorage *mocks.Storage) {
+				coinsStorage.EXPECT().AdjustTeamCoins(ctx, teamID, int32(-10)).
+					Once().
+					Return(nil)
+			},
+			amount:        -10,
+			expectedError: nil,
+		},
+		{
+			name:          "Error – Zero amount",
+			mockBehavior:  func(coinsStorage *mocks.Storage) {},
+			amount:        0,
+			expectedError: domain.ErrAmountMustBePositive,
+		},
+		{
+			name: "Error – Insufficient coins",
+			mockBehavior: func(coinsStorage *mocks.Storage) {
+				coinsStorage.EXPECT().AdjustTeamCoins(ctx, teamID, int32(-10)).
+					Once().
+					Return(storage.ErrInsufficientCoins)
+			},
+			amount:        -10,
+			expectedError: domain.ErrInsufficientCoins,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coinsStorage := mocks.NewStorage(t)
+			tt.mockBehavior(coinsStorage)
+
+			coinsService := New(coinsStorage)
+			err := coinsService.AdjustTeamCoins(ctx, teamID, tt.amount)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
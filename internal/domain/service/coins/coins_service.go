@@ -2,11 +2,13 @@ package coins
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/rshelekhov/merch-store/internal/domain/entity"
 
 	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
 )
 
 type Service struct {
@@ -16,6 +18,8 @@ type Service struct {
 type Storage interface {
 	UpdateUserCoins(ctx context.Context, senderID string, amount int32) error
 	RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransfer) error
+	AdjustUserCoins(ctx context.Context, userID string, amount int32) error
+	AdjustTeamCoins(ctx context.Context, teamID string, amount int32) error
 }
 
 func New(storage Storage) *Service {
@@ -49,3 +53,40 @@ func (s *Service) RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransf
 
 	return nil
 }
+
+// AdjustUserCoins adds amount to the user's balance without reading it first, so concurrent
+// updates can't overwrite each other. A negative amount debits the balance
+func (s *Service) AdjustUserCoins(ctx context.Context, userID string, amount int) error {
+	const op = "service.Coins.AdjustUserCoins"
+
+	if amount == 0 {
+		return domain.ErrAmountMustBePositive
+	}
+
+	if err := s.storage.AdjustUserCoins(ctx, userID, int32(amount)); err != nil {
+		if errors.Is(err, storage.ErrInsufficientCoins) {
+			return domain.ErrInsufficientCoins
+		}
+		return fmt.Errorf("%s: failed to adjust user coins %w", op, err)
+	}
+
+	return nil
+}
+
+// AdjustTeamCoins adds amount to the team wallet, a negative amount spends from it
+func (s *Service) AdjustTeamCoins(ctx context.Context, teamID string, amount int) error {
+	const op = "service.Coins.AdjustTeamCoins"
+
+	if amount == 0 {
+		return domain.ErrAmountMustBePositive
+	}
+
+	if err := s.storage.AdjustTeamCoins(ctx, teamID, int32(amount)); err != nil {
+		if errors.Is(err, storage.ErrInsufficientCoins) {
+			return domain.ErrInsufficientCoins
+		}
+		return fmt.Errorf("%s: failed to adjust team coins %w", op, err)
+	}
+
+	return nil
+}
@@ -22,6 +22,102 @@ func (_m *Storage) EXPECT() *Storage_Expecter {
 	return &Storage_Expecter{mock: &_m.Mock}
 }
 
+// AdjustTeamCoins provides a mock function with given fields: ctx, teamID, amount
+func (_m *Storage) AdjustTeamCoins(ctx context.Context, teamID string, amount int32) error {
+	ret := _m.Called(ctx, teamID, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustTeamCoins")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) error); ok {
+		r0 = rf(ctx, teamID, amount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_AdjustTeamCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustTeamCoins'
+type Storage_AdjustTeamCoins_Call struct {
+	*mock.Call
+}
+
+// AdjustTeamCoins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID string
+//   - amount int32
+func (_e *Storage_Expecter) AdjustTeamCoins(ctx interface{}, teamID interface{}, amount interface{}) *Storage_AdjustTeamCoins_Call {
+	return &Storage_AdjustTeamCoins_Call{Call: _e.mock.On("AdjustTeamCoins", ctx, teamID, amount)}
+}
+
+func (_c *Storage_AdjustTeamCoins_Call) Run(run func(ctx context.Context, teamID string, amount int32)) *Storage_AdjustTeamCoins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *Storage_AdjustTeamCoins_Call) Return(_a0 error) *Storage_AdjustTeamCoins_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_AdjustTeamCoins_Call) RunAndReturn(run func(context.Context, string, int32) error) *Storage_AdjustTeamCoins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AdjustUserCoins provides a mock function with given fields: ctx, userID, amount
+func (_m *Storage) AdjustUserCoins(ctx context.Context, userID string, amount int32) error {
+	ret := _m.Called(ctx, userID, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustUserCoins")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) error); ok {
+		r0 = rf(ctx, userID, amount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_AdjustUserCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustUserCoins'
+type Storage_AdjustUserCoins_Call struct {
+	*mock.Call
+}
+
+// AdjustUserCoins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - amount int32
+func (_e *Storage_Expecter) AdjustUserCoins(ctx interface{}, userID interface{}, amount interface{}) *Storage_AdjustUserCoins_Call {
+	return &Storage_AdjustUserCoins_Call{Call: _e.mock.On("AdjustUserCoins", ctx, userID, amount)}
+}
+
+func (_c *Storage_AdjustUserCoins_Call) Run(run func(ctx context.Context, userID string, amount int32)) *Storage_AdjustUserCoins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *Storage_AdjustUserCoins_Call) Return(_a0 error) *Storage_AdjustUserCoins_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_AdjustUserCoins_Call) RunAndReturn(run func(context.Context, string, int32) error) *Storage_AdjustUserCoins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegisterCoinTransfer provides a mock function with given fields: ctx, ct
 func (_m *Storage) RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransfer) error {
 	ret := _m.Called(ctx, ct)
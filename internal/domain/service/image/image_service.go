@@ -0,0 +1,175 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"time"
+
+	// Register GIF decoder for image.Decode
+	_ "image/gif"
+
+	"github.com/segmentio/ksuid"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+// maxImageDimension protects the service from decompression bombs:
+// small files that decode into enormous images
+const maxImageDimension = 8000
+
+const thumbnailJPEGQuality = 85
+
+// allowedContentTypes maps supported upload types to the extension of the stored original
+var allowedContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+type Service struct {
+	blobs         BlobStorage
+	maxUploadSize int64
+	thumbnailSize int
+}
+
+type BlobStorage interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (entity.Blob, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Config struct {
+	MaxUploadSize int64
+	ThumbnailSize int
+}
+
+func New(blobs BlobStorage, cfg Config) *Service {
+	return &Service{
+		blobs:         blobs,
+		maxUploadSize: cfg.MaxUploadSize,
+		thumbnailSize: cfg.ThumbnailSize,
+	}
+}
+
+func (s *Service) MaxUploadSize() int64 {
+	return s.maxUploadSize
+}
+
+// SaveMerchImage validates the uploaded image, stores it together with its thumbnail
+// and returns the image metadata to be persisted
+func (s *Service) SaveMerchImage(ctx context.Context, merchID string, data []byte) (entity.MerchImage, error) {
+	const op = "service.image.SaveMerchImage"
+
+	if int64(len(data)) > s.maxUploadSize {
+		return entity.MerchImage{}, domain.ErrImageTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+
+	ext, ok := allowedContentTypes[contentType]
+	if !ok {
+		return entity.MerchImage{}, domain.ErrUnsupportedImageType
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return entity.MerchImage{}, domain.ErrInvalidImage
+	}
+
+	if cfg.Width > maxImageDimension || cfg.Height > maxImageDimension {
+		return entity.MerchImage{}, domain.ErrImageTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return entity.MerchImage{}, domain.ErrInvalidImage
+	}
+
+	thumbnail, thumbnailExt, err := s.encodeThumbnail(img, contentType)
+	if err != nil {
+		return entity.MerchImage{}, fmt.Errorf("%s: failed to encode thumbnail: %w", op, err)
+	}
+
+	id := ksuid.New().String()
+
+	merchImage := entity.MerchImage{
+		ID:           id,
+		MerchID:      merchID,
+		ContentType:  contentType,
+		Key:          fmt.Sprintf("merch/%s/%s%s", merchID, id, ext),
+		ThumbnailKey: fmt.Sprintf("merch/%s/%s_thumb%s", merchID, id, thumbnailExt),
+		Width:        cfg.Width,
+		Height:       cfg.Height,
+		CreatedAt:    time.Now(),
+	}
+
+	if err = s.blobs.Put(ctx, merchImage.Key, bytes.NewReader(data)); err != nil {
+		return entity.MerchImage{}, fmt.Errorf("%s: failed to store image: %w", op, err)
+	}
+
+	if err = s.blobs.Put(ctx, merchImage.ThumbnailKey, bytes.NewReader(thumbnail)); err != nil {
+		// Don't leave an orphaned original behind
+		_ = s.blobs.Delete(ctx, merchImage.Key)
+		return entity.MerchImage{}, fmt.Errorf("%s: failed to store thumbnail: %w", op, err)
+	}
+
+	return merchImage, nil
+}
+
+func (s *Service) DeleteMerchImage(ctx context.Context, merchImage entity.MerchImage) error {
+	const op = "service.image.DeleteMerchImage"
+
+	if err := s.blobs.Delete(ctx, merchImage.ThumbnailKey); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.blobs.Delete(ctx, merchImage.Key); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetImage(ctx context.Context, key string) (entity.Blob, error) {
+	const op = "service.image.GetImage"
+
+	blob, err := s.blobs.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrBlobNotFound) || errors.Is(err, storage.ErrInvalidBlobKey) {
+			return entity.Blob{}, domain.ErrImageNotFound
+		}
+		return entity.Blob{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return blob, nil
+}
+
+// encodeThumbnail keeps JPEG thumbnails for photos and uses PNG for everything else,
+// so transparency survives the resize
+func (s *Service) encodeThumbnail(img image.Image, contentType string) ([]byte, string, error) {
+	thumbnail := resizeToFit(img, s.thumbnailSize)
+
+	var buf bytes.Buffer
+
+	if contentType == "image/jpeg" {
+		if err := jpeg.Encode(&buf, thumbnail, &jpeg.Options{Quality: thumbnailJPEGQuality}); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), ".jpg", nil
+	}
+
+	if err := png.Encode(&buf, thumbnail); err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), ".png", nil
+}
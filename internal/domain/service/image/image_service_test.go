@@ -0,0 +1,140 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/service/image/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newPNG(t *testing.T, width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	return buf.Bytes()
+}
+
+func TestImageService_SaveMerchImage(t *testing.T) {
+	ctx := context.Background()
+	merchID := "test-merch-id"
+
+	validImage := newPNG(t, 640, 320)
+
+	tests := []struct {
+		name          string
+		data          []byte
+		mockBehavior  func(blobs *mocks.BlobStorage)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			data: validImage,
+			mockBehavior: func(blobs *mocks.BlobStorage) {
+				blobs.EXPECT().Put(ctx, mock.MatchedBy(func(key string) bool {
+					return strings.HasPrefix(key, "merch/"+merchID+"/")
+				}), mock.Anything).
+					Twice().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Error – Unsupported image type",
+			data:          []byte("definitely not an image"),
+			mockBehavior:  func(_ *mocks.BlobStorage) {},
+			expectedError: domain.ErrUnsupportedImageType,
+		},
+		{
+			name:          "Error – Image is too large",
+			data:          make([]byte, 2<<20),
+			mockBehavior:  func(_ *mocks.BlobStorage) {},
+			expectedError: domain.ErrImageTooLarge,
+		},
+		{
+			name:          "Error – Corrupted image",
+			data:          validImage[:64],
+			mockBehavior:  func(_ *mocks.BlobStorage) {},
+			expectedError: domain.ErrInvalidImage,
+		},
+		{
+			name: "Error – Failed to store thumbnail removes the original",
+			data: validImage,
+			mockBehavior: func(blobs *mocks.BlobStorage) {
+				blobs.EXPECT().Put(ctx, mock.AnythingOfType("string"), mock.Anything).
+					Once().
+					Return(nil)
+
+				blobs.EXPECT().Put(ctx, mock.AnythingOfType("string"), mock.Anything).
+					Once().
+					Return(errors.New("blob storage error"))
+
+				blobs.EXPECT().Delete(ctx, mock.AnythingOfType("string")).
+					Once().
+					Return(nil)
+			},
+			expectedError: errors.New("blob storage error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blobs := mocks.NewBlobStorage(t)
+			tt.mockBehavior(blobs)
+
+			imageService := New(blobs, Config{MaxUploadSize: 1 << 20, ThumbnailSize: 128})
+			merchImage, err := imageService.SaveMerchImage(ctx, merchID, tt.data)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError.Error())
+				require.Empty(t, merchImage)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "image/png", merchImage.ContentType)
+				require.Equal(t, 640, merchImage.Width)
+				require.Equal(t, 320, merchImage.Height)
+				require.NotEqual(t, merchImage.Key, merchImage.ThumbnailKey)
+			}
+		})
+	}
+}
+
+func TestResizeToFit(t *testing.T) {
+	tests := []struct {
+		name           string
+		width, height  int
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{name: "Landscape", width: 640, height: 320, expectedWidth: 128, expectedHeight: 64},
+		{name: "Portrait", width: 300, height: 600, expectedWidth: 64, expectedHeight: 128},
+		{name: "Already fits", width: 100, height: 50, expectedWidth: 100, expectedHeight: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := image.NewNRGBA(image.Rect(0, 0, tt.width, tt.height))
+
+			resized := resizeToFit(img, 128)
+
+			require.Equal(t, tt.expectedWidth, resized.Bounds().Dx())
+			require.Equal(t, tt.expectedHeight, resized.Bounds().Dy())
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package lockout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+// Service tracks failed logins per username and per client IP. Every failure of a username after
+// the first BackoffAfter ones blocks the next attempt for an exponentially growing delay. Reaching
+// the failure limit of either scope locks logins for LockoutDuration. An IP only gets the lockout,
+// since many users may share it behind a NAT. Blocked logins are rejected before the password
+// is checked, so guessing doesn't cost a password hash comparison
+type Service struct {
+	storage Storage
+	cfg     Config
+}
+
+type (
+	Storage interface {
+		GetLoginFailures(ctx context.Context, scope entity.LoginScope, subject string) (entity.LoginFailures, error)
+		IncrementLoginFailures(ctx context.Context, scope entity.LoginScope, subject string, failedAt, windowStart time.Time) (int, error)
+		BlockLogin(ctx context.Context, scope entity.LoginScope, subject string, blockedUntil time.Time, locked bool) error
+		DeleteLoginFailures(ctx context.Context, scope entity.LoginScope, subject string) error
+		PurgeLoginFailures(ctx context.Context, windowStart, now time.Time) (int, error)
+	}
+
+	Config struct {
+		MaxFailuresPerUsername int
+		MaxFailuresPerIP       int
+		BackoffAfter           int
+		BackoffBase            time.Duration
+		BackoffMax             time.Duration
+		LockoutDuration        time.Duration
+		// FailureWindow is how long a failure is remembered after the last one
+		FailureWindow time.Duration
+	}
+)
+
+func New(storage Storage, cfg Config) *Service {
+	return &Service{
+		storage: storage,
+		cfg:     cfg,
+	}
+}
+
+// CheckLogin returns a *domain.LoginThrottledError if logins of the username or from the IP are blocked.
+// A locked username is reported as ErrAccountLocked, everything else as ErrTooManyLoginAttempts
+func (s *Service) CheckLogin(ctx context.Context, username, ip string) error {
+	const op = "service.lockout.CheckLogin"
+
+	now := time.Now()
+
+	byUsername, err := s.getLoginFailures(ctx, entity.LoginScopeUsername, username)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if byUsername.Locked && byUsername.IsBlocked(now) {
+		return &domain.LoginThrottledError{
+			Err:        domain.ErrAccountLocked,
+			RetryAfter: byUsername.BlockedUntil.Sub(now),
+		}
+	}
+
+	byIP, err := s.getLoginFailures(ctx, entity.LoginScopeIP, ip)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	blockedUntil := byUsername.BlockedUntil
+	if byIP.BlockedUntil.After(blockedUntil) {
+		blockedUntil = byIP.BlockedUntil
+	}
+
+	if now.Before(blockedUntil) {
+		return &domain.LoginThrottledError{
+			Err:        domain.ErrTooManyLoginAttempts,
+			RetryAfter: blockedUntil.Sub(now),
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) getLoginFailures(ctx context.Context, scope entity.LoginScope, subject string) (entity.LoginFailures, error) {
+	failures, err := s.storage.GetLoginFailures(ctx, scope, subject)
+	if err != nil && !errors.Is(err, storage.ErrLoginFailuresNotFound) {
+		return entity.LoginFailures{}, err
+	}
+
+	return failures, nil
+}
+
+// RecordLoginFailure counts a failed login for the username and the IP and blocks the next attempts.
+// It returns the scopes that got locked by this failure
+func (s *Service) RecordLoginFailure(ctx context.Context, username, ip string) ([]entity.LoginFailures, error) {
+	const op = "service.lockout.RecordLoginFailure"
+
+	now := time.Now()
+
+	var lockouts []entity.LoginFailures
+
+	for _, target := range []struct {
+		scope       entity.LoginScope
+		subject     string
+		maxFailures int
+		backoff     bool
+	}{
+		{entity.LoginScopeUsername, username, s.cfg.MaxFailuresPerUsername, true},
+		{entity.LoginScopeIP, ip, s.cfg.MaxFailuresPerIP, false},
+	} {
+		failures, err := s.storage.IncrementLoginFailures(ctx, target.scope, target.subject, now, now.Add(-s.cfg.FailureWindow))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		locked := failures >= target.maxFailures
+
+		var blockedUntil time.Time
+
+		switch {
+		case locked:
+			blockedUntil = now.Add(s.cfg.LockoutDuration)
+		case target.backoff:
+			blockedUntil = now.Add(s.backoff(failures))
+		}
+
+		if !blockedUntil.After(now) {
+			continue
+		}
+
+		if err = s.storage.BlockLogin(ctx, target.scope, target.subject, blockedUntil, locked); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Only the failure reaching the limit is reported, so every lockout is reported once
+		if failures == target.maxFailures {
+			lockouts = append(lockouts, entity.LoginFailures{
+				Scope:        target.scope,
+				Subject:      target.subject,
+				Failures:     failures,
+				Locked:       true,
+				BlockedUntil: blockedUntil,
+				LastFailedAt: now,
+			})
+		}
+	}
+
+	return lockouts, nil
+}
+
+// backoff doubles with every failure after the first BackoffAfter ones
+func (s *Service) backoff(failures int) time.Duration {
+	exceeded := failures - s.cfg.BackoffAfter
+	if exceeded <= 0 || s.cfg.BackoffBase <= 0 {
+		return 0
+	}
+
+	delay := s.cfg.BackoffBase
+	for i := 1; i < exceeded; i++ {
+		delay *= 2
+		if delay >= s.cfg.BackoffMax {
+			return s.cfg.BackoffMax
+		}
+	}
+
+	return min(delay, s.cfg.BackoffMax)
+}
+
+// ResetLoginFailures forgets failures of the username after a successful login.
+// Failures from the IP are kept, otherwise logging into one account would reset guessing on others
+func (s *Service) ResetLoginFailures(ctx context.Context, username string) error {
+	const op = "service.lockout.ResetLoginFailures"
+
+	if err := s.storage.DeleteLoginFailures(ctx, entity.LoginScopeUsername, username); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// UnlockUsername lifts a lockout and backoff of the username before they expire
+func (s *Service) UnlockUsername(ctx context.Context, username string) error {
+	const op = "service.lockout.UnlockUsername"
+
+	if err := s.storage.DeleteLoginFailures(ctx, entity.LoginScopeUsername, username); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// PurgeLoginFailures deletes failures that are out of the window and don't block anything
+func (s *Service) PurgeLoginFailures(ctx context.Context) (int, error) {
+	const op = "service.lockout.PurgeLoginFailures"
+
+	now := time.Now()
+
+	purged, err := s.storage.PurgeLoginFailures(ctx, now.Add(-s.cfg.FailureWindow), now)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,206 @@
+package lockout
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/service/lockout/mocks"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = Config{
+	MaxFailuresPerUsername: 5,
+	MaxFailuresPerIP:       50,
+	BackoffAfter:           2,
+	BackoffBase:            time.Second,
+	BackoffMax:             time.Minute,
+	LockoutDuration:        15 * time.Minute,
+	FailureWindow:          15 * time.Minute,
+}
+
+func TestLockoutService_CheckLogin(t *testing.T) {
+	ctx := context.Background()
+
+	username := "testuser"
+	ip := "192.0.2.1"
+
+	tests := []struct {
+		name          string
+		byUsername    entity.LoginFailures
+		byIP          entity.LoginFailures
+		expectedError error
+	}{
+		{
+			name:          "Success – No failures",
+			expectedError: nil,
+		},
+		{
+			name:          "Success – Backoff is over",
+			byUsername:    entity.LoginFailures{Failures: 3, BlockedUntil: time.Now().Add(-time.Second)},
+			expectedError: nil,
+		},
+		{
+			name:          "Error – Username is backing off",
+			byUsername:    entity.LoginFailures{Failures: 3, BlockedUntil: time.Now().Add(time.Minute)},
+			expectedError: domain.ErrTooManyLoginAttempts,
+		},
+		{
+			name:          "Error – Username is locked",
+			byUsername:    entity.LoginFailures{Failures: 5, Locked: true, BlockedUntil: time.Now().Add(time.Minute)},
+			expectedError: domain.ErrAccountLocked,
+		},
+		{
+			name:          "Error – IP is locked",
+			byIP:          entity.LoginFailures{Failures: 50, Locked: true, BlockedUntil: time.Now().Add(time.Minute)},
+			expectedError: domain.ErrTooManyLoginAttempts,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockoutStorage := mocks.NewStorage(t)
+
+			expectFailures(ctx, lockoutStorage, entity.LoginScopeUsername, username, tt.byUsername)
+			if !tt.byUsername.Locked {
+				expectFailures(ctx, lockoutStorage, entity.LoginScopeIP, ip, tt.byIP)
+			}
+
+			err := New(lockoutStorage, testConfig).CheckLogin(ctx, username, ip)
+
+			if tt.expectedError == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tt.expectedError)
+
+			var throttledErr *domain.LoginThrottledError
+			require.True(t, errors.As(err, &throttledErr))
+			require.Greater(t, throttledErr.RetryAfter, time.Duration(0))
+			require.LessOrEqual(t, throttledErr.RetryAfter, time.Minute)
+		})
+	}
+}
+
+func expectFailures(
+	ctx context.Context,
+	lockoutStorage *mocks.Storage,
+	scope entity.LoginScope,
+	subject string,
+	failures entity.LoginFailures,
+) {
+	if failures.Failures == 0 {
+		lockoutStorage.EXPECT().GetLoginFailures(ctx, scope, subject).
+			Once().
+			Return(entity.LoginFailures{}, storage.ErrLoginFailuresNotFound)
+		return
+	}
+
+	lockoutStorage.EXPECT().GetLoginFailures(ctx, scope, subject).
+		Once().
+		Return(failures, nil)
+}
+
+func TestLockoutService_RecordLoginFailure(t *testing.T) {
+	ctx := context.Background()
+
+	username := "testuser"
+	ip := "192.0.2.1"
+
+	tests := []struct {
+		name             string
+		usernameFailures int
+		ipFailures       int
+		expectedBlock    time.Duration
+		expectedLocked   bool
+		expectedLockouts []entity.LoginScope
+	}{
+		{
+			name:             "No backoff for the first failures",
+			usernameFailures: 2,
+			ipFailures:       2,
+		},
+		{
+			name:             "Backoff starts",
+			usernameFailures: 3,
+			ipFailures:       3,
+			expectedBlock:    time.Second,
+		},
+		{
+			name:             "Backoff doubles",
+			usernameFailures: 4,
+			ipFailures:       4,
+			expectedBlock:    2 * time.Second,
+		},
+		{
+			name:             "Username gets locked",
+			usernameFailures: 5,
+			ipFailures:       5,
+			expectedBlock:    15 * time.Minute,
+			expectedLocked:   true,
+			expectedLockouts: []entity.LoginScope{entity.LoginScopeUsername},
+		},
+		{
+			name:             "IP gets locked",
+			usernameFailures: 1,
+			ipFailures:       50,
+			expectedLockouts: []entity.LoginScope{entity.LoginScopeIP},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockoutStorage := mocks.NewStorage(t)
+
+			lockoutStorage.EXPECT().IncrementLoginFailures(ctx, entity.LoginScopeUsername, username,
+				mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+				Once().
+				Return(tt.usernameFailures, nil)
+
+			lockoutStorage.EXPECT().IncrementLoginFailures(ctx, entity.LoginScopeIP, ip,
+				mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+				Once().
+				Return(tt.ipFailures, nil)
+
+			if tt.expectedBlock > 0 {
+				lockoutStorage.EXPECT().BlockLogin(ctx, entity.LoginScopeUsername, username,
+					mock.MatchedBy(func(blockedUntil time.Time) bool {
+						return time.Until(blockedUntil).Round(time.Second) == tt.expectedBlock
+					}), tt.expectedLocked).
+					Once().
+					Return(nil)
+			}
+
+			if tt.ipFailures >= testConfig.MaxFailuresPerIP {
+				lockoutStorage.EXPECT().BlockLogin(ctx, entity.LoginScopeIP, ip, mock.AnythingOfType("time.Time"), true).
+					Once().
+					Return(nil)
+			}
+
+			lockouts, err := New(lockoutStorage, testConfig).RecordLoginFailure(ctx, username, ip)
+			require.NoError(t, err)
+
+			scopes := make([]entity.LoginScope, 0, len(lockouts))
+			for _, lockout := range lockouts {
+				scopes = append(scopes, lockout.Scope)
+			}
+
+			require.ElementsMatch(t, tt.expectedLockouts, scopes)
+		})
+	}
+}
+
+func TestLockoutService_Backoff(t *testing.T) {
+	service := New(nil, testConfig)
+
+	require.Equal(t, time.Duration(0), service.backoff(2))
+	require.Equal(t, time.Second, service.backoff(3))
+	require.Equal(t, 8*time.Second, service.backoff(6))
+	require.Equal(t, time.Minute, service.backoff(20))
+}
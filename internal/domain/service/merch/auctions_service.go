@@ -0,0 +1,131 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+func (s *Service) CreateAuction(ctx context.Context, auction entity.Auction) error {
+	const op = "service.merch.CreateAuction"
+
+	if err := s.storage.CreateAuction(ctx, auction); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetAuctionByID(ctx context.Context, auctionID string) (entity.Auction, error) {
+	const op = "service.merch.GetAuctionByID"
+
+	auction, err := s.storage.GetAuctionByID(ctx, auctionID)
+	if err != nil {
+		if errors.Is(err, storage.ErrAuctionNotFound) {
+			return entity.Auction{}, domain.ErrAuctionNotFound
+		}
+		return entity.Auction{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return auction, nil
+}
+
+func (s *Service) LockAuction(ctx context.Context, auctionID string) (entity.Auction, error) {
+	const op = "service.merch.LockAuction"
+
+	auction, err := s.storage.LockAuction(ctx, auctionID)
+	if err != nil {
+		if errors.Is(err, storage.ErrAuctionNotFound) {
+			return entity.Auction{}, domain.ErrAuctionNotFound
+		}
+		return entity.Auction{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return auction, nil
+}
+
+func (s *Service) ListAuctions(ctx context.Context, filter entity.AuctionFilter) ([]entity.Auction, error) {
+	const op = "service.merch.ListAuctions"
+
+	auctions, err := s.storage.ListAuctions(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return auctions, nil
+}
+
+func (s *Service) ListDueAuctions(ctx context.Context) ([]string, error) {
+	const op = "service.merch.ListDueAuctions"
+
+	auctionIDs, err := s.storage.ListDueAuctions(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return auctionIDs, nil
+}
+
+func (s *Service) ListAuctionBids(ctx context.Context, auctionID string) ([]entity.AuctionBid, error) {
+	const op = "service.merch.ListAuctionBids"
+
+	bids, err := s.storage.ListAuctionBids(ctx, auctionID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return bids, nil
+}
+
+func (s *Service) PlaceAuctionBid(ctx context.Context, bid entity.AuctionBid) error {
+	const op = "service.merch.PlaceAuctionBid"
+
+	if err := s.storage.PlaceAuctionBid(ctx, bid); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) ResolveAuctionBid(ctx context.Context, bidID string, status entity.BidStatus) error {
+	const op = "service.merch.ResolveAuctionBid"
+
+	if err := s.storage.ResolveAuctionBid(ctx, bidID, status, time.Now()); err != nil {
+		if errors.Is(err, storage.ErrAuctionBidNotHeld) {
+			return domain.ErrAuctionBidNotHeld
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) CloseAuction(ctx context.Context, auctionID string, status entity.AuctionStatus) error {
+	const op = "service.merch.CloseAuction"
+
+	if err := s.storage.CloseAuction(ctx, auctionID, status, time.Now()); err != nil {
+		if errors.Is(err, storage.ErrAuctionNotOpen) {
+			return domain.ErrAuctionNotOpen
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RefundUserBids releases every bid the user holds in escrow, crediting the coins is up to the caller
+func (s *Service) RefundUserBids(ctx context.Context, userID string) ([]entity.AuctionBid, error) {
+	const op = "service.merch.RefundUserBids"
+
+	bids, err := s.storage.RefundUserBids(ctx, userID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return bids, nil
+}
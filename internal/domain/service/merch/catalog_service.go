@@ -0,0 +1,41 @@
+package merch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/segmentio/ksuid"
+
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+func (s *Service) ListCatalog(ctx context.Context) ([]entity.CatalogItem, error) {
+	const op = "service.merch.ListCatalog"
+
+	items, err := s.storage.ListCatalog(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return items, nil
+}
+
+func (s *Service) UpsertCatalogItem(ctx context.Context, item entity.CatalogItem) error {
+	const op = "service.merch.UpsertCatalogItem"
+
+	if err := s.storage.UpsertCatalogItem(ctx, ksuid.New().String(), ksuid.New().String(), item); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) RetireMerch(ctx context.Context, name string) error {
+	const op = "service.merch.RetireMerch"
+
+	if err := s.storage.RetireMerch(ctx, name); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+func (s *Service) CreateMerchImage(ctx context.Context, image entity.MerchImage) error {
+	const op = "service.merch.CreateMerchImage"
+
+	if err := s.storage.CreateMerchImage(ctx, image); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetMerchImageByID(ctx context.Context, imageID string) (entity.MerchImage, error) {
+	const op = "service.merch.GetMerchImageByID"
+
+	image, err := s.storage.GetMerchImageByID(ctx, imageID)
+	if err != nil {
+		if errors.Is(err, storage.ErrMerchImageNotFound) {
+			return entity.MerchImage{}, domain.ErrMerchImageNotFound
+		}
+		return entity.MerchImage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return image, nil
+}
+
+func (s *Service) ListMerchImages(ctx context.Context, merchIDs []string) ([]entity.MerchImage, error) {
+	const op = "service.merch.ListMerchImages"
+
+	images, err := s.storage.ListMerchImages(ctx, merchIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return images, nil
+}
+
+func (s *Service) DeleteMerchImage(ctx context.Context, imageID string) error {
+	const op = "service.merch.DeleteMerchImage"
+
+	if err := s.storage.DeleteMerchImage(ctx, imageID); err != nil {
+		if errors.Is(err, storage.ErrMerchImageNotFound) {
+			return domain.ErrMerchImageNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -26,6 +26,58 @@ func New(storage Storage) *Service {
 type Storage interface {
 	GetMerchByName(ctx context.Context, itemName string) (entity.Merch, error)
 	AddToInventory(ctx context.Context, purchase entity.Purchase) error
+	TakeFromStock(ctx context.Context, merchID string) error
+	Restock(ctx context.Context, merchID string) error
+	SearchMerch(ctx context.Context, filter entity.MerchFilter) (entity.MerchPage, error)
+	ListCategories(ctx context.Context) ([]string, error)
+	GetPurchaseByID(ctx context.Context, purchaseID string) (entity.Purchase, error)
+	ListUserPurchases(ctx context.Context, userID string) ([]entity.Purchase, error)
+	MarkPurchaseReturned(ctx context.Context, purchaseID string) error
+	CreateMerchReturn(ctx context.Context, merchReturn entity.MerchReturn) error
+	GetMerchReturnByID(ctx context.Context, returnID string) (entity.MerchReturn, error)
+	ListMerchReturns(ctx context.Context, filter entity.MerchReturnFilter) ([]entity.MerchReturn, error)
+	ResolveMerchReturn(ctx context.Context, merchReturn entity.MerchReturn) error
+	ListCatalog(ctx context.Context) ([]entity.CatalogItem, error)
+	UpsertCatalogItem(ctx context.Context, merchID, categoryID string, item entity.CatalogItem) error
+	RetireMerch(ctx context.Context, name string) error
+	CreateMerchImage(ctx context.Context, image entity.MerchImage) error
+	GetMerchImageByID(ctx context.Context, imageID string) (entity.MerchImage, error)
+	ListMerchImages(ctx context.Context, merchIDs []string) ([]entity.MerchImage, error)
+	DeleteMerchImage(ctx context.Context, imageID string) error
+	TransferItems(ctx context.Context, transfer entity.ItemTransfer) error
+	ListItemTransfers(ctx context.Context, userID string) ([]entity.ItemTransfer, error)
+	JoinWaitlist(ctx context.Context, entry entity.WaitlistEntry) error
+	LeaveWaitlist(ctx context.Context, userID, merchID string) error
+	DeleteUserWaitlist(ctx context.Context, userID string) error
+	ListUserWaitlist(ctx context.Context, userID string) ([]entity.WaitlistEntry, error)
+	ListRestockedMerch(ctx context.Context) ([]entity.RestockedMerch, error)
+	PopWaitlist(ctx context.Context, merchID string, limit int) ([]entity.WaitlistEntry, error)
+	ReserveStock(ctx context.Context, merchID string, quantity int) error
+	CreateReservation(ctx context.Context, reservation entity.Reservation) error
+	GetReservationByID(ctx context.Context, reservationID string) (entity.Reservation, error)
+	ListUserReservations(ctx context.Context, userID string) ([]entity.Reservation, error)
+	CompleteReservation(ctx context.Context, reservationID string, now time.Time) error
+	ExpireReservations(ctx context.Context, now time.Time) ([]entity.Reservation, error)
+	CreateAuction(ctx context.Context, auction entity.Auction) error
+	GetAuctionByID(ctx context.Context, auctionID string) (entity.Auction, error)
+	LockAuction(ctx context.Context, auctionID string) (entity.Auction, error)
+	ListAuctions(ctx context.Context, filter entity.AuctionFilter) ([]entity.Auction, error)
+	ListDueAuctions(ctx context.Context, now time.Time) ([]string, error)
+	ListAuctionBids(ctx context.Context, auctionID string) ([]entity.AuctionBid, error)
+	PlaceAuctionBid(ctx context.Context, bid entity.AuctionBid) error
+	ResolveAuctionBid(ctx context.Context, bidID string, status entity.BidStatus, now time.Time) error
+	RefundUserBids(ctx context.Context, userID string, now time.Time) ([]entity.AuctionBid, error)
+	CloseAuction(ctx context.Context, auctionID string, status entity.AuctionStatus, now time.Time) error
+	CreateRaffle(ctx context.Context, raffle entity.Raffle) error
+	GetRaffleByID(ctx context.Context, raffleID string) (entity.Raffle, error)
+	LockRaffle(ctx context.Context, raffleID string) (entity.Raffle, error)
+	ListRaffles(ctx context.Context, filter entity.RaffleFilter) ([]entity.Raffle, error)
+	ListDueRaffles(ctx context.Context, now time.Time) ([]string, error)
+	CountRaffleTickets(ctx context.Context, raffleID, userID string) (total, owned int, err error)
+	CreateRaffleTickets(ctx context.Context, tickets []entity.RaffleTicket) error
+	ListRaffleTickets(ctx context.Context, raffleID string) ([]entity.RaffleTicket, error)
+	ListRaffleWinners(ctx context.Context, raffleID string) ([]entity.RaffleWinner, error)
+	CompleteRaffleDraw(ctx context.Context, raffleID string, winners []entity.RaffleWinner, now time.Time) error
 }
 
 func (s *Service) GetMerchByName(ctx context.Context, itemName string) (entity.Merch, error) {
@@ -42,13 +94,14 @@ func (s *Service) GetMerchByName(ctx context.Context, itemName string) (entity.M
 	return merch, nil
 }
 
-func (s *Service) AddToInventory(ctx context.Context, userID, merchID string) error {
+func (s *Service) AddToInventory(ctx context.Context, userID, merchID string, price int) error {
 	const op = "service.merch.AddToInventory"
 
 	purchase := entity.Purchase{
 		ID:        ksuid.New().String(),
 		UserID:    userID,
 		MerchID:   merchID,
+		Price:     price,
 		CreatedAt: time.Now(),
 	}
 
@@ -59,3 +112,53 @@ func (s *Service) AddToInventory(ctx context.Context, userID, merchID string) er
 
 	return nil
 }
+
+func (s *Service) TakeFromStock(ctx context.Context, merchID string) error {
+	const op = "service.merch.TakeFromStock"
+
+	err := s.storage.TakeFromStock(ctx, merchID)
+	if err != nil {
+		if errors.Is(err, storage.ErrMerchOutOfStock) {
+			return domain.ErrMerchOutOfStock
+		}
+		if errors.Is(err, storage.ErrMerchReservedForWaitlist) {
+			return domain.ErrMerchReservedForWaitlist
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) Restock(ctx context.Context, merchID string) error {
+	const op = "service.merch.Restock"
+
+	err := s.storage.Restock(ctx, merchID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) SearchMerch(ctx context.Context, filter entity.MerchFilter) (entity.MerchPage, error) {
+	const op = "service.merch.SearchMerch"
+
+	page, err := s.storage.SearchMerch(ctx, filter)
+	if err != nil {
+		return entity.MerchPage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return page, nil
+}
+
+func (s *Service) ListCategories(ctx context.Context) ([]string, error) {
+	const op = "service.merch.ListCategories"
+
+	categories, err := s.storage.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return categories, nil
+}
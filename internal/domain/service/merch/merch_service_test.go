@@ -117,7 +117,138 @@ func TestMerchService_AddToInventory(t *testing.T) {
 			tt.mockBehavior(merchStorage)
 
 			merchService := New(merchStorage)
-			err := merchService.AddToInventory(ctx, userID, merchID)
+			err := merchService.AddToInventory(ctx, userID, merchID, 10)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMerchService_SearchMerch(t *testing.T) {
+	ctx := context.Background()
+	filter := entity.MerchFilter{
+		Query: "hoody",
+		Limit: entity.DefaultMerchPageLimit,
+	}
+
+	expectedPage := entity.MerchPage{
+		Items: []entity.Merch{
+			{
+				ID:    "test-merch-id",
+				Name:  "hoody",
+				Price: 300,
+			},
+		},
+		Total: 1,
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(merchStorage *mocks.Storage)
+		expectedPage  entity.MerchPage
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(merchStorage *mocks.Storage) {
+				merchStorage.EXPECT().SearchMerch(ctx, filter).
+					Once().
+					Return(expectedPage, nil)
+			},
+			expectedPage:  expectedPage,
+			expectedError: nil,
+		},
+		{
+			name: "Error – Storage error",
+			mockBehavior: func(merchStorage *mocks.Storage) {
+				merchStorage.EXPECT().SearchMerch(ctx, filter).
+					Once().
+					Return(entity.MerchPage{}, errors.New("storage error"))
+			},
+			expectedPage:  entity.MerchPage{},
+			expectedError: errors.New("storage error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merchStorage := mocks.NewStorage(t)
+			tt.mockBehavior(merchStorage)
+
+			merchService := New(merchStorage)
+			page, err := merchService.SearchMerch(ctx, filter)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError.Error())
+				require.Empty(t, page)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedPage, page)
+			}
+		})
+	}
+}
+
+func TestMerchService_TakeFromStock(t *testing.T) {
+	ctx := context.Background()
+	merchID := "test-merch-id"
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(merchStorage *mocks.Storage)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(merchStorage *mocks.Storage) {
+				merchStorage.EXPECT().TakeFromStock(ctx, merchID).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Merch out of stock",
+			mockBehavior: func(merchStorage *mocks.Storage) {
+				merchStorage.EXPECT().TakeFromStock(ctx, merchID).
+					Once().
+					Return(storage.ErrMerchOutOfStock)
+			},
+			expectedError: domain.ErrMerchOutOfStock,
+		},
+		{
+			name: "Error – Merch reserved for waitlist",
+			mockBehavior: func(merchStorage *mocks.Storage) {
+				merchStorage.EXPECT().TakeFromStock(ctx, merchID).
+					Once().
+					Return(storage.ErrMerchReservedForWaitlist)
+			},
+			expectedError: domain.ErrMerchReservedForWaitlist,
+		},
+		{
+			name: "Error – Storage error",
+			mockBehavior: func(merchStorage *mocks.Storage) {
+				merchStorage.EXPECT().TakeFromStock(ctx, merchID).
+					Once().
+					Return(errors.New("storage error"))
+			},
+			expectedError: errors.New("storage error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merchStorage := mocks.NewStorage(t)
+			tt.mockBehavior(merchStorage)
+
+			merchService := New(merchStorage)
+			err := merchService.TakeFromStock(ctx, merchID)
 
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -8,6 +8,8 @@ import (
 	entity "github.com/rshelekhov/merch-store/internal/domain/entity"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Storage is an autogenerated mock type for the Storage type
@@ -70,59 +72,2849 @@ func (_c *Storage_AddToInventory_Call) RunAndReturn(run func(context.Context, en
 	return _c
 }
 
-// GetMerchByName provides a mock function with given fields: ctx, itemName
-func (_m *Storage) GetMerchByName(ctx context.Context, itemName string) (entity.Merch, error) {
-	ret := _m.Called(ctx, itemName)
+// CloseAuction provides a mock function with given fields: ctx, auctionID, status, now
+func (_m *Storage) CloseAuction(ctx context.Context, auctionID string, status entity.AuctionStatus, now time.Time) error {
+	ret := _m.Called(ctx, auctionID, status, now)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMerchByName")
+		panic("no return value specified for CloseAuction")
 	}
 
-	var r0 entity.Merch
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Merch, error)); ok {
-		return rf(ctx, itemName)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.AuctionStatus, time.Time) error); ok {
+		r0 = rf(ctx, auctionID, status, now)
+	} else {
+		r0 = ret.Error(0)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Merch); ok {
-		r0 = rf(ctx, itemName)
+
+	return r0
+}
+
+// Storage_CloseAuction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseAuction'
+type Storage_CloseAuction_Call struct {
+	*mock.Call
+}
+
+// CloseAuction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - auctionID string
+//   - status entity.AuctionStatus
+//   - now time.Time
+func (_e *Storage_Expecter) CloseAuction(ctx interface{}, auctionID interface{}, status interface{}, now interface{}) *Storage_CloseAuction_Call {
+	return &Storage_CloseAuction_Call{Call: _e.mock.On("CloseAuction", ctx, auctionID, status, now)}
+}
+
+func (_c *Storage_CloseAuction_Call) Run(run func(ctx context.Context, auctionID string, status entity.AuctionStatus, now time.Time)) *Storage_CloseAuction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(entity.AuctionStatus), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_CloseAuction_Call) Return(_a0 error) *Storage_CloseAuction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_CloseAuction_Call) RunAndReturn(run func(context.Context, string, entity.AuctionStatus, time.Time) error) *Storage_CloseAuction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteRaffleDraw provides a mock function with given fields: ctx, raffleID, winners, now
+func (_m *Storage) CompleteRaffleDraw(ctx context.Context, raffleID string, winners []entity.RaffleWinner, now time.Time) error {
+	ret := _m.Called(ctx, raffleID, winners, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteRaffleDraw")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []entity.RaffleWinner, time.Time) error); ok {
+		r0 = rf(ctx, raffleID, winners, now)
 	} else {
-		r0 = ret.Get(0).(entity.Merch)
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, itemName)
+	return r0
+}
+
+// Storage_CompleteRaffleDraw_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteRaffleDraw'
+type Storage_CompleteRaffleDraw_Call struct {
+	*mock.Call
+}
+
+// CompleteRaffleDraw is a helper method to define mock.On call
+//   - ctx context.Context
+//   - raffleID string
+//   - winners []entity.RaffleWinner
+//   - now time.Time
+func (_e *Storage_Expecter) CompleteRaffleDraw(ctx interface{}, raffleID interface{}, winners interface{}, now interface{}) *Storage_CompleteRaffleDraw_Call {
+	return &Storage_CompleteRaffleDraw_Call{Call: _e.mock.On("CompleteRaffleDraw", ctx, raffleID, winners, now)}
+}
+
+func (_c *Storage_CompleteRaffleDraw_Call) Run(run func(ctx context.Context, raffleID string, winners []entity.RaffleWinner, now time.Time)) *Storage_CompleteRaffleDraw_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]entity.RaffleWinner), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_CompleteRaffleDraw_Call) Return(_a0 error) *Storage_CompleteRaffleDraw_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_CompleteRaffleDraw_Call) RunAndReturn(run func(context.Context, string, []entity.RaffleWinner, time.Time) error) *Storage_CompleteRaffleDraw_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteReservation provides a mock function with given fields: ctx, reservationID, now
+func (_m *Storage) CompleteReservation(ctx context.Context, reservationID string, now time.Time) error {
+	ret := _m.Called(ctx, reservationID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, reservationID, now)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Storage_GetMerchByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchByName'
-type Storage_GetMerchByName_Call struct {
+// Storage_CompleteReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteReservation'
+type Storage_CompleteReservation_Call struct {
 	*mock.Call
 }
 
-// GetMerchByName is a helper method to define mock.On call
+// CompleteReservation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - itemName string
-func (_e *Storage_Expecter) GetMerchByName(ctx interface{}, itemName interface{}) *Storage_GetMerchByName_Call {
-	return &Storage_GetMerchByName_Call{Call: _e.mock.On("GetMerchByName", ctx, itemName)}
+//   - reservationID string
+//   - now time.Time
+func (_e *Storage_Expecter) CompleteReservation(ctx interface{}, reservationID interface{}, now interface{}) *Storage_CompleteReservation_Call {
+	return &Storage_CompleteReservation_Call{Call: _e.mock.On("CompleteReservation", ctx, reservationID, now)}
 }
 
-func (_c *Storage_GetMerchByName_Call) Run(run func(ctx context.Context, itemName string)) *Storage_GetMerchByName_Call {
+func (_c *Storage_CompleteReservation_Call) Run(run func(ctx context.Context, reservationID string, now time.Time)) *Storage_CompleteReservation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *Storage_GetMerchByName_Call) Return(_a0 entity.Merch, _a1 error) *Storage_GetMerchByName_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Storage_CompleteReservation_Call) Return(_a0 error) *Storage_CompleteReservation_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Storage_GetMerchByName_Call) RunAndReturn(run func(context.Context, string) (entity.Merch, error)) *Storage_GetMerchByName_Call {
+func (_c *Storage_CompleteReservation_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *Storage_CompleteReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountRaffleTickets provides a mock function with given fields: ctx, raffleID, userID
+func (_m *Storage) CountRaffleTickets(ctx context.Context, raffleID string, userID string) (int, int, error) {
+	ret := _m.Called(ctx, raffleID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountRaffleTickets")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int, int, error)); ok {
+		return rf(ctx, raffleID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int); ok {
+		r0 = rf(ctx, raffleID, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) int); ok {
+		r1 = rf(ctx, raffleID, userID)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, raffleID, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Storage_CountRaffleTickets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountRaffleTickets'
+type Storage_CountRaffleTickets_Call struct {
+	*mock.Call
+}
+
+// CountRaffleTickets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - raffleID string
+//   - userID string
+func (_e *Storage_Expecter) CountRaffleTickets(ctx interface{}, raffleID interface{}, userID interface{}) *Storage_CountRaffleTickets_Call {
+	return &Storage_CountRaffleTickets_Call{Call: _e.mock.On("CountRaffleTickets", ctx, raffleID, userID)}
+}
+
+func (_c *Storage_CountRaffleTickets_Call) Run(run func(ctx context.Context, raffleID string, userID string)) *Storage_CountRaffleTickets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_CountRaffleTickets_Call) Return(total int, owned int, err error) *Storage_CountRaffleTickets_Call {
+	_c.Call.Return(total, owned, err)
+	return _c
+}
+
+func (_c *Storage_CountRaffleTickets_Call) RunAndReturn(run func(context.Context, string, string) (int, int, error)) *Storage_CountRaffleTickets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAuction provides a mock function with given fields: ctx, auction
+func (_m *Storage) CreateAuction(ctx context.Context, auction entity.Auction) error {
+	ret := _m.Called(ctx, auction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Auction) error); ok {
+		r0 = rf(ctx, auction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_CreateAuction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuction'
+type Storage_CreateAuction_Call struct {
+	*mock.Call
+}
+
+// CreateAuction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - auction entity.Auction
+func (_e *Storage_Expecter) CreateAuction(ctx interface{}, auction interface{}) *Storage_CreateAuction_Call {
+	return &Storage_CreateAuction_Call{Call: _e.mock.On("CreateAuction", ctx, auction)}
+}
+
+func (_c *Storage_CreateAuction_Call) Run(run func(ctx context.Context, auction entity.Auction)) *Storage_CreateAuction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Auction))
+	})
+	return _c
+}
+
+func (_c *Storage_CreateAuction_Call) Return(_a0 error) *Storage_CreateAuction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_CreateAuction_Call) RunAndReturn(run func(context.Context, entity.Auction) error) *Storage_CreateAuction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMerchImage provides a mock function with given fields: ctx, image
+func (_m *Storage) CreateMerchImage(ctx context.Context, image entity.MerchImage) error {
+	ret := _m.Called(ctx, image)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMerchImage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchImage) error); ok {
+		r0 = rf(ctx, image)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_CreateMerchImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMerchImage'
+type Storage_CreateMerchImage_Call struct {
+	*mock.Call
+}
+
+// CreateMerchImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - image entity.MerchImage
+func (_e *Storage_Expecter) CreateMerchImage(ctx interface{}, image interface{}) *Storage_CreateMerchImage_Call {
+	return &Storage_CreateMerchImage_Call{Call: _e.mock.On("CreateMerchImage", ctx, image)}
+}
+
+func (_c *Storage_CreateMerchImage_Call) Run(run func(ctx context.Context, image entity.MerchImage)) *Storage_CreateMerchImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MerchImage))
+	})
+	return _c
+}
+
+func (_c *Storage_CreateMerchImage_Call) Return(_a0 error) *Storage_CreateMerchImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_CreateMerchImage_Call) RunAndReturn(run func(context.Context, entity.MerchImage) error) *Storage_CreateMerchImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMerchReturn provides a mock function with given fields: ctx, merchReturn
+func (_m *Storage) CreateMerchReturn(ctx context.Context, merchReturn entity.MerchReturn) error {
+	ret := _m.Called(ctx, merchReturn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMerchReturn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchReturn) error); ok {
+		r0 = rf(ctx, merchReturn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_CreateMerchReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMerchReturn'
+type Storage_CreateMerchReturn_Call struct {
+	*mock.Call
+}
+
+// CreateMerchReturn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchReturn entity.MerchReturn
+func (_e *Storage_Expecter) CreateMerchReturn(ctx interface{}, merchReturn interface{}) *Storage_CreateMerchReturn_Call {
+	return &Storage_CreateMerchReturn_Call{Call: _e.mock.On("CreateMerchReturn", ctx, merchReturn)}
+}
+
+func (_c *Storage_CreateMerchReturn_Call) Run(run func(ctx context.Context, merchReturn entity.MerchReturn)) *Storage_CreateMerchReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MerchReturn))
+	})
+	return _c
+}
+
+func (_c *Storage_CreateMerchReturn_Call) Return(_a0 error) *Storage_CreateMerchReturn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_CreateMerchReturn_Call) RunAndReturn(run func(context.Context, entity.MerchReturn) error) *Storage_CreateMerchReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRaffle provides a mock function with given fields: ctx, raffle
+func (_m *Storage) CreateRaffle(ctx context.Context, raffle entity.Raffle) error {
+	ret := _m.Called(ctx, raffle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRaffle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Raffle) error); ok {
+		r0 = rf(ctx, raffle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_CreateRaffle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRaffle'
+type Storage_CreateRaffle_Call struct {
+	*mock.Call
+}
+
+// CreateRaffle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - raffle entity.Raffle
+func (_e *Storage_Expecter) CreateRaffle(ctx interface{}, raffle interface{}) *Storage_CreateRaffle_Call {
+	return &Storage_CreateRaffle_Call{Call: _e.mock.On("CreateRaffle", ctx, raffle)}
+}
+
+func (_c *Storage_CreateRaffle_Call) Run(run func(ctx context.Context, raffle entity.Raffle)) *Storage_CreateRaffle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Raffle))
+	})
+	return _c
+}
+
+func (_c *Storage_CreateRaffle_Call) Return(_a0 error) *Storage_CreateRaffle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_CreateRaffle_Call) RunAndReturn(run func(context.Context, entity.Raffle) error) *Storage_CreateRaffle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRaffleTickets provides a mock function with given fields: ctx, tickets
+func (_m *Storage) CreateRaffleTickets(ctx context.Context, tickets []entity.RaffleTicket) error {
+	ret := _m.Called(ctx, tickets)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRaffleTickets")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.RaffleTicket) error); ok {
+		r0 = rf(ctx, tickets)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_CreateRaffleTickets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRaffleTickets'
+type Storage_CreateRaffleTickets_Call struct {
+	*mock.Call
+}
+
+// CreateRaffleTickets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tickets []entity.RaffleTicket
+func (_e *Storage_Expecter) CreateRaffleTickets(ctx interface{}, tickets interface{}) *Storage_CreateRaffleTickets_Call {
+	return &Storage_CreateRaffleTickets_Call{Call: _e.mock.On("CreateRaffleTickets", ctx, tickets)}
+}
+
+func (_c *Storage_CreateRaffleTickets_Call) Run(run func(ctx context.Context, tickets []entity.RaffleTicket)) *Storage_CreateRaffleTickets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]entity.RaffleTicket))
+	})
+	return _c
+}
+
+func (_c *Storage_CreateRaffleTickets_Call) Return(_a0 error) *Storage_CreateRaffleTickets_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_CreateRaffleTickets_Call) RunAndReturn(run func(context.Context, []entity.RaffleTicket) error) *Storage_CreateRaffleTickets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReservation provides a mock function with given fields: ctx, reservation
+func (_m *Storage) CreateReservation(ctx context.Context, reservation entity.Reservation) error {
+	ret := _m.Called(ctx, reservation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Reservation) error); ok {
+		r0 = rf(ctx, reservation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_CreateReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReservation'
+type Storage_CreateReservation_Call struct {
+	*mock.Call
+}
+
+// CreateReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservation entity.Reservation
+func (_e *Storage_Expecter) CreateReservation(ctx interface{}, reservation interface{}) *Storage_CreateReservation_Call {
+	return &Storage_CreateReservation_Call{Call: _e.mock.On("CreateReservation", ctx, reservation)}
+}
+
+func (_c *Storage_CreateReservation_Call) Run(run func(ctx context.Context, reservation entity.Reservation)) *Storage_CreateReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Reservation))
+	})
+	return _c
+}
+
+func (_c *Storage_CreateReservation_Call) Return(_a0 error) *Storage_CreateReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_CreateReservation_Call) RunAndReturn(run func(context.Context, entity.Reservation) error) *Storage_CreateReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMerchImage provides a mock function with given fields: ctx, imageID
+func (_m *Storage) DeleteMerchImage(ctx context.Context, imageID string) error {
+	ret := _m.Called(ctx, imageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMerchImage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, imageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_DeleteMerchImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMerchImage'
+type Storage_DeleteMerchImage_Call struct {
+	*mock.Call
+}
+
+// DeleteMerchImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imageID string
+func (_e *Storage_Expecter) DeleteMerchImage(ctx interface{}, imageID interface{}) *Storage_DeleteMerchImage_Call {
+	return &Storage_DeleteMerchImage_Call{Call: _e.mock.On("DeleteMerchImage", ctx, imageID)}
+}
+
+func (_c *Storage_DeleteMerchImage_Call) Run(run func(ctx context.Context, imageID string)) *Storage_DeleteMerchImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_DeleteMerchImage_Call) Return(_a0 error) *Storage_DeleteMerchImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_DeleteMerchImage_Call) RunAndReturn(run func(context.Context, string) error) *Storage_DeleteMerchImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserWaitlist provides a mock function with given fields: ctx, userID
+func (_m *Storage) DeleteUserWaitlist(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserWaitlist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_DeleteUserWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserWaitlist'
+type Storage_DeleteUserWaitlist_Call struct {
+	*mock.Call
+}
+
+// DeleteUserWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *Storage_Expecter) DeleteUserWaitlist(ctx interface{}, userID interface{}) *Storage_DeleteUserWaitlist_Call {
+	return &Storage_DeleteUserWaitlist_Call{Call: _e.mock.On("DeleteUserWaitlist", ctx, userID)}
+}
+
+func (_c *Storage_DeleteUserWaitlist_Call) Run(run func(ctx context.Context, userID string)) *Storage_DeleteUserWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_DeleteUserWaitlist_Call) Return(_a0 error) *Storage_DeleteUserWaitlist_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_DeleteUserWaitlist_Call) RunAndReturn(run func(context.Context, string) error) *Storage_DeleteUserWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireReservations provides a mock function with given fields: ctx, now
+func (_m *Storage) ExpireReservations(ctx context.Context, now time.Time) ([]entity.Reservation, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireReservations")
+	}
+
+	var r0 []entity.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]entity.Reservation, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []entity.Reservation); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ExpireReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireReservations'
+type Storage_ExpireReservations_Call struct {
+	*mock.Call
+}
+
+// ExpireReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *Storage_Expecter) ExpireReservations(ctx interface{}, now interface{}) *Storage_ExpireReservations_Call {
+	return &Storage_ExpireReservations_Call{Call: _e.mock.On("ExpireReservations", ctx, now)}
+}
+
+func (_c *Storage_ExpireReservations_Call) Run(run func(ctx context.Context, now time.Time)) *Storage_ExpireReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_ExpireReservations_Call) Return(_a0 []entity.Reservation, _a1 error) *Storage_ExpireReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ExpireReservations_Call) RunAndReturn(run func(context.Context, time.Time) ([]entity.Reservation, error)) *Storage_ExpireReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuctionByID provides a mock function with given fields: ctx, auctionID
+func (_m *Storage) GetAuctionByID(ctx context.Context, auctionID string) (entity.Auction, error) {
+	ret := _m.Called(ctx, auctionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuctionByID")
+	}
+
+	var r0 entity.Auction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Auction, error)); ok {
+		return rf(ctx, auctionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Auction); ok {
+		r0 = rf(ctx, auctionID)
+	} else {
+		r0 = ret.Get(0).(entity.Auction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, auctionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_GetAuctionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuctionByID'
+type Storage_GetAuctionByID_Call struct {
+	*mock.Call
+}
+
+// GetAuctionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - auctionID string
+func (_e *Storage_Expecter) GetAuctionByID(ctx interface{}, auctionID interface{}) *Storage_GetAuctionByID_Call {
+	return &Storage_GetAuctionByID_Call{Call: _e.mock.On("GetAuctionByID", ctx, auctionID)}
+}
+
+func (_c *Storage_GetAuctionByID_Call) Run(run func(ctx context.Context, auctionID string)) *Storage_GetAuctionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_GetAuctionByID_Call) Return(_a0 entity.Auction, _a1 error) *Storage_GetAuctionByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_GetAuctionByID_Call) RunAndReturn(run func(context.Context, string) (entity.Auction, error)) *Storage_GetAuctionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchByName provides a mock function with given fields: ctx, itemName
+func (_m *Storage) GetMerchByName(ctx context.Context, itemName string) (entity.Merch, error) {
+	ret := _m.Called(ctx, itemName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchByName")
+	}
+
+	var r0 entity.Merch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Merch, error)); ok {
+		return rf(ctx, itemName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Merch); ok {
+		r0 = rf(ctx, itemName)
+	} else {
+		r0 = ret.Get(0).(entity.Merch)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, itemName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_GetMerchByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchByName'
+type Storage_GetMerchByName_Call struct {
+	*mock.Call
+}
+
+// GetMerchByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemName string
+func (_e *Storage_Expecter) GetMerchByName(ctx interface{}, itemName interface{}) *Storage_GetMerchByName_Call {
+	return &Storage_GetMerchByName_Call{Call: _e.mock.On("GetMerchByName", ctx, itemName)}
+}
+
+func (_c *Storage_GetMerchByName_Call) Run(run func(ctx context.Context, itemName string)) *Storage_GetMerchByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_GetMerchByName_Call) Return(_a0 entity.Merch, _a1 error) *Storage_GetMerchByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_GetMerchByName_Call) RunAndReturn(run func(context.Context, string) (entity.Merch, error)) *Storage_GetMerchByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchImageByID provides a mock function with given fields: ctx, imageID
+func (_m *Storage) GetMerchImageByID(ctx context.Context, imageID string) (entity.MerchImage, error) {
+	ret := _m.Called(ctx, imageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchImageByID")
+	}
+
+	var r0 entity.MerchImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.MerchImage, error)); ok {
+		return rf(ctx, imageID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.MerchImage); ok {
+		r0 = rf(ctx, imageID)
+	} else {
+		r0 = ret.Get(0).(entity.MerchImage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, imageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_GetMerchImageByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchImageByID'
+type Storage_GetMerchImageByID_Call struct {
+	*mock.Call
+}
+
+// GetMerchImageByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imageID string
+func (_e *Storage_Expecter) GetMerchImageByID(ctx interface{}, imageID interface{}) *Storage_GetMerchImageByID_Call {
+	return &Storage_GetMerchImageByID_Call{Call: _e.mock.On("GetMerchImageByID", ctx, imageID)}
+}
+
+func (_c *Storage_GetMerchImageByID_Call) Run(run func(ctx context.Context, imageID string)) *Storage_GetMerchImageByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_GetMerchImageByID_Call) Return(_a0 entity.MerchImage, _a1 error) *Storage_GetMerchImageByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_GetMerchImageByID_Call) RunAndReturn(run func(context.Context, string) (entity.MerchImage, error)) *Storage_GetMerchImageByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMerchReturnByID provides a mock function with given fields: ctx, returnID
+func (_m *Storage) GetMerchReturnByID(ctx context.Context, returnID string) (entity.MerchReturn, error) {
+	ret := _m.Called(ctx, returnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMerchReturnByID")
+	}
+
+	var r0 entity.MerchReturn
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.MerchReturn, error)); ok {
+		return rf(ctx, returnID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.MerchReturn); ok {
+		r0 = rf(ctx, returnID)
+	} else {
+		r0 = ret.Get(0).(entity.MerchReturn)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, returnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_GetMerchReturnByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMerchReturnByID'
+type Storage_GetMerchReturnByID_Call struct {
+	*mock.Call
+}
+
+// GetMerchReturnByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - returnID string
+func (_e *Storage_Expecter) GetMerchReturnByID(ctx interface{}, returnID interface{}) *Storage_GetMerchReturnByID_Call {
+	return &Storage_GetMerchReturnByID_Call{Call: _e.mock.On("GetMerchReturnByID", ctx, returnID)}
+}
+
+func (_c *Storage_GetMerchReturnByID_Call) Run(run func(ctx context.Context, returnID string)) *Storage_GetMerchReturnByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_GetMerchReturnByID_Call) Return(_a0 entity.MerchReturn, _a1 error) *Storage_GetMerchReturnByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_GetMerchReturnByID_Call) RunAndReturn(run func(context.Context, string) (entity.MerchReturn, error)) *Storage_GetMerchReturnByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPurchaseByID provides a mock function with given fields: ctx, purchaseID
+func (_m *Storage) GetPurchaseByID(ctx context.Context, purchaseID string) (entity.Purchase, error) {
+	ret := _m.Called(ctx, purchaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPurchaseByID")
+	}
+
+	var r0 entity.Purchase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Purchase, error)); ok {
+		return rf(ctx, purchaseID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Purchase); ok {
+		r0 = rf(ctx, purchaseID)
+	} else {
+		r0 = ret.Get(0).(entity.Purchase)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, purchaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_GetPurchaseByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPurchaseByID'
+type Storage_GetPurchaseByID_Call struct {
+	*mock.Call
+}
+
+// GetPurchaseByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - purchaseID string
+func (_e *Storage_Expecter) GetPurchaseByID(ctx interface{}, purchaseID interface{}) *Storage_GetPurchaseByID_Call {
+	return &Storage_GetPurchaseByID_Call{Call: _e.mock.On("GetPurchaseByID", ctx, purchaseID)}
+}
+
+func (_c *Storage_GetPurchaseByID_Call) Run(run func(ctx context.Context, purchaseID string)) *Storage_GetPurchaseByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_GetPurchaseByID_Call) Return(_a0 entity.Purchase, _a1 error) *Storage_GetPurchaseByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_GetPurchaseByID_Call) RunAndReturn(run func(context.Context, string) (entity.Purchase, error)) *Storage_GetPurchaseByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRaffleByID provides a mock function with given fields: ctx, raffleID
+func (_m *Storage) GetRaffleByID(ctx context.Context, raffleID string) (entity.Raffle, error) {
+	ret := _m.Called(ctx, raffleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRaffleByID")
+	}
+
+	var r0 entity.Raffle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Raffle, error)); ok {
+		return rf(ctx, raffleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Raffle); ok {
+		r0 = rf(ctx, raffleID)
+	} else {
+		r0 = ret.Get(0).(entity.Raffle)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, raffleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_GetRaffleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRaffleByID'
+type Storage_GetRaffleByID_Call struct {
+	*mock.Call
+}
+
+// GetRaffleByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - raffleID string
+func (_e *Storage_Expecter) GetRaffleByID(ctx interface{}, raffleID interface{}) *Storage_GetRaffleByID_Call {
+	return &Storage_GetRaffleByID_Call{Call: _e.mock.On("GetRaffleByID", ctx, raffleID)}
+}
+
+func (_c *Storage_GetRaffleByID_Call) Run(run func(ctx context.Context, raffleID string)) *Storage_GetRaffleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_GetRaffleByID_Call) Return(_a0 entity.Raffle, _a1 error) *Storage_GetRaffleByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_GetRaffleByID_Call) RunAndReturn(run func(context.Context, string) (entity.Raffle, error)) *Storage_GetRaffleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReservationByID provides a mock function with given fields: ctx, reservationID
+func (_m *Storage) GetReservationByID(ctx context.Context, reservationID string) (entity.Reservation, error) {
+	ret := _m.Called(ctx, reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReservationByID")
+	}
+
+	var r0 entity.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Reservation, error)); ok {
+		return rf(ctx, reservationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Reservation); ok {
+		r0 = rf(ctx, reservationID)
+	} else {
+		r0 = ret.Get(0).(entity.Reservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, reservationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_GetReservationByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReservationByID'
+type Storage_GetReservationByID_Call struct {
+	*mock.Call
+}
+
+// GetReservationByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationID string
+func (_e *Storage_Expecter) GetReservationByID(ctx interface{}, reservationID interface{}) *Storage_GetReservationByID_Call {
+	return &Storage_GetReservationByID_Call{Call: _e.mock.On("GetReservationByID", ctx, reservationID)}
+}
+
+func (_c *Storage_GetReservationByID_Call) Run(run func(ctx context.Context, reservationID string)) *Storage_GetReservationByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_GetReservationByID_Call) Return(_a0 entity.Reservation, _a1 error) *Storage_GetReservationByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_GetReservationByID_Call) RunAndReturn(run func(context.Context, string) (entity.Reservation, error)) *Storage_GetReservationByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JoinWaitlist provides a mock function with given fields: ctx, entry
+func (_m *Storage) JoinWaitlist(ctx context.Context, entry entity.WaitlistEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JoinWaitlist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.WaitlistEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_JoinWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JoinWaitlist'
+type Storage_JoinWaitlist_Call struct {
+	*mock.Call
+}
+
+// JoinWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry entity.WaitlistEntry
+func (_e *Storage_Expecter) JoinWaitlist(ctx interface{}, entry interface{}) *Storage_JoinWaitlist_Call {
+	return &Storage_JoinWaitlist_Call{Call: _e.mock.On("JoinWaitlist", ctx, entry)}
+}
+
+func (_c *Storage_JoinWaitlist_Call) Run(run func(ctx context.Context, entry entity.WaitlistEntry)) *Storage_JoinWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.WaitlistEntry))
+	})
+	return _c
+}
+
+func (_c *Storage_JoinWaitlist_Call) Return(_a0 error) *Storage_JoinWaitlist_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_JoinWaitlist_Call) RunAndReturn(run func(context.Context, entity.WaitlistEntry) error) *Storage_JoinWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LeaveWaitlist provides a mock function with given fields: ctx, userID, merchID
+func (_m *Storage) LeaveWaitlist(ctx context.Context, userID string, merchID string) error {
+	ret := _m.Called(ctx, userID, merchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LeaveWaitlist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, merchID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_LeaveWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LeaveWaitlist'
+type Storage_LeaveWaitlist_Call struct {
+	*mock.Call
+}
+
+// LeaveWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - merchID string
+func (_e *Storage_Expecter) LeaveWaitlist(ctx interface{}, userID interface{}, merchID interface{}) *Storage_LeaveWaitlist_Call {
+	return &Storage_LeaveWaitlist_Call{Call: _e.mock.On("LeaveWaitlist", ctx, userID, merchID)}
+}
+
+func (_c *Storage_LeaveWaitlist_Call) Run(run func(ctx context.Context, userID string, merchID string)) *Storage_LeaveWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_LeaveWaitlist_Call) Return(_a0 error) *Storage_LeaveWaitlist_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_LeaveWaitlist_Call) RunAndReturn(run func(context.Context, string, string) error) *Storage_LeaveWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuctionBids provides a mock function with given fields: ctx, auctionID
+func (_m *Storage) ListAuctionBids(ctx context.Context, auctionID string) ([]entity.AuctionBid, error) {
+	ret := _m.Called(ctx, auctionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuctionBids")
+	}
+
+	var r0 []entity.AuctionBid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.AuctionBid, error)); ok {
+		return rf(ctx, auctionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.AuctionBid); ok {
+		r0 = rf(ctx, auctionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AuctionBid)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, auctionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListAuctionBids_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuctionBids'
+type Storage_ListAuctionBids_Call struct {
+	*mock.Call
+}
+
+// ListAuctionBids is a helper method to define mock.On call
+//   - ctx context.Context
+//   - auctionID string
+func (_e *Storage_Expecter) ListAuctionBids(ctx interface{}, auctionID interface{}) *Storage_ListAuctionBids_Call {
+	return &Storage_ListAuctionBids_Call{Call: _e.mock.On("ListAuctionBids", ctx, auctionID)}
+}
+
+func (_c *Storage_ListAuctionBids_Call) Run(run func(ctx context.Context, auctionID string)) *Storage_ListAuctionBids_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_ListAuctionBids_Call) Return(_a0 []entity.AuctionBid, _a1 error) *Storage_ListAuctionBids_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListAuctionBids_Call) RunAndReturn(run func(context.Context, string) ([]entity.AuctionBid, error)) *Storage_ListAuctionBids_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuctions provides a mock function with given fields: ctx, filter
+func (_m *Storage) ListAuctions(ctx context.Context, filter entity.AuctionFilter) ([]entity.Auction, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuctions")
+	}
+
+	var r0 []entity.Auction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.AuctionFilter) ([]entity.Auction, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.AuctionFilter) []entity.Auction); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Auction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.AuctionFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListAuctions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuctions'
+type Storage_ListAuctions_Call struct {
+	*mock.Call
+}
+
+// ListAuctions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.AuctionFilter
+func (_e *Storage_Expecter) ListAuctions(ctx interface{}, filter interface{}) *Storage_ListAuctions_Call {
+	return &Storage_ListAuctions_Call{Call: _e.mock.On("ListAuctions", ctx, filter)}
+}
+
+func (_c *Storage_ListAuctions_Call) Run(run func(ctx context.Context, filter entity.AuctionFilter)) *Storage_ListAuctions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.AuctionFilter))
+	})
+	return _c
+}
+
+func (_c *Storage_ListAuctions_Call) Return(_a0 []entity.Auction, _a1 error) *Storage_ListAuctions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListAuctions_Call) RunAndReturn(run func(context.Context, entity.AuctionFilter) ([]entity.Auction, error)) *Storage_ListAuctions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCatalog provides a mock function with given fields: ctx
+func (_m *Storage) ListCatalog(ctx context.Context) ([]entity.CatalogItem, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCatalog")
+	}
+
+	var r0 []entity.CatalogItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.CatalogItem, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.CatalogItem); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CatalogItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListCatalog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCatalog'
+type Storage_ListCatalog_Call struct {
+	*mock.Call
+}
+
+// ListCatalog is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Storage_Expecter) ListCatalog(ctx interface{}) *Storage_ListCatalog_Call {
+	return &Storage_ListCatalog_Call{Call: _e.mock.On("ListCatalog", ctx)}
+}
+
+func (_c *Storage_ListCatalog_Call) Run(run func(ctx context.Context)) *Storage_ListCatalog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Storage_ListCatalog_Call) Return(_a0 []entity.CatalogItem, _a1 error) *Storage_ListCatalog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListCatalog_Call) RunAndReturn(run func(context.Context) ([]entity.CatalogItem, error)) *Storage_ListCatalog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function with given fields: ctx
+func (_m *Storage) ListCategories(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type Storage_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Storage_Expecter) ListCategories(ctx interface{}) *Storage_ListCategories_Call {
+	return &Storage_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx)}
+}
+
+func (_c *Storage_ListCategories_Call) Run(run func(ctx context.Context)) *Storage_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Storage_ListCategories_Call) Return(_a0 []string, _a1 error) *Storage_ListCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListCategories_Call) RunAndReturn(run func(context.Context) ([]string, error)) *Storage_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDueAuctions provides a mock function with given fields: ctx, now
+func (_m *Storage) ListDueAuctions(ctx context.Context, now time.Time) ([]string, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDueAuctions")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]string, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListDueAuctions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDueAuctions'
+type Storage_ListDueAuctions_Call struct {
+	*mock.Call
+}
+
+// ListDueAuctions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *Storage_Expecter) ListDueAuctions(ctx interface{}, now interface{}) *Storage_ListDueAuctions_Call {
+	return &Storage_ListDueAuctions_Call{Call: _e.mock.On("ListDueAuctions", ctx, now)}
+}
+
+func (_c *Storage_ListDueAuctions_Call) Run(run func(ctx context.Context, now time.Time)) *Storage_ListDueAuctions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_ListDueAuctions_Call) Return(_a0 []string, _a1 error) *Storage_ListDueAuctions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListDueAuctions_Call) RunAndReturn(run func(context.Context, time.Time) ([]string, error)) *Storage_ListDueAuctions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDueRaffles provides a mock function with given fields: ctx, now
+func (_m *Storage) ListDueRaffles(ctx context.Context, now time.Time) ([]string, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDueRaffles")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]string, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListDueRaffles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDueRaffles'
+type Storage_ListDueRaffles_Call struct {
+	*mock.Call
+}
+
+// ListDueRaffles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *Storage_Expecter) ListDueRaffles(ctx interface{}, now interface{}) *Storage_ListDueRaffles_Call {
+	return &Storage_ListDueRaffles_Call{Call: _e.mock.On("ListDueRaffles", ctx, now)}
+}
+
+func (_c *Storage_ListDueRaffles_Call) Run(run func(ctx context.Context, now time.Time)) *Storage_ListDueRaffles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_ListDueRaffles_Call) Return(_a0 []string, _a1 error) *Storage_ListDueRaffles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListDueRaffles_Call) RunAndReturn(run func(context.Context, time.Time) ([]string, error)) *Storage_ListDueRaffles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListItemTransfers provides a mock function with given fields: ctx, userID
+func (_m *Storage) ListItemTransfers(ctx context.Context, userID string) ([]entity.ItemTransfer, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListItemTransfers")
+	}
+
+	var r0 []entity.ItemTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.ItemTransfer, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.ItemTransfer); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ItemTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListItemTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListItemTransfers'
+type Storage_ListItemTransfers_Call struct {
+	*mock.Call
+}
+
+// ListItemTransfers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *Storage_Expecter) ListItemTransfers(ctx interface{}, userID interface{}) *Storage_ListItemTransfers_Call {
+	return &Storage_ListItemTransfers_Call{Call: _e.mock.On("ListItemTransfers", ctx, userID)}
+}
+
+func (_c *Storage_ListItemTransfers_Call) Run(run func(ctx context.Context, userID string)) *Storage_ListItemTransfers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_ListItemTransfers_Call) Return(_a0 []entity.ItemTransfer, _a1 error) *Storage_ListItemTransfers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListItemTransfers_Call) RunAndReturn(run func(context.Context, string) ([]entity.ItemTransfer, error)) *Storage_ListItemTransfers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMerchImages provides a mock function with given fields: ctx, merchIDs
+func (_m *Storage) ListMerchImages(ctx context.Context, merchIDs []string) ([]entity.MerchImage, error) {
+	ret := _m.Called(ctx, merchIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMerchImages")
+	}
+
+	var r0 []entity.MerchImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]entity.MerchImage, error)); ok {
+		return rf(ctx, merchIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []entity.MerchImage); ok {
+		r0 = rf(ctx, merchIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MerchImage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, merchIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListMerchImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMerchImages'
+type Storage_ListMerchImages_Call struct {
+	*mock.Call
+}
+
+// ListMerchImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchIDs []string
+func (_e *Storage_Expecter) ListMerchImages(ctx interface{}, merchIDs interface{}) *Storage_ListMerchImages_Call {
+	return &Storage_ListMerchImages_Call{Call: _e.mock.On("ListMerchImages", ctx, merchIDs)}
+}
+
+func (_c *Storage_ListMerchImages_Call) Run(run func(ctx context.Context, merchIDs []string)) *Storage_ListMerchImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *Storage_ListMerchImages_Call) Return(_a0 []entity.MerchImage, _a1 error) *Storage_ListMerchImages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListMerchImages_Call) RunAndReturn(run func(context.Context, []string) ([]entity.MerchImage, error)) *Storage_ListMerchImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMerchReturns provides a mock function with given fields: ctx, filter
+func (_m *Storage) ListMerchReturns(ctx context.Context, filter entity.MerchReturnFilter) ([]entity.MerchReturn, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMerchReturns")
+	}
+
+	var r0 []entity.MerchReturn
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchReturnFilter) ([]entity.MerchReturn, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchReturnFilter) []entity.MerchReturn); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MerchReturn)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.MerchReturnFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListMerchReturns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMerchReturns'
+type Storage_ListMerchReturns_Call struct {
+	*mock.Call
+}
+
+// ListMerchReturns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.MerchReturnFilter
+func (_e *Storage_Expecter) ListMerchReturns(ctx interface{}, filter interface{}) *Storage_ListMerchReturns_Call {
+	return &Storage_ListMerchReturns_Call{Call: _e.mock.On("ListMerchReturns", ctx, filter)}
+}
+
+func (_c *Storage_ListMerchReturns_Call) Run(run func(ctx context.Context, filter entity.MerchReturnFilter)) *Storage_ListMerchReturns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MerchReturnFilter))
+	})
+	return _c
+}
+
+func (_c *Storage_ListMerchReturns_Call) Return(_a0 []entity.MerchReturn, _a1 error) *Storage_ListMerchReturns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListMerchReturns_Call) RunAndReturn(run func(context.Context, entity.MerchReturnFilter) ([]entity.MerchReturn, error)) *Storage_ListMerchReturns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRaffleTickets provides a mock function with given fields: ctx, raffleID
+func (_m *Storage) ListRaffleTickets(ctx context.Context, raffleID string) ([]entity.RaffleTicket, error) {
+	ret := _m.Called(ctx, raffleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRaffleTickets")
+	}
+
+	var r0 []entity.RaffleTicket
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.RaffleTicket, error)); ok {
+		return rf(ctx, raffleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.RaffleTicket); ok {
+		r0 = rf(ctx, raffleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.RaffleTicket)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, raffleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListRaffleTickets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRaffleTickets'
+type Storage_ListRaffleTickets_Call struct {
+	*mock.Call
+}
+
+// ListRaffleTickets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - raffleID string
+func (_e *Storage_Expecter) ListRaffleTickets(ctx interface{}, raffleID interface{}) *Storage_ListRaffleTickets_Call {
+	return &Storage_ListRaffleTickets_Call{Call: _e.mock.On("ListRaffleTickets", ctx, raffleID)}
+}
+
+func (_c *Storage_ListRaffleTickets_Call) Run(run func(ctx context.Context, raffleID string)) *Storage_ListRaffleTickets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_ListRaffleTickets_Call) Return(_a0 []entity.RaffleTicket, _a1 error) *Storage_ListRaffleTickets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListRaffleTickets_Call) RunAndReturn(run func(context.Context, string) ([]entity.RaffleTicket, error)) *Storage_ListRaffleTickets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRaffleWinners provides a mock function with given fields: ctx, raffleID
+func (_m *Storage) ListRaffleWinners(ctx context.Context, raffleID string) ([]entity.RaffleWinner, error) {
+	ret := _m.Called(ctx, raffleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRaffleWinners")
+	}
+
+	var r0 []entity.RaffleWinner
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.RaffleWinner, error)); ok {
+		return rf(ctx, raffleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.RaffleWinner); ok {
+		r0 = rf(ctx, raffleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.RaffleWinner)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, raffleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListRaffleWinners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRaffleWinners'
+type Storage_ListRaffleWinners_Call struct {
+	*mock.Call
+}
+
+// ListRaffleWinners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - raffleID string
+func (_e *Storage_Expecter) ListRaffleWinners(ctx interface{}, raffleID interface{}) *Storage_ListRaffleWinners_Call {
+	return &Storage_ListRaffleWinners_Call{Call: _e.mock.On("ListRaffleWinners", ctx, raffleID)}
+}
+
+func (_c *Storage_ListRaffleWinners_Call) Run(run func(ctx context.Context, raffleID string)) *Storage_ListRaffleWinners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_ListRaffleWinners_Call) Return(_a0 []entity.RaffleWinner, _a1 error) *Storage_ListRaffleWinners_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListRaffleWinners_Call) RunAndReturn(run func(context.Context, string) ([]entity.RaffleWinner, error)) *Storage_ListRaffleWinners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRaffles provides a mock function with given fields: ctx, filter
+func (_m *Storage) ListRaffles(ctx context.Context, filter entity.RaffleFilter) ([]entity.Raffle, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRaffles")
+	}
+
+	var r0 []entity.Raffle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.RaffleFilter) ([]entity.Raffle, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.RaffleFilter) []entity.Raffle); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Raffle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.RaffleFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListRaffles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRaffles'
+type Storage_ListRaffles_Call struct {
+	*mock.Call
+}
+
+// ListRaffles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.RaffleFilter
+func (_e *Storage_Expecter) ListRaffles(ctx interface{}, filter interface{}) *Storage_ListRaffles_Call {
+	return &Storage_ListRaffles_Call{Call: _e.mock.On("ListRaffles", ctx, filter)}
+}
+
+func (_c *Storage_ListRaffles_Call) Run(run func(ctx context.Context, filter entity.RaffleFilter)) *Storage_ListRaffles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.RaffleFilter))
+	})
+	return _c
+}
+
+func (_c *Storage_ListRaffles_Call) Return(_a0 []entity.Raffle, _a1 error) *Storage_ListRaffles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListRaffles_Call) RunAndReturn(run func(context.Context, entity.RaffleFilter) ([]entity.Raffle, error)) *Storage_ListRaffles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRestockedMerch provides a mock function with given fields: ctx
+func (_m *Storage) ListRestockedMerch(ctx context.Context) ([]entity.RestockedMerch, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRestockedMerch")
+	}
+
+	var r0 []entity.RestockedMerch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.RestockedMerch, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.RestockedMerch); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.RestockedMerch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListRestockedMerch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRestockedMerch'
+type Storage_ListRestockedMerch_Call struct {
+	*mock.Call
+}
+
+// ListRestockedMerch is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Storage_Expecter) ListRestockedMerch(ctx interface{}) *Storage_ListRestockedMerch_Call {
+	return &Storage_ListRestockedMerch_Call{Call: _e.mock.On("ListRestockedMerch", ctx)}
+}
+
+func (_c *Storage_ListRestockedMerch_Call) Run(run func(ctx context.Context)) *Storage_ListRestockedMerch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Storage_ListRestockedMerch_Call) Return(_a0 []entity.RestockedMerch, _a1 error) *Storage_ListRestockedMerch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListRestockedMerch_Call) RunAndReturn(run func(context.Context) ([]entity.RestockedMerch, error)) *Storage_ListRestockedMerch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserPurchases provides a mock function with given fields: ctx, userID
+func (_m *Storage) ListUserPurchases(ctx context.Context, userID string) ([]entity.Purchase, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserPurchases")
+	}
+
+	var r0 []entity.Purchase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.Purchase, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Purchase); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Purchase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListUserPurchases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserPurchases'
+type Storage_ListUserPurchases_Call struct {
+	*mock.Call
+}
+
+// ListUserPurchases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *Storage_Expecter) ListUserPurchases(ctx interface{}, userID interface{}) *Storage_ListUserPurchases_Call {
+	return &Storage_ListUserPurchases_Call{Call: _e.mock.On("ListUserPurchases", ctx, userID)}
+}
+
+func (_c *Storage_ListUserPurchases_Call) Run(run func(ctx context.Context, userID string)) *Storage_ListUserPurchases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_ListUserPurchases_Call) Return(_a0 []entity.Purchase, _a1 error) *Storage_ListUserPurchases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListUserPurchases_Call) RunAndReturn(run func(context.Context, string) ([]entity.Purchase, error)) *Storage_ListUserPurchases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserReservations provides a mock function with given fields: ctx, userID
+func (_m *Storage) ListUserReservations(ctx context.Context, userID string) ([]entity.Reservation, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserReservations")
+	}
+
+	var r0 []entity.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.Reservation, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Reservation); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListUserReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserReservations'
+type Storage_ListUserReservations_Call struct {
+	*mock.Call
+}
+
+// ListUserReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *Storage_Expecter) ListUserReservations(ctx interface{}, userID interface{}) *Storage_ListUserReservations_Call {
+	return &Storage_ListUserReservations_Call{Call: _e.mock.On("ListUserReservations", ctx, userID)}
+}
+
+func (_c *Storage_ListUserReservations_Call) Run(run func(ctx context.Context, userID string)) *Storage_ListUserReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_ListUserReservations_Call) Return(_a0 []entity.Reservation, _a1 error) *Storage_ListUserReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListUserReservations_Call) RunAndReturn(run func(context.Context, string) ([]entity.Reservation, error)) *Storage_ListUserReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserWaitlist provides a mock function with given fields: ctx, userID
+func (_m *Storage) ListUserWaitlist(ctx context.Context, userID string) ([]entity.WaitlistEntry, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserWaitlist")
+	}
+
+	var r0 []entity.WaitlistEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.WaitlistEntry, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.WaitlistEntry); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.WaitlistEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ListUserWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserWaitlist'
+type Storage_ListUserWaitlist_Call struct {
+	*mock.Call
+}
+
+// ListUserWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *Storage_Expecter) ListUserWaitlist(ctx interface{}, userID interface{}) *Storage_ListUserWaitlist_Call {
+	return &Storage_ListUserWaitlist_Call{Call: _e.mock.On("ListUserWaitlist", ctx, userID)}
+}
+
+func (_c *Storage_ListUserWaitlist_Call) Run(run func(ctx context.Context, userID string)) *Storage_ListUserWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_ListUserWaitlist_Call) Return(_a0 []entity.WaitlistEntry, _a1 error) *Storage_ListUserWaitlist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ListUserWaitlist_Call) RunAndReturn(run func(context.Context, string) ([]entity.WaitlistEntry, error)) *Storage_ListUserWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockAuction provides a mock function with given fields: ctx, auctionID
+func (_m *Storage) LockAuction(ctx context.Context, auctionID string) (entity.Auction, error) {
+	ret := _m.Called(ctx, auctionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockAuction")
+	}
+
+	var r0 entity.Auction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Auction, error)); ok {
+		return rf(ctx, auctionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Auction); ok {
+		r0 = rf(ctx, auctionID)
+	} else {
+		r0 = ret.Get(0).(entity.Auction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, auctionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_LockAuction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockAuction'
+type Storage_LockAuction_Call struct {
+	*mock.Call
+}
+
+// LockAuction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - auctionID string
+func (_e *Storage_Expecter) LockAuction(ctx interface{}, auctionID interface{}) *Storage_LockAuction_Call {
+	return &Storage_LockAuction_Call{Call: _e.mock.On("LockAuction", ctx, auctionID)}
+}
+
+func (_c *Storage_LockAuction_Call) Run(run func(ctx context.Context, auctionID string)) *Storage_LockAuction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_LockAuction_Call) Return(_a0 entity.Auction, _a1 error) *Storage_LockAuction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_LockAuction_Call) RunAndReturn(run func(context.Context, string) (entity.Auction, error)) *Storage_LockAuction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockRaffle provides a mock function with given fields: ctx, raffleID
+func (_m *Storage) LockRaffle(ctx context.Context, raffleID string) (entity.Raffle, error) {
+	ret := _m.Called(ctx, raffleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockRaffle")
+	}
+
+	var r0 entity.Raffle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Raffle, error)); ok {
+		return rf(ctx, raffleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Raffle); ok {
+		r0 = rf(ctx, raffleID)
+	} else {
+		r0 = ret.Get(0).(entity.Raffle)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, raffleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_LockRaffle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockRaffle'
+type Storage_LockRaffle_Call struct {
+	*mock.Call
+}
+
+// LockRaffle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - raffleID string
+func (_e *Storage_Expecter) LockRaffle(ctx interface{}, raffleID interface{}) *Storage_LockRaffle_Call {
+	return &Storage_LockRaffle_Call{Call: _e.mock.On("LockRaffle", ctx, raffleID)}
+}
+
+func (_c *Storage_LockRaffle_Call) Run(run func(ctx context.Context, raffleID string)) *Storage_LockRaffle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_LockRaffle_Call) Return(_a0 entity.Raffle, _a1 error) *Storage_LockRaffle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_LockRaffle_Call) RunAndReturn(run func(context.Context, string) (entity.Raffle, error)) *Storage_LockRaffle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPurchaseReturned provides a mock function with given fields: ctx, purchaseID
+func (_m *Storage) MarkPurchaseReturned(ctx context.Context, purchaseID string) error {
+	ret := _m.Called(ctx, purchaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPurchaseReturned")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, purchaseID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_MarkPurchaseReturned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPurchaseReturned'
+type Storage_MarkPurchaseReturned_Call struct {
+	*mock.Call
+}
+
+// MarkPurchaseReturned is a helper method to define mock.On call
+//   - ctx context.Context
+//   - purchaseID string
+func (_e *Storage_Expecter) MarkPurchaseReturned(ctx interface{}, purchaseID interface{}) *Storage_MarkPurchaseReturned_Call {
+	return &Storage_MarkPurchaseReturned_Call{Call: _e.mock.On("MarkPurchaseReturned", ctx, purchaseID)}
+}
+
+func (_c *Storage_MarkPurchaseReturned_Call) Run(run func(ctx context.Context, purchaseID string)) *Storage_MarkPurchaseReturned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_MarkPurchaseReturned_Call) Return(_a0 error) *Storage_MarkPurchaseReturned_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_MarkPurchaseReturned_Call) RunAndReturn(run func(context.Context, string) error) *Storage_MarkPurchaseReturned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceAuctionBid provides a mock function with given fields: ctx, bid
+func (_m *Storage) PlaceAuctionBid(ctx context.Context, bid entity.AuctionBid) error {
+	ret := _m.Called(ctx, bid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceAuctionBid")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.AuctionBid) error); ok {
+		r0 = rf(ctx, bid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_PlaceAuctionBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceAuctionBid'
+type Storage_PlaceAuctionBid_Call struct {
+	*mock.Call
+}
+
+// PlaceAuctionBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bid entity.AuctionBid
+func (_e *Storage_Expecter) PlaceAuctionBid(ctx interface{}, bid interface{}) *Storage_PlaceAuctionBid_Call {
+	return &Storage_PlaceAuctionBid_Call{Call: _e.mock.On("PlaceAuctionBid", ctx, bid)}
+}
+
+func (_c *Storage_PlaceAuctionBid_Call) Run(run func(ctx context.Context, bid entity.AuctionBid)) *Storage_PlaceAuctionBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.AuctionBid))
+	})
+	return _c
+}
+
+func (_c *Storage_PlaceAuctionBid_Call) Return(_a0 error) *Storage_PlaceAuctionBid_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_PlaceAuctionBid_Call) RunAndReturn(run func(context.Context, entity.AuctionBid) error) *Storage_PlaceAuctionBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PopWaitlist provides a mock function with given fields: ctx, merchID, limit
+func (_m *Storage) PopWaitlist(ctx context.Context, merchID string, limit int) ([]entity.WaitlistEntry, error) {
+	ret := _m.Called(ctx, merchID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PopWaitlist")
+	}
+
+	var r0 []entity.WaitlistEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]entity.WaitlistEntry, error)); ok {
+		return rf(ctx, merchID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []entity.WaitlistEntry); ok {
+		r0 = rf(ctx, merchID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.WaitlistEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, merchID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_PopWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PopWaitlist'
+type Storage_PopWaitlist_Call struct {
+	*mock.Call
+}
+
+// PopWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchID string
+//   - limit int
+func (_e *Storage_Expecter) PopWaitlist(ctx interface{}, merchID interface{}, limit interface{}) *Storage_PopWaitlist_Call {
+	return &Storage_PopWaitlist_Call{Call: _e.mock.On("PopWaitlist", ctx, merchID, limit)}
+}
+
+func (_c *Storage_PopWaitlist_Call) Run(run func(ctx context.Context, merchID string, limit int)) *Storage_PopWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *Storage_PopWaitlist_Call) Return(_a0 []entity.WaitlistEntry, _a1 error) *Storage_PopWaitlist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_PopWaitlist_Call) RunAndReturn(run func(context.Context, string, int) ([]entity.WaitlistEntry, error)) *Storage_PopWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundUserBids provides a mock function with given fields: ctx, userID, now
+func (_m *Storage) RefundUserBids(ctx context.Context, userID string, now time.Time) ([]entity.AuctionBid, error) {
+	ret := _m.Called(ctx, userID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundUserBids")
+	}
+
+	var r0 []entity.AuctionBid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]entity.AuctionBid, error)); ok {
+		return rf(ctx, userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []entity.AuctionBid); ok {
+		r0 = rf(ctx, userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AuctionBid)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_RefundUserBids_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundUserBids'
+type Storage_RefundUserBids_Call struct {
+	*mock.Call
+}
+
+// RefundUserBids is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - now time.Time
+func (_e *Storage_Expecter) RefundUserBids(ctx interface{}, userID interface{}, now interface{}) *Storage_RefundUserBids_Call {
+	return &Storage_RefundUserBids_Call{Call: _e.mock.On("RefundUserBids", ctx, userID, now)}
+}
+
+func (_c *Storage_RefundUserBids_Call) Run(run func(ctx context.Context, userID string, now time.Time)) *Storage_RefundUserBids_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_RefundUserBids_Call) Return(_a0 []entity.AuctionBid, _a1 error) *Storage_RefundUserBids_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_RefundUserBids_Call) RunAndReturn(run func(context.Context, string, time.Time) ([]entity.AuctionBid, error)) *Storage_RefundUserBids_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveStock provides a mock function with given fields: ctx, merchID, quantity
+func (_m *Storage) ReserveStock(ctx context.Context, merchID string, quantity int) error {
+	ret := _m.Called(ctx, merchID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, merchID, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
+type Storage_ReserveStock_Call struct {
+	*mock.Call
+}
+
+// ReserveStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchID string
+//   - quantity int
+func (_e *Storage_Expecter) ReserveStock(ctx interface{}, merchID interface{}, quantity interface{}) *Storage_ReserveStock_Call {
+	return &Storage_ReserveStock_Call{Call: _e.mock.On("ReserveStock", ctx, merchID, quantity)}
+}
+
+func (_c *Storage_ReserveStock_Call) Run(run func(ctx context.Context, merchID string, quantity int)) *Storage_ReserveStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *Storage_ReserveStock_Call) Return(_a0 error) *Storage_ReserveStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_ReserveStock_Call) RunAndReturn(run func(context.Context, string, int) error) *Storage_ReserveStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveAuctionBid provides a mock function with given fields: ctx, bidID, status, now
+func (_m *Storage) ResolveAuctionBid(ctx context.Context, bidID string, status entity.BidStatus, now time.Time) error {
+	ret := _m.Called(ctx, bidID, status, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveAuctionBid")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.BidStatus, time.Time) error); ok {
+		r0 = rf(ctx, bidID, status, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_ResolveAuctionBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveAuctionBid'
+type Storage_ResolveAuctionBid_Call struct {
+	*mock.Call
+}
+
+// ResolveAuctionBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidID string
+//   - status entity.BidStatus
+//   - now time.Time
+func (_e *Storage_Expecter) ResolveAuctionBid(ctx interface{}, bidID interface{}, status interface{}, now interface{}) *Storage_ResolveAuctionBid_Call {
+	return &Storage_ResolveAuctionBid_Call{Call: _e.mock.On("ResolveAuctionBid", ctx, bidID, status, now)}
+}
+
+func (_c *Storage_ResolveAuctionBid_Call) Run(run func(ctx context.Context, bidID string, status entity.BidStatus, now time.Time)) *Storage_ResolveAuctionBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(entity.BidStatus), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_ResolveAuctionBid_Call) Return(_a0 error) *Storage_ResolveAuctionBid_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_ResolveAuctionBid_Call) RunAndReturn(run func(context.Context, string, entity.BidStatus, time.Time) error) *Storage_ResolveAuctionBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveMerchReturn provides a mock function with given fields: ctx, merchReturn
+func (_m *Storage) ResolveMerchReturn(ctx context.Context, merchReturn entity.MerchReturn) error {
+	ret := _m.Called(ctx, merchReturn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveMerchReturn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchReturn) error); ok {
+		r0 = rf(ctx, merchReturn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_ResolveMerchReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveMerchReturn'
+type Storage_ResolveMerchReturn_Call struct {
+	*mock.Call
+}
+
+// ResolveMerchReturn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchReturn entity.MerchReturn
+func (_e *Storage_Expecter) ResolveMerchReturn(ctx interface{}, merchReturn interface{}) *Storage_ResolveMerchReturn_Call {
+	return &Storage_ResolveMerchReturn_Call{Call: _e.mock.On("ResolveMerchReturn", ctx, merchReturn)}
+}
+
+func (_c *Storage_ResolveMerchReturn_Call) Run(run func(ctx context.Context, merchReturn entity.MerchReturn)) *Storage_ResolveMerchReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MerchReturn))
+	})
+	return _c
+}
+
+func (_c *Storage_ResolveMerchReturn_Call) Return(_a0 error) *Storage_ResolveMerchReturn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_ResolveMerchReturn_Call) RunAndReturn(run func(context.Context, entity.MerchReturn) error) *Storage_ResolveMerchReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restock provides a mock function with given fields: ctx, merchID
+func (_m *Storage) Restock(ctx context.Context, merchID string) error {
+	ret := _m.Called(ctx, merchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, merchID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_Restock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restock'
+type Storage_Restock_Call struct {
+	*mock.Call
+}
+
+// Restock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchID string
+func (_e *Storage_Expecter) Restock(ctx interface{}, merchID interface{}) *Storage_Restock_Call {
+	return &Storage_Restock_Call{Call: _e.mock.On("Restock", ctx, merchID)}
+}
+
+func (_c *Storage_Restock_Call) Run(run func(ctx context.Context, merchID string)) *Storage_Restock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_Restock_Call) Return(_a0 error) *Storage_Restock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_Restock_Call) RunAndReturn(run func(context.Context, string) error) *Storage_Restock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetireMerch provides a mock function with given fields: ctx, name
+func (_m *Storage) RetireMerch(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetireMerch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_RetireMerch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetireMerch'
+type Storage_RetireMerch_Call struct {
+	*mock.Call
+}
+
+// RetireMerch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *Storage_Expecter) RetireMerch(ctx interface{}, name interface{}) *Storage_RetireMerch_Call {
+	return &Storage_RetireMerch_Call{Call: _e.mock.On("RetireMerch", ctx, name)}
+}
+
+func (_c *Storage_RetireMerch_Call) Run(run func(ctx context.Context, name string)) *Storage_RetireMerch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_RetireMerch_Call) Return(_a0 error) *Storage_RetireMerch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_RetireMerch_Call) RunAndReturn(run func(context.Context, string) error) *Storage_RetireMerch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchMerch provides a mock function with given fields: ctx, filter
+func (_m *Storage) SearchMerch(ctx context.Context, filter entity.MerchFilter) (entity.MerchPage, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchMerch")
+	}
+
+	var r0 entity.MerchPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchFilter) (entity.MerchPage, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MerchFilter) entity.MerchPage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(entity.MerchPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.MerchFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_SearchMerch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchMerch'
+type Storage_SearchMerch_Call struct {
+	*mock.Call
+}
+
+// SearchMerch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.MerchFilter
+func (_e *Storage_Expecter) SearchMerch(ctx interface{}, filter interface{}) *Storage_SearchMerch_Call {
+	return &Storage_SearchMerch_Call{Call: _e.mock.On("SearchMerch", ctx, filter)}
+}
+
+func (_c *Storage_SearchMerch_Call) Run(run func(ctx context.Context, filter entity.MerchFilter)) *Storage_SearchMerch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MerchFilter))
+	})
+	return _c
+}
+
+func (_c *Storage_SearchMerch_Call) Return(_a0 entity.MerchPage, _a1 error) *Storage_SearchMerch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_SearchMerch_Call) RunAndReturn(run func(context.Context, entity.MerchFilter) (entity.MerchPage, error)) *Storage_SearchMerch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TakeFromStock provides a mock function with given fields: ctx, merchID
+func (_m *Storage) TakeFromStock(ctx context.Context, merchID string) error {
+	ret := _m.Called(ctx, merchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeFromStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, merchID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_TakeFromStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeFromStock'
+type Storage_TakeFromStock_Call struct {
+	*mock.Call
+}
+
+// TakeFromStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchID string
+func (_e *Storage_Expecter) TakeFromStock(ctx interface{}, merchID interface{}) *Storage_TakeFromStock_Call {
+	return &Storage_TakeFromStock_Call{Call: _e.mock.On("TakeFromStock", ctx, merchID)}
+}
+
+func (_c *Storage_TakeFromStock_Call) Run(run func(ctx context.Context, merchID string)) *Storage_TakeFromStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_TakeFromStock_Call) Return(_a0 error) *Storage_TakeFromStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_TakeFromStock_Call) RunAndReturn(run func(context.Context, string) error) *Storage_TakeFromStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferItems provides a mock function with given fields: ctx, transfer
+func (_m *Storage) TransferItems(ctx context.Context, transfer entity.ItemTransfer) error {
+	ret := _m.Called(ctx, transfer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferItems")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ItemTransfer) error); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_TransferItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferItems'
+type Storage_TransferItems_Call struct {
+	*mock.Call
+}
+
+// TransferItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transfer entity.ItemTransfer
+func (_e *Storage_Expecter) TransferItems(ctx interface{}, transfer interface{}) *Storage_TransferItems_Call {
+	return &Storage_TransferItems_Call{Call: _e.mock.On("TransferItems", ctx, transfer)}
+}
+
+func (_c *Storage_TransferItems_Call) Run(run func(ctx context.Context, transfer entity.ItemTransfer)) *Storage_TransferItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.ItemTransfer))
+	})
+	return _c
+}
+
+func (_c *Storage_TransferItems_Call) Return(_a0 error) *Storage_TransferItems_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_TransferItems_Call) RunAndReturn(run func(context.Context, entity.ItemTransfer) error) *Storage_TransferItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertCatalogItem provides a mock function with given fields: ctx, merchID, categoryID, item
+func (_m *Storage) UpsertCatalogItem(ctx context.Context, merchID string, categoryID string, item entity.CatalogItem) error {
+	ret := _m.Called(ctx, merchID, categoryID, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertCatalogItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.CatalogItem) error); ok {
+		r0 = rf(ctx, merchID, categoryID, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_UpsertCatalogItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertCatalogItem'
+type Storage_UpsertCatalogItem_Call struct {
+	*mock.Call
+}
+
+// UpsertCatalogItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchID string
+//   - categoryID string
+//   - item entity.CatalogItem
+func (_e *Storage_Expecter) UpsertCatalogItem(ctx interface{}, merchID interface{}, categoryID interface{}, item interface{}) *Storage_UpsertCatalogItem_Call {
+	return &Storage_UpsertCatalogItem_Call{Call: _e.mock.On("UpsertCatalogItem", ctx, merchID, categoryID, item)}
+}
+
+func (_c *Storage_UpsertCatalogItem_Call) Run(run func(ctx context.Context, merchID string, categoryID string, item entity.CatalogItem)) *Storage_UpsertCatalogItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(entity.CatalogItem))
+	})
+	return _c
+}
+
+func (_c *Storage_UpsertCatalogItem_Call) Return(_a0 error) *Storage_UpsertCatalogItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_UpsertCatalogItem_Call) RunAndReturn(run func(context.Context, string, string, entity.CatalogItem) error) *Storage_UpsertCatalogItem_Call {
 	_c.Call.Return(run)
 	return _c
 }
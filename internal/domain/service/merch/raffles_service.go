@@ -0,0 +1,128 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+func (s *Service) CreateRaffle(ctx context.Context, raffle entity.Raffle) error {
+	const op = "service.merch.CreateRaffle"
+
+	if err := s.storage.CreateRaffle(ctx, raffle); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetRaffleByID(ctx context.Context, raffleID string) (entity.Raffle, error) {
+	const op = "service.merch.GetRaffleByID"
+
+	raffle, err := s.storage.GetRaffleByID(ctx, raffleID)
+	if err != nil {
+		if errors.Is(err, storage.ErrRaffleNotFound) {
+			return entity.Raffle{}, domain.ErrRaffleNotFound
+		}
+		return entity.Raffle{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return raffle, nil
+}
+
+func (s *Service) LockRaffle(ctx context.Context, raffleID string) (entity.Raffle, error) {
+	const op = "service.merch.LockRaffle"
+
+	raffle, err := s.storage.LockRaffle(ctx, raffleID)
+	if err != nil {
+		if errors.Is(err, storage.ErrRaffleNotFound) {
+			return entity.Raffle{}, domain.ErrRaffleNotFound
+		}
+		return entity.Raffle{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return raffle, nil
+}
+
+func (s *Service) ListRaffles(ctx context.Context, filter entity.RaffleFilter) ([]entity.Raffle, error) {
+	const op = "service.merch.ListRaffles"
+
+	raffles, err := s.storage.ListRaffles(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return raffles, nil
+}
+
+func (s *Service) ListDueRaffles(ctx context.Context) ([]string, error) {
+	const op = "service.merch.ListDueRaffles"
+
+	raffleIDs, err := s.storage.ListDueRaffles(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return raffleIDs, nil
+}
+
+func (s *Service) CountRaffleTickets(ctx context.Context, raffleID, userID string) (total, owned int, err error) {
+	const op = "service.merch.CountRaffleTickets"
+
+	total, owned, err = s.storage.CountRaffleTickets(ctx, raffleID, userID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return total, owned, nil
+}
+
+func (s *Service) CreateRaffleTickets(ctx context.Context, tickets []entity.RaffleTicket) error {
+	const op = "service.merch.CreateRaffleTickets"
+
+	if err := s.storage.CreateRaffleTickets(ctx, tickets); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) ListRaffleTickets(ctx context.Context, raffleID string) ([]entity.RaffleTicket, error) {
+	const op = "service.merch.ListRaffleTickets"
+
+	tickets, err := s.storage.ListRaffleTickets(ctx, raffleID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tickets, nil
+}
+
+func (s *Service) ListRaffleWinners(ctx context.Context, raffleID string) ([]entity.RaffleWinner, error) {
+	const op = "service.merch.ListRaffleWinners"
+
+	winners, err := s.storage.ListRaffleWinners(ctx, raffleID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return winners, nil
+}
+
+func (s *Service) CompleteRaffleDraw(ctx context.Context, raffleID string, winners []entity.RaffleWinner) error {
+	const op = "service.merch.CompleteRaffleDraw"
+
+	if err := s.storage.CompleteRaffleDraw(ctx, raffleID, winners, time.Now()); err != nil {
+		if errors.Is(err, storage.ErrRaffleNotOpen) {
+			return domain.ErrRaffleNotOpen
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
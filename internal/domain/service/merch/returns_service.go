@@ -0,0 +1,103 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+func (s *Service) GetPurchaseByID(ctx context.Context, purchaseID string) (entity.Purchase, error) {
+	const op = "service.merch.GetPurchaseByID"
+
+	purchase, err := s.storage.GetPurchaseByID(ctx, purchaseID)
+	if err != nil {
+		if errors.Is(err, storage.ErrPurchaseNotFound) {
+			return entity.Purchase{}, domain.ErrPurchaseNotFound
+		}
+		return entity.Purchase{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return purchase, nil
+}
+
+func (s *Service) ListUserPurchases(ctx context.Context, userID string) ([]entity.Purchase, error) {
+	const op = "service.merch.ListUserPurchases"
+
+	purchases, err := s.storage.ListUserPurchases(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return purchases, nil
+}
+
+func (s *Service) MarkPurchaseReturned(ctx context.Context, purchaseID string) error {
+	const op = "service.merch.MarkPurchaseReturned"
+
+	err := s.storage.MarkPurchaseReturned(ctx, purchaseID)
+	if err != nil {
+		if errors.Is(err, storage.ErrPurchaseAlreadyReturned) {
+			return domain.ErrPurchaseAlreadyReturned
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) CreateMerchReturn(ctx context.Context, merchReturn entity.MerchReturn) error {
+	const op = "service.merch.CreateMerchReturn"
+
+	err := s.storage.CreateMerchReturn(ctx, merchReturn)
+	if err != nil {
+		if errors.Is(err, storage.ErrMerchReturnAlreadyExists) {
+			return domain.ErrReturnAlreadyRequested
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetMerchReturnByID(ctx context.Context, returnID string) (entity.MerchReturn, error) {
+	const op = "service.merch.GetMerchReturnByID"
+
+	merchReturn, err := s.storage.GetMerchReturnByID(ctx, returnID)
+	if err != nil {
+		if errors.Is(err, storage.ErrMerchReturnNotFound) {
+			return entity.MerchReturn{}, domain.ErrMerchReturnNotFound
+		}
+		return entity.MerchReturn{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return merchReturn, nil
+}
+
+func (s *Service) ListMerchReturns(ctx context.Context, filter entity.MerchReturnFilter) ([]entity.MerchReturn, error) {
+	const op = "service.merch.ListMerchReturns"
+
+	returns, err := s.storage.ListMerchReturns(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return returns, nil
+}
+
+func (s *Service) ResolveMerchReturn(ctx context.Context, merchReturn entity.MerchReturn) error {
+	const op = "service.merch.ResolveMerchReturn"
+
+	err := s.storage.ResolveMerchReturn(ctx, merchReturn)
+	if err != nil {
+		if errors.Is(err, storage.ErrMerchReturnAlreadyResolved) {
+			return domain.ErrReturnAlreadyResolved
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
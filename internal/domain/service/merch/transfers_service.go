@@ -0,0 +1,35 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+func (s *Service) TransferItems(ctx context.Context, transfer entity.ItemTransfer) error {
+	const op = "service.merch.TransferItems"
+
+	if err := s.storage.TransferItems(ctx, transfer); err != nil {
+		if errors.Is(err, storage.ErrNotEnoughItems) {
+			return domain.ErrNotEnoughItems
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) ListItemTransfers(ctx context.Context, userID string) ([]entity.ItemTransfer, error) {
+	const op = "service.merch.ListItemTransfers"
+
+	transfers, err := s.storage.ListItemTransfers(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return transfers, nil
+}
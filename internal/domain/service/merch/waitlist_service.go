@@ -0,0 +1,153 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+func (s *Service) JoinWaitlist(ctx context.Context, entry entity.WaitlistEntry) error {
+	const op = "service.merch.JoinWaitlist"
+
+	if err := s.storage.JoinWaitlist(ctx, entry); err != nil {
+		if errors.Is(err, storage.ErrAlreadyInWaitlist) {
+			return domain.ErrAlreadyInWaitlist
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) LeaveWaitlist(ctx context.Context, userID, merchID string) error {
+	const op = "service.merch.LeaveWaitlist"
+
+	if err := s.storage.LeaveWaitlist(ctx, userID, merchID); err != nil {
+		if errors.Is(err, storage.ErrWaitlistEntryNotFound) {
+			return domain.ErrWaitlistEntryNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) ListUserWaitlist(ctx context.Context, userID string) ([]entity.WaitlistEntry, error) {
+	const op = "service.merch.ListUserWaitlist"
+
+	entries, err := s.storage.ListUserWaitlist(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return entries, nil
+}
+
+func (s *Service) ListRestockedMerch(ctx context.Context) ([]entity.RestockedMerch, error) {
+	const op = "service.merch.ListRestockedMerch"
+
+	items, err := s.storage.ListRestockedMerch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return items, nil
+}
+
+func (s *Service) PopWaitlist(ctx context.Context, merchID string, limit int) ([]entity.WaitlistEntry, error) {
+	const op = "service.merch.PopWaitlist"
+
+	entries, err := s.storage.PopWaitlist(ctx, merchID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return entries, nil
+}
+
+func (s *Service) ReserveStock(ctx context.Context, merchID string, quantity int) error {
+	const op = "service.merch.ReserveStock"
+
+	if err := s.storage.ReserveStock(ctx, merchID, quantity); err != nil {
+		if errors.Is(err, storage.ErrMerchOutOfStock) {
+			return domain.ErrMerchOutOfStock
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) CreateReservation(ctx context.Context, reservation entity.Reservation) error {
+	const op = "service.merch.CreateReservation"
+
+	if err := s.storage.CreateReservation(ctx, reservation); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetReservationByID(ctx context.Context, reservationID string) (entity.Reservation, error) {
+	const op = "service.merch.GetReservationByID"
+
+	reservation, err := s.storage.GetReservationByID(ctx, reservationID)
+	if err != nil {
+		if errors.Is(err, storage.ErrReservationNotFound) {
+			return entity.Reservation{}, domain.ErrReservationNotFound
+		}
+		return entity.Reservation{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return reservation, nil
+}
+
+func (s *Service) ListUserReservations(ctx context.Context, userID string) ([]entity.Reservation, error) {
+	const op = "service.merch.ListUserReservations"
+
+	reservations, err := s.storage.ListUserReservations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return reservations, nil
+}
+
+func (s *Service) CompleteReservation(ctx context.Context, reservationID string) error {
+	const op = "service.merch.CompleteReservation"
+
+	if err := s.storage.CompleteReservation(ctx, reservationID, time.Now()); err != nil {
+		if errors.Is(err, storage.ErrReservationNotActive) {
+			return domain.ErrReservationNotActive
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) ExpireReservations(ctx context.Context) ([]entity.Reservation, error) {
+	const op = "service.merch.ExpireReservations"
+
+	reservations, err := s.storage.ExpireReservations(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return reservations, nil
+}
+
+func (s *Service) DeleteUserWaitlist(ctx context.Context, userID string) error {
+	const op = "service.merch.DeleteUserWaitlist"
+
+	if err := s.storage.DeleteUserWaitlist(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package serviceaccount
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+// lastUsedPrecision limits how often the last-used time of a key is written,
+// so bots calling the API in a loop don't cause a write per request
+const lastUsedPrecision = time.Minute
+
+type Service struct {
+	storage Storage
+}
+
+type Storage interface {
+	CreateServiceAccount(ctx context.Context, account entity.ServiceAccount) error
+	GetServiceAccountByID(ctx context.Context, serviceAccountID string) (entity.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context) ([]entity.ServiceAccount, error)
+	CreateAPIKey(ctx context.Context, key entity.APIKey) error
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (entity.APIKey, error)
+	ListAPIKeys(ctx context.Context, serviceAccountID string) ([]entity.APIKey, error)
+	TouchAPIKey(ctx context.Context, keyID string, usedAt, staleBefore time.Time) error
+	RevokeAPIKey(ctx context.Context, serviceAccountID, keyID string, revokedAt time.Time) error
+}
+
+func New(storage Storage) *Service {
+	return &Service{
+		storage: storage,
+	}
+}
+
+func (s *Service) CreateServiceAccount(ctx context.Context, account entity.ServiceAccount) error {
+	const op = "service.serviceaccount.CreateServiceAccount"
+
+	if err := s.storage.CreateServiceAccount(ctx, account); err != nil {
+		if errors.Is(err, storage.ErrServiceAccountExists) {
+			return domain.ErrServiceAccountAlreadyExists
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetServiceAccountByID(ctx context.Context, serviceAccountID string) (entity.ServiceAccount, error) {
+	const op = "service.serviceaccount.GetServiceAccountByID"
+
+	account, err := s.storage.GetServiceAccountByID(ctx, serviceAccountID)
+	if err != nil {
+		if errors.Is(err, storage.ErrServiceAccountNotFound) {
+			return entity.ServiceAccount{}, domain.ErrServiceAccountNotFound
+		}
+		return entity.ServiceAccount{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return account, nil
+}
+
+func (s *Service) ListServiceAccounts(ctx context.Context) ([]entity.ServiceAccount, error) {
+	const op = "service.serviceaccount.ListServiceAccounts"
+
+	accounts, err := s.storage.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return accounts, nil
+}
+
+func (s *Service) CreateAPIKey(ctx context.Context, key entity.APIKey) error {
+	const op = "service.serviceaccount.CreateAPIKey"
+
+	if err := s.storage.CreateAPIKey(ctx, key); err != nil {
+		if errors.Is(err, storage.ErrServiceAccountNotFound) {
+			return domain.ErrServiceAccountNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetAPIKeyByHash(ctx context.Context, keyHash string) (entity.APIKey, error) {
+	const op = "service.serviceaccount.GetAPIKeyByHash"
+
+	key, err := s.storage.GetAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		if errors.Is(err, storage.ErrAPIKeyNotFound) {
+			return entity.APIKey{}, domain.ErrAPIKeyNotFound
+		}
+		return entity.APIKey{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return key, nil
+}
+
+func (s *Service) ListAPIKeys(ctx context.Context, serviceAccountID string) ([]entity.APIKey, error) {
+	const op = "service.serviceaccount.ListAPIKeys"
+
+	keys, err := s.storage.ListAPIKeys(ctx, serviceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return keys, nil
+}
+
+// TouchAPIKey records that the key was used. The time is kept with a precision of lastUsedPrecision
+func (s *Service) TouchAPIKey(ctx context.Context, key entity.APIKey) error {
+	const op = "service.serviceaccount.TouchAPIKey"
+
+	now := time.Now()
+	staleBefore := now.Add(-lastUsedPrecision)
+
+	if !key.LastUsedAt.IsZero() && key.LastUsedAt.After(staleBefore) {
+		return nil
+	}
+
+	if err := s.storage.TouchAPIKey(ctx, key.ID, now, staleBefore); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) RevokeAPIKey(ctx context.Context, serviceAccountID, keyID string) error {
+	const op = "service.serviceaccount.RevokeAPIKey"
+
+	if err := s.storage.RevokeAPIKey(ctx, serviceAccountID, keyID, time.Now()); err != nil {
+		if errors.Is(err, storage.ErrAPIKeyNotFound) {
+			return domain.ErrAPIKeyNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package session
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+// revocationCache keeps unexpired revocations in memory, so checking a token on every
+// request doesn't hit the database. It is filled on revoke and synced from Postgres periodically,
+// which is how revocations made by other instances get here
+type revocationCache struct {
+	mu sync.RWMutex
+	// tokens maps revoked token IDs to the time they expire anyway
+	tokens map[string]time.Time
+	// sessions maps user IDs to their latest session revocation
+	sessions map[string]entity.SessionRevocation
+}
+
+func newRevocationCache() *revocationCache {
+	return &revocationCache{
+		tokens:   make(map[string]time.Time),
+		sessions: make(map[string]entity.SessionRevocation),
+	}
+}
+
+func (c *revocationCache) addToken(token entity.AccessToken) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tokens[token.ID] = token.ExpiresAt
+}
+
+func (c *revocationCache) addSession(revocation entity.SessionRevocation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.addSessionLocked(revocation)
+}
+
+func (c *revocationCache) addSessionLocked(revocation entity.SessionRevocation) {
+	if current, ok := c.sessions[revocation.UserID]; ok && current.RevokedBefore.After(revocation.RevokedBefore) {
+		return
+	}
+
+	c.sessions[revocation.UserID] = revocation
+}
+
+// merge adds revocations loaded from storage and drops the expired ones. Entries are never
+// replaced wholesale, so a revocation made while the sync was running isn't lost
+func (c *revocationCache) merge(revocations entity.Revocations, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, token := range revocations.Tokens {
+		c.tokens[token.ID] = token.ExpiresAt
+	}
+
+	for _, revocation := range revocations.Sessions {
+		c.addSessionLocked(revocation)
+	}
+
+	for id, expiresAt := range c.tokens {
+		if !now.Before(expiresAt) {
+			delete(c.tokens, id)
+		}
+	}
+
+	for userID, revocation := range c.sessions {
+		if !now.Before(revocation.ExpiresAt) {
+			delete(c.sessions, userID)
+		}
+	}
+}
+
+func (c *revocationCache) isRevoked(tokenID, userID string, issuedAt time.Time) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if tokenID != "" {
+		if _, ok := c.tokens[tokenID]; ok {
+			return true
+		}
+	}
+
+	revocation, ok := c.sessions[userID]
+	if !ok {
+		return false
+	}
+
+	// iat has a precision of a second, so a token issued in the same second as the revocation
+	// is rejected too. It's better to ask for one more login than to let a revoked token through
+	return issuedAt.Unix() <= revocation.RevokedBefore.Unix()
+}
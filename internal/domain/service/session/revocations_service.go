@@ -0,0 +1,83 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+func (s *Service) RevokeAccessToken(ctx context.Context, token entity.AccessToken) error {
+	const op = "service.session.RevokeAccessToken"
+
+	if err := s.storage.RevokeToken(ctx, token, time.Now()); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.revocations.addToken(token)
+
+	return nil
+}
+
+func (s *Service) RevokeRefreshTokenFamilyByToken(ctx context.Context, userID, tokenHash string) error {
+	const op = "service.session.RevokeRefreshTokenFamilyByToken"
+
+	if err := s.storage.RevokeRefreshTokenFamilyByToken(ctx, userID, tokenHash, time.Now()); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) RevokeUserSessions(ctx context.Context, userID string) error {
+	const op = "service.session.RevokeUserSessions"
+
+	revocation := entity.NewSessionRevocation(userID, s.accessTTL)
+
+	if err := s.storage.RevokeUserSessions(ctx, revocation); err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.revocations.addSession(revocation)
+
+	return nil
+}
+
+// SyncRevocations loads revocations made by other instances into the cache
+func (s *Service) SyncRevocations(ctx context.Context) error {
+	const op = "service.session.SyncRevocations"
+
+	now := time.Now()
+
+	revocations, err := s.storage.ListRevocations(ctx, now)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.revocations.merge(revocations, now)
+
+	return nil
+}
+
+func (s *Service) PurgeExpiredSessions(ctx context.Context) (int, error) {
+	const op = "service.session.PurgeExpiredSessions"
+
+	purged, err := s.storage.PurgeExpiredSessions(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return purged, nil
+}
+
+// IsTokenRevoked only checks the in-process cache, so it's cheap enough for every request
+func (s *Service) IsTokenRevoked(tokenID, userID string, issuedAt time.Time) bool {
+	return s.revocations.isRevoked(tokenID, userID, issuedAt)
+}
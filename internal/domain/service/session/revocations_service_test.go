@@ -0,0 +1,187 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/service/session/mocks"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionService_IsTokenRevoked(t *testing.T) {
+	ctx := context.Background()
+	userID := "test-user-id"
+
+	token := entity.AccessToken{
+		ID:        "test-token-id",
+		UserID:    userID,
+		IssuedAt:  time.Now().Add(-time.Minute),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name            string
+		revoke          func(t *testing.T, sessionService *Service, sessionStorage *mocks.Storage)
+		tokenID         string
+		issuedAt        time.Time
+		expectedRevoked bool
+	}{
+		{
+			name:            "Not revoked",
+			revoke:          func(t *testing.T, sessionService *Service, sessionStorage *mocks.Storage) {},
+			tokenID:         token.ID,
+			issuedAt:        token.IssuedAt,
+			expectedRevoked: false,
+		},
+		{
+			name: "Revoked token",
+			revoke: func(t *testing.T, sessionService *Service, sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().RevokeToken(ctx, token, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(nil)
+
+				require.NoError(t, sessionService.RevokeAccessToken(ctx, token))
+			},
+			tokenID:         token.ID,
+			issuedAt:        token.IssuedAt,
+			expectedRevoked: true,
+		},
+		{
+			name: "Another token of the user stays valid",
+			revoke: func(t *testing.T, sessionService *Service, sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().RevokeToken(ctx, token, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(nil)
+
+				require.NoError(t, sessionService.RevokeAccessToken(ctx, token))
+			},
+			tokenID:         "another-token-id",
+			issuedAt:        token.IssuedAt,
+			expectedRevoked: false,
+		},
+		{
+			name: "All sessions revoked",
+			revoke: func(t *testing.T, sessionService *Service, sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().RevokeUserSessions(ctx, mock.AnythingOfType("entity.SessionRevocation")).
+					Once().
+					Return(nil)
+
+				require.NoError(t, sessionService.RevokeUserSessions(ctx, userID))
+			},
+			tokenID:         "another-token-id",
+			issuedAt:        token.IssuedAt,
+			expectedRevoked: true,
+		},
+		{
+			name: "Token issued after all sessions were revoked",
+			revoke: func(t *testing.T, sessionService *Service, sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().RevokeUserSessions(ctx, mock.AnythingOfType("entity.SessionRevocation")).
+					Once().
+					Return(nil)
+
+				require.NoError(t, sessionService.RevokeUserSessions(ctx, userID))
+			},
+			tokenID:         "new-token-id",
+			issuedAt:        time.Now().Add(2 * time.Second),
+			expectedRevoked: false,
+		},
+		{
+			name: "Revocation made by another instance",
+			revoke: func(t *testing.T, sessionService *Service, sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().ListRevocations(ctx, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(entity.Revocations{Tokens: []entity.AccessToken{token}}, nil)
+
+				require.NoError(t, sessionService.SyncRevocations(ctx))
+			},
+			tokenID:         token.ID,
+			issuedAt:        token.IssuedAt,
+			expectedRevoked: true,
+		},
+		{
+			name: "Expired revocation is dropped on sync",
+			revoke: func(t *testing.T, sessionService *Service, sessionStorage *mocks.Storage) {
+				expired := token
+				expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+				sessionStorage.EXPECT().ListRevocations(ctx, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(entity.Revocations{Tokens: []entity.AccessToken{expired}}, nil)
+
+				require.NoError(t, sessionService.SyncRevocations(ctx))
+			},
+			tokenID:         token.ID,
+			issuedAt:        token.IssuedAt,
+			expectedRevoked: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionStorage := mocks.NewStorage(t)
+			sessionService := New(sessionStorage, time.Hour)
+
+			tt.revoke(t, sessionService, sessionStorage)
+
+			revoked := sessionService.IsTokenRevoked(tt.tokenID, userID, tt.issuedAt)
+			require.Equal(t, tt.expectedRevoked, revoked)
+		})
+	}
+}
+
+func TestSessionService_RevokeUserSessions(t *testing.T) {
+	ctx := context.Background()
+	userID := "test-user-id"
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(sessionStorage *mocks.Storage)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().RevokeUserSessions(ctx, mock.MatchedBy(func(revocation entity.SessionRevocation) bool {
+					return revocation.UserID == userID &&
+						revocation.ExpiresAt.Sub(revocation.RevokedBefore) == time.Hour
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – User not found",
+			mockBehavior: func(sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().RevokeUserSessions(ctx, mock.AnythingOfType("entity.SessionRevocation")).
+					Once().
+					Return(storage.ErrUserNotFound)
+			},
+			expectedError: domain.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionStorage := mocks.NewStorage(t)
+			tt.mockBehavior(sessionStorage)
+
+			sessionService := New(sessionStorage, time.Hour)
+			err := sessionService.RevokeUserSessions(ctx, userID)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+				require.False(t, sessionService.IsTokenRevoked("", userID, time.Now().Add(-time.Minute)))
+			} else {
+				require.NoError(t, err)
+				require.True(t, sessionService.IsTokenRevoked("", userID, time.Now().Add(-time.Minute)))
+			}
+		})
+	}
+}
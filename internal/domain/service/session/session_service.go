@@ -0,0 +1,86 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+type Service struct {
+	storage     Storage
+	accessTTL   time.Duration
+	revocations *revocationCache
+}
+
+type Storage interface {
+	CreateRefreshToken(ctx context.Context, token entity.RefreshToken) error
+	LockRefreshToken(ctx context.Context, tokenHash string) (entity.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, tokenID string, replacement entity.RefreshToken) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string, revokedAt time.Time) error
+	RevokeRefreshTokenFamilyByToken(ctx context.Context, userID, tokenHash string, revokedAt time.Time) error
+	RevokeToken(ctx context.Context, token entity.AccessToken, revokedAt time.Time) error
+	RevokeUserSessions(ctx context.Context, revocation entity.SessionRevocation) error
+	ListRevocations(ctx context.Context, now time.Time) (entity.Revocations, error)
+	PurgeExpiredSessions(ctx context.Context, now time.Time) (int, error)
+}
+
+// New needs the access token TTL to know how long a revocation of all user sessions has to be kept
+func New(storage Storage, accessTTL time.Duration) *Service {
+	return &Service{
+		storage:     storage,
+		accessTTL:   accessTTL,
+		revocations: newRevocationCache(),
+	}
+}
+
+func (s *Service) CreateRefreshToken(ctx context.Context, token entity.RefreshToken) error {
+	const op = "service.session.CreateRefreshToken"
+
+	if err := s.storage.CreateRefreshToken(ctx, token); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) LockRefreshToken(ctx context.Context, tokenHash string) (entity.RefreshToken, error) {
+	const op = "service.session.LockRefreshToken"
+
+	token, err := s.storage.LockRefreshToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, storage.ErrRefreshTokenNotFound) {
+			return entity.RefreshToken{}, domain.ErrRefreshTokenNotFound
+		}
+		return entity.RefreshToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return token, nil
+}
+
+func (s *Service) RotateRefreshToken(ctx context.Context, tokenID string, replacement entity.RefreshToken) error {
+	const op = "service.session.RotateRefreshToken"
+
+	if err := s.storage.RotateRefreshToken(ctx, tokenID, replacement); err != nil {
+		if errors.Is(err, storage.ErrRefreshTokenNotActive) {
+			return domain.ErrRefreshTokenNotActive
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	const op = "service.session.RevokeRefreshTokenFamily"
+
+	if err := s.storage.RevokeRefreshTokenFamily(ctx, familyID, time.Now()); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/service/session/mocks"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionService_LockRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	tokenHash := "test-token-hash"
+
+	expectedToken := entity.RefreshToken{
+		ID:        "test-token-id",
+		UserID:    "test-user-id",
+		FamilyID:  "test-token-id",
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(time.Hour),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(sessionStorage *mocks.Storage)
+		expectedToken entity.RefreshToken
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().LockRefreshToken(ctx, tokenHash).
+					Once().
+					Return(expectedToken, nil)
+			},
+			expectedToken: expectedToken,
+			expectedError: nil,
+		},
+		{
+			name: "Error – Refresh token not found",
+			mockBehavior: func(sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().LockRefreshToken(ctx, tokenHash).
+					Once().
+					Return(entity.RefreshToken{}, storage.ErrRefreshTokenNotFound)
+			},
+			expectedToken: entity.RefreshToken{},
+			expectedError: domain.ErrRefreshTokenNotFound,
+		},
+		{
+			name: "Error – Storage error",
+			mockBehavior: func(sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().LockRefreshToken(ctx, tokenHash).
+					Once().
+					Return(entity.RefreshToken{}, errors.New("storage error"))
+			},
+			expectedToken: entity.RefreshToken{},
+			expectedError: errors.New("storage error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionStorage := mocks.NewStorage(t)
+			tt.mockBehavior(sessionStorage)
+
+			sessionService := New(sessionStorage, time.Hour)
+			token, err := sessionService.LockRefreshToken(ctx, tokenHash)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expectedToken, token)
+		})
+	}
+}
+
+func TestSessionService_RotateRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	tokenID := "test-token-id"
+	replacement := entity.NewRefreshToken("test-user-id", tokenID, "new-token-hash", time.Hour)
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(sessionStorage *mocks.Storage)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().RotateRefreshToken(ctx, tokenID, replacement).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Refresh token is not active",
+			mockBehavior: func(sessionStorage *mocks.Storage) {
+				sessionStorage.EXPECT().RotateRefreshToken(ctx, tokenID, replacement).
+					Once().
+					Return(storage.ErrRefreshTokenNotActive)
+			},
+			expectedError: domain.ErrRefreshTokenNotActive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionStorage := mocks.NewStorage(t)
+			tt.mockBehavior(sessionStorage)
+
+			sessionService := New(sessionStorage, time.Hour)
+			err := sessionService.RotateRefreshToken(ctx, tokenID, replacement)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
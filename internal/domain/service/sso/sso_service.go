@@ -0,0 +1,193 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/lib/oidc"
+)
+
+// Claims local usernames can be taken from
+const (
+	// UsernameClaimEmail takes the part of a verified email before the @
+	UsernameClaimEmail             = "email"
+	UsernameClaimPreferredUsername = "preferred_username"
+	UsernameClaimSubject           = "sub"
+)
+
+// Service runs the OpenID Connect authorization code flow with PKCE. It keeps the state of logins
+// in progress and turns a successful callback into a verified identity, mapping it to local users
+// is up to the caller
+type Service struct {
+	provider Provider
+	storage  Storage
+	cfg      Config
+}
+
+type (
+	Provider interface {
+		AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+		Exchange(ctx context.Context, code, codeVerifier string) (string, error)
+		VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (oidc.IDToken, error)
+	}
+
+	Storage interface {
+		CreateOIDCLoginState(ctx context.Context, state entity.OIDCLoginState) error
+		ConsumeOIDCLoginState(ctx context.Context, state string, now time.Time) (entity.OIDCLoginState, error)
+	}
+
+	Config struct {
+		// Issuer is the issuer identifier of the provider, links to local users are made for it
+		Issuer string
+		// LoginTTL is how long the user has to sign in at the provider
+		LoginTTL time.Duration
+		// UsernameClaim is one of email, preferred_username or sub
+		UsernameClaim string
+		// AllowedEmailDomains are the domains of emails usernames can be taken from. The provider may let
+		// anyone register an address, so without them any local part could be claimed
+		AllowedEmailDomains []string
+	}
+)
+
+func New(provider Provider, storage Storage, cfg Config) (*Service, error) {
+	switch cfg.UsernameClaim {
+	case UsernameClaimEmail:
+		if len(cfg.AllowedEmailDomains) == 0 {
+			return nil, errors.New("allowed email domains are required for the email username claim")
+		}
+	case UsernameClaimPreferredUsername, UsernameClaimSubject:
+	default:
+		return nil, fmt.Errorf("unsupported username claim %q", cfg.UsernameClaim)
+	}
+
+	return &Service{
+		provider: provider,
+		storage:  storage,
+		cfg:      cfg,
+	}, nil
+}
+
+// Issuer returns the issuer identifier of the provider
+func (s *Service) Issuer() string {
+	return s.cfg.Issuer
+}
+
+// StartLogin returns the URL of the provider to send the user to
+func (s *Service) StartLogin(ctx context.Context) (string, error) {
+	const op = "service.sso.StartLogin"
+
+	var values [3]string
+	for i := range values {
+		value, err := oidc.RandomValue()
+		if err != nil {
+			return "", fmt.Errorf("%s: failed to generate login state: %w", op, err)
+		}
+
+		values[i] = value
+	}
+
+	loginState := entity.NewOIDCLoginState(values[0], values[1], values[2], s.cfg.LoginTTL)
+
+	authURL, err := s.provider.AuthCodeURL(ctx, loginState.State, loginState.Nonce, loginState.CodeVerifier)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = s.storage.CreateOIDCLoginState(ctx, loginState); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return authURL, nil
+}
+
+// CompleteLogin redeems the code the provider redirected back with. The state can be used once,
+// so a callback URL that leaked can't be replayed
+func (s *Service) CompleteLogin(ctx context.Context, code, state string) (entity.OIDCIdentity, error) {
+	const op = "service.sso.CompleteLogin"
+
+	loginState, err := s.storage.ConsumeOIDCLoginState(ctx, state, time.Now())
+	if err != nil {
+		if errors.Is(err, storage.ErrOIDCLoginStateNotFound) {
+			return entity.OIDCIdentity{}, domain.ErrInvalidOIDCState
+		}
+		return entity.OIDCIdentity{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rawIDToken, err := s.provider.Exchange(ctx, code, loginState.CodeVerifier)
+	if err != nil {
+		if errors.Is(err, oidc.ErrCodeRejected) {
+			return entity.OIDCIdentity{}, fmt.Errorf("%w: %w", domain.ErrOIDCLoginRejected, err)
+		}
+		return entity.OIDCIdentity{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	idToken, err := s.provider.VerifyIDToken(ctx, rawIDToken, loginState.Nonce)
+	if err != nil {
+		if errors.Is(err, oidc.ErrInvalidIDToken) {
+			return entity.OIDCIdentity{}, fmt.Errorf("%w: %w", domain.ErrOIDCLoginRejected, err)
+		}
+		return entity.OIDCIdentity{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	username, err := s.username(idToken)
+	if err != nil {
+		return entity.OIDCIdentity{}, err
+	}
+
+	identity := entity.OIDCIdentity{
+		Issuer:   idToken.Issuer,
+		Subject:  idToken.Subject,
+		Username: username,
+	}
+
+	// An unverified email may belong to someone else, it isn't kept
+	if idToken.EmailVerified {
+		identity.Email = idToken.Email
+	}
+
+	return identity, nil
+}
+
+func (s *Service) username(idToken oidc.IDToken) (string, error) {
+	switch s.cfg.UsernameClaim {
+	case UsernameClaimEmail:
+		if !idToken.EmailVerified {
+			return "", fmt.Errorf("%w: email is not verified", domain.ErrOIDCUsernameUnavailable)
+		}
+
+		localPart, emailDomain, ok := strings.Cut(idToken.Email, "@")
+		if !ok || localPart == "" {
+			return "", fmt.Errorf("%w: invalid email", domain.ErrOIDCUsernameUnavailable)
+		}
+
+		if !s.allowedEmailDomain(emailDomain) {
+			return "", fmt.Errorf("%w: email domain %q is not allowed", domain.ErrOIDCUsernameUnavailable, emailDomain)
+		}
+
+		return strings.ToLower(localPart), nil
+	case UsernameClaimPreferredUsername:
+		if idToken.PreferredUsername == "" {
+			return "", fmt.Errorf("%w: preferred_username claim is missing", domain.ErrOIDCUsernameUnavailable)
+		}
+
+		return idToken.PreferredUsername, nil
+	default:
+		return idToken.Subject, nil
+	}
+}
+
+func (s *Service) allowedEmailDomain(emailDomain string) bool {
+	for _, allowed := range s.cfg.AllowedEmailDomains {
+		if strings.EqualFold(allowed, emailDomain) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,274 @@
+package sso
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/service/sso/mocks"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/lib/oidc"
+	"github.com/rshelekhov/merch-store/internal/lib/oidc/oidctest"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = Config{
+	LoginTTL:            10 * time.Minute,
+	UsernameClaim:       UsernameClaimEmail,
+	AllowedEmailDomains: []string{"example.com"},
+}
+
+func TestSSOService_Login(t *testing.T) {
+	ctx := context.Background()
+
+	idp := oidctest.NewServer(t, "merch-store", "test-client-secret")
+
+	provider, err := oidc.New(oidc.Config{
+		IssuerURL:    idp.Issuer(),
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		RedirectURL:  "http://localhost:8080/api/auth/oidc/callback",
+	})
+	require.NoError(t, err)
+
+	ssoStorage := mocks.NewStorage(t)
+
+	var saved entity.OIDCLoginState
+	ssoStorage.EXPECT().CreateOIDCLoginState(ctx, mock.AnythingOfType("entity.OIDCLoginState")).
+		Run(func(_ context.Context, state entity.OIDCLoginState) {
+			saved = state
+		}).
+		Once().
+		Return(nil)
+
+	svc, err := New(provider, ssoStorage, testConfig)
+	require.NoError(t, err)
+
+	authURL, err := svc.StartLogin(ctx)
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	require.Equal(t, saved.State, parsed.Query().Get("state"))
+	require.Equal(t, saved.Nonce, parsed.Query().Get("nonce"))
+	require.WithinDuration(t, time.Now().Add(testConfig.LoginTTL), saved.ExpiresAt, time.Minute)
+
+	code, state, err := idp.Login(authURL, oidctest.User{
+		Subject:       "00u1a2b3c4",
+		Email:         "John.Doe@example.com",
+		EmailVerified: true,
+	})
+	require.NoError(t, err)
+
+	ssoStorage.EXPECT().ConsumeOIDCLoginState(ctx, state, mock.AnythingOfType("time.Time")).
+		Once().
+		Return(saved, nil)
+
+	identity, err := svc.CompleteLogin(ctx, code, state)
+	require.NoError(t, err)
+	require.Equal(t, entity.OIDCIdentity{
+		Issuer:   idp.Issuer(),
+		Subject:  "00u1a2b3c4",
+		Email:    "John.Doe@example.com",
+		Username: "john.doe",
+	}, identity)
+}
+
+func TestSSOService_CompleteLogin(t *testing.T) {
+	ctx := context.Background()
+
+	code := "test-code"
+	loginState := entity.NewOIDCLoginState("test-state", "test-nonce", "test-verifier", time.Minute)
+
+	idToken := oidc.IDToken{
+		Issuer:            "https://idp.example.com",
+		Subject:           "00u1a2b3c4",
+		Email:             "john.doe@example.com",
+		EmailVerified:     true,
+		PreferredUsername: "jdoe",
+	}
+
+	tests := []struct {
+		name             string
+		usernameClaim    string
+		mockBehavior     func(ssoStorage *mocks.Storage, provider *mocks.Provider)
+		expectedUsername string
+		expectedError    error
+	}{
+		{
+			name:          "Success – Username from preferred_username",
+			usernameClaim: UsernameClaimPreferredUsername,
+			mockBehavior: func(ssoStorage *mocks.Storage, provider *mocks.Provider) {
+				ssoStorage.EXPECT().ConsumeOIDCLoginState(ctx, loginState.State, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(loginState, nil)
+
+				provider.EXPECT().Exchange(ctx, code, loginState.CodeVerifier).
+					Once().
+					Return("raw-id-token", nil)
+
+				provider.EXPECT().VerifyIDToken(ctx, "raw-id-token", loginState.Nonce).
+					Once().
+					Return(idToken, nil)
+			},
+			expectedUsername: "jdoe",
+			expectedError:    nil,
+		},
+		{
+			name:          "Success – Username from email",
+			usernameClaim: UsernameClaimEmail,
+			mockBehavior: func(ssoStorage *mocks.Storage, provider *mocks.Provider) {
+				ssoStorage.EXPECT().ConsumeOIDCLoginState(ctx, loginState.State, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(loginState, nil)
+
+				provider.EXPECT().Exchange(ctx, code, loginState.CodeVerifier).
+					Once().
+					Return("raw-id-token", nil)
+
+				provider.EXPECT().VerifyIDToken(ctx, "raw-id-token", loginState.Nonce).
+					Once().
+					Return(idToken, nil)
+			},
+			expectedUsername: "john.doe",
+			expectedError:    nil,
+		},
+		{
+			name:          "Error – Email domain is not allowed",
+			usernameClaim: UsernameClaimEmail,
+			mockBehavior: func(ssoStorage *mocks.Storage, provider *mocks.Provider) {
+				ssoStorage.EXPECT().ConsumeOIDCLoginState(ctx, loginState.State, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(loginState, nil)
+
+				provider.EXPECT().Exchange(ctx, code, loginState.CodeVerifier).
+					Once().
+					Return("raw-id-token", nil)
+
+				foreign := idToken
+				foreign.Email = "admin@attacker.example.net"
+
+				provider.EXPECT().VerifyIDToken(ctx, "raw-id-token", loginState.Nonce).
+					Once().
+					Return(foreign, nil)
+			},
+			expectedError: domain.ErrOIDCUsernameUnavailable,
+		},
+		{
+			name:          "Error – Unknown or expired state",
+			usernameClaim: UsernameClaimEmail,
+			mockBehavior: func(ssoStorage *mocks.Storage, _ *mocks.Provider) {
+				ssoStorage.EXPECT().ConsumeOIDCLoginState(ctx, loginState.State, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(entity.OIDCLoginState{}, storage.ErrOIDCLoginStateNotFound)
+			},
+			expectedError: domain.ErrInvalidOIDCState,
+		},
+		{
+			name:          "Error – Code rejected",
+			usernameClaim: UsernameClaimEmail,
+			mockBehavior: func(ssoStorage *mocks.Storage, provider *mocks.Provider) {
+				ssoStorage.EXPECT().ConsumeOIDCLoginState(ctx, loginState.State, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(loginState, nil)
+
+				provider.EXPECT().Exchange(ctx, code, loginState.CodeVerifier).
+					Once().
+					Return("", oidc.ErrCodeRejected)
+			},
+			expectedError: domain.ErrOIDCLoginRejected,
+		},
+		{
+			name:          "Error – Invalid ID token",
+			usernameClaim: UsernameClaimEmail,
+			mockBehavior: func(ssoStorage *mocks.Storage, provider *mocks.Provider) {
+				ssoStorage.EXPECT().ConsumeOIDCLoginState(ctx, loginState.State, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(loginState, nil)
+
+				provider.EXPECT().Exchange(ctx, code, loginState.CodeVerifier).
+					Once().
+					Return("raw-id-token", nil)
+
+				provider.EXPECT().VerifyIDToken(ctx, "raw-id-token", loginState.Nonce).
+					Once().
+					Return(oidc.IDToken{}, oidc.ErrInvalidIDToken)
+			},
+			expectedError: domain.ErrOIDCLoginRejected,
+		},
+		{
+			name:          "Error – Email is not verified",
+			usernameClaim: UsernameClaimEmail,
+			mockBehavior: func(ssoStorage *mocks.Storage, provider *mocks.Provider) {
+				ssoStorage.EXPECT().ConsumeOIDCLoginState(ctx, loginState.State, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(loginState, nil)
+
+				provider.EXPECT().Exchange(ctx, code, loginState.CodeVerifier).
+					Once().
+					Return("raw-id-token", nil)
+
+				unverified := idToken
+				unverified.EmailVerified = false
+
+				provider.EXPECT().VerifyIDToken(ctx, "raw-id-token", loginState.Nonce).
+					Once().
+					Return(unverified, nil)
+			},
+			expectedError: domain.ErrOIDCUsernameUnavailable,
+		},
+		{
+			name:          "Error – Provider is unavailable",
+			usernameClaim: UsernameClaimEmail,
+			mockBehavior: func(ssoStorage *mocks.Storage, provider *mocks.Provider) {
+				ssoStorage.EXPECT().ConsumeOIDCLoginState(ctx, loginState.State, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(loginState, nil)
+
+				provider.EXPECT().Exchange(ctx, code, loginState.CodeVerifier).
+					Once().
+					Return("", oidc.ErrDiscoveryFailed)
+			},
+			expectedError: oidc.ErrDiscoveryFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssoStorage := mocks.NewStorage(t)
+			provider := mocks.NewProvider(t)
+
+			tt.mockBehavior(ssoStorage, provider)
+
+			svc, err := New(provider, ssoStorage, Config{
+				LoginTTL:            time.Minute,
+				UsernameClaim:       tt.usernameClaim,
+				AllowedEmailDomains: []string{"Example.com"},
+			})
+			require.NoError(t, err)
+
+			identity, err := svc.CompleteLogin(ctx, code, loginState.State)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedUsername, identity.Username)
+			require.Equal(t, idToken.Email, identity.Email)
+		})
+	}
+}
+
+func TestSSOService_New_EmailClaimRequiresDomains(t *testing.T) {
+	_, err := New(mocks.NewProvider(t), mocks.NewStorage(t), Config{
+		LoginTTL:      time.Minute,
+		UsernameClaim: UsernameClaimEmail,
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,145 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+type Service struct {
+	storage Storage
+}
+
+type Storage interface {
+	CreateTeam(ctx context.Context, team entity.Team) error
+	GetTeamByID(ctx context.Context, teamID string) (entity.Team, error)
+	ListTeams(ctx context.Context) ([]entity.Team, error)
+	ListUserTeams(ctx context.Context, userID string) ([]entity.Team, error)
+	SaveTeamMember(ctx context.Context, member entity.TeamMember) error
+	DeleteTeamMember(ctx context.Context, teamID, userID string) error
+	GetTeamMember(ctx context.Context, teamID, userID string) (entity.TeamMember, error)
+	ListTeamMembers(ctx context.Context, teamID string) ([]entity.TeamMember, error)
+	ListTeamTransactions(ctx context.Context, teamID string, limit int) ([]entity.TeamTransaction, error)
+}
+
+func New(storage Storage) *Service {
+	return &Service{
+		storage: storage,
+	}
+}
+
+func (s *Service) CreateTeam(ctx context.Context, team entity.Team) error {
+	const op = "service.team.CreateTeam"
+
+	if err := s.storage.CreateTeam(ctx, team); err != nil {
+		if errors.Is(err, storage.ErrTeamExists) {
+			return domain.ErrTeamAlreadyExists
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetTeamByID(ctx context.Context, teamID string) (entity.Team, error) {
+	const op = "service.team.GetTeamByID"
+
+	team, err := s.storage.GetTeamByID(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, storage.ErrTeamNotFound) {
+			return entity.Team{}, domain.ErrTeamNotFound
+		}
+		return entity.Team{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return team, nil
+}
+
+func (s *Service) ListTeams(ctx context.Context) ([]entity.Team, error) {
+	const op = "service.team.ListTeams"
+
+	teams, err := s.storage.ListTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return teams, nil
+}
+
+func (s *Service) ListUserTeams(ctx context.Context, userID string) ([]entity.Team, error) {
+	const op = "service.team.ListUserTeams"
+
+	teams, err := s.storage.ListUserTeams(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return teams, nil
+}
+
+func (s *Service) SaveTeamMember(ctx context.Context, member entity.TeamMember) error {
+	const op = "service.team.SaveTeamMember"
+
+	if err := s.storage.SaveTeamMember(ctx, member); err != nil {
+		if errors.Is(err, storage.ErrTeamNotFound) {
+			return domain.ErrTeamNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) RemoveTeamMember(ctx context.Context, teamID, userID string) error {
+	const op = "service.team.RemoveTeamMember"
+
+	if err := s.storage.DeleteTeamMember(ctx, teamID, userID); err != nil {
+		if errors.Is(err, storage.ErrTeamMemberNotFound) {
+			return domain.ErrTeamMemberNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetTeamMember returns ErrTeamMemberNotFound for deactivated members as well
+func (s *Service) GetTeamMember(ctx context.Context, teamID, userID string) (entity.TeamMember, error) {
+	const op = "service.team.GetTeamMember"
+
+	member, err := s.storage.GetTeamMember(ctx, teamID, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrTeamMemberNotFound) {
+			return entity.TeamMember{}, domain.ErrTeamMemberNotFound
+		}
+		return entity.TeamMember{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return member, nil
+}
+
+func (s *Service) ListTeamMembers(ctx context.Context, teamID string) ([]entity.TeamMember, error) {
+	const op = "service.team.ListTeamMembers"
+
+	members, err := s.storage.ListTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return members, nil
+}
+
+func (s *Service) ListTeamTransactions(ctx context.Context, teamID string, limit int) ([]entity.TeamTransaction, error) {
+	const op = "service.team.ListTeamTransactions"
+
+	transactions, err := s.storage.ListTeamTransactions(ctx, teamID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return transactions, nil
+}
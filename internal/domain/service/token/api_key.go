@@ -0,0 +1,34 @@
+package token
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+// APIKeyPrefix marks API keys, so they can be told apart from access tokens and found by secret scanners
+const APIKeyPrefix = "msk_"
+
+// GenerateAPIKey returns a new API key of the service account and its record to be stored.
+// Only the hash of the key is kept in the record
+func (s *Service) GenerateAPIKey(
+	serviceAccountID, createdBy string,
+	scopes []entity.Scope,
+	expiresAt time.Time,
+) (string, entity.APIKey, error) {
+	const op = "service.token.GenerateAPIKey"
+
+	token, err := newOpaqueToken()
+	if err != nil {
+		return "", entity.APIKey{}, fmt.Errorf("%s: failed to generate api key: %w", op, err)
+	}
+
+	apiKey := APIKeyPrefix + token
+
+	return apiKey, entity.NewAPIKey(serviceAccountID, createdBy, s.HashAPIKey(apiKey), scopes, expiresAt), nil
+}
+
+func (s *Service) HashAPIKey(apiKey string) string {
+	return hashOpaqueToken(apiKey)
+}
@@ -0,0 +1,30 @@
+package token
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenService_GenerateAPIKey(t *testing.T) {
+	tokenService := setup(t)
+
+	serviceAccountID := "test-service-account-id"
+	adminID := "test-admin-id"
+	scopes := []entity.Scope{entity.ScopeMerchRead}
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	apiKey, key, err := tokenService.GenerateAPIKey(serviceAccountID, adminID, scopes, expiresAt)
+
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(apiKey, APIKeyPrefix))
+	require.Equal(t, serviceAccountID, key.ServiceAccountID)
+	require.Equal(t, adminID, key.CreatedBy)
+	require.Equal(t, scopes, key.Scopes)
+	require.Equal(t, expiresAt, key.ExpiresAt)
+	require.Equal(t, tokenService.HashAPIKey(apiKey), key.KeyHash)
+	require.NotContains(t, key.KeyHash, apiKey)
+}
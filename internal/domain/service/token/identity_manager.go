@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
 )
 
 func (s *Service) ExtractUserIDFromContext(ctx context.Context) (string, error) {
@@ -17,3 +18,25 @@ func (s *Service) ExtractUserIDFromContext(ctx context.Context) (string, error)
 
 	return userID, nil
 }
+
+func (s *Service) ExtractAccessTokenFromContext(ctx context.Context) (entity.AccessToken, error) {
+	const op = "service.token.ExtractAccessTokenFromContext"
+
+	token, ok := ctx.Value(domain.AccessTokenKey).(entity.AccessToken)
+	if !ok {
+		return entity.AccessToken{}, fmt.Errorf("%s: %w", op, domain.ErrAccessTokenNotFoundInContext)
+	}
+
+	return token, nil
+}
+
+func (s *Service) ExtractAPIKeyFromContext(ctx context.Context) (entity.APIKey, error) {
+	const op = "service.token.ExtractAPIKeyFromContext"
+
+	key, ok := ctx.Value(domain.APIKeyKey).(entity.APIKey)
+	if !ok {
+		return entity.APIKey{}, fmt.Errorf("%s: %w", op, domain.ErrAPIKeyNotFoundInContext)
+	}
+
+	return key, nil
+}
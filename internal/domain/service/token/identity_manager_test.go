@@ -3,8 +3,10 @@ package token
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
 	"github.com/stretchr/testify/require"
 )
 
@@ -54,3 +56,54 @@ func TestTokenService_ExtractUserIDFromContext(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenService_ExtractAccessTokenFromContext(t *testing.T) {
+	tokenService := setup(t)
+
+	expectedToken := entity.AccessToken{
+		ID:        "test-token-id",
+		UserID:    "test-user-id",
+		IssuedAt:  time.Now(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name          string
+		setupContext  func() context.Context
+		expectedToken entity.AccessToken
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), domain.AccessTokenKey, expectedToken)
+			},
+			expectedToken: expectedToken,
+			expectedError: nil,
+		},
+		{
+			name: "Error — Access token not found in context",
+			setupContext: func() context.Context {
+				return context.Background()
+			},
+			expectedToken: entity.AccessToken{},
+			expectedError: domain.ErrAccessTokenNotFoundInContext,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.setupContext()
+
+			token, err := tokenService.ExtractAccessTokenFromContext(ctx)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expectedToken, token)
+		})
+	}
+}
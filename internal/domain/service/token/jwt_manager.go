@@ -6,20 +6,23 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/segmentio/ksuid"
 )
 
-func (s *Service) GenerateToken(userID string) (string, error) {
+func (s *Service) GenerateToken(userID string, role entity.Role) (string, error) {
 	const op = "service.token.GenerateToken"
 
+	// jti lets a single token be revoked, e.g. on logout
 	claims := jwt.MapClaims{
+		domain.TokenIDKey:    ksuid.New().String(),
 		domain.UserIDKey:     userID,
+		domain.RoleKey:       string(role),
 		domain.ExpirationKey: time.Now().Add(s.jwt.TTL).Unix(),
 		domain.IssuedAtKey:   time.Now().Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	tokenString, err := token.SignedString([]byte(s.jwt.Secret))
+	tokenString, err := s.signer.Sign(claims)
 	if err != nil {
 		return "", fmt.Errorf("%s: failed to sign token: %w", op, err)
 	}
@@ -3,6 +3,7 @@ package token
 import (
 	"testing"
 
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
 	"github.com/stretchr/testify/require"
 )
 
@@ -11,7 +12,7 @@ func TestTokenService_GenerateToken(t *testing.T) {
 
 	userID := "test-user-id"
 
-	token, err := tokenService.GenerateToken(userID)
+	token, err := tokenService.GenerateToken(userID, entity.RoleEmployee)
 
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
@@ -2,14 +2,47 @@ package token
 
 import (
 	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/rshelekhov/merch-store/internal/domain"
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	// New hashes are Argon2id in the PHC string format:
+	// $argon2id$v=19$m=<memory KiB>,t=<iterations>,p=<parallelism>[,keyid=<pepper version>]$<salt>$<key>
+	// The pepper version is base64-encoded into keyid, hashes without it use the unversioned pepper
+	argon2idPrefix = "$argon2id$"
+
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+// Legacy hashes were made with bcrypt and the unversioned pepper, they are still verified and get rehashed on login
+var bcryptPrefixes = []string{"$2a$", "$2b$", "$2y$"}
+
+type argon2Params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+type argon2Hash struct {
+	params        argon2Params
+	pepperVersion string
+	salt          []byte
+	key           []byte
+}
+
+// PasswordHash hashes the password with Argon2id. The password is peppered with HMAC-SHA256
+// using the current pepper first
 func (s *Service) PasswordHash(password string) (string, error) {
 	const op = "service.token.PasswordHash"
 
@@ -17,7 +50,7 @@ func (s *Service) PasswordHash(password string) (string, error) {
 		return "", fmt.Errorf("%s: %w", op, domain.ErrPasswordIsNotAllowed)
 	}
 
-	hash, err := s.passwordHashBcrypt(password)
+	hash, err := s.passwordHashArgon2id(password)
 	if err != nil {
 		return "", fmt.Errorf("%s: failed to hash password: %w", op, err)
 	}
@@ -25,6 +58,7 @@ func (s *Service) PasswordHash(password string) (string, error) {
 	return hash, nil
 }
 
+// ValidatePassword checks the password against an Argon2id or a legacy bcrypt hash
 func (s *Service) ValidatePassword(providedPassword, passwordHash string) error {
 	const op = "service.token.ValidatePassword"
 
@@ -36,36 +70,114 @@ func (s *Service) ValidatePassword(providedPassword, passwordHash string) error
 		return fmt.Errorf("%s: %w", op, domain.ErrPasswordHashIsNotAllowed)
 	}
 
-	return s.passwordMatchBcrypt(providedPassword, passwordHash)
+	switch {
+	case strings.HasPrefix(passwordHash, argon2idPrefix):
+		return s.passwordMatchArgon2id(providedPassword, passwordHash)
+	case isBcryptHash(passwordHash):
+		return s.passwordMatchBcrypt(providedPassword, passwordHash)
+	default:
+		return fmt.Errorf("%s: %w", op, domain.ErrUnsupportedPasswordHash)
+	}
 }
 
-func (s *Service) passwordHashBcrypt(password string) (string, error) {
-	const op = "service.token.passwordHashBcrypt"
+// PasswordNeedsRehash reports whether the hash is a legacy bcrypt hash or an Argon2id hash
+// made with other parameters or another pepper than the current ones
+func (s *Service) PasswordNeedsRehash(passwordHash string) bool {
+	if !strings.HasPrefix(passwordHash, argon2idPrefix) {
+		return true
+	}
 
-	passwordHmac := hmac.New(sha256.New, []byte(s.passwordHash.Pepper))
-	_, err := passwordHmac.Write([]byte(password))
+	hash, err := decodeArgon2id(passwordHash)
 	if err != nil {
-		return "", fmt.Errorf("%s: %w", op, err)
+		return true
 	}
 
-	passwordBcrypt, err := bcrypt.GenerateFromPassword(passwordHmac.Sum(nil), s.passwordHash.BcryptCost)
+	return hash.params != s.argon2Params() || hash.pepperVersion != s.passwordHash.PepperVersion
+}
+
+func (s *Service) argon2Params() argon2Params {
+	return argon2Params{
+		memory:      s.passwordHash.Argon2Memory,
+		iterations:  s.passwordHash.Argon2Iterations,
+		parallelism: s.passwordHash.Argon2Parallelism,
+	}
+}
+
+// pepper applies the pepper of the version, an empty version stands for the unversioned pepper
+func (s *Service) pepper(password, version string) ([]byte, error) {
+	secret := s.passwordHash.Pepper
+
+	if version != "" {
+		var ok bool
+		if secret, ok = s.passwordHash.Peppers[version]; !ok {
+			return nil, fmt.Errorf("%w: %q", domain.ErrUnknownPepperVersion, version)
+		}
+	}
+
+	passwordHmac := hmac.New(sha256.New, []byte(secret))
+	if _, err := passwordHmac.Write([]byte(password)); err != nil {
+		return nil, err
+	}
+
+	return passwordHmac.Sum(nil), nil
+}
+
+func (s *Service) passwordHashArgon2id(password string) (string, error) {
+	const op = "service.token.passwordHashArgon2id"
+
+	hash := argon2Hash{
+		params:        s.argon2Params(),
+		pepperVersion: s.passwordHash.PepperVersion,
+		salt:          make([]byte, argon2SaltLength),
+	}
+
+	peppered, err := s.pepper(password, hash.pepperVersion)
 	if err != nil {
 		return "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	return string(passwordBcrypt), nil
+	if _, err = rand.Read(hash.salt); err != nil {
+		return "", fmt.Errorf("%s: failed to generate salt: %w", op, err)
+	}
+
+	params := hash.params
+	hash.key = argon2.IDKey(peppered, hash.salt, params.iterations, params.memory, params.parallelism, argon2KeyLength)
+
+	return encodeArgon2id(hash), nil
+}
+
+func (s *Service) passwordMatchArgon2id(providedPassword, passwordHash string) error {
+	const op = "service.token.passwordMatchArgon2id"
+
+	hash, err := decodeArgon2id(passwordHash)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	peppered, err := s.pepper(providedPassword, hash.pepperVersion)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	params := hash.params
+	providedKey := argon2.IDKey(peppered, hash.salt, params.iterations, params.memory, params.parallelism, uint32(len(hash.key)))
+
+	if subtle.ConstantTimeCompare(providedKey, hash.key) != 1 {
+		return fmt.Errorf("%s: %w", op, domain.ErrInvalidPassword)
+	}
+
+	return nil
 }
 
 func (s *Service) passwordMatchBcrypt(providedPassword, passwordHash string) error {
 	const op = "service.token.passwordMatchBcrypt"
 
-	passwordHmac := hmac.New(sha256.New, []byte(s.passwordHash.Pepper))
-	_, err := passwordHmac.Write([]byte(providedPassword))
+	peppered, err := s.pepper(providedPassword, "")
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(passwordHash), passwordHmac.Sum(nil))
+	err = bcrypt.CompareHashAndPassword([]byte(passwordHash), peppered)
 	if err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
 			return fmt.Errorf("%s: %w", op, domain.ErrInvalidPassword)
@@ -75,3 +187,67 @@ func (s *Service) passwordMatchBcrypt(providedPassword, passwordHash string) err
 
 	return nil
 }
+
+func isBcryptHash(passwordHash string) bool {
+	for _, prefix := range bcryptPrefixes {
+		if strings.HasPrefix(passwordHash, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func encodeArgon2id(hash argon2Hash) string {
+	params := fmt.Sprintf("m=%d,t=%d,p=%d", hash.params.memory, hash.params.iterations, hash.params.parallelism)
+	if hash.pepperVersion != "" {
+		params += ",keyid=" + base64.RawStdEncoding.EncodeToString([]byte(hash.pepperVersion))
+	}
+
+	return fmt.Sprintf("%sv=%d$%s$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		params,
+		base64.RawStdEncoding.EncodeToString(hash.salt),
+		base64.RawStdEncoding.EncodeToString(hash.key),
+	)
+}
+
+func decodeArgon2id(passwordHash string) (argon2Hash, error) {
+	var hash argon2Hash
+
+	// "", "argon2id", "v=19", "m=...,t=...,p=...[,keyid=...]", salt, key
+	parts := strings.Split(passwordHash, "$")
+	if len(parts) != 6 {
+		return hash, domain.ErrUnsupportedPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return hash, domain.ErrUnsupportedPasswordHash
+	}
+
+	params, keyID, _ := strings.Cut(parts[3], ",keyid=")
+
+	_, err := fmt.Sscanf(params, "m=%d,t=%d,p=%d", &hash.params.memory, &hash.params.iterations, &hash.params.parallelism)
+	if err != nil || hash.params.memory == 0 || hash.params.iterations == 0 || hash.params.parallelism == 0 {
+		return hash, domain.ErrUnsupportedPasswordHash
+	}
+
+	pepperVersion, err := base64.RawStdEncoding.DecodeString(keyID)
+	if err != nil {
+		return hash, domain.ErrUnsupportedPasswordHash
+	}
+
+	hash.pepperVersion = string(pepperVersion)
+
+	if hash.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil || len(hash.salt) == 0 {
+		return hash, domain.ErrUnsupportedPasswordHash
+	}
+
+	if hash.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(hash.key) == 0 {
+		return hash, domain.ErrUnsupportedPasswordHash
+	}
+
+	return hash, nil
+}
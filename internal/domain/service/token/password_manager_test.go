@@ -1,10 +1,12 @@
 package token
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/rshelekhov/merch-store/internal/domain"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestTokenService_PasswordHash(t *testing.T) {
@@ -39,8 +41,7 @@ func TestTokenService_PasswordHash(t *testing.T) {
 				require.Empty(t, hashedPassword)
 			} else {
 				require.NoError(t, err)
-				require.NotEmpty(t, hashedPassword)
-				require.Greater(t, len(hashedPassword), 0)
+				require.True(t, strings.HasPrefix(hashedPassword, "$argon2id$v=19$m=8192,t=1,p=1$"))
 			}
 		})
 	}
@@ -64,6 +65,36 @@ func TestTokenService_ValidatePassword(t *testing.T) {
 			setupHash:     true,
 			expectedError: nil,
 		},
+		{
+			name:           "Success – Legacy bcrypt hash",
+			hashedPassword: legacyBcryptHash(t, tokenService, password),
+			password:       password,
+			expectedError:  nil,
+		},
+		{
+			name:          "Error – Wrong password",
+			password:      "wrong-password",
+			setupHash:     true,
+			expectedError: domain.ErrInvalidPassword,
+		},
+		{
+			name:           "Error – Wrong password for a legacy bcrypt hash",
+			hashedPassword: legacyBcryptHash(t, tokenService, password),
+			password:       "wrong-password",
+			expectedError:  domain.ErrInvalidPassword,
+		},
+		{
+			name:           "Error – Unsupported hash format",
+			hashedPassword: "$1$salt$hash",
+			password:       password,
+			expectedError:  domain.ErrUnsupportedPasswordHash,
+		},
+		{
+			name:           "Error – Malformed Argon2id hash",
+			hashedPassword: "$argon2id$v=19$m=8192,t=1$salt$key",
+			password:       password,
+			expectedError:  domain.ErrUnsupportedPasswordHash,
+		},
 		{
 			name:          "Error – Empty password",
 			password:      "",
@@ -101,3 +132,105 @@ func TestTokenService_ValidatePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenService_PasswordNeedsRehash(t *testing.T) {
+	tokenService := setup(t)
+
+	password := "test-password"
+
+	currentHash, err := tokenService.PasswordHash(password)
+	require.NoError(t, err)
+
+	outdatedService := setup(t)
+	outdatedService.passwordHash.Argon2Iterations = 2
+
+	outdatedHash, err := outdatedService.PasswordHash(password)
+	require.NoError(t, err)
+
+	// Hashes made with other parameters still verify, they are only flagged for a rehash
+	require.NoError(t, tokenService.ValidatePassword(password, outdatedHash))
+
+	tests := []struct {
+		name         string
+		passwordHash string
+		expected     bool
+	}{
+		{
+			name:         "Current parameters",
+			passwordHash: currentHash,
+			expected:     false,
+		},
+		{
+			name:         "Outdated parameters",
+			passwordHash: outdatedHash,
+			expected:     true,
+		},
+		{
+			name:         "Legacy bcrypt hash",
+			passwordHash: legacyBcryptHash(t, tokenService, password),
+			expected:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tokenService.PasswordNeedsRehash(tt.passwordHash))
+		})
+	}
+}
+
+func TestTokenService_PepperRotation(t *testing.T) {
+	password := "test-password"
+
+	unversioned := setup(t)
+
+	unversionedHash, err := unversioned.PasswordHash(password)
+	require.NoError(t, err)
+
+	first := setup(t)
+	first.passwordHash.Peppers = map[string]string{"1": "first-pepper"}
+	first.passwordHash.PepperVersion = "1"
+
+	firstHash, err := first.PasswordHash(password)
+	require.NoError(t, err)
+	require.Contains(t, firstHash, ",keyid=MQ$")
+
+	// The second pepper is added and made current, the first one is kept for existing hashes
+	rotated := setup(t)
+	rotated.passwordHash.Peppers = map[string]string{"1": "first-pepper", "2": "second-pepper"}
+	rotated.passwordHash.PepperVersion = "2"
+
+	rotatedHash, err := rotated.PasswordHash(password)
+	require.NoError(t, err)
+	require.Contains(t, rotatedHash, ",keyid=Mg$")
+
+	for _, hash := range []string{unversionedHash, firstHash, rotatedHash} {
+		require.NoError(t, rotated.ValidatePassword(password, hash))
+		require.ErrorIs(t, rotated.ValidatePassword("wrong-password", hash), domain.ErrInvalidPassword)
+	}
+
+	require.True(t, rotated.PasswordNeedsRehash(unversionedHash))
+	require.True(t, rotated.PasswordNeedsRehash(firstHash))
+	require.False(t, rotated.PasswordNeedsRehash(rotatedHash))
+
+	// Once the first pepper is retired, hashes that weren't upgraded can't be verified
+	retired := setup(t)
+	retired.passwordHash.Peppers = map[string]string{"2": "second-pepper"}
+	retired.passwordHash.PepperVersion = "2"
+
+	require.ErrorIs(t, retired.ValidatePassword(password, firstHash), domain.ErrUnknownPepperVersion)
+	require.NoError(t, retired.ValidatePassword(password, rotatedHash))
+}
+
+// legacyBcryptHash makes a hash the way passwords were hashed before Argon2id
+func legacyBcryptHash(t *testing.T, s *Service, password string) string {
+	t.Helper()
+
+	peppered, err := s.pepper(password, "")
+	require.NoError(t, err)
+
+	hash, err := bcrypt.GenerateFromPassword(peppered, bcrypt.MinCost)
+	require.NoError(t, err)
+
+	return string(hash)
+}
@@ -0,0 +1,108 @@
+package token
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+)
+
+//go:embed common_passwords.txt
+var commonPasswordsList string
+
+// commonPasswords holds lowercased entries of common_passwords.txt,
+// a list of the most frequent passwords found in public breaches
+var commonPasswords = loadCommonPasswords(commonPasswordsList)
+
+func loadCommonPasswords(list string) map[string]struct{} {
+	passwords := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(strings.NewReader(list))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		passwords[strings.ToLower(line)] = struct{}{}
+	}
+
+	return passwords
+}
+
+// CheckPasswordPolicy returns a *domain.PasswordPolicyError with all the rules the password breaks
+func (s *Service) CheckPasswordPolicy(username, password string) error {
+	const op = "service.token.CheckPasswordPolicy"
+
+	var violations []domain.PasswordViolation
+
+	violate := func(rule domain.PasswordRule, format string, args ...any) {
+		violations = append(violations, domain.PasswordViolation{
+			Rule:    rule,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	policy := s.passwordPolicy
+
+	if utf8.RuneCountInString(password) < policy.MinLength {
+		violate(domain.PasswordRuleMinLength, "password must be at least %d characters long", policy.MinLength)
+	}
+
+	if policy.MaxLength > 0 && len(password) > policy.MaxLength {
+		violate(domain.PasswordRuleMaxLength, "password must be at most %d bytes long", policy.MaxLength)
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+
+	if policy.RequireLowercase && !hasLower {
+		violate(domain.PasswordRuleLowercase, "password must contain a lowercase letter")
+	}
+
+	if policy.RequireUppercase && !hasUpper {
+		violate(domain.PasswordRuleUppercase, "password must contain an uppercase letter")
+	}
+
+	if policy.RequireDigit && !hasDigit {
+		violate(domain.PasswordRuleDigit, "password must contain a digit")
+	}
+
+	if policy.RequireSymbol && !hasSymbol {
+		violate(domain.PasswordRuleSymbol, "password must contain a symbol")
+	}
+
+	lowered := strings.ToLower(password)
+
+	if username != "" && strings.Contains(lowered, strings.ToLower(username)) {
+		violate(domain.PasswordRuleContainsUsername, "password must not contain the username")
+	}
+
+	if policy.RejectCommon {
+		if _, ok := commonPasswords[lowered]; ok {
+			violate(domain.PasswordRuleCommon, "password is too common")
+		}
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("%s: %w", op, &domain.PasswordPolicyError{Violations: violations})
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package token
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenService_CheckPasswordPolicy(t *testing.T) {
+	tokenService := setup(t)
+
+	username := "john.doe"
+
+	tests := []struct {
+		name          string
+		password      string
+		expectedRules []domain.PasswordRule
+	}{
+		{
+			name:          "Success",
+			password:      "Correct-Horse-42",
+			expectedRules: nil,
+		},
+		{
+			name:          "Error — Too short",
+			password:      "Ab1",
+			expectedRules: []domain.PasswordRule{domain.PasswordRuleMinLength},
+		},
+		{
+			name:          "Error — Too long",
+			password:      "Ab1" + strings.Repeat("x", 70),
+			expectedRules: []domain.PasswordRule{domain.PasswordRuleMaxLength},
+		},
+		{
+			name:     "Error — Missing character classes",
+			password: "correcthorse",
+			expectedRules: []domain.PasswordRule{
+				domain.PasswordRuleUppercase,
+				domain.PasswordRuleDigit,
+			},
+		},
+		{
+			name:          "Error — Contains username",
+			password:      "My-John.Doe-42",
+			expectedRules: []domain.PasswordRule{domain.PasswordRuleContainsUsername},
+		},
+		{
+			name:          "Error — Common password",
+			password:      "Password123",
+			expectedRules: []domain.PasswordRule{domain.PasswordRuleCommon},
+		},
+		{
+			name:     "Error — Empty password",
+			password: "",
+			expectedRules: []domain.PasswordRule{
+				domain.PasswordRuleMinLength,
+				domain.PasswordRuleLowercase,
+				domain.PasswordRuleUppercase,
+				domain.PasswordRuleDigit,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tokenService.CheckPasswordPolicy(username, tt.password)
+
+			if tt.expectedRules == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, domain.ErrPasswordPolicyViolation)
+
+			var policyErr *domain.PasswordPolicyError
+			require.True(t, errors.As(err, &policyErr))
+
+			rules := make([]domain.PasswordRule, 0, len(policyErr.Violations))
+			for _, v := range policyErr.Violations {
+				rules = append(rules, v.Rule)
+			}
+
+			require.Equal(t, tt.expectedRules, rules)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package token
+
+import (
+	"fmt"
+
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+// GeneratePasswordResetToken returns a one-time password reset token and its record to be stored.
+// Only the hash of the token is kept in the record
+func (s *Service) GeneratePasswordResetToken(userID, createdBy string) (string, entity.PasswordResetToken, error) {
+	const op = "service.token.GeneratePasswordResetToken"
+
+	token, err := newOpaqueToken()
+	if err != nil {
+		return "", entity.PasswordResetToken{}, fmt.Errorf("%s: failed to generate password reset token: %w", op, err)
+	}
+
+	return token, entity.NewPasswordResetToken(userID, createdBy, s.HashPasswordResetToken(token), s.passwordReset.TTL), nil
+}
+
+func (s *Service) HashPasswordResetToken(token string) string {
+	return hashOpaqueToken(token)
+}
@@ -0,0 +1,25 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenService_GeneratePasswordResetToken(t *testing.T) {
+	tokenService := setup(t)
+
+	userID := "test-user-id"
+	adminID := "test-admin-id"
+
+	token, resetToken, err := tokenService.GeneratePasswordResetToken(userID, adminID)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.Equal(t, userID, resetToken.UserID)
+	require.Equal(t, adminID, resetToken.CreatedBy)
+	require.Equal(t, tokenService.HashPasswordResetToken(token), resetToken.TokenHash)
+	require.NotEqual(t, token, resetToken.TokenHash)
+	require.WithinDuration(t, time.Now().Add(time.Hour), resetToken.ExpiresAt, time.Minute)
+}
@@ -0,0 +1,45 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+const opaqueTokenSize = 32
+
+// GenerateRefreshToken returns an opaque refresh token and its record to be stored.
+// Only the hash of the token is kept in the record
+func (s *Service) GenerateRefreshToken(userID, familyID string) (string, entity.RefreshToken, error) {
+	const op = "service.token.GenerateRefreshToken"
+
+	token, err := newOpaqueToken()
+	if err != nil {
+		return "", entity.RefreshToken{}, fmt.Errorf("%s: failed to generate refresh token: %w", op, err)
+	}
+
+	return token, entity.NewRefreshToken(userID, familyID, s.HashRefreshToken(token), s.jwt.RefreshTTL), nil
+}
+
+func (s *Service) HashRefreshToken(token string) string {
+	return hashOpaqueToken(token)
+}
+
+func newOpaqueToken() (string, error) {
+	b := make([]byte, opaqueTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashOpaqueToken doesn't need a salt or a slow hash, since opaque tokens are random and long enough
+func hashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
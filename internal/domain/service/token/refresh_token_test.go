@@ -0,0 +1,28 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenService_GenerateRefreshToken(t *testing.T) {
+	tokenService := setup(t)
+
+	userID := "test-user-id"
+
+	token, refreshToken, err := tokenService.GenerateRefreshToken(userID, "")
+
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.Equal(t, userID, refreshToken.UserID)
+	require.Equal(t, refreshToken.ID, refreshToken.FamilyID)
+	require.Equal(t, tokenService.HashRefreshToken(token), refreshToken.TokenHash)
+	require.NotEqual(t, token, refreshToken.TokenHash)
+
+	rotated, rotatedRefreshToken, err := tokenService.GenerateRefreshToken(userID, refreshToken.FamilyID)
+
+	require.NoError(t, err)
+	require.NotEqual(t, token, rotated)
+	require.Equal(t, refreshToken.FamilyID, rotatedRefreshToken.FamilyID)
+}
@@ -1,32 +1,74 @@
 package token
 
-import "time"
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
 
 type Service struct {
-	jwt          JWT
-	passwordHash PasswordHash
+	jwt            JWT
+	signer         Signer
+	passwordHash   PasswordHash
+	passwordReset  PasswordReset
+	passwordPolicy PasswordPolicy
 }
 
 func NewService(cfg Config) *Service {
 	return &Service{
-		jwt:          cfg.JWT,
-		passwordHash: cfg.PasswordHash,
+		jwt:            cfg.JWT,
+		signer:         cfg.Signer,
+		passwordHash:   cfg.PasswordHash,
+		passwordReset:  cfg.PasswordReset,
+		passwordPolicy: cfg.PasswordPolicy,
 	}
 }
 
 type (
 	Config struct {
-		JWT          JWT
-		PasswordHash PasswordHash
+		JWT            JWT
+		Signer         Signer
+		PasswordHash   PasswordHash
+		PasswordReset  PasswordReset
+		PasswordPolicy PasswordPolicy
 	}
 
 	JWT struct {
-		Secret string
-		TTL    time.Duration
+		TTL        time.Duration
+		RefreshTTL time.Duration
+	}
+
+	// Signer signs access tokens with the current key, see jwk.Set
+	Signer interface {
+		Sign(claims jwt.Claims) (string, error)
 	}
 
 	PasswordHash struct {
-		Pepper     string
-		BcryptCost int
+		// Pepper is used for hashes that don't record a pepper version, made before peppers were versioned
+		Pepper string
+		// Peppers are keyed by version, every hash records the version it was made with
+		Peppers map[string]string
+		// PepperVersion selects the pepper for new hashes. Empty means the unversioned Pepper
+		PepperVersion string
+		// Argon2Memory is in KiB
+		Argon2Memory      uint32
+		Argon2Iterations  uint32
+		Argon2Parallelism uint8
+	}
+
+	PasswordReset struct {
+		TTL time.Duration
+	}
+
+	PasswordPolicy struct {
+		MinLength int
+		// MaxLength is in bytes, it bounds the hashing work spent on a single password
+		MaxLength        int
+		RequireLowercase bool
+		RequireUppercase bool
+		RequireDigit     bool
+		RequireSymbol    bool
+		// RejectCommon checks passwords against the bundled list of common breached passwords
+		RejectCommon bool
 	}
 )
@@ -1,16 +1,42 @@
 package token
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/lib/jwk"
+	"github.com/stretchr/testify/require"
+)
 
 func setup(t *testing.T) *Service {
+	keys, err := jwk.New(jwk.Config{
+		Algorithm: jwk.AlgorithmHS256,
+		Secret:    "secret",
+	})
+	require.NoError(t, err)
+
 	return NewService(Config{
 		JWT: JWT{
-			Secret: "secret",
-			TTL:    3600,
+			TTL:        3600,
+			RefreshTTL: time.Hour,
 		},
+		Signer: keys,
 		PasswordHash: PasswordHash{
-			Pepper:     "red-hot-chili-peppers",
-			BcryptCost: 10,
+			Pepper:            "red-hot-chili-peppers",
+			Argon2Memory:      8 * 1024,
+			Argon2Iterations:  1,
+			Argon2Parallelism: 1,
+		},
+		PasswordReset: PasswordReset{
+			TTL: time.Hour,
+		},
+		PasswordPolicy: PasswordPolicy{
+			MinLength:        8,
+			MaxLength:        72,
+			RequireLowercase: true,
+			RequireUppercase: true,
+			RequireDigit:     true,
+			RejectCommon:     true,
 		},
 	})
 }
@@ -0,0 +1,75 @@
+package twofactor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+const challengeTokenSize = 32
+
+// CreateChallenge starts a login waiting for the second factor and returns the token to complete it with.
+// Only the hash of the token is stored
+func (s *Service) CreateChallenge(ctx context.Context, userID string) (string, entity.MFAChallenge, error) {
+	const op = "service.twofactor.CreateChallenge"
+
+	b := make([]byte, challengeTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", entity.MFAChallenge{}, fmt.Errorf("%s: failed to generate challenge token: %w", op, err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(b)
+	challenge := entity.NewMFAChallenge(userID, hashChallengeToken(token), s.cfg.ChallengeTTL)
+
+	if err := s.challenges.CreateMFAChallenge(ctx, challenge); err != nil {
+		return "", entity.MFAChallenge{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return token, challenge, nil
+}
+
+// GetChallenge returns the login for the token. It stays valid until it expires or is consumed,
+// so a mistyped code can be retried
+func (s *Service) GetChallenge(ctx context.Context, token string) (entity.MFAChallenge, error) {
+	const op = "service.twofactor.GetChallenge"
+
+	challenge, err := s.challenges.GetMFAChallenge(ctx, hashChallengeToken(token), time.Now())
+	if err != nil {
+		if errors.Is(err, storage.ErrMFAChallengeNotFound) {
+			return entity.MFAChallenge{}, domain.ErrInvalidMFAToken
+		}
+		return entity.MFAChallenge{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return challenge, nil
+}
+
+// ConsumeChallenge ends the login, the token can't be used again
+func (s *Service) ConsumeChallenge(ctx context.Context, token string) error {
+	const op = "service.twofactor.ConsumeChallenge"
+
+	deleted, err := s.challenges.DeleteMFAChallenge(ctx, hashChallengeToken(token))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !deleted {
+		return domain.ErrInvalidMFAToken
+	}
+
+	return nil
+}
+
+func hashChallengeToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,85 @@
+package twofactor
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/totp"
+)
+
+const (
+	// recoveryCodeSize gives 80 bits of entropy, 16 characters in groups of 4
+	recoveryCodeSize      = 10
+	recoveryCodeGroupSize = 4
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateRecoveryCodes returns the codes to show to the user and their records to be stored
+func (s *Service) generateRecoveryCodes(userID string) ([]string, []entity.RecoveryCode, error) {
+	codes := make([]string, 0, s.cfg.RecoveryCodes)
+	recoveryCodes := make([]entity.RecoveryCode, 0, s.cfg.RecoveryCodes)
+
+	for range s.cfg.RecoveryCodes {
+		b := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+
+		code := formatRecoveryCode(strings.ToLower(recoveryCodeEncoding.EncodeToString(b)))
+
+		codes = append(codes, code)
+		recoveryCodes = append(recoveryCodes, entity.NewRecoveryCode(userID, hashRecoveryCode(code)))
+	}
+
+	return codes, recoveryCodes, nil
+}
+
+func formatRecoveryCode(code string) string {
+	var sb strings.Builder
+
+	for i, r := range code {
+		if i > 0 && i%recoveryCodeGroupSize == 0 {
+			sb.WriteByte('-')
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// hashRecoveryCode ignores case, dashes and spaces, so the code can be typed the way it's read.
+// Recovery codes are random and long enough not to need a salt or a slow hash
+func hashRecoveryCode(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(code))
+
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// isTOTPCode tells codes of the authenticator from recovery codes, which are longer and not only digits
+func isTOTPCode(code string) bool {
+	code = strings.TrimSpace(code)
+	if len(code) != totp.Digits {
+		return false
+	}
+
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
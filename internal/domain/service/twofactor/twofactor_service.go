@@ -0,0 +1,255 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/lib/totp"
+)
+
+// Service manages TOTP authenticators (RFC 6238), recovery codes and logins waiting for the second factor.
+// TOTP secrets are encrypted with the user ID as associated data, so a secret copied to another
+// user's row doesn't decrypt
+type Service struct {
+	storage    Storage
+	challenges ChallengeStorage
+	cipher     Cipher
+	cfg        Config
+}
+
+type (
+	Storage interface {
+		GetUserTOTP(ctx context.Context, userID string) (entity.TOTPAuthenticator, error)
+		UpsertUserTOTP(ctx context.Context, authenticator entity.TOTPAuthenticator) (bool, error)
+		ConfirmUserTOTP(
+			ctx context.Context,
+			userID string,
+			step int64,
+			recoveryCodes []entity.RecoveryCode,
+			confirmedAt time.Time,
+		) (bool, error)
+		UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error)
+		UseRecoveryCode(ctx context.Context, userID, codeHash string, usedAt time.Time) (bool, error)
+		DeleteUserTOTP(ctx context.Context, userID string) error
+	}
+
+	ChallengeStorage interface {
+		CreateMFAChallenge(ctx context.Context, challenge entity.MFAChallenge) error
+		GetMFAChallenge(ctx context.Context, tokenHash string, now time.Time) (entity.MFAChallenge, error)
+		DeleteMFAChallenge(ctx context.Context, tokenHash string) (bool, error)
+	}
+
+	// Cipher encrypts TOTP secrets at rest, see encryption.Keyring
+	Cipher interface {
+		Encrypt(plaintext, associatedData []byte) (string, error)
+		Decrypt(value string, associatedData []byte) ([]byte, error)
+	}
+
+	Config struct {
+		// Issuer is shown next to the account in authenticator apps
+		Issuer string
+		// Skew is the number of time steps accepted before and after the current one
+		Skew int
+		// ChallengeTTL is how long the user has to enter the code after the password
+		ChallengeTTL time.Duration
+		// RecoveryCodes is the number of recovery codes issued when an authenticator is confirmed
+		RecoveryCodes int
+	}
+)
+
+// New takes a nil cipher if no encryption keys are configured, enrollment is disabled then
+func New(storage Storage, challenges ChallengeStorage, cipher Cipher, cfg Config) *Service {
+	return &Service{
+		storage:    storage,
+		challenges: challenges,
+		cipher:     cipher,
+		cfg:        cfg,
+	}
+}
+
+// Enroll generates a new secret for the user. It takes effect once it's confirmed with a code,
+// until then enrolling again replaces it
+func (s *Service) Enroll(ctx context.Context, userID, accountName string) (entity.TOTPEnrollment, error) {
+	const op = "service.twofactor.Enroll"
+
+	if s.cipher == nil {
+		return entity.TOTPEnrollment{}, domain.ErrTwoFactorNotConfigured
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return entity.TOTPEnrollment{}, fmt.Errorf("%s: failed to generate totp secret: %w", op, err)
+	}
+
+	secretEncrypted, err := s.cipher.Encrypt([]byte(secret), []byte(userID))
+	if err != nil {
+		return entity.TOTPEnrollment{}, fmt.Errorf("%s: failed to encrypt totp secret: %w", op, err)
+	}
+
+	saved, err := s.storage.UpsertUserTOTP(ctx, entity.NewTOTPAuthenticator(userID, secretEncrypted))
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return entity.TOTPEnrollment{}, domain.ErrUserNotFound
+		}
+		return entity.TOTPEnrollment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !saved {
+		return entity.TOTPEnrollment{}, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	return entity.TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(s.cfg.Issuer, accountName, secret),
+	}, nil
+}
+
+// Confirm enables the enrolled authenticator if the code is right and returns new recovery codes.
+// It must be called within a transaction
+func (s *Service) Confirm(ctx context.Context, userID, code string) ([]string, error) {
+	const op = "service.twofactor.Confirm"
+
+	authenticator, err := s.storage.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrTOTPNotFound) {
+			return nil, domain.ErrTwoFactorNotEnrolled
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if authenticator.IsConfirmed() {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	step, err := s.validateCode(authenticator, code)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, recoveryCodes, err := s.generateRecoveryCodes(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	confirmed, err := s.storage.ConfirmUserTOTP(ctx, userID, step, recoveryCodes, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Another request has confirmed it in the meantime
+	if !confirmed {
+		return nil, domain.ErrInvalidTOTPCode
+	}
+
+	return codes, nil
+}
+
+// IsEnabled reports whether the user has a confirmed authenticator
+func (s *Service) IsEnabled(ctx context.Context, userID string) (bool, error) {
+	const op = "service.twofactor.IsEnabled"
+
+	authenticator, err := s.storage.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrTOTPNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return authenticator.IsConfirmed(), nil
+}
+
+// VerifyCode checks a code of the confirmed authenticator. Every code is accepted once
+func (s *Service) VerifyCode(ctx context.Context, userID, code string) error {
+	const op = "service.twofactor.VerifyCode"
+
+	authenticator, err := s.storage.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrTOTPNotFound) {
+			return domain.ErrTwoFactorNotEnabled
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !authenticator.IsConfirmed() {
+		return domain.ErrTwoFactorNotEnabled
+	}
+
+	step, err := s.validateCode(authenticator, code)
+	if err != nil {
+		return err
+	}
+
+	used, err := s.storage.UseTOTPStep(ctx, userID, step)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !used {
+		return domain.ErrInvalidTOTPCode
+	}
+
+	return nil
+}
+
+// VerifySecondFactor accepts either a code of the authenticator or one of the recovery codes
+func (s *Service) VerifySecondFactor(ctx context.Context, userID, code string) error {
+	const op = "service.twofactor.VerifySecondFactor"
+
+	if isTOTPCode(code) {
+		return s.VerifyCode(ctx, userID, code)
+	}
+
+	used, err := s.storage.UseRecoveryCode(ctx, userID, hashRecoveryCode(code), time.Now())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !used {
+		return domain.ErrInvalidTOTPCode
+	}
+
+	return nil
+}
+
+// Disable removes the authenticator and the recovery codes. It must be called within a transaction
+func (s *Service) Disable(ctx context.Context, userID string) error {
+	const op = "service.twofactor.Disable"
+
+	if err := s.storage.DeleteUserTOTP(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// validateCode returns the step of the code. Codes of steps that were already used are rejected
+// here as well, the storage checks it again to catch concurrent requests
+func (s *Service) validateCode(authenticator entity.TOTPAuthenticator, code string) (int64, error) {
+	const op = "service.twofactor.validateCode"
+
+	if s.cipher == nil {
+		return 0, fmt.Errorf("%s: %w", op, domain.ErrTwoFactorNotConfigured)
+	}
+
+	secret, err := s.cipher.Decrypt(authenticator.SecretEncrypted, []byte(authenticator.UserID))
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to decrypt totp secret: %w", op, err)
+	}
+
+	step, ok, err := totp.Validate(string(secret), code, time.Now(), s.cfg.Skew)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !ok || step <= authenticator.LastUsedStep {
+		return 0, domain.ErrInvalidTOTPCode
+	}
+
+	return step, nil
+}
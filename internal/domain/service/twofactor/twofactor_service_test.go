@@ -0,0 +1,405 @@
+package twofactor
+
+import (
+	"context"
+	"crypto/rand"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/service/twofactor/mocks"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/lib/encryption"
+	"github.com/rshelekhov/merch-store/internal/lib/totp"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = Config{
+	Issuer:        "Merch Store",
+	Skew:          1,
+	ChallengeTTL:  5 * time.Minute,
+	RecoveryCodes: 10,
+}
+
+const testUserID = "test-user-id"
+
+func newTestKeyring(t *testing.T) *encryption.Keyring {
+	t.Helper()
+
+	key := make([]byte, encryption.KeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	keyring, err := encryption.NewKeyring(map[string][]byte{"v1": key}, "v1")
+	require.NoError(t, err)
+
+	return keyring
+}
+
+// newTestAuthenticator returns the secret and its authenticator record, encrypted the way Enroll does it
+func newTestAuthenticator(t *testing.T, keyring *encryption.Keyring, confirmed bool) (string, entity.TOTPAuthenticator) {
+	t.Helper()
+
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	secretEncrypted, err := keyring.Encrypt([]byte(secret), []byte(testUserID))
+	require.NoError(t, err)
+
+	authenticator := entity.NewTOTPAuthenticator(testUserID, secretEncrypted)
+	if confirmed {
+		authenticator.ConfirmedAt = time.Now()
+	}
+
+	return secret, authenticator
+}
+
+func currentCode(t *testing.T, secret string) (string, int64) {
+	t.Helper()
+
+	step := totp.Step(time.Now())
+
+	code, err := totp.Code(secret, step)
+	require.NoError(t, err)
+
+	return code, step
+}
+
+func TestTwoFactorService_Enroll(t *testing.T) {
+	ctx := context.Background()
+	keyring := newTestKeyring(t)
+
+	t.Run("Success", func(t *testing.T) {
+		twoFactorStorage := mocks.NewStorage(t)
+
+		var saved entity.TOTPAuthenticator
+		twoFactorStorage.EXPECT().UpsertUserTOTP(ctx, mock.AnythingOfType("entity.TOTPAuthenticator")).
+			Run(func(_ context.Context, authenticator entity.TOTPAuthenticator) {
+				saved = authenticator
+			}).
+			Once().
+			Return(true, nil)
+
+		svc := New(twoFactorStorage, mocks.NewChallengeStorage(t), keyring, testConfig)
+
+		enrollment, err := svc.Enroll(ctx, testUserID, "john.doe")
+		require.NoError(t, err)
+		require.NotEmpty(t, enrollment.Secret)
+		require.False(t, saved.IsConfirmed())
+
+		// The secret is stored encrypted and bound to the user
+		require.NotContains(t, saved.SecretEncrypted, enrollment.Secret)
+		secret, err := keyring.Decrypt(saved.SecretEncrypted, []byte(testUserID))
+		require.NoError(t, err)
+		require.Equal(t, enrollment.Secret, string(secret))
+		_, err = keyring.Decrypt(saved.SecretEncrypted, []byte("another-user-id"))
+		require.ErrorIs(t, err, encryption.ErrDecryptionFailed)
+
+		uri, err := url.Parse(enrollment.ProvisioningURI)
+		require.NoError(t, err)
+		require.Equal(t, "otpauth", uri.Scheme)
+		require.Equal(t, enrollment.Secret, uri.Query().Get("secret"))
+		require.Equal(t, testConfig.Issuer, uri.Query().Get("issuer"))
+	})
+
+	t.Run("Error – Already enabled", func(t *testing.T) {
+		twoFactorStorage := mocks.NewStorage(t)
+
+		twoFactorStorage.EXPECT().UpsertUserTOTP(ctx, mock.AnythingOfType("entity.TOTPAuthenticator")).
+			Once().
+			Return(false, nil)
+
+		svc := New(twoFactorStorage, mocks.NewChallengeStorage(t), keyring, testConfig)
+
+		_, err := svc.Enroll(ctx, testUserID, "john.doe")
+		require.ErrorIs(t, err, domain.ErrTwoFactorAlreadyEnabled)
+	})
+
+	t.Run("Error – No encryption keys", func(t *testing.T) {
+		svc := New(mocks.NewStorage(t), mocks.NewChallengeStorage(t), nil, testConfig)
+
+		_, err := svc.Enroll(ctx, testUserID, "john.doe")
+		require.ErrorIs(t, err, domain.ErrTwoFactorNotConfigured)
+	})
+}
+
+func TestTwoFactorService_Confirm(t *testing.T) {
+	ctx := context.Background()
+	keyring := newTestKeyring(t)
+
+	secret, authenticator := newTestAuthenticator(t, keyring, false)
+	code, step := currentCode(t, secret)
+
+	_, confirmedAuthenticator := newTestAuthenticator(t, keyring, true)
+
+	tests := []struct {
+		name          string
+		code          string
+		mockBehavior  func(twoFactorStorage *mocks.Storage)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			code: code,
+			mockBehavior: func(twoFactorStorage *mocks.Storage) {
+				twoFactorStorage.EXPECT().GetUserTOTP(ctx, testUserID).
+					Once().
+					Return(authenticator, nil)
+
+				twoFactorStorage.EXPECT().ConfirmUserTOTP(
+					ctx,
+					testUserID,
+					step,
+					mock.AnythingOfType("[]entity.RecoveryCode"),
+					mock.AnythingOfType("time.Time"),
+				).
+					Once().
+					Return(true, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Invalid code",
+			code: "000000",
+			mockBehavior: func(twoFactorStorage *mocks.Storage) {
+				wrongSecret, err := totp.GenerateSecret()
+				require.NoError(t, err)
+
+				wrongSecretEncrypted, err := keyring.Encrypt([]byte(wrongSecret), []byte(testUserID))
+				require.NoError(t, err)
+
+				twoFactorStorage.EXPECT().GetUserTOTP(ctx, testUserID).
+					Once().
+					Return(entity.NewTOTPAuthenticator(testUserID, wrongSecretEncrypted), nil)
+			},
+			expectedError: domain.ErrInvalidTOTPCode,
+		},
+		{
+			name: "Error – Not enrolled",
+			code: code,
+			mockBehavior: func(twoFactorStorage *mocks.Storage) {
+				twoFactorStorage.EXPECT().GetUserTOTP(ctx, testUserID).
+					Once().
+					Return(entity.TOTPAuthenticator{}, storage.ErrTOTPNotFound)
+			},
+			expectedError: domain.ErrTwoFactorNotEnrolled,
+		},
+		{
+			name: "Error – Already enabled",
+			code: code,
+			mockBehavior: func(twoFactorStorage *mocks.Storage) {
+				twoFactorStorage.EXPECT().GetUserTOTP(ctx, testUserID).
+					Once().
+					Return(confirmedAuthenticator, nil)
+			},
+			expectedError: domain.ErrTwoFactorAlreadyEnabled,
+		},
+		{
+			name: "Error – Confirmed by another request",
+			code: code,
+			mockBehavior: func(twoFactorStorage *mocks.Storage) {
+				twoFactorStorage.EXPECT().GetUserTOTP(ctx, testUserID).
+					Once().
+					Return(authenticator, nil)
+
+				twoFactorStorage.EXPECT().ConfirmUserTOTP(
+					ctx,
+					testUserID,
+					step,
+					mock.AnythingOfType("[]entity.RecoveryCode"),
+					mock.AnythingOfType("time.Time"),
+				).
+					Once().
+					Return(false, nil)
+			},
+			expectedError: domain.ErrInvalidTOTPCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twoFactorStorage := mocks.NewStorage(t)
+
+			tt.mockBehavior(twoFactorStorage)
+
+			svc := New(twoFactorStorage, mocks.NewChallengeStorage(t), keyring, testConfig)
+
+			recoveryCodes, err := svc.Confirm(ctx, testUserID, tt.code)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, recoveryCodes)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, recoveryCodes, testConfig.RecoveryCodes)
+				for _, recoveryCode := range recoveryCodes {
+					require.Regexp(t, regexp.MustCompile(`^[a-z2-7]{4}(-[a-z2-7]{4}){3}$`), recoveryCode)
+				}
+			}
+		})
+	}
+}
+
+func TestTwoFactorService_VerifySecondFactor(t *testing.T) {
+	ctx := context.Background()
+	keyring := newTestKeyring(t)
+
+	secret, authenticator := newTestAuthenticator(t, keyring, true)
+	code, step := currentCode(t, secret)
+
+	usedAuthenticator := authenticator
+	usedAuthenticator.LastUsedStep = step
+
+	_, unconfirmedAuthenticator := newTestAuthenticator(t, keyring, false)
+
+	recoveryCode := "abcd-efgh-ijkl-mnop"
+
+	tests := []struct {
+		name          string
+		code          string
+		mockBehavior  func(twoFactorStorage *mocks.Storage)
+		expectedError error
+	}{
+		{
+			name: "Success – TOTP code",
+			code: code,
+			mockBehavior: func(twoFactorStorage *mocks.Storage) {
+				twoFactorStorage.EXPECT().GetUserTOTP(ctx, testUserID).
+					Once().
+					Return(authenticator, nil)
+
+				twoFactorStorage.EXPECT().UseTOTPStep(ctx, testUserID, step).
+					Once().
+					Return(true, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success – Recovery code typed differently",
+			code: "ABCD EFGH IJKL MNOP",
+			mockBehavior: func(twoFactorStorage *mocks.Storage) {
+				twoFactorStorage.EXPECT().UseRecoveryCode(ctx, testUserID, hashRecoveryCode(recoveryCode), mock.AnythingOfType("time.Time")).
+					Once().
+					Return(true, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Code of a used step",
+			code: code,
+			mockBehavior: func(twoFactorStorage *mocks.Storage) {
+				twoFactorStorage.EXPECT().GetUserTOTP(ctx, testUserID).
+					Once().
+					Return(usedAuthenticator, nil)
+			},
+			expectedError: domain.ErrInvalidTOTPCode,
+		},
+		{
+			name: "Error – Code used by a concurrent request",
+			code: code,
+			mockBehavior: func(twoFactorStorage *mocks.Storage) {
+				twoFactorStorage.EXPECT().GetUserTOTP(ctx, testUserID).
+					Once().
+					Return(authenticator, nil)
+
+				twoFactorStorage.EXPECT().UseTOTPStep(ctx, testUserID, step).
+					Once().
+					Return(false, nil)
+			},
+			expectedError: domain.ErrInvalidTOTPCode,
+		},
+		{
+			name: "Error – Authenticator not confirmed",
+			code: code,
+			mockBehavior: func(twoFactorStorage *mocks.Storage) {
+				twoFactorStorage.EXPECT().GetUserTOTP(ctx, testUserID).
+					Once().
+					Return(unconfirmedAuthenticator, nil)
+			},
+			expectedError: domain.ErrTwoFactorNotEnabled,
+		},
+		{
+			name: "Error – Unknown or used recovery code",
+			code: recoveryCode,
+			mockBehavior: func(twoFactorStorage *mocks.Storage) {
+				twoFactorStorage.EXPECT().UseRecoveryCode(ctx, testUserID, hashRecoveryCode(recoveryCode), mock.AnythingOfType("time.Time")).
+					Once().
+					Return(false, nil)
+			},
+			expectedError: domain.ErrInvalidTOTPCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twoFactorStorage := mocks.NewStorage(t)
+
+			tt.mockBehavior(twoFactorStorage)
+
+			svc := New(twoFactorStorage, mocks.NewChallengeStorage(t), keyring, testConfig)
+
+			err := svc.VerifySecondFactor(ctx, testUserID, tt.code)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTwoFactorService_Challenge(t *testing.T) {
+	ctx := context.Background()
+
+	challengeStorage := mocks.NewChallengeStorage(t)
+
+	var saved entity.MFAChallenge
+	challengeStorage.EXPECT().CreateMFAChallenge(ctx, mock.AnythingOfType("entity.MFAChallenge")).
+		Run(func(_ context.Context, challenge entity.MFAChallenge) {
+			saved = challenge
+		}).
+		Once().
+		Return(nil)
+
+	svc := New(mocks.NewStorage(t), challengeStorage, nil, testConfig)
+
+	token, challenge, err := svc.CreateChallenge(ctx, testUserID)
+	require.NoError(t, err)
+	require.Equal(t, saved, challenge)
+	require.Equal(t, testUserID, challenge.UserID)
+	require.NotEqual(t, token, challenge.TokenHash)
+	require.WithinDuration(t, time.Now().Add(testConfig.ChallengeTTL), challenge.ExpiresAt, time.Minute)
+
+	challengeStorage.EXPECT().GetMFAChallenge(ctx, challenge.TokenHash, mock.AnythingOfType("time.Time")).
+		Once().
+		Return(challenge, nil)
+
+	found, err := svc.GetChallenge(ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, testUserID, found.UserID)
+
+	challengeStorage.EXPECT().DeleteMFAChallenge(ctx, challenge.TokenHash).
+		Once().
+		Return(true, nil)
+
+	require.NoError(t, svc.ConsumeChallenge(ctx, token))
+
+	// A consumed or expired challenge can't be used again
+	challengeStorage.EXPECT().GetMFAChallenge(ctx, challenge.TokenHash, mock.AnythingOfType("time.Time")).
+		Once().
+		Return(entity.MFAChallenge{}, storage.ErrMFAChallengeNotFound)
+
+	_, err = svc.GetChallenge(ctx, token)
+	require.ErrorIs(t, err, domain.ErrInvalidMFAToken)
+
+	challengeStorage.EXPECT().DeleteMFAChallenge(ctx, challenge.TokenHash).
+		Once().
+		Return(false, nil)
+
+	require.ErrorIs(t, svc.ConsumeChallenge(ctx, token), domain.ErrInvalidMFAToken)
+}
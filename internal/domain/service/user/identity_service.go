@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+func (s *Service) GetUserIdentity(ctx context.Context, issuer, subject string) (entity.UserIdentity, error) {
+	const op = "service.user.GetUserIdentity"
+
+	identity, err := s.storage.GetUserIdentity(ctx, issuer, subject)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserIdentityNotFound) {
+			return entity.UserIdentity{}, domain.ErrUserIdentityNotFound
+		}
+		return entity.UserIdentity{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return identity, nil
+}
+
+func (s *Service) CreateUserIdentity(ctx context.Context, identity entity.UserIdentity) error {
+	const op = "service.user.CreateUserIdentity"
+
+	if err := s.storage.CreateUserIdentity(ctx, identity); err != nil {
+		if errors.Is(err, storage.ErrUserIdentityExists) {
+			return domain.ErrUserIdentityAlreadyExists
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
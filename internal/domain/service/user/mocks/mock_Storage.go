@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/rshelekhov/merch-store/internal/domain/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Storage is an autogenerated mock type for the Storage type
@@ -22,6 +24,53 @@ func (_m *Storage) EXPECT() *Storage_Expecter {
 	return &Storage_Expecter{mock: &_m.Mock}
 }
 
+// CreatePasswordResetToken provides a mock function with given fields: ctx, token
+func (_m *Storage) CreatePasswordResetToken(ctx context.Context, token entity.PasswordResetToken) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePasswordResetToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.PasswordResetToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_CreatePasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePasswordResetToken'
+type Storage_CreatePasswordResetToken_Call struct {
+	*mock.Call
+}
+
+// CreatePasswordResetToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token entity.PasswordResetToken
+func (_e *Storage_Expecter) CreatePasswordResetToken(ctx interface{}, token interface{}) *Storage_CreatePasswordResetToken_Call {
+	return &Storage_CreatePasswordResetToken_Call{Call: _e.mock.On("CreatePasswordResetToken", ctx, token)}
+}
+
+func (_c *Storage_CreatePasswordResetToken_Call) Run(run func(ctx context.Context, token entity.PasswordResetToken)) *Storage_CreatePasswordResetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.PasswordResetToken))
+	})
+	return _c
+}
+
+func (_c *Storage_CreatePasswordResetToken_Call) Return(_a0 error) *Storage_CreatePasswordResetToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_CreatePasswordResetToken_Call) RunAndReturn(run func(context.Context, entity.PasswordResetToken) error) *Storage_CreatePasswordResetToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateUser provides a mock function with given fields: ctx, _a1
 func (_m *Storage) CreateUser(ctx context.Context, _a1 entity.User) error {
 	ret := _m.Called(ctx, _a1)
@@ -69,6 +118,169 @@ func (_c *Storage_CreateUser_Call) RunAndReturn(run func(context.Context, entity
 	return _c
 }
 
+// CreateUserIdentity provides a mock function with given fields: ctx, identity
+func (_m *Storage) CreateUserIdentity(ctx context.Context, identity entity.UserIdentity) error {
+	ret := _m.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.UserIdentity) error); ok {
+		r0 = rf(ctx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_CreateUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserIdentity'
+type Storage_CreateUserIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity entity.UserIdentity
+func (_e *Storage_Expecter) CreateUserIdentity(ctx interface{}, identity interface{}) *Storage_CreateUserIdentity_Call {
+	return &Storage_CreateUserIdentity_Call{Call: _e.mock.On("CreateUserIdentity", ctx, identity)}
+}
+
+func (_c *Storage_CreateUserIdentity_Call) Run(run func(ctx context.Context, identity entity.UserIdentity)) *Storage_CreateUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.UserIdentity))
+	})
+	return _c
+}
+
+func (_c *Storage_CreateUserIdentity_Call) Return(_a0 error) *Storage_CreateUserIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_CreateUserIdentity_Call) RunAndReturn(run func(context.Context, entity.UserIdentity) error) *Storage_CreateUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivateUser provides a mock function with given fields: ctx, userID, keepBalance, deactivatedAt
+func (_m *Storage) DeactivateUser(ctx context.Context, userID string, keepBalance bool, deactivatedAt time.Time) (int, error) {
+	ret := _m.Called(ctx, userID, keepBalance, deactivatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateUser")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, time.Time) (int, error)); ok {
+		return rf(ctx, userID, keepBalance, deactivatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, time.Time) int); ok {
+		r0 = rf(ctx, userID, keepBalance, deactivatedAt)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, time.Time) error); ok {
+		r1 = rf(ctx, userID, keepBalance, deactivatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_DeactivateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateUser'
+type Storage_DeactivateUser_Call struct {
+	*mock.Call
+}
+
+// DeactivateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - keepBalance bool
+//   - deactivatedAt time.Time
+func (_e *Storage_Expecter) DeactivateUser(ctx interface{}, userID interface{}, keepBalance interface{}, deactivatedAt interface{}) *Storage_DeactivateUser_Call {
+	return &Storage_DeactivateUser_Call{Call: _e.mock.On("DeactivateUser", ctx, userID, keepBalance, deactivatedAt)}
+}
+
+func (_c *Storage_DeactivateUser_Call) Run(run func(ctx context.Context, userID string, keepBalance bool, deactivatedAt time.Time)) *Storage_DeactivateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_DeactivateUser_Call) Return(_a0 int, _a1 error) *Storage_DeactivateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_DeactivateUser_Call) RunAndReturn(run func(context.Context, string, bool, time.Time) (int, error)) *Storage_DeactivateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function with given fields: ctx, userID
+func (_m *Storage) GetUserByID(ctx context.Context, userID string) (entity.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.User, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(entity.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type Storage_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *Storage_Expecter) GetUserByID(ctx interface{}, userID interface{}) *Storage_GetUserByID_Call {
+	return &Storage_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, userID)}
+}
+
+func (_c *Storage_GetUserByID_Call) Run(run func(ctx context.Context, userID string)) *Storage_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_GetUserByID_Call) Return(_a0 entity.User, _a1 error) *Storage_GetUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_GetUserByID_Call) RunAndReturn(run func(context.Context, string) (entity.User, error)) *Storage_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByName provides a mock function with given fields: ctx, username
 func (_m *Storage) GetUserByName(ctx context.Context, username string) (entity.User, error) {
 	ret := _m.Called(ctx, username)
@@ -126,6 +338,64 @@ func (_c *Storage_GetUserByName_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// GetUserIdentity provides a mock function with given fields: ctx, issuer, subject
+func (_m *Storage) GetUserIdentity(ctx context.Context, issuer string, subject string) (entity.UserIdentity, error) {
+	ret := _m.Called(ctx, issuer, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserIdentity")
+	}
+
+	var r0 entity.UserIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (entity.UserIdentity, error)); ok {
+		return rf(ctx, issuer, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) entity.UserIdentity); ok {
+		r0 = rf(ctx, issuer, subject)
+	} else {
+		r0 = ret.Get(0).(entity.UserIdentity)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, issuer, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_GetUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentity'
+type Storage_GetUserIdentity_Call struct {
+	*mock.Call
+}
+
+// GetUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issuer string
+//   - subject string
+func (_e *Storage_Expecter) GetUserIdentity(ctx interface{}, issuer interface{}, subject interface{}) *Storage_GetUserIdentity_Call {
+	return &Storage_GetUserIdentity_Call{Call: _e.mock.On("GetUserIdentity", ctx, issuer, subject)}
+}
+
+func (_c *Storage_GetUserIdentity_Call) Run(run func(ctx context.Context, issuer string, subject string)) *Storage_GetUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_GetUserIdentity_Call) Return(_a0 entity.UserIdentity, _a1 error) *Storage_GetUserIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_GetUserIdentity_Call) RunAndReturn(run func(context.Context, string, string) (entity.UserIdentity, error)) *Storage_GetUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserInfoByID provides a mock function with given fields: ctx, userID
 func (_m *Storage) GetUserInfoByID(ctx context.Context, userID string) (entity.UserInfo, error) {
 	ret := _m.Called(ctx, userID)
@@ -240,6 +510,499 @@ func (_c *Storage_GetUserInfoByUsername_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetUserProfile provides a mock function with given fields: ctx, userID
+func (_m *Storage) GetUserProfile(ctx context.Context, userID string) (entity.UserProfile, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserProfile")
+	}
+
+	var r0 entity.UserProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.UserProfile, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.UserProfile); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(entity.UserProfile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_GetUserProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserProfile'
+type Storage_GetUserProfile_Call struct {
+	*mock.Call
+}
+
+// GetUserProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *Storage_Expecter) GetUserProfile(ctx interface{}, userID interface{}) *Storage_GetUserProfile_Call {
+	return &Storage_GetUserProfile_Call{Call: _e.mock.On("GetUserProfile", ctx, userID)}
+}
+
+func (_c *Storage_GetUserProfile_Call) Run(run func(ctx context.Context, userID string)) *Storage_GetUserProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_GetUserProfile_Call) Return(_a0 entity.UserProfile, _a1 error) *Storage_GetUserProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_GetUserProfile_Call) RunAndReturn(run func(context.Context, string) (entity.UserProfile, error)) *Storage_GetUserProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRole provides a mock function with given fields: ctx, userID
+func (_m *Storage) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRole")
+	}
+
+	var r0 entity.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Role, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Role); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(entity.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_GetUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRole'
+type Storage_GetUserRole_Call struct {
+	*mock.Call
+}
+
+// GetUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *Storage_Expecter) GetUserRole(ctx interface{}, userID interface{}) *Storage_GetUserRole_Call {
+	return &Storage_GetUserRole_Call{Call: _e.mock.On("GetUserRole", ctx, userID)}
+}
+
+func (_c *Storage_GetUserRole_Call) Run(run func(ctx context.Context, userID string)) *Storage_GetUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Storage_GetUserRole_Call) Return(_a0 entity.Role, _a1 error) *Storage_GetUserRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_GetUserRole_Call) RunAndReturn(run func(context.Context, string) (entity.Role, error)) *Storage_GetUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplacePasswordHash provides a mock function with given fields: ctx, userID, oldHash, newHash, updatedAt
+func (_m *Storage) ReplacePasswordHash(ctx context.Context, userID string, oldHash string, newHash string, updatedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, userID, oldHash, newHash, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplacePasswordHash")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time) (bool, error)); ok {
+		return rf(ctx, userID, oldHash, newHash, updatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time) bool); ok {
+		r0 = rf(ctx, userID, oldHash, newHash, updatedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, time.Time) error); ok {
+		r1 = rf(ctx, userID, oldHash, newHash, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_ReplacePasswordHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplacePasswordHash'
+type Storage_ReplacePasswordHash_Call struct {
+	*mock.Call
+}
+
+// ReplacePasswordHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - oldHash string
+//   - newHash string
+//   - updatedAt time.Time
+func (_e *Storage_Expecter) ReplacePasswordHash(ctx interface{}, userID interface{}, oldHash interface{}, newHash interface{}, updatedAt interface{}) *Storage_ReplacePasswordHash_Call {
+	return &Storage_ReplacePasswordHash_Call{Call: _e.mock.On("ReplacePasswordHash", ctx, userID, oldHash, newHash, updatedAt)}
+}
+
+func (_c *Storage_ReplacePasswordHash_Call) Run(run func(ctx context.Context, userID string, oldHash string, newHash string, updatedAt time.Time)) *Storage_ReplacePasswordHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_ReplacePasswordHash_Call) Return(_a0 bool, _a1 error) *Storage_ReplacePasswordHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_ReplacePasswordHash_Call) RunAndReturn(run func(context.Context, string, string, string, time.Time) (bool, error)) *Storage_ReplacePasswordHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUsers provides a mock function with given fields: ctx, filter
+func (_m *Storage) SearchUsers(ctx context.Context, filter entity.UserFilter) (entity.UserPage, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUsers")
+	}
+
+	var r0 entity.UserPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.UserFilter) (entity.UserPage, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.UserFilter) entity.UserPage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(entity.UserPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.UserFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_SearchUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUsers'
+type Storage_SearchUsers_Call struct {
+	*mock.Call
+}
+
+// SearchUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.UserFilter
+func (_e *Storage_Expecter) SearchUsers(ctx interface{}, filter interface{}) *Storage_SearchUsers_Call {
+	return &Storage_SearchUsers_Call{Call: _e.mock.On("SearchUsers", ctx, filter)}
+}
+
+func (_c *Storage_SearchUsers_Call) Run(run func(ctx context.Context, filter entity.UserFilter)) *Storage_SearchUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.UserFilter))
+	})
+	return _c
+}
+
+func (_c *Storage_SearchUsers_Call) Return(_a0 entity.UserPage, _a1 error) *Storage_SearchUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_SearchUsers_Call) RunAndReturn(run func(context.Context, entity.UserFilter) (entity.UserPage, error)) *Storage_SearchUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserRole provides a mock function with given fields: ctx, userID, role, updatedAt
+func (_m *Storage) SetUserRole(ctx context.Context, userID string, role entity.Role, updatedAt time.Time) error {
+	ret := _m.Called(ctx, userID, role, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Role, time.Time) error); ok {
+		r0 = rf(ctx, userID, role, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_SetUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserRole'
+type Storage_SetUserRole_Call struct {
+	*mock.Call
+}
+
+// SetUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - role entity.Role
+//   - updatedAt time.Time
+func (_e *Storage_Expecter) SetUserRole(ctx interface{}, userID interface{}, role interface{}, updatedAt interface{}) *Storage_SetUserRole_Call {
+	return &Storage_SetUserRole_Call{Call: _e.mock.On("SetUserRole", ctx, userID, role, updatedAt)}
+}
+
+func (_c *Storage_SetUserRole_Call) Run(run func(ctx context.Context, userID string, role entity.Role, updatedAt time.Time)) *Storage_SetUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(entity.Role), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_SetUserRole_Call) Return(_a0 error) *Storage_SetUserRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_SetUserRole_Call) RunAndReturn(run func(context.Context, string, entity.Role, time.Time) error) *Storage_SetUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePasswordHash provides a mock function with given fields: ctx, userID, passwordHash, updatedAt
+func (_m *Storage) UpdatePasswordHash(ctx context.Context, userID string, passwordHash string, updatedAt time.Time) error {
+	ret := _m.Called(ctx, userID, passwordHash, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePasswordHash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, userID, passwordHash, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_UpdatePasswordHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasswordHash'
+type Storage_UpdatePasswordHash_Call struct {
+	*mock.Call
+}
+
+// UpdatePasswordHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - passwordHash string
+//   - updatedAt time.Time
+func (_e *Storage_Expecter) UpdatePasswordHash(ctx interface{}, userID interface{}, passwordHash interface{}, updatedAt interface{}) *Storage_UpdatePasswordHash_Call {
+	return &Storage_UpdatePasswordHash_Call{Call: _e.mock.On("UpdatePasswordHash", ctx, userID, passwordHash, updatedAt)}
+}
+
+func (_c *Storage_UpdatePasswordHash_Call) Run(run func(ctx context.Context, userID string, passwordHash string, updatedAt time.Time)) *Storage_UpdatePasswordHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_UpdatePasswordHash_Call) Return(_a0 error) *Storage_UpdatePasswordHash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_UpdatePasswordHash_Call) RunAndReturn(run func(context.Context, string, string, time.Time) error) *Storage_UpdatePasswordHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserProfile provides a mock function with given fields: ctx, userID, update, updatedAt
+func (_m *Storage) UpdateUserProfile(ctx context.Context, userID string, update entity.UserProfileUpdate, updatedAt time.Time) error {
+	ret := _m.Called(ctx, userID, update, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.UserProfileUpdate, time.Time) error); ok {
+		r0 = rf(ctx, userID, update, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_UpdateUserProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserProfile'
+type Storage_UpdateUserProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateUserProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - update entity.UserProfileUpdate
+//   - updatedAt time.Time
+func (_e *Storage_Expecter) UpdateUserProfile(ctx interface{}, userID interface{}, update interface{}, updatedAt interface{}) *Storage_UpdateUserProfile_Call {
+	return &Storage_UpdateUserProfile_Call{Call: _e.mock.On("UpdateUserProfile", ctx, userID, update, updatedAt)}
+}
+
+func (_c *Storage_UpdateUserProfile_Call) Run(run func(ctx context.Context, userID string, update entity.UserProfileUpdate, updatedAt time.Time)) *Storage_UpdateUserProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(entity.UserProfileUpdate), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_UpdateUserProfile_Call) Return(_a0 error) *Storage_UpdateUserProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_UpdateUserProfile_Call) RunAndReturn(run func(context.Context, string, entity.UserProfileUpdate, time.Time) error) *Storage_UpdateUserProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UsePasswordResetToken provides a mock function with given fields: ctx, tokenHash, usedAt
+func (_m *Storage) UsePasswordResetToken(ctx context.Context, tokenHash string, usedAt time.Time) (string, error) {
+	ret := _m.Called(ctx, tokenHash, usedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UsePasswordResetToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (string, error)); ok {
+		return rf(ctx, tokenHash, usedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) string); ok {
+		r0 = rf(ctx, tokenHash, usedAt)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, tokenHash, usedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_UsePasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UsePasswordResetToken'
+type Storage_UsePasswordResetToken_Call struct {
+	*mock.Call
+}
+
+// UsePasswordResetToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+//   - usedAt time.Time
+func (_e *Storage_Expecter) UsePasswordResetToken(ctx interface{}, tokenHash interface{}, usedAt interface{}) *Storage_UsePasswordResetToken_Call {
+	return &Storage_UsePasswordResetToken_Call{Call: _e.mock.On("UsePasswordResetToken", ctx, tokenHash, usedAt)}
+}
+
+func (_c *Storage_UsePasswordResetToken_Call) Run(run func(ctx context.Context, tokenHash string, usedAt time.Time)) *Storage_UsePasswordResetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Storage_UsePasswordResetToken_Call) Return(_a0 string, _a1 error) *Storage_UsePasswordResetToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_UsePasswordResetToken_Call) RunAndReturn(run func(context.Context, string, time.Time) (string, error)) *Storage_UsePasswordResetToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserWithRoleExists provides a mock function with given fields: ctx, role
+func (_m *Storage) UserWithRoleExists(ctx context.Context, role entity.Role) (bool, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserWithRoleExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Role) (bool, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Role) bool); ok {
+		r0 = rf(ctx, role)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Role) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_UserWithRoleExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserWithRoleExists'
+type Storage_UserWithRoleExists_Call struct {
+	*mock.Call
+}
+
+// UserWithRoleExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role entity.Role
+func (_e *Storage_Expecter) UserWithRoleExists(ctx interface{}, role interface{}) *Storage_UserWithRoleExists_Call {
+	return &Storage_UserWithRoleExists_Call{Call: _e.mock.On("UserWithRoleExists", ctx, role)}
+}
+
+func (_c *Storage_UserWithRoleExists_Call) Run(run func(ctx context.Context, role entity.Role)) *Storage_UserWithRoleExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Role))
+	})
+	return _c
+}
+
+func (_c *Storage_UserWithRoleExists_Call) Return(_a0 bool, _a1 error) *Storage_UserWithRoleExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_UserWithRoleExists_Call) RunAndReturn(run func(context.Context, entity.Role) (bool, error)) *Storage_UserWithRoleExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewStorage creates a new instance of Storage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewStorage(t interface {
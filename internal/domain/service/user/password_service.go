@@ -0,0 +1,80 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+func (s *Service) GetUserByID(ctx context.Context, userID string) (entity.User, error) {
+	const op = "service.user.GetUserByID"
+
+	user, err := s.storage.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return entity.User{}, domain.ErrUserNotFound
+		}
+		return entity.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+func (s *Service) UpdatePasswordHash(ctx context.Context, userID, passwordHash string) error {
+	const op = "service.user.UpdatePasswordHash"
+
+	if err := s.storage.UpdatePasswordHash(ctx, userID, passwordHash, time.Now()); err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ReplacePasswordHash replaces the hash only if it's still the old one.
+// It reports whether the hash was replaced
+func (s *Service) ReplacePasswordHash(ctx context.Context, userID, oldHash, newHash string) (bool, error) {
+	const op = "service.user.ReplacePasswordHash"
+
+	replaced, err := s.storage.ReplacePasswordHash(ctx, userID, oldHash, newHash, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return replaced, nil
+}
+
+func (s *Service) CreatePasswordResetToken(ctx context.Context, token entity.PasswordResetToken) error {
+	const op = "service.user.CreatePasswordResetToken"
+
+	if err := s.storage.CreatePasswordResetToken(ctx, token); err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// UsePasswordResetToken returns the ID of the user the token was issued for
+func (s *Service) UsePasswordResetToken(ctx context.Context, tokenHash string) (string, error) {
+	const op = "service.user.UsePasswordResetToken"
+
+	userID, err := s.storage.UsePasswordResetToken(ctx, tokenHash, time.Now())
+	if err != nil {
+		if errors.Is(err, storage.ErrPasswordResetTokenInvalid) {
+			return "", domain.ErrPasswordResetTokenInvalid
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userID, nil
+}
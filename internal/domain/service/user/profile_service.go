@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+func (s *Service) GetUserProfile(ctx context.Context, userID string) (entity.UserProfile, error) {
+	const op = "service.user.GetUserProfile"
+
+	profile, err := s.storage.GetUserProfile(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return entity.UserProfile{}, domain.ErrUserNotFound
+		}
+		return entity.UserProfile{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return profile, nil
+}
+
+func (s *Service) UpdateUserProfile(ctx context.Context, userID string, update entity.UserProfileUpdate) error {
+	const op = "service.user.UpdateUserProfile"
+
+	if err := s.storage.UpdateUserProfile(ctx, userID, update, time.Now()); err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Service) SearchUsers(ctx context.Context, filter entity.UserFilter) (entity.UserPage, error) {
+	const op = "service.user.SearchUsers"
+
+	page, err := s.storage.SearchUsers(ctx, filter)
+	if err != nil {
+		return entity.UserPage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return page, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/rshelekhov/merch-store/internal/domain"
 	"github.com/rshelekhov/merch-store/internal/domain/entity"
@@ -19,6 +20,20 @@ type Storage interface {
 	GetUserByName(ctx context.Context, username string) (entity.User, error)
 	GetUserInfoByID(ctx context.Context, userID string) (entity.UserInfo, error)
 	GetUserInfoByUsername(ctx context.Context, username string) (entity.UserInfo, error)
+	GetUserRole(ctx context.Context, userID string) (entity.Role, error)
+	SetUserRole(ctx context.Context, userID string, role entity.Role, updatedAt time.Time) error
+	UserWithRoleExists(ctx context.Context, role entity.Role) (bool, error)
+	DeactivateUser(ctx context.Context, userID string, keepBalance bool, deactivatedAt time.Time) (int, error)
+	GetUserByID(ctx context.Context, userID string) (entity.User, error)
+	UpdatePasswordHash(ctx context.Context, userID, passwordHash string, updatedAt time.Time) error
+	ReplacePasswordHash(ctx context.Context, userID, oldHash, newHash string, updatedAt time.Time) (bool, error)
+	CreatePasswordResetToken(ctx context.Context, token entity.PasswordResetToken) error
+	UsePasswordResetToken(ctx context.Context, tokenHash string, usedAt time.Time) (string, error)
+	GetUserIdentity(ctx context.Context, issuer, subject string) (entity.UserIdentity, error)
+	CreateUserIdentity(ctx context.Context, identity entity.UserIdentity) error
+	GetUserProfile(ctx context.Context, userID string) (entity.UserProfile, error)
+	UpdateUserProfile(ctx context.Context, userID string, update entity.UserProfileUpdate, updatedAt time.Time) error
+	SearchUsers(ctx context.Context, filter entity.UserFilter) (entity.UserPage, error)
 }
 
 func New(storage Storage) *Service {
@@ -31,6 +46,9 @@ func (s *Service) CreateUser(ctx context.Context, user entity.User) error {
 	const op = "service.user.CreateUser"
 
 	if err := s.storage.CreateUser(ctx, user); err != nil {
+		if errors.Is(err, storage.ErrUserAlreadyExists) {
+			return domain.ErrUserAlreadyExists
+		}
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -78,3 +96,61 @@ func (s *Service) GetUserInfoByUsername(ctx context.Context, toUsername string)
 
 	return userInfo, nil
 }
+
+func (s *Service) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	const op = "service.user.GetUserRole"
+
+	role, err := s.storage.GetUserRole(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return "", domain.ErrUserNotFound
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return role, nil
+}
+
+func (s *Service) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	const op = "service.user.SetUserRole"
+
+	if !role.IsValid() {
+		return domain.ErrInvalidRole
+	}
+
+	if err := s.storage.SetUserRole(ctx, userID, role, time.Now()); err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeactivateUser soft-deletes the user and returns the balance they had left,
+// which is zeroed unless keepBalance is set
+func (s *Service) DeactivateUser(ctx context.Context, userID string, keepBalance bool, deactivatedAt time.Time) (int, error) {
+	const op = "service.user.DeactivateUser"
+
+	balance, err := s.storage.DeactivateUser(ctx, userID, keepBalance, deactivatedAt)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return 0, domain.ErrUserNotFound
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return balance, nil
+}
+
+func (s *Service) UserWithRoleExists(ctx context.Context, role entity.Role) (bool, error) {
+	const op = "service.user.UserWithRoleExists"
+
+	exists, err := s.storage.UserWithRoleExists(ctx, role)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return exists, nil
+}
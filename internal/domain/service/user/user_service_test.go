@@ -10,6 +10,7 @@ import (
 	"github.com/rshelekhov/merch-store/internal/domain/entity"
 	"github.com/rshelekhov/merch-store/internal/domain/service/user/mocks"
 	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,6 +39,15 @@ func TestUserService_CreateUser(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "Error – User already exists",
+			mockBehavior: func(userStorage *mocks.Storage) {
+				userStorage.EXPECT().CreateUser(ctx, user).
+					Once().
+					Return(storage.ErrUserAlreadyExists)
+			},
+			expectedError: domain.ErrUserAlreadyExists,
+		},
 		{
 			name: "Error – Storage error",
 			mockBehavior: func(userStorage *mocks.Storage) {
@@ -316,3 +326,58 @@ func TestUserService_GetUserInfoByUsername(t *testing.T) {
 		})
 	}
 }
+
+func TestUserService_SetUserRole(t *testing.T) {
+	ctx := context.Background()
+	userID := "test-user-id"
+
+	tests := []struct {
+		name          string
+		role          entity.Role
+		mockBehavior  func(userStorage *mocks.Storage)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			role: entity.RoleStoreAdmin,
+			mockBehavior: func(userStorage *mocks.Storage) {
+				userStorage.EXPECT().SetUserRole(ctx, userID, entity.RoleStoreAdmin, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Error – Invalid role",
+			role:          entity.Role("owner"),
+			mockBehavior:  func(userStorage *mocks.Storage) {},
+			expectedError: domain.ErrInvalidRole,
+		},
+		{
+			name: "Error – User not found",
+			role: entity.RoleFinance,
+			mockBehavior: func(userStorage *mocks.Storage) {
+				userStorage.EXPECT().SetUserRole(ctx, userID, entity.RoleFinance, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(storage.ErrUserNotFound)
+			},
+			expectedError: domain.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userStorage := mocks.NewStorage(t)
+			tt.mockBehavior(userStorage)
+
+			userService := New(userStorage)
+			err := userService.SetUserRole(ctx, userID, tt.role)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
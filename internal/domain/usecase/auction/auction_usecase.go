@@ -0,0 +1,345 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log         *slog.Logger
+	identityMgr IdentityManager
+	coinsMgr    CoinManager
+	merchMgr    MerchManager
+	txMgr       TransactionManager
+}
+
+type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+	}
+
+	CoinManager interface {
+		AdjustUserCoins(ctx context.Context, userID string, amount int) error
+		RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransfer) error
+	}
+
+	MerchManager interface {
+		GetMerchByName(ctx context.Context, itemName string) (entity.Merch, error)
+		AddToInventory(ctx context.Context, userID, merchID string, price int) error
+		CreateAuction(ctx context.Context, auction entity.Auction) error
+		GetAuctionByID(ctx context.Context, auctionID string) (entity.Auction, error)
+		LockAuction(ctx context.Context, auctionID string) (entity.Auction, error)
+		ListAuctions(ctx context.Context, filter entity.AuctionFilter) ([]entity.Auction, error)
+		ListDueAuctions(ctx context.Context) ([]string, error)
+		ListAuctionBids(ctx context.Context, auctionID string) ([]entity.AuctionBid, error)
+		PlaceAuctionBid(ctx context.Context, bid entity.AuctionBid) error
+		ResolveAuctionBid(ctx context.Context, bidID string, status entity.BidStatus) error
+		CloseAuction(ctx context.Context, auctionID string, status entity.AuctionStatus) error
+	}
+
+	TransactionManager interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+)
+
+func NewUsecase(
+	log *slog.Logger,
+	identityMgr IdentityManager,
+	coinsMgr CoinManager,
+	merchMgr MerchManager,
+	txMgr TransactionManager,
+) *Usecase {
+	return &Usecase{
+		log:         log,
+		identityMgr: identityMgr,
+		coinsMgr:    coinsMgr,
+		merchMgr:    merchMgr,
+		txMgr:       txMgr,
+	}
+}
+
+func (u *Usecase) CreateAuction(
+	ctx context.Context,
+	itemName string,
+	reservePrice int,
+	startsAt, endsAt time.Time,
+) (entity.Auction, error) {
+	const op = "usecase.Auction.CreateAuction"
+
+	log := u.log.With(slog.String("op", op))
+
+	adminID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.Auction{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	if reservePrice <= 0 {
+		err = fmt.Errorf("%s: %w", op, domain.ErrReservePriceMustBePositive)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.Auction{}, domain.ErrBadRequest
+	}
+
+	if !endsAt.After(startsAt) || !endsAt.After(time.Now()) {
+		err = fmt.Errorf("%s: %w", op, domain.ErrInvalidAuctionWindow)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.Auction{}, domain.ErrBadRequest
+	}
+
+	merch, err := u.merchMgr.GetMerchByName(ctx, itemName)
+	if err != nil {
+		if errors.Is(err, domain.ErrMerchNotFound) {
+			e.LogError(ctx, log, domain.ErrMerchNotFound, err, slog.String("item", itemName))
+			return entity.Auction{}, domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetMerch, err)
+		return entity.Auction{}, domain.ErrFailedToGetMerch
+	}
+
+	auction := entity.NewAuction(adminID, merch, reservePrice, startsAt, endsAt)
+
+	if err = u.merchMgr.CreateAuction(ctx, auction); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCreateAuction, err)
+		return entity.Auction{}, domain.ErrFailedToCreateAuction
+	}
+
+	return auction, nil
+}
+
+func (u *Usecase) ListAuctions(ctx context.Context, status entity.AuctionStatus) ([]entity.Auction, error) {
+	const op = "usecase.Auction.ListAuctions"
+
+	log := u.log.With(slog.String("op", op))
+
+	if status != "" && !status.IsValid() {
+		err := fmt.Errorf("%s: %w", op, domain.ErrInvalidAuctionStatus)
+		e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("status", status.String()))
+		return nil, domain.ErrBadRequest
+	}
+
+	auctions, err := u.merchMgr.ListAuctions(ctx, entity.AuctionFilter{Status: status})
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListAuctions, err)
+		return nil, domain.ErrFailedToListAuctions
+	}
+
+	return auctions, nil
+}
+
+func (u *Usecase) GetAuction(ctx context.Context, auctionID string) (entity.Auction, []entity.AuctionBid, error) {
+	const op = "usecase.Auction.GetAuction"
+
+	log := u.log.With(slog.String("op", op))
+
+	auction, err := u.merchMgr.GetAuctionByID(ctx, auctionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAuctionNotFound) {
+			e.LogError(ctx, log, domain.ErrAuctionNotFound, err, slog.String("auctionID", auctionID))
+			return entity.Auction{}, nil, domain.ErrAuctionNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetAuction, err)
+		return entity.Auction{}, nil, domain.ErrFailedToGetAuction
+	}
+
+	bids, err := u.merchMgr.ListAuctionBids(ctx, auctionID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListAuctionBids, err)
+		return entity.Auction{}, nil, domain.ErrFailedToListAuctionBids
+	}
+
+	return auction, bids, nil
+}
+
+// PlaceBid holds the bid amount in escrow and immediately refunds the bidder it outbids.
+// The auction row stays locked for the whole transaction, so concurrent bids are applied one by one
+func (u *Usecase) PlaceBid(ctx context.Context, auctionID string, amount int) (entity.AuctionBid, error) {
+	const op = "usecase.Auction.PlaceBid"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.AuctionBid{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	if amount <= 0 {
+		err = fmt.Errorf("%s: %w", op, domain.ErrAmountMustBePositive)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.AuctionBid{}, domain.ErrBadRequest
+	}
+
+	bid := entity.NewAuctionBid(auctionID, userID, amount)
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		auction, err := u.merchMgr.LockAuction(txCtx, auctionID)
+		if err != nil {
+			if errors.Is(err, domain.ErrAuctionNotFound) {
+				e.LogError(txCtx, log, domain.ErrAuctionNotFound, err, slog.String("auctionID", auctionID))
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToGetAuction, err)
+			return domain.ErrFailedToGetAuction
+		}
+
+		if !auction.AcceptsBids(bid.CreatedAt) {
+			err = fmt.Errorf("%s: %w", op, domain.ErrAuctionNotOpen)
+			e.LogError(txCtx, log, domain.ErrBadRequest, err, slog.String("auctionID", auctionID))
+			return domain.ErrBadRequest
+		}
+
+		if amount <= auction.HighestBid.Amount {
+			err = fmt.Errorf("%s: %w", op, domain.ErrBidTooLow)
+			e.LogError(txCtx, log, domain.ErrBadRequest, err,
+				slog.Int("amount", amount),
+				slog.Int("highestBid", auction.HighestBid.Amount),
+			)
+			return domain.ErrBadRequest
+		}
+
+		// The previous bid is refunded first, so users raising their own bid only need the difference
+		if auction.HasBids() {
+			if err = u.refundBid(txCtx, auction.HighestBid); err != nil {
+				e.LogError(txCtx, log, domain.ErrFailedToPlaceBid, err)
+				return domain.ErrFailedToPlaceBid
+			}
+		}
+
+		if err = u.coinsMgr.AdjustUserCoins(txCtx, userID, -amount); err != nil {
+			if errors.Is(err, domain.ErrInsufficientCoins) {
+				e.LogError(txCtx, log, domain.ErrInsufficientCoins, err)
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToUpdateUserCoins, err)
+			return domain.ErrFailedToUpdateUserCoins
+		}
+
+		ct := entity.NewCoinTransfer(userID, "", entity.TransactionTypeAuctionHold, amount, bid.CreatedAt)
+
+		if err = u.coinsMgr.RegisterCoinTransfer(txCtx, ct); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToRegisterCoinTransfer, err)
+			return domain.ErrFailedToRegisterCoinTransfer
+		}
+
+		if err = u.merchMgr.PlaceAuctionBid(txCtx, bid); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToPlaceBid, err)
+			return domain.ErrFailedToPlaceBid
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err,
+			slog.Any("userID", userID),
+		)
+		return entity.AuctionBid{}, err
+	}
+
+	return bid, nil
+}
+
+// CloseDueAuctions is run periodically by the worker. Each auction is closed in its own
+// transaction, so one failing auction doesn't hold back the others
+func (u *Usecase) CloseDueAuctions(ctx context.Context) error {
+	const op = "usecase.Auction.CloseDueAuctions"
+
+	log := u.log.With(slog.String("op", op))
+
+	auctionIDs, err := u.merchMgr.ListDueAuctions(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCloseAuctions, err)
+		return domain.ErrFailedToCloseAuctions
+	}
+
+	var failed int
+
+	for _, auctionID := range auctionIDs {
+		if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+			return u.closeAuction(txCtx, log, auctionID)
+		}); err != nil {
+			failed++
+			e.LogError(ctx, log, domain.ErrFailedToCloseAuctions, err, slog.String("auctionID", auctionID))
+		}
+	}
+
+	if failed > 0 {
+		return domain.ErrFailedToCloseAuctions
+	}
+
+	return nil
+}
+
+func (u *Usecase) closeAuction(ctx context.Context, log *slog.Logger, auctionID string) error {
+	auction, err := u.merchMgr.LockAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	// Already closed by another worker between listing and locking
+	if auction.Status != entity.AuctionStatusOpen {
+		return nil
+	}
+
+	if !auction.ReserveMet() {
+		if auction.HasBids() {
+			if err = u.refundBid(ctx, auction.HighestBid); err != nil {
+				return err
+			}
+		}
+
+		if err = u.merchMgr.CloseAuction(ctx, auction.ID, entity.AuctionStatusUnsold); err != nil {
+			return err
+		}
+
+		log.Info("auction closed without a sale", slog.String("auctionID", auction.ID))
+
+		return nil
+	}
+
+	winner := auction.HighestBid
+
+	// The winner's coins were taken when the bid was placed, capturing only releases the escrow
+	if err = u.merchMgr.ResolveAuctionBid(ctx, winner.ID, entity.BidStatusCaptured); err != nil {
+		return err
+	}
+
+	if err = u.merchMgr.AddToInventory(ctx, winner.UserID, auction.MerchID, winner.Amount); err != nil {
+		return err
+	}
+
+	if err = u.merchMgr.CloseAuction(ctx, auction.ID, entity.AuctionStatusSold); err != nil {
+		return err
+	}
+
+	log.Info("auction sold",
+		slog.String("auctionID", auction.ID),
+		slog.String("winnerID", winner.UserID),
+		slog.Int("amount", winner.Amount),
+	)
+
+	return nil
+}
+
+func (u *Usecase) refundBid(ctx context.Context, bid entity.AuctionBid) error {
+	if err := u.merchMgr.ResolveAuctionBid(ctx, bid.ID, entity.BidStatusRefunded); err != nil {
+		return err
+	}
+
+	if err := u.coinsMgr.AdjustUserCoins(ctx, bid.UserID, bid.Amount); err != nil {
+		return err
+	}
+
+	ct := entity.NewCoinTransfer("", bid.UserID, entity.TransactionTypeAuctionRefund, bid.Amount, time.Now())
+
+	return u.coinsMgr.RegisterCoinTransfer(ctx, ct)
+}
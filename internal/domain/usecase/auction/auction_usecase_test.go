@@ -0,0 +1,377 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/auction/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_PlaceBid(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	userID := "test-user-id"
+	amount := 150
+
+	auction := entity.Auction{
+		ID:           "test-auction-id",
+		MerchID:      "test-merch-id",
+		ReservePrice: 100,
+		Status:       entity.AuctionStatusOpen,
+		StartsAt:     time.Now().Add(-time.Hour),
+		EndsAt:       time.Now().Add(time.Hour),
+	}
+
+	outbid := auction
+	outbid.HighestBid = entity.AuctionBid{
+		ID:        "test-bid-id",
+		AuctionID: auction.ID,
+		UserID:    "another-user-id",
+		Amount:    120,
+		Status:    entity.BidStatusHeld,
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(
+			coinsMgr *mocks.CoinManager,
+			merchMgr *mocks.MerchManager,
+		)
+		expectedError error
+	}{
+		{
+			name: "Success – First bid",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				merchMgr.EXPECT().LockAuction(ctx, auction.ID).
+					Once().
+					Return(auction, nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, userID, -amount).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+					return ct.SenderID == userID &&
+						ct.TransactionType == entity.TransactionTypeAuctionHold &&
+						int(ct.Amount) == amount
+				})).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().PlaceAuctionBid(ctx, mock.MatchedBy(func(bid entity.AuctionBid) bool {
+					return bid.AuctionID == auction.ID &&
+						bid.UserID == userID &&
+						bid.Amount == amount &&
+						bid.Status == entity.BidStatusHeld
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success – Outbid user is refunded",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				merchMgr.EXPECT().LockAuction(ctx, auction.ID).
+					Once().
+					Return(outbid, nil)
+
+				merchMgr.EXPECT().ResolveAuctionBid(ctx, outbid.HighestBid.ID, entity.BidStatusRefunded).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, outbid.HighestBid.UserID, outbid.HighestBid.Amount).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+					return ct.ReceiverID == outbid.HighestBid.UserID &&
+						ct.TransactionType == entity.TransactionTypeAuctionRefund
+				})).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, userID, -amount).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+					return ct.SenderID == userID &&
+						ct.TransactionType == entity.TransactionTypeAuctionHold
+				})).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().PlaceAuctionBid(ctx, mock.AnythingOfType("entity.AuctionBid")).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Auction not found",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				merchMgr.EXPECT().LockAuction(ctx, auction.ID).
+					Once().
+					Return(entity.Auction{}, domain.ErrAuctionNotFound)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Auction has ended",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				ended := auction
+				ended.EndsAt = time.Now().Add(-time.Minute)
+
+				merchMgr.EXPECT().LockAuction(ctx, auction.ID).
+					Once().
+					Return(ended, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Bid is not higher than the highest bid",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				higher := outbid
+				higher.HighestBid.Amount = amount
+
+				merchMgr.EXPECT().LockAuction(ctx, auction.ID).
+					Once().
+					Return(higher, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Insufficient coins",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				merchMgr.EXPECT().LockAuction(ctx, auction.ID).
+					Once().
+					Return(auction, nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, userID, -amount).
+					Once().
+					Return(domain.ErrInsufficientCoins)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Failed to place bid",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				merchMgr.EXPECT().LockAuction(ctx, auction.ID).
+					Once().
+					Return(auction, nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, userID, -amount).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.AnythingOfType("entity.CoinTransfer")).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().PlaceAuctionBid(ctx, mock.AnythingOfType("entity.AuctionBid")).
+					Once().
+					Return(errors.New("merch manager error"))
+			},
+			expectedError: domain.ErrFailedToPlaceBid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+				Once().
+				Return(userID, nil)
+
+			txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+
+			tt.mockBehavior(coinsMgr, merchMgr)
+
+			usecase := NewUsecase(logger, identityMgr, coinsMgr, merchMgr, txMgr)
+			bid, err := usecase.PlaceBid(ctx, auction.ID, amount)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, amount, bid.Amount)
+			}
+		})
+	}
+}
+
+func TestUsecase_CloseDueAuctions(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	auction := entity.Auction{
+		ID:           "test-auction-id",
+		MerchID:      "test-merch-id",
+		ReservePrice: 100,
+		Status:       entity.AuctionStatusOpen,
+		StartsAt:     time.Now().Add(-2 * time.Hour),
+		EndsAt:       time.Now().Add(-time.Minute),
+		HighestBid: entity.AuctionBid{
+			ID:     "test-bid-id",
+			UserID: "test-user-id",
+			Amount: 150,
+			Status: entity.BidStatusHeld,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(coinsMgr *mocks.CoinManager, merchMgr *mocks.MerchManager)
+		expectedError error
+	}{
+		{
+			name: "Success – Sold to the highest bidder",
+			mockBehavior: func(coinsMgr *mocks.CoinManager, merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().LockAuction(ctx, auction.ID).
+					Once().
+					Return(auction, nil)
+
+				merchMgr.EXPECT().ResolveAuctionBid(ctx, auction.HighestBid.ID, entity.BidStatusCaptured).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().AddToInventory(ctx, auction.HighestBid.UserID, auction.MerchID, auction.HighestBid.Amount).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().CloseAuction(ctx, auction.ID, entity.AuctionStatusSold).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success – Reserve not met, highest bid refunded",
+			mockBehavior: func(coinsMgr *mocks.CoinManager, merchMgr *mocks.MerchManager) {
+				belowReserve := auction
+				belowReserve.ReservePrice = 200
+
+				merchMgr.EXPECT().LockAuction(ctx, auction.ID).
+					Once().
+					Return(belowReserve, nil)
+
+				merchMgr.EXPECT().ResolveAuctionBid(ctx, auction.HighestBid.ID, entity.BidStatusRefunded).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, auction.HighestBid.UserID, auction.HighestBid.Amount).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+					return ct.ReceiverID == auction.HighestBid.UserID &&
+						ct.TransactionType == entity.TransactionTypeAuctionRefund
+				})).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().CloseAuction(ctx, auction.ID, entity.AuctionStatusUnsold).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success – Already closed",
+			mockBehavior: func(coinsMgr *mocks.CoinManager, merchMgr *mocks.MerchManager) {
+				closed := auction
+				closed.Status = entity.AuctionStatusSold
+
+				merchMgr.EXPECT().LockAuction(ctx, auction.ID).
+					Once().
+					Return(closed, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Failed to add item to inventory",
+			mockBehavior: func(coinsMgr *mocks.CoinManager, merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().LockAuction(ctx, auction.ID).
+					Once().
+					Return(auction, nil)
+
+				merchMgr.EXPECT().ResolveAuctionBid(ctx, auction.HighestBid.ID, entity.BidStatusCaptured).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().AddToInventory(ctx, auction.HighestBid.UserID, auction.MerchID, auction.HighestBid.Amount).
+					Once().
+					Return(errors.New("merch manager error"))
+			},
+			expectedError: domain.ErrFailedToCloseAuctions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			merchMgr.EXPECT().ListDueAuctions(ctx).
+				Once().
+				Return([]string{auction.ID}, nil)
+
+			txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+
+			tt.mockBehavior(coinsMgr, merchMgr)
+
+			usecase := NewUsecase(logger, identityMgr, coinsMgr, merchMgr, txMgr)
+			err := usecase.CloseDueAuctions(ctx)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,8 +2,11 @@ package auth
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
+	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/rshelekhov/merch-store/internal/domain"
 	"github.com/rshelekhov/merch-store/internal/domain/entity"
@@ -11,108 +14,1101 @@ import (
 )
 
 type Usecase struct {
-	log         *slog.Logger
-	userMgr     UserManager
-	tokenMgr    TokenManager
-	passwordMgr PasswordManager
+	log           *slog.Logger
+	identityMgr   IdentityManager
+	userMgr       UserManager
+	sessionMgr    SessionManager
+	tokenMgr      TokenManager
+	passwordMgr   PasswordManager
+	loginThrottle LoginThrottle
+	ssoMgr        SSOManager
+	twoFactorMgr  TwoFactorManager
+	txMgr         TransactionManager
+	signUp        signUpPolicy
+}
+
+// RegistrationConfig controls who may create an account
+type RegistrationConfig struct {
+	// AutoRegister keeps the legacy behavior of creating an account for any unknown username in Authenticate
+	AutoRegister bool
+	// AllowedUsernames, if not empty, is the only set of usernames allowed to sign up
+	AllowedUsernames []string
+	// InviteCodes, if not empty, makes an invite code mandatory for sign-up
+	InviteCodes []string
+}
+
+type signUpPolicy struct {
+	autoRegister     bool
+	allowedUsernames map[string]struct{}
+	inviteCodes      []string
 }
 
 type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+		ExtractAccessTokenFromContext(ctx context.Context) (entity.AccessToken, error)
+	}
+
 	UserManager interface {
+		GetUserByID(ctx context.Context, userID string) (entity.User, error)
 		GetUserByName(ctx context.Context, username string) (entity.User, error)
 		CreateUser(ctx context.Context, user entity.User) error
+		GetUserRole(ctx context.Context, userID string) (entity.Role, error)
+		SetUserRole(ctx context.Context, userID string, role entity.Role) error
+		UserWithRoleExists(ctx context.Context, role entity.Role) (bool, error)
+		ReplacePasswordHash(ctx context.Context, userID, oldHash, newHash string) (bool, error)
+		GetUserIdentity(ctx context.Context, issuer, subject string) (entity.UserIdentity, error)
+		CreateUserIdentity(ctx context.Context, identity entity.UserIdentity) error
+	}
+
+	SessionManager interface {
+		CreateRefreshToken(ctx context.Context, token entity.RefreshToken) error
+		LockRefreshToken(ctx context.Context, tokenHash string) (entity.RefreshToken, error)
+		RotateRefreshToken(ctx context.Context, tokenID string, replacement entity.RefreshToken) error
+		RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+		RevokeAccessToken(ctx context.Context, token entity.AccessToken) error
+		RevokeRefreshTokenFamilyByToken(ctx context.Context, userID, tokenHash string) error
+		RevokeUserSessions(ctx context.Context, userID string) error
+		SyncRevocations(ctx context.Context) error
+		PurgeExpiredSessions(ctx context.Context) (int, error)
 	}
 
 	TokenManager interface {
-		GenerateToken(userID string) (string, error)
+		GenerateToken(userID string, role entity.Role) (string, error)
+		GenerateRefreshToken(userID, familyID string) (string, entity.RefreshToken, error)
+		HashRefreshToken(token string) string
 	}
 
 	PasswordManager interface {
+		CheckPasswordPolicy(username, password string) error
 		PasswordHash(password string) (string, error)
 		ValidatePassword(providedPassword, passwordHash string) error
+		PasswordNeedsRehash(passwordHash string) bool
+	}
+
+	LoginThrottle interface {
+		CheckLogin(ctx context.Context, username, ip string) error
+		RecordLoginFailure(ctx context.Context, username, ip string) ([]entity.LoginFailures, error)
+		ResetLoginFailures(ctx context.Context, username string) error
+		UnlockUsername(ctx context.Context, username string) error
+		PurgeLoginFailures(ctx context.Context) (int, error)
+	}
+
+	SSOManager interface {
+		Issuer() string
+		StartLogin(ctx context.Context) (string, error)
+		CompleteLogin(ctx context.Context, code, state string) (entity.OIDCIdentity, error)
+	}
+
+	TwoFactorManager interface {
+		IsEnabled(ctx context.Context, userID string) (bool, error)
+		VerifySecondFactor(ctx context.Context, userID, code string) error
+		CreateChallenge(ctx context.Context, userID string) (string, entity.MFAChallenge, error)
+		GetChallenge(ctx context.Context, token string) (entity.MFAChallenge, error)
+		ConsumeChallenge(ctx context.Context, token string) error
+	}
+
+	TransactionManager interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 	}
 )
 
 func NewUsecase(
 	log *slog.Logger,
+	identityMgr IdentityManager,
 	userMgr UserManager,
+	sessionMgr SessionManager,
 	tokenMgr TokenManager,
 	passwordMgr PasswordManager,
+	loginThrottle LoginThrottle,
+	ssoMgr SSOManager,
+	twoFactorMgr TwoFactorManager,
+	txMgr TransactionManager,
+	registration RegistrationConfig,
 ) *Usecase {
+	allowedUsernames := make(map[string]struct{}, len(registration.AllowedUsernames))
+	for _, username := range registration.AllowedUsernames {
+		if username != "" {
+			allowedUsernames[username] = struct{}{}
+		}
+	}
+
+	var inviteCodes []string
+	for _, code := range registration.InviteCodes {
+		if code != "" {
+			inviteCodes = append(inviteCodes, code)
+		}
+	}
+
 	return &Usecase{
-		log:         log,
-		userMgr:     userMgr,
-		tokenMgr:    tokenMgr,
-		passwordMgr: passwordMgr,
+		log:           log,
+		identityMgr:   identityMgr,
+		userMgr:       userMgr,
+		sessionMgr:    sessionMgr,
+		tokenMgr:      tokenMgr,
+		passwordMgr:   passwordMgr,
+		loginThrottle: loginThrottle,
+		ssoMgr:        ssoMgr,
+		twoFactorMgr:  twoFactorMgr,
+		txMgr:         txMgr,
+		signUp: signUpPolicy{
+			autoRegister:     registration.AutoRegister,
+			allowedUsernames: allowedUsernames,
+			inviteCodes:      inviteCodes,
+		},
 	}
 }
 
-func (u *Usecase) Authenticate(ctx context.Context, credentials entity.UserCredentials) (string, error) {
+// Authenticate logs the user in. Failed attempts are counted per username and per client IP,
+// too many of them make it return a *domain.LoginThrottledError without checking the password.
+// A user with two-factor authentication enabled gets a *domain.SecondFactorRequiredError instead of tokens,
+// the login is completed with CompleteSecondFactor
+func (u *Usecase) Authenticate(ctx context.Context, credentials entity.UserCredentials, clientIP string) (entity.TokenPair, error) {
 	const op = "usecase.Auth.Authenticate"
 
 	log := u.log.With(slog.String("op", op))
 
+	if err := u.checkLogin(ctx, log, credentials.Username, clientIP); err != nil {
+		return entity.TokenPair{}, err
+	}
+
 	existingUser, err := u.userMgr.GetUserByName(ctx, credentials.Username)
 	if errors.Is(err, domain.ErrUserNotFound) {
+		// Implicit sign-up can't carry an invite code, so it's only possible when none is required.
+		// Otherwise, an unknown username is treated the same way as a wrong password
+		if err = u.signUp.allowImplicit(credentials.Username); err != nil {
+			e.LogError(ctx, log, domain.ErrRegistrationNotAllowed, err,
+				slog.String("username", credentials.Username),
+			)
+			u.recordLoginFailure(ctx, log, credentials.Username, clientIP)
+			return entity.TokenPair{}, domain.ErrBadRequest
+		}
+
 		// If user not found in storage, register new user and generate token
 		return u.registerNewUser(ctx, credentials)
 	}
 	if err != nil {
 		e.LogError(ctx, log, domain.ErrFailedToGetUser, err)
-		return "", domain.ErrFailedToGetUser
+		return entity.TokenPair{}, domain.ErrFailedToGetUser
 	}
 
 	// If user found in storage, authenticate and generate token
-	return u.authenticateExistingUser(ctx, existingUser, credentials.Password)
+	return u.authenticateExistingUser(ctx, existingUser, credentials.Password, clientIP)
+}
+
+func (u *Usecase) checkLogin(ctx context.Context, log *slog.Logger, username, clientIP string) error {
+	if err := u.loginThrottle.CheckLogin(ctx, username, clientIP); err != nil {
+		var throttledErr *domain.LoginThrottledError
+		if errors.As(err, &throttledErr) {
+			log.Warn("login blocked",
+				slog.String("username", username),
+				slog.String("ip", clientIP),
+				slog.String("reason", throttledErr.Err.Error()),
+				slog.Duration("retryAfter", throttledErr.RetryAfter),
+			)
+			return err
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToCheckLoginThrottle, err)
+		return domain.ErrFailedToCheckLoginThrottle
+	}
+
+	return nil
 }
 
-func (u *Usecase) registerNewUser(ctx context.Context, credentials entity.UserCredentials) (string, error) {
+// Register creates an account for a new user and returns tokens for it
+func (u *Usecase) Register(ctx context.Context, credentials entity.UserCredentials, inviteCode string) (entity.TokenPair, error) {
+	const op = "usecase.Auth.Register"
+
+	log := u.log.With(slog.String("op", op))
+
+	if err := validateUsername(credentials.Username); err != nil {
+		e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("username", credentials.Username))
+		return entity.TokenPair{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+	}
+
+	if err := u.signUp.allow(credentials.Username, inviteCode); err != nil {
+		e.LogError(ctx, log, domain.ErrRegistrationNotAllowed, err, slog.String("username", credentials.Username))
+		return entity.TokenPair{}, domain.ErrRegistrationNotAllowed
+	}
+
+	_, err := u.userMgr.GetUserByName(ctx, credentials.Username)
+	if err == nil {
+		e.LogError(ctx, log, domain.ErrUserAlreadyExists, domain.ErrUserAlreadyExists,
+			slog.String("username", credentials.Username),
+		)
+		return entity.TokenPair{}, domain.ErrUserAlreadyExists
+	}
+	if !errors.Is(err, domain.ErrUserNotFound) {
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err)
+		return entity.TokenPair{}, domain.ErrFailedToGetUser
+	}
+
+	return u.registerNewUser(ctx, credentials)
+}
+
+func (u *Usecase) registerNewUser(ctx context.Context, credentials entity.UserCredentials) (entity.TokenPair, error) {
 	const op = "usecase.Auth.registerNewUser"
 
 	log := u.log.With(slog.String("op", op))
 
+	if err := u.passwordMgr.CheckPasswordPolicy(credentials.Username, credentials.Password); err != nil {
+		e.LogError(ctx, log, domain.ErrPasswordPolicyViolation, err, slog.String("username", credentials.Username))
+		return entity.TokenPair{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+	}
+
 	passwordHash, err := u.passwordMgr.PasswordHash(credentials.Password)
 	if err != nil {
 		e.LogError(ctx, log, domain.ErrFailedToGeneratePasswordHash, err)
-		return "", domain.ErrFailedToGeneratePasswordHash
+		return entity.TokenPair{}, domain.ErrFailedToGeneratePasswordHash
 	}
 
 	newUser := entity.NewUser(credentials, passwordHash)
 
 	if err = u.userMgr.CreateUser(ctx, newUser); err != nil {
-		e.LogError(ctx, log, domain.ErrFailedToCreateUser, err)
-		return "", domain.ErrFailedToCreateUser
-	}
+		// Another request has taken the username in the meantime
+		if errors.Is(err, domain.ErrUserAlreadyExists) {
+			e.LogError(ctx, log, domain.ErrUserAlreadyExists, err)
+			return entity.TokenPair{}, domain.ErrUserAlreadyExists
+		}
 
-	token, err := u.tokenMgr.GenerateToken(newUser.ID)
-	if err != nil {
-		e.LogError(ctx, log, domain.ErrFailedToGenerateToken, err)
-		return "", domain.ErrFailedToGenerateToken
+		e.LogError(ctx, log, domain.ErrFailedToCreateUser, err)
+		return entity.TokenPair{}, domain.ErrFailedToCreateUser
 	}
 
-	return token, nil
+	return u.issueTokens(ctx, log, newUser.ID, newUser.Role)
 }
 
-func (u *Usecase) authenticateExistingUser(ctx context.Context, existingUser entity.User, providedPassword string) (string, error) {
+func (u *Usecase) authenticateExistingUser(
+	ctx context.Context,
+	existingUser entity.User,
+	providedPassword, clientIP string,
+) (entity.TokenPair, error) {
 	const op = "usecase.Auth.authenticateExistingUser"
 
 	log := u.log.With(slog.String("op", op))
 
+	// Users created by single sign-on have no password, they can't log in with one
+	if existingUser.PasswordHash == "" {
+		e.LogError(ctx, log, domain.ErrPasswordNotSet, domain.ErrPasswordNotSet, slog.String("userID", existingUser.ID))
+		u.recordLoginFailure(ctx, log, existingUser.Username, clientIP)
+		return entity.TokenPair{}, domain.ErrBadRequest
+	}
+
 	if err := u.passwordMgr.ValidatePassword(providedPassword, existingUser.PasswordHash); err != nil {
 		if errors.Is(err, domain.ErrInvalidPassword) {
 			e.LogError(ctx, log, domain.ErrInvalidPassword, err)
-			return "", domain.ErrBadRequest
+			u.recordLoginFailure(ctx, log, existingUser.Username, clientIP)
+			return entity.TokenPair{}, domain.ErrBadRequest
 		}
 
 		e.LogError(ctx, log, domain.ErrFailedToValidatePassword, err)
-		return "", domain.ErrFailedToValidatePassword
+		return entity.TokenPair{}, domain.ErrFailedToValidatePassword
+	}
+
+	if u.twoFactorMgr != nil {
+		enabled, err := u.twoFactorMgr.IsEnabled(ctx, existingUser.ID)
+		if err != nil {
+			e.LogError(ctx, log, domain.ErrFailedToCheckTwoFactor, err, slog.String("userID", existingUser.ID))
+			return entity.TokenPair{}, domain.ErrFailedToCheckTwoFactor
+		}
+
+		// The failures are kept until the second factor is verified too,
+		// so knowing the password doesn't give unlimited attempts at the code
+		if enabled {
+			u.rehashPassword(ctx, log, existingUser, providedPassword)
+			return entity.TokenPair{}, u.startSecondFactor(ctx, log, existingUser.ID)
+		}
+	}
+
+	// Not being able to reset the counter only makes a later lockout come earlier, the login goes on
+	if err := u.loginThrottle.ResetLoginFailures(ctx, existingUser.Username); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToResetLoginFailures, err, slog.String("userID", existingUser.ID))
+	}
+
+	u.rehashPassword(ctx, log, existingUser, providedPassword)
+
+	return u.issueTokens(ctx, log, existingUser.ID, existingUser.Role)
+}
+
+func (u *Usecase) startSecondFactor(ctx context.Context, log *slog.Logger, userID string) error {
+	token, challenge, err := u.twoFactorMgr.CreateChallenge(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCreateMFAChallenge, err, slog.String("userID", userID))
+		return domain.ErrFailedToCreateMFAChallenge
+	}
+
+	log.Info("second factor required", slog.String("userID", userID))
+
+	return &domain.SecondFactorRequiredError{
+		ChallengeToken: token,
+		ExpiresAt:      challenge.ExpiresAt,
+	}
+}
+
+// CompleteSecondFactor finishes a login that Authenticate answered with a *domain.SecondFactorRequiredError.
+// The code is either a TOTP code or a recovery code. Wrong codes count as failed logins, so they are
+// throttled the same way as wrong passwords, and the challenge can be retried until it expires
+func (u *Usecase) CompleteSecondFactor(ctx context.Context, challengeToken, code, clientIP string) (entity.TokenPair, error) {
+	const op = "usecase.Auth.CompleteSecondFactor"
+
+	log := u.log.With(slog.String("op", op))
+
+	if u.twoFactorMgr == nil {
+		return entity.TokenPair{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrInvalidMFAToken)
+	}
+
+	challenge, err := u.twoFactorMgr.GetChallenge(ctx, challengeToken)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidMFAToken) {
+			e.LogError(ctx, log, domain.ErrInvalidMFAToken, err)
+			return entity.TokenPair{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToVerifySecondFactor, err)
+		return entity.TokenPair{}, domain.ErrFailedToVerifySecondFactor
+	}
+
+	user, err := u.userMgr.GetUserByID(ctx, challenge.UserID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err, slog.String("userID", challenge.UserID))
+		return entity.TokenPair{}, domain.ErrFailedToGetUser
+	}
+
+	if err = u.checkLogin(ctx, log, user.Username, clientIP); err != nil {
+		return entity.TokenPair{}, err
+	}
+
+	if err = u.twoFactorMgr.VerifySecondFactor(ctx, user.ID, code); err != nil {
+		if errors.Is(err, domain.ErrInvalidTOTPCode) {
+			e.LogError(ctx, log, domain.ErrInvalidTOTPCode, err, slog.String("userID", user.ID))
+			u.recordLoginFailure(ctx, log, user.Username, clientIP)
+			return entity.TokenPair{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToVerifySecondFactor, err, slog.String("userID", user.ID))
+		return entity.TokenPair{}, domain.ErrFailedToVerifySecondFactor
+	}
+
+	// Only one of concurrent requests with the same challenge gets tokens
+	if err = u.twoFactorMgr.ConsumeChallenge(ctx, challengeToken); err != nil {
+		if errors.Is(err, domain.ErrInvalidMFAToken) {
+			e.LogError(ctx, log, domain.ErrInvalidMFAToken, err, slog.String("userID", user.ID))
+			return entity.TokenPair{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToVerifySecondFactor, err, slog.String("userID", user.ID))
+		return entity.TokenPair{}, domain.ErrFailedToVerifySecondFactor
+	}
+
+	if err = u.loginThrottle.ResetLoginFailures(ctx, user.Username); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToResetLoginFailures, err, slog.String("userID", user.ID))
+	}
+
+	return u.issueTokens(ctx, log, user.ID, user.Role)
+}
+
+// rehashPassword upgrades legacy bcrypt hashes and hashes made with outdated Argon2id parameters.
+// The login doesn't depend on it, the next one tries again
+func (u *Usecase) rehashPassword(ctx context.Context, log *slog.Logger, user entity.User, password string) {
+	if !u.passwordMgr.PasswordNeedsRehash(user.PasswordHash) {
+		return
+	}
+
+	passwordHash, err := u.passwordMgr.PasswordHash(password)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToRehashPassword, err, slog.String("userID", user.ID))
+		return
+	}
+
+	replaced, err := u.userMgr.ReplacePasswordHash(ctx, user.ID, user.PasswordHash, passwordHash)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToRehashPassword, err, slog.String("userID", user.ID))
+		return
+	}
+
+	if replaced {
+		log.Info("password rehashed", slog.String("userID", user.ID))
+	}
+}
+
+// recordLoginFailure doesn't fail the login, the user gets the invalid credentials error either way
+func (u *Usecase) recordLoginFailure(ctx context.Context, log *slog.Logger, username, clientIP string) {
+	lockouts, err := u.loginThrottle.RecordLoginFailure(ctx, username, clientIP)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToRecordLoginFailure, err,
+			slog.String("username", username),
+			slog.String("ip", clientIP),
+		)
+		return
+	}
+
+	for _, lockout := range lockouts {
+		log.Warn("logins locked out",
+			slog.String("scope", string(lockout.Scope)),
+			slog.String("subject", lockout.Subject),
+			slog.Int("failures", lockout.Failures),
+			slog.Time("lockedUntil", lockout.BlockedUntil),
+		)
+	}
+}
+
+// StartOIDCLogin returns the URL of the identity provider to send the user to
+func (u *Usecase) StartOIDCLogin(ctx context.Context) (string, error) {
+	const op = "usecase.Auth.StartOIDCLogin"
+
+	log := u.log.With(slog.String("op", op))
+
+	if u.ssoMgr == nil {
+		return "", domain.ErrOIDCNotConfigured
+	}
+
+	authURL, err := u.ssoMgr.StartLogin(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToStartOIDCLogin, err)
+		return "", domain.ErrFailedToStartOIDCLogin
+	}
+
+	return authURL, nil
+}
+
+// AuthenticateOIDC completes the login at the identity provider and returns tokens for the local user.
+// The provider account is looked up by issuer and subject, on the first login it's linked to the user
+// with the username taken from the configured claim, and the user is created if there is none.
+// Only users that could have been created this way are linked by username, accounts with a password
+// or a role have to be linked by a superadmin with LinkUserIdentity. The provider doesn't replace
+// the second factor, users with it enabled get a *domain.SecondFactorRequiredError like in Authenticate
+func (u *Usecase) AuthenticateOIDC(ctx context.Context, code, state string) (entity.TokenPair, error) {
+	const op = "usecase.Auth.AuthenticateOIDC"
+
+	log := u.log.With(slog.String("op", op))
+
+	if u.ssoMgr == nil {
+		return entity.TokenPair{}, domain.ErrOIDCNotConfigured
+	}
+
+	identity, err := u.ssoMgr.CompleteLogin(ctx, code, state)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidOIDCState),
+			errors.Is(err, domain.ErrOIDCLoginRejected),
+			errors.Is(err, domain.ErrOIDCUsernameUnavailable):
+			e.LogError(ctx, log, domain.ErrOIDCLoginRejected, err)
+			return entity.TokenPair{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+		default:
+			e.LogError(ctx, log, domain.ErrFailedToCompleteOIDCLogin, err)
+			return entity.TokenPair{}, domain.ErrFailedToCompleteOIDCLogin
+		}
+	}
+
+	link, err := u.userMgr.GetUserIdentity(ctx, identity.Issuer, identity.Subject)
+	switch {
+	case err == nil:
+		user, err := u.userMgr.GetUserByID(ctx, link.UserID)
+		if err != nil {
+			// The linked user is deactivated, the identity provider alone can't bring it back
+			if errors.Is(err, domain.ErrUserNotFound) {
+				e.LogError(ctx, log, domain.ErrOIDCLoginRejected, err, slog.String("userID", link.UserID))
+				return entity.TokenPair{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrOIDCLoginRejected)
+			}
+
+			e.LogError(ctx, log, domain.ErrFailedToGetUser, err, slog.String("userID", link.UserID))
+			return entity.TokenPair{}, domain.ErrFailedToGetUser
+		}
+
+		return u.issueOIDCTokens(ctx, log, user)
+	case !errors.Is(err, domain.ErrUserIdentityNotFound):
+		e.LogError(ctx, log, domain.ErrFailedToGetUserIdentity, err,
+			slog.String("issuer", identity.Issuer),
+			slog.String("subject", identity.Subject),
+		)
+		return entity.TokenPair{}, domain.ErrFailedToGetUserIdentity
+	}
+
+	user, err := u.oidcUser(ctx, log, identity)
+	if err != nil {
+		return entity.TokenPair{}, err
+	}
+
+	// Without the link the next login finds the user by username again, so a failure here isn't left behind
+	if err = u.userMgr.CreateUserIdentity(ctx, entity.NewUserIdentity(identity, user.ID)); err != nil {
+		if errors.Is(err, domain.ErrUserIdentityAlreadyExists) {
+			e.LogError(ctx, log, domain.ErrUserIdentityAlreadyExists, err,
+				slog.String("issuer", identity.Issuer),
+				slog.String("userID", user.ID),
+			)
+			return entity.TokenPair{}, domain.ErrUserIdentityAlreadyExists
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToLinkUserIdentity, err, slog.String("userID", user.ID))
+		return entity.TokenPair{}, domain.ErrFailedToLinkUserIdentity
+	}
+
+	log.Info("user identity linked",
+		slog.String("issuer", identity.Issuer),
+		slog.String("subject", identity.Subject),
+		slog.String("userID", user.ID),
+	)
+
+	return u.issueOIDCTokens(ctx, log, user)
+}
+
+// issueOIDCTokens completes a single sign-on, starting the second factor if the user has it enabled
+func (u *Usecase) issueOIDCTokens(ctx context.Context, log *slog.Logger, user entity.User) (entity.TokenPair, error) {
+	if u.twoFactorMgr != nil {
+		enabled, err := u.twoFactorMgr.IsEnabled(ctx, user.ID)
+		if err != nil {
+			e.LogError(ctx, log, domain.ErrFailedToCheckTwoFactor, err, slog.String("userID", user.ID))
+			return entity.TokenPair{}, domain.ErrFailedToCheckTwoFactor
+		}
+
+		if enabled {
+			return entity.TokenPair{}, u.startSecondFactor(ctx, log, user.ID)
+		}
+	}
+
+	return u.issueTokens(ctx, log, user.ID, user.Role)
+}
+
+// oidcUser returns the user with the username asserted by the identity provider, creating one without
+// a password if there is none. The sign-up policy doesn't apply, the provider decides who may sign in
+func (u *Usecase) oidcUser(ctx context.Context, log *slog.Logger, identity entity.OIDCIdentity) (entity.User, error) {
+	user, err := u.userMgr.GetUserByName(ctx, identity.Username)
+	if err == nil {
+		// Matching usernames don't prove that the provider account and the local one belong to the same person,
+		// so an account that has more to lose than a single sign-on user has isn't taken over this way
+		if user.PasswordHash != "" || user.Role != entity.RoleEmployee {
+			e.LogError(ctx, log, domain.ErrOIDCLinkRequired, domain.ErrOIDCLinkRequired,
+				slog.String("userID", user.ID),
+				slog.String("issuer", identity.Issuer),
+				slog.String("subject", identity.Subject),
+			)
+			return entity.User{}, domain.ErrOIDCLinkRequired
+		}
+
+		return user, nil
+	}
+	if !errors.Is(err, domain.ErrUserNotFound) {
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err)
+		return entity.User{}, domain.ErrFailedToGetUser
+	}
+
+	if err = validateUsername(identity.Username); err != nil {
+		e.LogError(ctx, log, domain.ErrOIDCUsernameUnavailable, err, slog.String("username", identity.Username))
+		return entity.User{}, fmt.Errorf("%w: %w: %w", domain.ErrBadRequest, domain.ErrOIDCUsernameUnavailable, err)
+	}
+
+	user = entity.NewUser(entity.UserCredentials{Username: identity.Username}, "")
+
+	if err = u.userMgr.CreateUser(ctx, user); err != nil {
+		if errors.Is(err, domain.ErrUserAlreadyExists) {
+			e.LogError(ctx, log, domain.ErrUserAlreadyExists, err)
+			return entity.User{}, domain.ErrUserAlreadyExists
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToCreateUser, err)
+		return entity.User{}, domain.ErrFailedToCreateUser
+	}
+
+	log.Info("user created on single sign-on", slog.String("userID", user.ID), slog.String("username", user.Username))
+
+	return user, nil
+}
+
+// LinkUserIdentity links the account with the subject at the configured identity provider to the user,
+// so the user can sign in with it. Accounts with a password or a role are only linked this way
+func (u *Usecase) LinkUserIdentity(ctx context.Context, userID, subject string) error {
+	const op = "usecase.Auth.LinkUserIdentity"
+
+	log := u.log.With(slog.String("op", op))
+
+	if u.ssoMgr == nil {
+		return domain.ErrOIDCNotConfigured
+	}
+
+	adminID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	if _, err = u.userMgr.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			e.LogError(ctx, log, domain.ErrUserNotFound, err, slog.String("userID", userID))
+			return domain.ErrUserNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err, slog.String("userID", userID))
+		return domain.ErrFailedToGetUser
+	}
+
+	identity := entity.OIDCIdentity{
+		Issuer:  u.ssoMgr.Issuer(),
+		Subject: subject,
+	}
+
+	if err = u.userMgr.CreateUserIdentity(ctx, entity.NewUserIdentity(identity, userID)); err != nil {
+		if errors.Is(err, domain.ErrUserIdentityAlreadyExists) {
+			e.LogError(ctx, log, domain.ErrUserIdentityAlreadyExists, err, slog.String("userID", userID))
+			return domain.ErrUserIdentityAlreadyExists
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToLinkUserIdentity, err, slog.String("userID", userID))
+		return domain.ErrFailedToLinkUserIdentity
 	}
 
-	token, err := u.tokenMgr.GenerateToken(existingUser.ID)
+	log.Info("user identity linked",
+		slog.String("issuer", identity.Issuer),
+		slog.String("subject", subject),
+		slog.String("userID", userID),
+		slog.String("linkedBy", adminID),
+	)
+
+	return nil
+}
+
+// Refresh exchanges a refresh token for a new pair of tokens. Every refresh token can be used once,
+// presenting an already rotated one means it has leaked, so the whole family is revoked
+func (u *Usecase) Refresh(ctx context.Context, refreshToken string) (entity.TokenPair, error) {
+	const op = "usecase.Auth.Refresh"
+
+	log := u.log.With(slog.String("op", op))
+
+	var (
+		tokens entity.TokenPair
+		reused entity.RefreshToken
+	)
+
+	tokenHash := u.tokenMgr.HashRefreshToken(refreshToken)
+
+	if err := u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		current, err := u.sessionMgr.LockRefreshToken(txCtx, tokenHash)
+		if err != nil {
+			if errors.Is(err, domain.ErrRefreshTokenNotFound) {
+				e.LogError(txCtx, log, domain.ErrRefreshTokenNotFound, err)
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToRefreshTokens, err)
+			return domain.ErrFailedToRefreshTokens
+		}
+
+		switch {
+		case current.IsRevoked():
+			e.LogError(txCtx, log, domain.ErrRefreshTokenNotActive, domain.ErrRefreshTokenNotActive,
+				slog.String("userID", current.UserID),
+			)
+			return domain.ErrBadRequest
+		case current.IsRotated():
+			// The revocation has to be committed, so the error is returned after the transaction
+			if err = u.sessionMgr.RevokeRefreshTokenFamily(txCtx, current.FamilyID); err != nil {
+				e.LogError(txCtx, log, domain.ErrFailedToRefreshTokens, err)
+				return domain.ErrFailedToRefreshTokens
+			}
+
+			reused = current
+			return nil
+		case current.IsExpired(time.Now()):
+			e.LogError(txCtx, log, domain.ErrRefreshTokenExpired, domain.ErrRefreshTokenExpired,
+				slog.String("userID", current.UserID),
+			)
+			return domain.ErrBadRequest
+		}
+
+		// The role is read again, so role changes are picked up on refresh
+		role, err := u.userMgr.GetUserRole(txCtx, current.UserID)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				e.LogError(txCtx, log, domain.ErrUserNotFound, err, slog.String("userID", current.UserID))
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToGetUserRole, err)
+			return domain.ErrFailedToGetUserRole
+		}
+
+		accessToken, err := u.tokenMgr.GenerateToken(current.UserID, role)
+		if err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToGenerateToken, err)
+			return domain.ErrFailedToGenerateToken
+		}
+
+		nextToken, next, err := u.tokenMgr.GenerateRefreshToken(current.UserID, current.FamilyID)
+		if err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToIssueRefreshToken, err)
+			return domain.ErrFailedToIssueRefreshToken
+		}
+
+		if err = u.sessionMgr.RotateRefreshToken(txCtx, current.ID, next); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToRefreshTokens, err)
+			return domain.ErrFailedToRefreshTokens
+		}
+
+		tokens = entity.TokenPair{
+			AccessToken:  accessToken,
+			RefreshToken: nextToken,
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err)
+		return entity.TokenPair{}, err
+	}
+
+	if reused.ID != "" {
+		e.LogError(ctx, log, domain.ErrRefreshTokenReused, domain.ErrRefreshTokenReused,
+			slog.String("userID", reused.UserID),
+			slog.String("familyID", reused.FamilyID),
+		)
+		return entity.TokenPair{}, domain.ErrBadRequest
+	}
+
+	return tokens, nil
+}
+
+// Logout revokes the access token of the current request. If the refresh token is provided,
+// its family is revoked too, so the session can't be continued from another place
+func (u *Usecase) Logout(ctx context.Context, refreshToken string) error {
+	const op = "usecase.Auth.Logout"
+
+	log := u.log.With(slog.String("op", op))
+
+	accessToken, err := u.identityMgr.ExtractAccessTokenFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrAccessTokenNotFoundInContext, err)
+		return domain.ErrAccessTokenNotFoundInContext
+	}
+
+	if err = u.sessionMgr.RevokeAccessToken(ctx, accessToken); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToLogout, err, slog.String("userID", accessToken.UserID))
+		return domain.ErrFailedToLogout
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
+
+	tokenHash := u.tokenMgr.HashRefreshToken(refreshToken)
+
+	if err = u.sessionMgr.RevokeRefreshTokenFamilyByToken(ctx, accessToken.UserID, tokenHash); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToLogout, err, slog.String("userID", accessToken.UserID))
+		return domain.ErrFailedToLogout
+	}
+
+	return nil
+}
+
+// RevokeAllSessions logs the user out everywhere: all refresh tokens are revoked
+// and access tokens issued before now are rejected until they expire
+func (u *Usecase) RevokeAllSessions(ctx context.Context, userID string) error {
+	const op = "usecase.Auth.RevokeAllSessions"
+
+	log := u.log.With(slog.String("op", op))
+
+	if err := u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.sessionMgr.RevokeUserSessions(txCtx, userID); err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				e.LogError(txCtx, log, domain.ErrUserNotFound, err, slog.String("userID", userID))
+				return domain.ErrUserNotFound
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToRevokeSessions, err, slog.String("userID", userID))
+			return domain.ErrFailedToRevokeSessions
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err)
+		return err
+	}
+
+	log.Info("all sessions revoked", slog.String("userID", userID))
+
+	return nil
+}
+
+// SetUserRole changes the role of another user. The user's sessions are revoked,
+// so tokens with the previous role can't be used anymore
+func (u *Usecase) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	const op = "usecase.Auth.SetUserRole"
+
+	log := u.log.With(slog.String("op", op))
+
+	if !role.IsValid() {
+		e.LogError(ctx, log, domain.ErrInvalidRole, domain.ErrInvalidRole, slog.String("role", string(role)))
+		return fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrInvalidRole)
+	}
+
+	// Otherwise the last superadmin could lock everyone out of role management
+	currentUserID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	if currentUserID == userID {
+		e.LogError(ctx, log, domain.ErrCannotChangeOwnRole, domain.ErrCannotChangeOwnRole, slog.String("userID", userID))
+		return fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrCannotChangeOwnRole)
+	}
+
+	if err = u.userMgr.SetUserRole(ctx, userID, role); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			e.LogError(ctx, log, domain.ErrUserNotFound, err, slog.String("userID", userID))
+			return domain.ErrUserNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToSetUserRole, err, slog.String("userID", userID))
+		return domain.ErrFailedToSetUserRole
+	}
+
+	if err = u.RevokeAllSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	log.Info("user role changed",
+		slog.String("userID", userID),
+		slog.String("role", string(role)),
+		slog.String("changedBy", currentUserID),
+	)
+
+	return nil
+}
+
+// UnlockUser lifts the login lockout and backoff of the user before they expire
+func (u *Usecase) UnlockUser(ctx context.Context, userID string) error {
+	const op = "usecase.Auth.UnlockUser"
+
+	log := u.log.With(slog.String("op", op))
+
+	adminID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	user, err := u.userMgr.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			e.LogError(ctx, log, domain.ErrUserNotFound, err, slog.String("userID", userID))
+			return domain.ErrUserNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err, slog.String("userID", userID))
+		return domain.ErrFailedToGetUser
+	}
+
+	if err = u.loginThrottle.UnlockUsername(ctx, user.Username); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToUnlockUser, err, slog.String("userID", userID))
+		return domain.ErrFailedToUnlockUser
+	}
+
+	log.Info("user unlocked",
+		slog.String("userID", userID),
+		slog.String("username", user.Username),
+		slog.String("unlockedBy", adminID),
+	)
+
+	return nil
+}
+
+// BootstrapSuperadmin creates the first superadmin with the password. Does nothing if a superadmin already exists.
+// An existing user is only promoted with promoteExisting and if the password matches theirs,
+// otherwise anyone who signed up with the configured username first would become the superadmin
+func (u *Usecase) BootstrapSuperadmin(ctx context.Context, credentials entity.UserCredentials, promoteExisting bool) error {
+	const op = "usecase.Auth.BootstrapSuperadmin"
+
+	log := u.log.With(slog.String("op", op))
+
+	exists, err := u.userMgr.UserWithRoleExists(ctx, entity.RoleSuperadmin)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToBootstrapSuperadmin, err)
+		return domain.ErrFailedToBootstrapSuperadmin
+	}
+
+	if exists {
+		return domain.ErrSuperadminAlreadyExists
+	}
+
+	user, err := u.userMgr.GetUserByName(ctx, credentials.Username)
+	switch {
+	case errors.Is(err, domain.ErrUserNotFound):
+		if user, err = u.createSuperadmin(ctx, credentials); err != nil {
+			e.LogError(ctx, log, domain.ErrFailedToBootstrapSuperadmin, err, slog.String("username", credentials.Username))
+			return fmt.Errorf("%w: %w", domain.ErrFailedToBootstrapSuperadmin, err)
+		}
+	case err != nil:
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err)
+		return domain.ErrFailedToBootstrapSuperadmin
+	case !promoteExisting:
+		e.LogError(ctx, log, domain.ErrBootstrapUserExists, domain.ErrBootstrapUserExists, slog.String("userID", user.ID))
+		return domain.ErrBootstrapUserExists
+	default:
+		if err = u.verifyOwnPassword(user, credentials.Password); err != nil {
+			e.LogError(ctx, log, domain.ErrFailedToBootstrapSuperadmin, err, slog.String("userID", user.ID))
+			return fmt.Errorf("%w: %w", domain.ErrFailedToBootstrapSuperadmin, err)
+		}
+	}
+
+	if err = u.userMgr.SetUserRole(ctx, user.ID, entity.RoleSuperadmin); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToSetUserRole, err, slog.String("userID", user.ID))
+		return domain.ErrFailedToBootstrapSuperadmin
+	}
+
+	log.Info("superadmin bootstrapped",
+		slog.String("userID", user.ID),
+		slog.String("username", credentials.Username),
+	)
+
+	return nil
+}
+
+// verifyOwnPassword proves that whoever runs the bootstrap owns the existing account
+func (u *Usecase) verifyOwnPassword(user entity.User, password string) error {
+	if user.PasswordHash == "" {
+		return domain.ErrPasswordNotSet
+	}
+
+	if password == "" {
+		return errors.New("password is required to promote an existing user")
+	}
+
+	return u.passwordMgr.ValidatePassword(password, user.PasswordHash)
+}
+
+func (u *Usecase) createSuperadmin(ctx context.Context, credentials entity.UserCredentials) (entity.User, error) {
+	// Reserved names are meant for accounts like this one, they are only closed for sign-up
+	if err := validateUsername(credentials.Username); err != nil && !errors.Is(err, domain.ErrUsernameReserved) {
+		return entity.User{}, err
+	}
+
+	if credentials.Password == "" {
+		return entity.User{}, errors.New("password is required to create a new user")
+	}
+
+	if err := u.passwordMgr.CheckPasswordPolicy(credentials.Username, credentials.Password); err != nil {
+		return entity.User{}, err
+	}
+
+	passwordHash, err := u.passwordMgr.PasswordHash(credentials.Password)
+	if err != nil {
+		return entity.User{}, err
+	}
+
+	user := entity.NewUser(credentials, passwordHash)
+
+	if err = u.userMgr.CreateUser(ctx, user); err != nil {
+		return entity.User{}, err
+	}
+
+	return user, nil
+}
+
+// SyncRevocations picks up revocations made by other instances of the service
+func (u *Usecase) SyncRevocations(ctx context.Context) error {
+	const op = "usecase.Auth.SyncRevocations"
+
+	log := u.log.With(slog.String("op", op))
+
+	if err := u.sessionMgr.SyncRevocations(ctx); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToSyncRevocations, err)
+		return domain.ErrFailedToSyncRevocations
+	}
+
+	return nil
+}
+
+// PurgeExpiredSessions removes refresh tokens, revocations and login failures that can't affect anything anymore
+func (u *Usecase) PurgeExpiredSessions(ctx context.Context) error {
+	const op = "usecase.Auth.PurgeExpiredSessions"
+
+	log := u.log.With(slog.String("op", op))
+
+	purged, err := u.sessionMgr.PurgeExpiredSessions(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToPurgeSessions, err)
+		return domain.ErrFailedToPurgeSessions
+	}
+
+	if purged > 0 {
+		log.Info("expired sessions purged", slog.Int("count", purged))
+	}
+
+	purged, err = u.loginThrottle.PurgeLoginFailures(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToPurgeLoginFailures, err)
+		return domain.ErrFailedToPurgeLoginFailures
+	}
+
+	if purged > 0 {
+		log.Info("stale login failures purged", slog.Int("count", purged))
+	}
+
+	return nil
+}
+
+// issueTokens starts a new session with a fresh refresh token family
+func (u *Usecase) issueTokens(ctx context.Context, log *slog.Logger, userID string, role entity.Role) (entity.TokenPair, error) {
+	accessToken, err := u.tokenMgr.GenerateToken(userID, role)
 	if err != nil {
 		e.LogError(ctx, log, domain.ErrFailedToGenerateToken, err)
-		return "", domain.ErrFailedToGenerateToken
+		return entity.TokenPair{}, domain.ErrFailedToGenerateToken
+	}
+
+	refreshToken, token, err := u.tokenMgr.GenerateRefreshToken(userID, "")
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToIssueRefreshToken, err)
+		return entity.TokenPair{}, domain.ErrFailedToIssueRefreshToken
+	}
+
+	if err = u.sessionMgr.CreateRefreshToken(ctx, token); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToIssueRefreshToken, err)
+		return entity.TokenPair{}, domain.ErrFailedToIssueRefreshToken
+	}
+
+	return entity.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// allowImplicit applies the same username rules as Register, otherwise signing in
+// would be a way around them, e.g. to take a reserved name
+func (p signUpPolicy) allowImplicit(username string) error {
+	if err := validateUsername(username); err != nil {
+		return err
+	}
+
+	if !p.autoRegister {
+		return errors.New("implicit registration is disabled")
+	}
+
+	if len(p.inviteCodes) > 0 {
+		return errors.New("registration requires an invite code")
+	}
+
+	return p.allow(username, "")
+}
+
+func (p signUpPolicy) allow(username, inviteCode string) error {
+	if len(p.allowedUsernames) > 0 {
+		if _, ok := p.allowedUsernames[username]; !ok {
+			return errors.New("username is not in the allowlist")
+		}
+	}
+
+	if len(p.inviteCodes) > 0 && !p.validInviteCode(inviteCode) {
+		return errors.New("invalid invite code")
+	}
+
+	return nil
+}
+
+func (p signUpPolicy) validInviteCode(inviteCode string) bool {
+	for _, code := range p.inviteCodes {
+		if subtle.ConstantTimeCompare([]byte(code), []byte(inviteCode)) == 1 {
+			return true
+		}
 	}
 
-	return token, nil
+	return false
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/rshelekhov/merch-store/internal/domain"
 	"github.com/rshelekhov/merch-store/internal/domain/entity"
@@ -28,14 +29,26 @@ func TestUsecase_Authenticate(t *testing.T) {
 		Password: "password123",
 	}
 
+	clientIP := "192.0.2.1"
+
+	refreshToken := entity.RefreshToken{
+		ID:        "test-refresh-token-id",
+		UserID:    "test-user-id",
+		FamilyID:  "test-refresh-token-id",
+		TokenHash: "refresh_token_hash",
+	}
+
 	tests := []struct {
 		name         string
 		credentials  entity.UserCredentials
 		mockBehavior func(
 			userMgr *mocks.UserManager,
+			sessionMgr *mocks.SessionManager,
 			tokenMgr *mocks.TokenManager,
 			passwordMgr *mocks.PasswordManager,
+			loginThrottle *mocks.LoginThrottle,
 		)
+		registration  *RegistrationConfig
 		expectedToken string
 		expectedError error
 	}{
@@ -44,9 +57,113 @@ func TestUsecase_Authenticate(t *testing.T) {
 			credentials: testCreds,
 			mockBehavior: func(
 				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
+			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+					Once().
+					Return(testUser, nil)
+
+				passwordMgr.EXPECT().ValidatePassword(testCreds.Password, testUser.PasswordHash).
+					Once().
+					Return(nil)
+
+				loginThrottle.EXPECT().ResetLoginFailures(ctx, testUser.Username).
+					Once().
+					Return(nil)
+
+				passwordMgr.EXPECT().PasswordNeedsRehash(testUser.PasswordHash).
+					Once().
+					Return(false)
+
+				tokenMgr.EXPECT().GenerateToken(testUser.ID, testUser.Role).
+					Once().
+					Return("valid_token", nil)
+
+				tokenMgr.EXPECT().GenerateRefreshToken(testUser.ID, "").
+					Once().
+					Return("refresh_token", refreshToken, nil)
+
+				sessionMgr.EXPECT().CreateRefreshToken(ctx, refreshToken).
+					Once().
+					Return(nil)
+			},
+			expectedToken: "valid_token",
+			expectedError: nil,
+		},
+		{
+			name:        "Success - Outdated password hash is rehashed",
+			credentials: testCreds,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
+			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+					Once().
+					Return(testUser, nil)
+
+				passwordMgr.EXPECT().ValidatePassword(testCreds.Password, testUser.PasswordHash).
+					Once().
+					Return(nil)
+
+				loginThrottle.EXPECT().ResetLoginFailures(ctx, testUser.Username).
+					Once().
+					Return(nil)
+
+				passwordMgr.EXPECT().PasswordNeedsRehash(testUser.PasswordHash).
+					Once().
+					Return(true)
+
+				passwordMgr.EXPECT().PasswordHash(testCreds.Password).
+					Once().
+					Return("new_hashed_password", nil)
+
+				userMgr.EXPECT().ReplacePasswordHash(ctx, testUser.ID, testUser.PasswordHash, "new_hashed_password").
+					Once().
+					Return(true, nil)
+
+				tokenMgr.EXPECT().GenerateToken(testUser.ID, testUser.Role).
+					Once().
+					Return("valid_token", nil)
+
+				tokenMgr.EXPECT().GenerateRefreshToken(testUser.ID, "").
+					Once().
+					Return("refresh_token", refreshToken, nil)
+
+				sessionMgr.EXPECT().CreateRefreshToken(ctx, refreshToken).
+					Once().
+					Return(nil)
+			},
+			expectedToken: "valid_token",
+			expectedError: nil,
+		},
+		{
+			name:        "Success - Failed rehash doesn't fail the login",
+			credentials: testCreds,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
 				tokenMgr *mocks.TokenManager,
 				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
 			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
 				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
 					Once().
 					Return(testUser, nil)
@@ -55,9 +172,33 @@ func TestUsecase_Authenticate(t *testing.T) {
 					Once().
 					Return(nil)
 
-				tokenMgr.EXPECT().GenerateToken(testUser.ID).
+				loginThrottle.EXPECT().ResetLoginFailures(ctx, testUser.Username).
+					Once().
+					Return(nil)
+
+				passwordMgr.EXPECT().PasswordNeedsRehash(testUser.PasswordHash).
+					Once().
+					Return(true)
+
+				passwordMgr.EXPECT().PasswordHash(testCreds.Password).
+					Once().
+					Return("new_hashed_password", nil)
+
+				userMgr.EXPECT().ReplacePasswordHash(ctx, testUser.ID, testUser.PasswordHash, "new_hashed_password").
+					Once().
+					Return(false, errors.New("storage error"))
+
+				tokenMgr.EXPECT().GenerateToken(testUser.ID, testUser.Role).
 					Once().
 					Return("valid_token", nil)
+
+				tokenMgr.EXPECT().GenerateRefreshToken(testUser.ID, "").
+					Once().
+					Return("refresh_token", refreshToken, nil)
+
+				sessionMgr.EXPECT().CreateRefreshToken(ctx, refreshToken).
+					Once().
+					Return(nil)
 			},
 			expectedToken: "valid_token",
 			expectedError: nil,
@@ -67,13 +208,23 @@ func TestUsecase_Authenticate(t *testing.T) {
 			credentials: testCreds,
 			mockBehavior: func(
 				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
 				tokenMgr *mocks.TokenManager,
 				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
 			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
 				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
 					Once().
 					Return(entity.User{}, domain.ErrUserNotFound)
 
+				passwordMgr.EXPECT().CheckPasswordPolicy(testCreds.Username, testCreds.Password).
+					Once().
+					Return(nil)
+
 				passwordMgr.EXPECT().PasswordHash(testCreds.Password).
 					Once().
 					Return("hashed_password", nil)
@@ -82,21 +233,131 @@ func TestUsecase_Authenticate(t *testing.T) {
 					Once().
 					Return(nil)
 
-				tokenMgr.EXPECT().GenerateToken(mock.AnythingOfType("string")).
+				tokenMgr.EXPECT().GenerateToken(mock.AnythingOfType("string"), entity.RoleEmployee).
 					Once().
 					Return("new_user_token", nil)
+
+				tokenMgr.EXPECT().GenerateRefreshToken(mock.AnythingOfType("string"), "").
+					Once().
+					Return("refresh_token", refreshToken, nil)
+
+				sessionMgr.EXPECT().CreateRefreshToken(ctx, refreshToken).
+					Once().
+					Return(nil)
 			},
 			expectedToken: "new_user_token",
 			expectedError: nil,
 		},
+		{
+			name:         "Error - Unknown user with auto-registration disabled",
+			credentials:  testCreds,
+			registration: &RegistrationConfig{AutoRegister: false},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
+			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+
+				loginThrottle.EXPECT().RecordLoginFailure(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil, nil)
+			},
+			expectedToken: "",
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:         "Error - Unknown user when invite codes are required",
+			credentials:  testCreds,
+			registration: &RegistrationConfig{AutoRegister: true, InviteCodes: []string{"invite"}},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
+			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+
+				loginThrottle.EXPECT().RecordLoginFailure(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil, nil)
+			},
+			expectedToken: "",
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Unknown user with a reserved username",
+			credentials: entity.UserCredentials{
+				Username: "admin",
+				Password: testCreds.Password,
+			},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
+			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, "admin", clientIP).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().GetUserByName(ctx, "admin").
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+
+				loginThrottle.EXPECT().RecordLoginFailure(ctx, "admin", clientIP).
+					Once().
+					Return(nil, nil)
+			},
+			expectedToken: "",
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:        "Error - Account is locked",
+			credentials: testCreds,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
+			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(&domain.LoginThrottledError{Err: domain.ErrAccountLocked, RetryAfter: time.Minute})
+			},
+			expectedError: domain.ErrAccountLocked,
+		},
 		{
 			name:        "Error - Failed to get user",
 			credentials: testCreds,
 			mockBehavior: func(
 				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
 				tokenMgr *mocks.TokenManager,
 				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
 			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
 				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
 					Once().
 					Return(entity.User{}, errors.New("user manager error"))
@@ -109,9 +370,15 @@ func TestUsecase_Authenticate(t *testing.T) {
 			credentials: testCreds,
 			mockBehavior: func(
 				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
 				tokenMgr *mocks.TokenManager,
 				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
 			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
 				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
 					Once().
 					Return(testUser, nil)
@@ -119,6 +386,38 @@ func TestUsecase_Authenticate(t *testing.T) {
 				passwordMgr.EXPECT().ValidatePassword(testCreds.Password, testUser.PasswordHash).
 					Once().
 					Return(domain.ErrInvalidPassword)
+
+				loginThrottle.EXPECT().RecordLoginFailure(ctx, testUser.Username, clientIP).
+					Once().
+					Return(nil, nil)
+			},
+			expectedToken: "",
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:        "Error - User signs in with single sign-on only",
+			credentials: testCreds,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
+			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
+				ssoUser := testUser
+				ssoUser.PasswordHash = ""
+
+				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+					Once().
+					Return(ssoUser, nil)
+
+				loginThrottle.EXPECT().RecordLoginFailure(ctx, testUser.Username, clientIP).
+					Once().
+					Return(nil, nil)
 			},
 			expectedToken: "",
 			expectedError: domain.ErrBadRequest,
@@ -128,9 +427,15 @@ func TestUsecase_Authenticate(t *testing.T) {
 			credentials: testCreds,
 			mockBehavior: func(
 				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
 				tokenMgr *mocks.TokenManager,
 				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
 			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
 				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
 					Once().
 					Return(testUser, nil)
@@ -147,13 +452,23 @@ func TestUsecase_Authenticate(t *testing.T) {
 			credentials: testCreds,
 			mockBehavior: func(
 				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
 				tokenMgr *mocks.TokenManager,
 				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
 			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
 				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
 					Once().
 					Return(entity.User{}, domain.ErrUserNotFound)
 
+				passwordMgr.EXPECT().CheckPasswordPolicy(testCreds.Username, testCreds.Password).
+					Once().
+					Return(nil)
+
 				passwordMgr.EXPECT().PasswordHash(testCreds.Password).
 					Once().
 					Return("hashed_password", nil)
@@ -162,7 +477,7 @@ func TestUsecase_Authenticate(t *testing.T) {
 					Once().
 					Return(nil)
 
-				tokenMgr.EXPECT().GenerateToken(mock.AnythingOfType("string")).
+				tokenMgr.EXPECT().GenerateToken(mock.AnythingOfType("string"), entity.RoleEmployee).
 					Once().
 					Return("", errors.New("token manager error"))
 			},
@@ -174,9 +489,15 @@ func TestUsecase_Authenticate(t *testing.T) {
 			credentials: testCreds,
 			mockBehavior: func(
 				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
 				tokenMgr *mocks.TokenManager,
 				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
 			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
 				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
 					Once().
 					Return(testUser, nil)
@@ -185,7 +506,15 @@ func TestUsecase_Authenticate(t *testing.T) {
 					Once().
 					Return(nil)
 
-				tokenMgr.EXPECT().GenerateToken(testUser.ID).
+				loginThrottle.EXPECT().ResetLoginFailures(ctx, testUser.Username).
+					Once().
+					Return(nil)
+
+				passwordMgr.EXPECT().PasswordNeedsRehash(testUser.PasswordHash).
+					Once().
+					Return(false)
+
+				tokenMgr.EXPECT().GenerateToken(testUser.ID, testUser.Role).
 					Once().
 					Return("", errors.New("token manager error"))
 			},
@@ -197,13 +526,23 @@ func TestUsecase_Authenticate(t *testing.T) {
 			credentials: testCreds,
 			mockBehavior: func(
 				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
 				tokenMgr *mocks.TokenManager,
 				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
 			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
 				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
 					Once().
 					Return(entity.User{}, domain.ErrUserNotFound)
 
+				passwordMgr.EXPECT().CheckPasswordPolicy(testCreds.Username, testCreds.Password).
+					Once().
+					Return(nil)
+
 				passwordMgr.EXPECT().PasswordHash(testCreds.Password).
 					Once().
 					Return("hashed_password", nil)
@@ -220,13 +559,23 @@ func TestUsecase_Authenticate(t *testing.T) {
 			credentials: testCreds,
 			mockBehavior: func(
 				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
 				tokenMgr *mocks.TokenManager,
 				passwordMgr *mocks.PasswordManager,
+				loginThrottle *mocks.LoginThrottle,
 			) {
+				loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+					Once().
+					Return(nil)
+
 				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
 					Once().
 					Return(entity.User{}, domain.ErrUserNotFound)
 
+				passwordMgr.EXPECT().CheckPasswordPolicy(testCreds.Username, testCreds.Password).
+					Once().
+					Return(nil)
+
 				passwordMgr.EXPECT().PasswordHash(testCreds.Password).
 					Once().
 					Return("", errors.New("password manager error"))
@@ -238,22 +587,2020 @@ func TestUsecase_Authenticate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			sessionMgr := mocks.NewSessionManager(t)
+			tokenMgr := mocks.NewTokenManager(t)
+			passwordMgr := mocks.NewPasswordManager(t)
+			loginThrottle := mocks.NewLoginThrottle(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tt.mockBehavior(userMgr, sessionMgr, tokenMgr, passwordMgr, loginThrottle)
+
+			registration := RegistrationConfig{AutoRegister: true}
+			if tt.registration != nil {
+				registration = *tt.registration
+			}
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, sessionMgr, tokenMgr, passwordMgr, loginThrottle, nil, nil, txMgr, registration)
+			tokens, err := usecase.Authenticate(ctx, tt.credentials, clientIP)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, tokens)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedToken, tokens.AccessToken)
+				require.Equal(t, "refresh_token", tokens.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestUsecase_Register(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	testCreds := entity.UserCredentials{
+		Username: "testuser",
+		Password: "password123",
+	}
+
+	refreshToken := entity.RefreshToken{
+		ID:        "test-refresh-token-id",
+		UserID:    "test-user-id",
+		FamilyID:  "test-refresh-token-id",
+		TokenHash: "refresh_token_hash",
+	}
+
+	tests := []struct {
+		name         string
+		credentials  entity.UserCredentials
+		inviteCode   string
+		registration RegistrationConfig
+		mockBehavior func(
+			userMgr *mocks.UserManager,
+			sessionMgr *mocks.SessionManager,
+			tokenMgr *mocks.TokenManager,
+			passwordMgr *mocks.PasswordManager,
+		)
+		expectedToken string
+		expectedError error
+	}{
+		{
+			name:         "Success",
+			credentials:  testCreds,
+			registration: RegistrationConfig{},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+
+				passwordMgr.EXPECT().CheckPasswordPolicy(testCreds.Username, testCreds.Password).
+					Once().
+					Return(nil)
+
+				passwordMgr.EXPECT().PasswordHash(testCreds.Password).
+					Once().
+					Return("hashed_password", nil)
+
+				userMgr.EXPECT().CreateUser(ctx, mock.MatchedBy(func(user entity.User) bool {
+					return user.Username == testCreds.Username &&
+						user.Balance == entity.DefaultBalance
+				})).
+					Once().
+					Return(nil)
+
+				tokenMgr.EXPECT().GenerateToken(mock.AnythingOfType("string"), entity.RoleEmployee).
+					Once().
+					Return("new_user_token", nil)
+
+				tokenMgr.EXPECT().GenerateRefreshToken(mock.AnythingOfType("string"), "").
+					Once().
+					Return("refresh_token", refreshToken, nil)
+
+				sessionMgr.EXPECT().CreateRefreshToken(ctx, refreshToken).
+					Once().
+					Return(nil)
+			},
+			expectedToken: "new_user_token",
+			expectedError: nil,
+		},
+		{
+			name:         "Success - Valid invite code",
+			credentials:  testCreds,
+			inviteCode:   "second-invite",
+			registration: RegistrationConfig{InviteCodes: []string{"first-invite", "second-invite"}},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+
+				passwordMgr.EXPECT().CheckPasswordPolicy(testCreds.Username, testCreds.Password).
+					Once().
+					Return(nil)
+
+				passwordMgr.EXPECT().PasswordHash(testCreds.Password).
+					Once().
+					Return("hashed_password", nil)
+
+				userMgr.EXPECT().CreateUser(ctx, mock.AnythingOfType("entity.User")).
+					Once().
+					Return(nil)
+
+				tokenMgr.EXPECT().GenerateToken(mock.AnythingOfType("string"), entity.RoleEmployee).
+					Once().
+					Return("new_user_token", nil)
+
+				tokenMgr.EXPECT().GenerateRefreshToken(mock.AnythingOfType("string"), "").
+					Once().
+					Return("refresh_token", refreshToken, nil)
+
+				sessionMgr.EXPECT().CreateRefreshToken(ctx, refreshToken).
+					Once().
+					Return(nil)
+			},
+			expectedToken: "new_user_token",
+			expectedError: nil,
+		},
+		{
+			name:         "Error - Username is too short",
+			credentials:  entity.UserCredentials{Username: "ab", Password: "password123"},
+			registration: RegistrationConfig{},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+			},
+			expectedToken: "",
+			expectedError: domain.ErrUsernameTooShort,
+		},
+		{
+			name:         "Error - Username has invalid characters",
+			credentials:  entity.UserCredentials{Username: "test user", Password: "password123"},
+			registration: RegistrationConfig{},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+			},
+			expectedToken: "",
+			expectedError: domain.ErrUsernameInvalidCharacters,
+		},
+		{
+			name:         "Error - Username is reserved",
+			credentials:  entity.UserCredentials{Username: "Admin", Password: "password123"},
+			registration: RegistrationConfig{},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+			},
+			expectedToken: "",
+			expectedError: domain.ErrUsernameReserved,
+		},
+		{
+			name:         "Error - Password policy violation",
+			credentials:  testCreds,
+			registration: RegistrationConfig{},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+
+				passwordMgr.EXPECT().CheckPasswordPolicy(testCreds.Username, testCreds.Password).
+					Once().
+					Return(&domain.PasswordPolicyError{Violations: []domain.PasswordViolation{
+						{Rule: domain.PasswordRuleCommon, Message: "password is too common"},
+					}})
+			},
+			expectedToken: "",
+			expectedError: domain.ErrPasswordPolicyViolation,
+		},
+		{
+			name:         "Error - Username is not in the allowlist",
+			credentials:  testCreds,
+			registration: RegistrationConfig{AllowedUsernames: []string{"anotheruser"}},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+			},
+			expectedToken: "",
+			expectedError: domain.ErrRegistrationNotAllowed,
+		},
+		{
+			name:         "Error - Invalid invite code",
+			credentials:  testCreds,
+			inviteCode:   "wrong-invite",
+			registration: RegistrationConfig{InviteCodes: []string{"first-invite"}},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+			},
+			expectedToken: "",
+			expectedError: domain.ErrRegistrationNotAllowed,
+		},
+		{
+			name:         "Error - Username is taken",
+			credentials:  testCreds,
+			registration: RegistrationConfig{},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+					Once().
+					Return(entity.User{ID: "test-user-id", Username: testCreds.Username}, nil)
+			},
+			expectedToken: "",
+			expectedError: domain.ErrUserAlreadyExists,
+		},
+		{
+			name:         "Error - Username taken concurrently",
+			credentials:  testCreds,
+			registration: RegistrationConfig{},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+
+				passwordMgr.EXPECT().CheckPasswordPolicy(testCreds.Username, testCreds.Password).
+					Once().
+					Return(nil)
+
+				passwordMgr.EXPECT().PasswordHash(testCreds.Password).
+					Once().
+					Return("hashed_password", nil)
+
+				userMgr.EXPECT().CreateUser(ctx, mock.AnythingOfType("entity.User")).
+					Once().
+					Return(domain.ErrUserAlreadyExists)
+			},
+			expectedToken: "",
+			expectedError: domain.ErrUserAlreadyExists,
+		},
+		{
+			name:         "Error - Failed to get user",
+			credentials:  testCreds,
+			registration: RegistrationConfig{},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+					Once().
+					Return(entity.User{}, errors.New("user manager error"))
+			},
+			expectedToken: "",
+			expectedError: domain.ErrFailedToGetUser,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			sessionMgr := mocks.NewSessionManager(t)
+			tokenMgr := mocks.NewTokenManager(t)
+			passwordMgr := mocks.NewPasswordManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tt.mockBehavior(userMgr, sessionMgr, tokenMgr, passwordMgr)
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, sessionMgr, tokenMgr, passwordMgr, mocks.NewLoginThrottle(t), nil, nil, txMgr, tt.registration)
+			tokens, err := usecase.Register(ctx, tt.credentials, tt.inviteCode)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, tokens)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedToken, tokens.AccessToken)
+				require.Equal(t, "refresh_token", tokens.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestUsecase_AuthenticateOIDC(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	code := "test-code"
+	state := "test-state"
+
+	identity := entity.OIDCIdentity{
+		Issuer:   "https://idp.example.com",
+		Subject:  "00u1a2b3c4",
+		Email:    "john.doe@example.com",
+		Username: "john.doe",
+	}
+
+	testUser := entity.User{
+		ID:           "test-user-id",
+		Username:     "john.doe",
+		PasswordHash: "hashed_password",
+		Role:         entity.RoleEmployee,
+	}
+
+	// Created by an earlier single sign-on, e.g. the link failed to be saved
+	ssoUser := entity.User{
+		ID:       "test-user-id",
+		Username: "john.doe",
+		Role:     entity.RoleEmployee,
+	}
+
+	refreshToken := entity.RefreshToken{
+		ID:        "test-refresh-token-id",
+		UserID:    "test-user-id",
+		FamilyID:  "test-refresh-token-id",
+		TokenHash: "refresh_token_hash",
+	}
+
+	expectTokens := func(sessionMgr *mocks.SessionManager, tokenMgr *mocks.TokenManager, userID string) {
+		tokenMgr.EXPECT().GenerateToken(userID, entity.RoleEmployee).
+			Once().
+			Return("valid_token", nil)
+
+		tokenMgr.EXPECT().GenerateRefreshToken(userID, "").
+			Once().
+			Return("refresh_token", refreshToken, nil)
+
+		sessionMgr.EXPECT().CreateRefreshToken(ctx, refreshToken).
+			Once().
+			Return(nil)
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(
+			ssoMgr *mocks.SSOManager,
+			userMgr *mocks.UserManager,
+			sessionMgr *mocks.SessionManager,
+			tokenMgr *mocks.TokenManager,
+		)
+		expectedError error
+	}{
+		{
+			name: "Success – Linked identity",
+			mockBehavior: func(
+				ssoMgr *mocks.SSOManager,
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				ssoMgr.EXPECT().CompleteLogin(ctx, code, state).
+					Once().
+					Return(identity, nil)
+
+				userMgr.EXPECT().GetUserIdentity(ctx, identity.Issuer, identity.Subject).
+					Once().
+					Return(entity.NewUserIdentity(identity, testUser.ID), nil)
+
+				userMgr.EXPECT().GetUserByID(ctx, testUser.ID).
+					Once().
+					Return(testUser, nil)
+
+				expectTokens(sessionMgr, tokenMgr, testUser.ID)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success – First login links the existing user without password",
+			mockBehavior: func(
+				ssoMgr *mocks.SSOManager,
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				ssoMgr.EXPECT().CompleteLogin(ctx, code, state).
+					Once().
+					Return(identity, nil)
+
+				userMgr.EXPECT().GetUserIdentity(ctx, identity.Issuer, identity.Subject).
+					Once().
+					Return(entity.UserIdentity{}, domain.ErrUserIdentityNotFound)
+
+				userMgr.EXPECT().GetUserByName(ctx, identity.Username).
+					Once().
+					Return(ssoUser, nil)
+
+				userMgr.EXPECT().CreateUserIdentity(ctx, mock.MatchedBy(func(link entity.UserIdentity) bool {
+					return link.UserID == ssoUser.ID && link.Subject == identity.Subject
+				})).
+					Once().
+					Return(nil)
+
+				expectTokens(sessionMgr, tokenMgr, ssoUser.ID)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Existing user with password isn't linked by username",
+			mockBehavior: func(
+				ssoMgr *mocks.SSOManager,
+				userMgr *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+			) {
+				ssoMgr.EXPECT().CompleteLogin(ctx, code, state).
+					Once().
+					Return(identity, nil)
+
+				userMgr.EXPECT().GetUserIdentity(ctx, identity.Issuer, identity.Subject).
+					Once().
+					Return(entity.UserIdentity{}, domain.ErrUserIdentityNotFound)
+
+				userMgr.EXPECT().GetUserByName(ctx, identity.Username).
+					Once().
+					Return(testUser, nil)
+			},
+			expectedError: domain.ErrOIDCLinkRequired,
+		},
+		{
+			name: "Error – Existing admin isn't linked by username",
+			mockBehavior: func(
+				ssoMgr *mocks.SSOManager,
+				userMgr *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+			) {
+				ssoMgr.EXPECT().CompleteLogin(ctx, code, state).
+					Once().
+					Return(identity, nil)
+
+				userMgr.EXPECT().GetUserIdentity(ctx, identity.Issuer, identity.Subject).
+					Once().
+					Return(entity.UserIdentity{}, domain.ErrUserIdentityNotFound)
+
+				admin := ssoUser
+				admin.Role = entity.RoleSuperadmin
+
+				userMgr.EXPECT().GetUserByName(ctx, identity.Username).
+					Once().
+					Return(admin, nil)
+			},
+			expectedError: domain.ErrOIDCLinkRequired,
+		},
+		{
+			name: "Success – First login creates the user",
+			mockBehavior: func(
+				ssoMgr *mocks.SSOManager,
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				ssoMgr.EXPECT().CompleteLogin(ctx, code, state).
+					Once().
+					Return(identity, nil)
+
+				userMgr.EXPECT().GetUserIdentity(ctx, identity.Issuer, identity.Subject).
+					Once().
+					Return(entity.UserIdentity{}, domain.ErrUserIdentityNotFound)
+
+				userMgr.EXPECT().GetUserByName(ctx, identity.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+
+				var created entity.User
+				userMgr.EXPECT().CreateUser(ctx, mock.MatchedBy(func(user entity.User) bool {
+					return user.Username == identity.Username && user.PasswordHash == ""
+				})).
+					Run(func(_ context.Context, user entity.User) {
+						created = user
+					}).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().CreateUserIdentity(ctx, mock.MatchedBy(func(link entity.UserIdentity) bool {
+					return link.UserID == created.ID
+				})).
+					Once().
+					Return(nil)
+
+				tokenMgr.EXPECT().GenerateToken(mock.AnythingOfType("string"), entity.RoleEmployee).
+					Once().
+					Return("valid_token", nil)
+
+				tokenMgr.EXPECT().GenerateRefreshToken(mock.AnythingOfType("string"), "").
+					Once().
+					Return("refresh_token", refreshToken, nil)
+
+				sessionMgr.EXPECT().CreateRefreshToken(ctx, refreshToken).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Linked user is deactivated",
+			mockBehavior: func(
+				ssoMgr *mocks.SSOManager,
+				userMgr *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+			) {
+				ssoMgr.EXPECT().CompleteLogin(ctx, code, state).
+					Once().
+					Return(identity, nil)
+
+				userMgr.EXPECT().GetUserIdentity(ctx, identity.Issuer, identity.Subject).
+					Once().
+					Return(entity.NewUserIdentity(identity, testUser.ID), nil)
+
+				userMgr.EXPECT().GetUserByID(ctx, testUser.ID).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrOIDCLoginRejected,
+		},
+		{
+			name: "Error – Login rejected",
+			mockBehavior: func(
+				ssoMgr *mocks.SSOManager,
+				_ *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+			) {
+				ssoMgr.EXPECT().CompleteLogin(ctx, code, state).
+					Once().
+					Return(entity.OIDCIdentity{}, domain.ErrInvalidOIDCState)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Username not allowed for a new user",
+			mockBehavior: func(
+				ssoMgr *mocks.SSOManager,
+				userMgr *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+			) {
+				reserved := identity
+				reserved.Username = "admin"
+
+				ssoMgr.EXPECT().CompleteLogin(ctx, code, state).
+					Once().
+					Return(reserved, nil)
+
+				userMgr.EXPECT().GetUserIdentity(ctx, identity.Issuer, identity.Subject).
+					Once().
+					Return(entity.UserIdentity{}, domain.ErrUserIdentityNotFound)
+
+				userMgr.EXPECT().GetUserByName(ctx, reserved.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrOIDCUsernameUnavailable,
+		},
+		{
+			name: "Error – User is linked to another account of the provider",
+			mockBehavior: func(
+				ssoMgr *mocks.SSOManager,
+				userMgr *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+			) {
+				ssoMgr.EXPECT().CompleteLogin(ctx, code, state).
+					Once().
+					Return(identity, nil)
+
+				userMgr.EXPECT().GetUserIdentity(ctx, identity.Issuer, identity.Subject).
+					Once().
+					Return(entity.UserIdentity{}, domain.ErrUserIdentityNotFound)
+
+				userMgr.EXPECT().GetUserByName(ctx, identity.Username).
+					Once().
+					Return(ssoUser, nil)
+
+				userMgr.EXPECT().CreateUserIdentity(ctx, mock.AnythingOfType("entity.UserIdentity")).
+					Once().
+					Return(domain.ErrUserIdentityAlreadyExists)
+			},
+			expectedError: domain.ErrUserIdentityAlreadyExists,
+		},
+		{
+			name: "Error – Identity provider is unavailable",
+			mockBehavior: func(
+				ssoMgr *mocks.SSOManager,
+				_ *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+			) {
+				ssoMgr.EXPECT().CompleteLogin(ctx, code, state).
+					Once().
+					Return(entity.OIDCIdentity{}, errors.New("provider error"))
+			},
+			expectedError: domain.ErrFailedToCompleteOIDCLogin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssoMgr := mocks.NewSSOManager(t)
+			userMgr := mocks.NewUserManager(t)
+			sessionMgr := mocks.NewSessionManager(t)
+			tokenMgr := mocks.NewTokenManager(t)
+
+			tt.mockBehavior(ssoMgr, userMgr, sessionMgr, tokenMgr)
+
+			usecase := NewUsecase(
+				logger,
+				mocks.NewIdentityManager(t),
+				userMgr,
+				sessionMgr,
+				tokenMgr,
+				mocks.NewPasswordManager(t),
+				mocks.NewLoginThrottle(t),
+				ssoMgr,
+				nil,
+				mocks.NewTransactionManager(t),
+				RegistrationConfig{},
+			)
+
+			tokens, err := usecase.AuthenticateOIDC(ctx, code, state)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, tokens)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "valid_token", tokens.AccessToken)
+				require.Equal(t, "refresh_token", tokens.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestUsecase_LinkUserIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	adminID := "test-admin-id"
+	userID := "test-user-id"
+	issuer := "https://idp.example.com"
+	subject := "00u1a2b3c4"
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(ssoMgr *mocks.SSOManager, userMgr *mocks.UserManager)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(ssoMgr *mocks.SSOManager, userMgr *mocks.UserManager) {
+				userMgr.EXPECT().GetUserByID(ctx, userID).
+					Once().
+					Return(entity.User{ID: userID, PasswordHash: "hashed_password"}, nil)
+
+				ssoMgr.EXPECT().Issuer().
+					Once().
+					Return(issuer)
+
+				userMgr.EXPECT().CreateUserIdentity(ctx, mock.MatchedBy(func(link entity.UserIdentity) bool {
+					return link.Issuer == issuer && link.Subject == subject && link.UserID == userID
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – User not found",
+			mockBehavior: func(_ *mocks.SSOManager, userMgr *mocks.UserManager) {
+				userMgr.EXPECT().GetUserByID(ctx, userID).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrUserNotFound,
+		},
+		{
+			name: "Error – Already linked",
+			mockBehavior: func(ssoMgr *mocks.SSOManager, userMgr *mocks.UserManager) {
+				userMgr.EXPECT().GetUserByID(ctx, userID).
+					Once().
+					Return(entity.User{ID: userID}, nil)
+
+				ssoMgr.EXPECT().Issuer().
+					Once().
+					Return(issuer)
+
+				userMgr.EXPECT().CreateUserIdentity(ctx, mock.AnythingOfType("entity.UserIdentity")).
+					Once().
+					Return(domain.ErrUserIdentityAlreadyExists)
+			},
+			expectedError: domain.ErrUserIdentityAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			ssoMgr := mocks.NewSSOManager(t)
+			userMgr := mocks.NewUserManager(t)
+
+			identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+				Once().
+				Return(adminID, nil)
+
+			tt.mockBehavior(ssoMgr, userMgr)
+
+			usecase := NewUsecase(
+				slogdiscard.NewDiscardLogger(),
+				identityMgr,
+				userMgr,
+				mocks.NewSessionManager(t),
+				mocks.NewTokenManager(t),
+				mocks.NewPasswordManager(t),
+				mocks.NewLoginThrottle(t),
+				ssoMgr,
+				nil,
+				mocks.NewTransactionManager(t),
+				RegistrationConfig{},
+			)
+
+			err := usecase.LinkUserIdentity(ctx, userID, subject)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_AuthenticateOIDC_NotConfigured(t *testing.T) {
+	usecase := NewUsecase(
+		slogdiscard.NewDiscardLogger(),
+		mocks.NewIdentityManager(t),
+		mocks.NewUserManager(t),
+		mocks.NewSessionManager(t),
+		mocks.NewTokenManager(t),
+		mocks.NewPasswordManager(t),
+		mocks.NewLoginThrottle(t),
+		nil,
+		nil,
+		mocks.NewTransactionManager(t),
+		RegistrationConfig{},
+	)
+
+	_, err := usecase.StartOIDCLogin(context.Background())
+	require.ErrorIs(t, err, domain.ErrOIDCNotConfigured)
+
+	_, err = usecase.AuthenticateOIDC(context.Background(), "test-code", "test-state")
+	require.ErrorIs(t, err, domain.ErrOIDCNotConfigured)
+}
+
+func TestUsecase_Authenticate_SecondFactor(t *testing.T) {
+	ctx := context.Background()
+
+	testUser := entity.User{
+		ID:           "test-user-id",
+		Username:     "testuser",
+		PasswordHash: "hashed_password",
+	}
+
+	testCreds := entity.UserCredentials{
+		Username: "testuser",
+		Password: "password123",
+	}
+
+	clientIP := "192.0.2.1"
+
+	challenge := entity.MFAChallenge{
+		TokenHash: "challenge_token_hash",
+		UserID:    testUser.ID,
+		ExpiresAt: time.Now().Add(5 * time.Minute),
+	}
+
+	userMgr := mocks.NewUserManager(t)
+	passwordMgr := mocks.NewPasswordManager(t)
+	loginThrottle := mocks.NewLoginThrottle(t)
+	twoFactorMgr := mocks.NewTwoFactorManager(t)
+
+	loginThrottle.EXPECT().CheckLogin(ctx, testCreds.Username, clientIP).
+		Once().
+		Return(nil)
+
+	userMgr.EXPECT().GetUserByName(ctx, testCreds.Username).
+		Once().
+		Return(testUser, nil)
+
+	passwordMgr.EXPECT().ValidatePassword(testCreds.Password, testUser.PasswordHash).
+		Once().
+		Return(nil)
+
+	twoFactorMgr.EXPECT().IsEnabled(ctx, testUser.ID).
+		Once().
+		Return(true, nil)
+
+	passwordMgr.EXPECT().PasswordNeedsRehash(testUser.PasswordHash).
+		Once().
+		Return(false)
+
+	twoFactorMgr.EXPECT().CreateChallenge(ctx, testUser.ID).
+		Once().
+		Return("challenge_token", challenge, nil)
+
+	// Neither tokens are issued nor login failures are reset until the second factor is verified
+	usecase := NewUsecase(
+		slogdiscard.NewDiscardLogger(),
+		mocks.NewIdentityManager(t),
+		userMgr,
+		mocks.NewSessionManager(t),
+		mocks.NewTokenManager(t),
+		passwordMgr,
+		loginThrottle,
+		nil,
+		twoFactorMgr,
+		mocks.NewTransactionManager(t),
+		RegistrationConfig{},
+	)
+
+	tokens, err := usecase.Authenticate(ctx, testCreds, clientIP)
+	require.ErrorIs(t, err, domain.ErrSecondFactorRequired)
+	require.Empty(t, tokens)
+
+	var secondFactorErr *domain.SecondFactorRequiredError
+	require.ErrorAs(t, err, &secondFactorErr)
+	require.Equal(t, "challenge_token", secondFactorErr.ChallengeToken)
+	require.Equal(t, challenge.ExpiresAt, secondFactorErr.ExpiresAt)
+}
+
+func TestUsecase_AuthenticateOIDC_SecondFactor(t *testing.T) {
+	ctx := context.Background()
+
+	code := "test-code"
+	state := "test-state"
+
+	identity := entity.OIDCIdentity{
+		Issuer:   "https://idp.example.com",
+		Subject:  "00u1a2b3c4",
+		Username: "john.doe",
+	}
+
+	testUser := entity.User{
+		ID:       "test-user-id",
+		Username: "john.doe",
+		Role:     entity.RoleEmployee,
+	}
+
+	challenge := entity.MFAChallenge{
+		TokenHash: "challenge_token_hash",
+		UserID:    testUser.ID,
+		ExpiresAt: time.Now().Add(5 * time.Minute),
+	}
+
+	ssoMgr := mocks.NewSSOManager(t)
+	userMgr := mocks.NewUserManager(t)
+	twoFactorMgr := mocks.NewTwoFactorManager(t)
+
+	ssoMgr.EXPECT().CompleteLogin(ctx, code, state).
+		Once().
+		Return(identity, nil)
+
+	userMgr.EXPECT().GetUserIdentity(ctx, identity.Issuer, identity.Subject).
+		Once().
+		Return(entity.NewUserIdentity(identity, testUser.ID), nil)
+
+	userMgr.EXPECT().GetUserByID(ctx, testUser.ID).
+		Once().
+		Return(testUser, nil)
+
+	twoFactorMgr.EXPECT().IsEnabled(ctx, testUser.ID).
+		Once().
+		Return(true, nil)
+
+	twoFactorMgr.EXPECT().CreateChallenge(ctx, testUser.ID).
+		Once().
+		Return("challenge_token", challenge, nil)
+
+	// The identity provider doesn't replace the second factor, no tokens are issued
+	usecase := NewUsecase(
+		slogdiscard.NewDiscardLogger(),
+		mocks.NewIdentityManager(t),
+		userMgr,
+		mocks.NewSessionManager(t),
+		mocks.NewTokenManager(t),
+		mocks.NewPasswordManager(t),
+		mocks.NewLoginThrottle(t),
+		ssoMgr,
+		twoFactorMgr,
+		mocks.NewTransactionManager(t),
+		RegistrationConfig{},
+	)
+
+	tokens, err := usecase.AuthenticateOIDC(ctx, code, state)
+	require.ErrorIs(t, err, domain.ErrSecondFactorRequired)
+	require.Empty(t, tokens)
+
+	var secondFactorErr *domain.SecondFactorRequiredError
+	require.ErrorAs(t, err, &secondFactorErr)
+	require.Equal(t, "challenge_token", secondFactorErr.ChallengeToken)
+}
+
+func TestUsecase_CompleteSecondFactor(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	testUser := entity.User{
+		ID:       "test-user-id",
+		Username: "testuser",
+	}
+
+	clientIP := "192.0.2.1"
+	challengeToken := "challenge_token"
+	code := "123456"
+
+	challenge := entity.MFAChallenge{
+		TokenHash: "challenge_token_hash",
+		UserID:    testUser.ID,
+		ExpiresAt: time.Now().Add(5 * time.Minute),
+	}
+
+	refreshToken := entity.RefreshToken{
+		ID:        "test-refresh-token-id",
+		UserID:    "test-user-id",
+		FamilyID:  "test-refresh-token-id",
+		TokenHash: "refresh_token_hash",
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(
+			userMgr *mocks.UserManager,
+			sessionMgr *mocks.SessionManager,
+			tokenMgr *mocks.TokenManager,
+			loginThrottle *mocks.LoginThrottle,
+			twoFactorMgr *mocks.TwoFactorManager,
+		)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+				loginThrottle *mocks.LoginThrottle,
+				twoFactorMgr *mocks.TwoFactorManager,
+			) {
+				twoFactorMgr.EXPECT().GetChallenge(ctx, challengeToken).
+					Once().
+					Return(challenge, nil)
+
+				userMgr.EXPECT().GetUserByID(ctx, testUser.ID).
+					Once().
+					Return(testUser, nil)
+
+				loginThrottle.EXPECT().CheckLogin(ctx, testUser.Username, clientIP).
+					Once().
+					Return(nil)
+
+				twoFactorMgr.EXPECT().VerifySecondFactor(ctx, testUser.ID, code).
+					Once().
+					Return(nil)
+
+				twoFactorMgr.EXPECT().ConsumeChallenge(ctx, challengeToken).
+					Once().
+					Return(nil)
+
+				loginThrottle.EXPECT().ResetLoginFailures(ctx, testUser.Username).
+					Once().
+					Return(nil)
+
+				tokenMgr.EXPECT().GenerateToken(testUser.ID, testUser.Role).
+					Once().
+					Return("valid_token", nil)
+
+				tokenMgr.EXPECT().GenerateRefreshToken(testUser.ID, "").
+					Once().
+					Return("refresh_token", refreshToken, nil)
+
+				sessionMgr.EXPECT().CreateRefreshToken(ctx, refreshToken).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Invalid or expired challenge",
+			mockBehavior: func(
+				_ *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+				_ *mocks.LoginThrottle,
+				twoFactorMgr *mocks.TwoFactorManager,
+			) {
+				twoFactorMgr.EXPECT().GetChallenge(ctx, challengeToken).
+					Once().
+					Return(entity.MFAChallenge{}, domain.ErrInvalidMFAToken)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Login throttled",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+				loginThrottle *mocks.LoginThrottle,
+				twoFactorMgr *mocks.TwoFactorManager,
+			) {
+				twoFactorMgr.EXPECT().GetChallenge(ctx, challengeToken).
+					Once().
+					Return(challenge, nil)
+
+				userMgr.EXPECT().GetUserByID(ctx, testUser.ID).
+					Once().
+					Return(testUser, nil)
+
+				loginThrottle.EXPECT().CheckLogin(ctx, testUser.Username, clientIP).
+					Once().
+					Return(&domain.LoginThrottledError{
+						Err:        domain.ErrTooManyLoginAttempts,
+						RetryAfter: time.Minute,
+					})
+			},
+			expectedError: domain.ErrTooManyLoginAttempts,
+		},
+		{
+			name: "Error – Invalid code",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+				loginThrottle *mocks.LoginThrottle,
+				twoFactorMgr *mocks.TwoFactorManager,
+			) {
+				twoFactorMgr.EXPECT().GetChallenge(ctx, challengeToken).
+					Once().
+					Return(challenge, nil)
+
+				userMgr.EXPECT().GetUserByID(ctx, testUser.ID).
+					Once().
+					Return(testUser, nil)
+
+				loginThrottle.EXPECT().CheckLogin(ctx, testUser.Username, clientIP).
+					Once().
+					Return(nil)
+
+				twoFactorMgr.EXPECT().VerifySecondFactor(ctx, testUser.ID, code).
+					Once().
+					Return(domain.ErrInvalidTOTPCode)
+
+				loginThrottle.EXPECT().RecordLoginFailure(ctx, testUser.Username, clientIP).
+					Once().
+					Return(nil, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Challenge used by a concurrent request",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+				loginThrottle *mocks.LoginThrottle,
+				twoFactorMgr *mocks.TwoFactorManager,
+			) {
+				twoFactorMgr.EXPECT().GetChallenge(ctx, challengeToken).
+					Once().
+					Return(challenge, nil)
+
+				userMgr.EXPECT().GetUserByID(ctx, testUser.ID).
+					Once().
+					Return(testUser, nil)
+
+				loginThrottle.EXPECT().CheckLogin(ctx, testUser.Username, clientIP).
+					Once().
+					Return(nil)
+
+				twoFactorMgr.EXPECT().VerifySecondFactor(ctx, testUser.ID, code).
+					Once().
+					Return(nil)
+
+				twoFactorMgr.EXPECT().ConsumeChallenge(ctx, challengeToken).
+					Once().
+					Return(domain.ErrInvalidMFAToken)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Failed to verify code",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				_ *mocks.SessionManager,
+				_ *mocks.TokenManager,
+				loginThrottle *mocks.LoginThrottle,
+				twoFactorMgr *mocks.TwoFactorManager,
+			) {
+				twoFactorMgr.EXPECT().GetChallenge(ctx, challengeToken).
+					Once().
+					Return(challenge, nil)
+
+				userMgr.EXPECT().GetUserByID(ctx, testUser.ID).
+					Once().
+					Return(testUser, nil)
+
+				loginThrottle.EXPECT().CheckLogin(ctx, testUser.Username, clientIP).
+					Once().
+					Return(nil)
+
+				twoFactorMgr.EXPECT().VerifySecondFactor(ctx, testUser.ID, code).
+					Once().
+					Return(errors.New("storage error"))
+			},
+			expectedError: domain.ErrFailedToVerifySecondFactor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userMgr := mocks.NewUserManager(t)
+			sessionMgr := mocks.NewSessionManager(t)
+			tokenMgr := mocks.NewTokenManager(t)
+			loginThrottle := mocks.NewLoginThrottle(t)
+			twoFactorMgr := mocks.NewTwoFactorManager(t)
+
+			tt.mockBehavior(userMgr, sessionMgr, tokenMgr, loginThrottle, twoFactorMgr)
+
+			usecase := NewUsecase(
+				logger,
+				mocks.NewIdentityManager(t),
+				userMgr,
+				sessionMgr,
+				tokenMgr,
+				mocks.NewPasswordManager(t),
+				loginThrottle,
+				nil,
+				twoFactorMgr,
+				mocks.NewTransactionManager(t),
+				RegistrationConfig{},
+			)
+
+			tokens, err := usecase.CompleteSecondFactor(ctx, challengeToken, code, clientIP)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, tokens)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "valid_token", tokens.AccessToken)
+				require.Equal(t, "refresh_token", tokens.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestUsecase_Refresh(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	plainToken := "refresh_token"
+	tokenHash := "refresh_token_hash"
+
+	current := entity.RefreshToken{
+		ID:        "test-refresh-token-id",
+		UserID:    "test-user-id",
+		FamilyID:  "test-family-id",
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(time.Hour),
+		CreatedAt: time.Now().Add(-time.Hour),
+	}
+
+	next := entity.RefreshToken{
+		ID:        "next-refresh-token-id",
+		UserID:    current.UserID,
+		FamilyID:  current.FamilyID,
+		TokenHash: "next_refresh_token_hash",
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(
+			userMgr *mocks.UserManager,
+			sessionMgr *mocks.SessionManager,
+			tokenMgr *mocks.TokenManager,
+		)
+		expectedTokens entity.TokenPair
+		expectedError  error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				sessionMgr.EXPECT().LockRefreshToken(ctx, tokenHash).
+					Once().
+					Return(current, nil)
+
+				userMgr.EXPECT().GetUserRole(ctx, current.UserID).
+					Once().
+					Return(entity.RoleEmployee, nil)
+
+				tokenMgr.EXPECT().GenerateToken(current.UserID, entity.RoleEmployee).
+					Once().
+					Return("new_access_token", nil)
+
+				tokenMgr.EXPECT().GenerateRefreshToken(current.UserID, current.FamilyID).
+					Once().
+					Return("next_refresh_token", next, nil)
+
+				sessionMgr.EXPECT().RotateRefreshToken(ctx, current.ID, next).
+					Once().
+					Return(nil)
+			},
+			expectedTokens: entity.TokenPair{
+				AccessToken:  "new_access_token",
+				RefreshToken: "next_refresh_token",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Unknown refresh token",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				sessionMgr.EXPECT().LockRefreshToken(ctx, tokenHash).
+					Once().
+					Return(entity.RefreshToken{}, domain.ErrRefreshTokenNotFound)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Reused refresh token revokes the family",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				rotated := current
+				rotated.RotatedAt = time.Now().Add(-time.Minute)
+
+				sessionMgr.EXPECT().LockRefreshToken(ctx, tokenHash).
+					Once().
+					Return(rotated, nil)
+
+				sessionMgr.EXPECT().RevokeRefreshTokenFamily(ctx, current.FamilyID).
+					Once().
+					Return(nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Revoked refresh token",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				revoked := current
+				revoked.RevokedAt = time.Now().Add(-time.Minute)
+
+				sessionMgr.EXPECT().LockRefreshToken(ctx, tokenHash).
+					Once().
+					Return(revoked, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Expired refresh token",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				expired := current
+				expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+				sessionMgr.EXPECT().LockRefreshToken(ctx, tokenHash).
+					Once().
+					Return(expired, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Failed to rotate refresh token",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				sessionMgr.EXPECT().LockRefreshToken(ctx, tokenHash).
+					Once().
+					Return(current, nil)
+
+				userMgr.EXPECT().GetUserRole(ctx, current.UserID).
+					Once().
+					Return(entity.RoleEmployee, nil)
+
+				tokenMgr.EXPECT().GenerateToken(current.UserID, entity.RoleEmployee).
+					Once().
+					Return("new_access_token", nil)
+
+				tokenMgr.EXPECT().GenerateRefreshToken(current.UserID, current.FamilyID).
+					Once().
+					Return("next_refresh_token", next, nil)
+
+				sessionMgr.EXPECT().RotateRefreshToken(ctx, current.ID, next).
+					Once().
+					Return(errors.New("session manager error"))
+			},
+			expectedError: domain.ErrFailedToRefreshTokens,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			sessionMgr := mocks.NewSessionManager(t)
+			tokenMgr := mocks.NewTokenManager(t)
+			passwordMgr := mocks.NewPasswordManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tokenMgr.EXPECT().HashRefreshToken(plainToken).
+				Once().
+				Return(tokenHash)
+
+			txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+
+			tt.mockBehavior(userMgr, sessionMgr, tokenMgr)
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, sessionMgr, tokenMgr, passwordMgr, mocks.NewLoginThrottle(t), nil, nil, txMgr, RegistrationConfig{})
+			tokens, err := usecase.Refresh(ctx, plainToken)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, tokens)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedTokens, tokens)
+			}
+		})
+	}
+}
+
+func TestUsecase_Logout(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	accessToken := entity.AccessToken{
+		ID:        "test-token-id",
+		UserID:    "test-user-id",
+		IssuedAt:  time.Now().Add(-time.Minute),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name         string
+		refreshToken string
+		mockBehavior func(
+			identityMgr *mocks.IdentityManager,
+			sessionMgr *mocks.SessionManager,
+			tokenMgr *mocks.TokenManager,
+		)
+		expectedError error
+	}{
+		{
+			name:         "Success – Access token only",
+			refreshToken: "",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				identityMgr.EXPECT().ExtractAccessTokenFromContext(ctx).
+					Once().
+					Return(accessToken, nil)
+
+				sessionMgr.EXPECT().RevokeAccessToken(ctx, accessToken).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:         "Success – With refresh token",
+			refreshToken: "refresh_token",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				identityMgr.EXPECT().ExtractAccessTokenFromContext(ctx).
+					Once().
+					Return(accessToken, nil)
+
+				sessionMgr.EXPECT().RevokeAccessToken(ctx, accessToken).
+					Once().
+					Return(nil)
+
+				tokenMgr.EXPECT().HashRefreshToken("refresh_token").
+					Once().
+					Return("refresh_token_hash")
+
+				sessionMgr.EXPECT().RevokeRefreshTokenFamilyByToken(ctx, accessToken.UserID, "refresh_token_hash").
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:         "Error – Access token not found in context",
+			refreshToken: "",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				identityMgr.EXPECT().ExtractAccessTokenFromContext(ctx).
+					Once().
+					Return(entity.AccessToken{}, domain.ErrAccessTokenNotFoundInContext)
+			},
+			expectedError: domain.ErrAccessTokenNotFoundInContext,
+		},
+		{
+			name:         "Error – Failed to revoke access token",
+			refreshToken: "refresh_token",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				sessionMgr *mocks.SessionManager,
+				tokenMgr *mocks.TokenManager,
+			) {
+				identityMgr.EXPECT().ExtractAccessTokenFromContext(ctx).
+					Once().
+					Return(accessToken, nil)
+
+				sessionMgr.EXPECT().RevokeAccessToken(ctx, accessToken).
+					Once().
+					Return(errors.New("session manager error"))
+			},
+			expectedError: domain.ErrFailedToLogout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			sessionMgr := mocks.NewSessionManager(t)
+			tokenMgr := mocks.NewTokenManager(t)
+			passwordMgr := mocks.NewPasswordManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tt.mockBehavior(identityMgr, sessionMgr, tokenMgr)
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, sessionMgr, tokenMgr, passwordMgr, mocks.NewLoginThrottle(t), nil, nil, txMgr, RegistrationConfig{})
+			err := usecase.Logout(ctx, tt.refreshToken)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_RevokeAllSessions(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	userID := "test-user-id"
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(sessionMgr *mocks.SessionManager)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(sessionMgr *mocks.SessionManager) {
+				sessionMgr.EXPECT().RevokeUserSessions(ctx, userID).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – User not found",
+			mockBehavior: func(sessionMgr *mocks.SessionManager) {
+				sessionMgr.EXPECT().RevokeUserSessions(ctx, userID).
+					Once().
+					Return(domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrUserNotFound,
+		},
+		{
+			name: "Error – Failed to revoke sessions",
+			mockBehavior: func(sessionMgr *mocks.SessionManager) {
+				sessionMgr.EXPECT().RevokeUserSessions(ctx, userID).
+					Once().
+					Return(errors.New("session manager error"))
+			},
+			expectedError: domain.ErrFailedToRevokeSessions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			sessionMgr := mocks.NewSessionManager(t)
+			tokenMgr := mocks.NewTokenManager(t)
+			passwordMgr := mocks.NewPasswordManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+
+			tt.mockBehavior(sessionMgr)
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, sessionMgr, tokenMgr, passwordMgr, mocks.NewLoginThrottle(t), nil, nil, txMgr, RegistrationConfig{})
+			err := usecase.RevokeAllSessions(ctx, userID)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_SetUserRole(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	adminID := "test-admin-id"
+	userID := "test-user-id"
+
+	tests := []struct {
+		name         string
+		userID       string
+		role         entity.Role
+		mockBehavior func(
+			identityMgr *mocks.IdentityManager,
+			userMgr *mocks.UserManager,
+			sessionMgr *mocks.SessionManager,
+			txMgr *mocks.TransactionManager,
+		)
+		expectedError error
+	}{
+		{
+			name:   "Success",
+			userID: userID,
+			role:   entity.RoleStoreAdmin,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				userMgr.EXPECT().SetUserRole(ctx, userID, entity.RoleStoreAdmin).
+					Once().
+					Return(nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				sessionMgr.EXPECT().RevokeUserSessions(ctx, userID).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Error – Invalid role",
+			userID: userID,
+			role:   entity.Role("owner"),
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				txMgr *mocks.TransactionManager,
+			) {
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:   "Error – Own role",
+			userID: adminID,
+			role:   entity.RoleEmployee,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+			},
+			expectedError: domain.ErrCannotChangeOwnRole,
+		},
+		{
+			name:   "Error – User not found",
+			userID: userID,
+			role:   entity.RoleFinance,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				sessionMgr *mocks.SessionManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				userMgr.EXPECT().SetUserRole(ctx, userID, entity.RoleFinance).
+					Once().
+					Return(domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			sessionMgr := mocks.NewSessionManager(t)
+			tokenMgr := mocks.NewTokenManager(t)
+			passwordMgr := mocks.NewPasswordManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tt.mockBehavior(identityMgr, userMgr, sessionMgr, txMgr)
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, sessionMgr, tokenMgr, passwordMgr, mocks.NewLoginThrottle(t), nil, nil, txMgr, RegistrationConfig{})
+			err := usecase.SetUserRole(ctx, tt.userID, tt.role)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_UnlockUser(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	adminID := "test-admin-id"
+
+	user := entity.User{
+		ID:       "test-user-id",
+		Username: "testuser",
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(
+			userMgr *mocks.UserManager,
+			loginThrottle *mocks.LoginThrottle,
+		)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				loginThrottle *mocks.LoginThrottle,
+			) {
+				userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				loginThrottle.EXPECT().UnlockUsername(ctx, user.Username).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – User not found",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				loginThrottle *mocks.LoginThrottle,
+			) {
+				userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrUserNotFound,
+		},
+		{
+			name: "Error – Failed to unlock",
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				loginThrottle *mocks.LoginThrottle,
+			) {
+				userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				loginThrottle.EXPECT().UnlockUsername(ctx, user.Username).
+					Once().
+					Return(errors.New("lockout storage error"))
+			},
+			expectedError: domain.ErrFailedToUnlockUser,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			loginThrottle := mocks.NewLoginThrottle(t)
+
+			identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+				Once().
+				Return(adminID, nil)
+
+			tt.mockBehavior(userMgr, loginThrottle)
+
+			usecase := NewUsecase(
+				logger,
+				identityMgr,
+				userMgr,
+				mocks.NewSessionManager(t),
+				mocks.NewTokenManager(t),
+				mocks.NewPasswordManager(t),
+				loginThrottle,
+				nil,
+				nil,
+				mocks.NewTransactionManager(t),
+				RegistrationConfig{},
+			)
+
+			err := usecase.UnlockUser(ctx, user.ID)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_BootstrapSuperadmin(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	credentials := entity.UserCredentials{
+		Username: "admin",
+		Password: "password",
+	}
+
+	existingUser := entity.User{
+		ID:           "test-user-id",
+		Username:     credentials.Username,
+		PasswordHash: "password_hash",
+		Role:         entity.RoleEmployee,
+	}
+
+	tests := []struct {
+		name            string
+		credentials     entity.UserCredentials
+		promoteExisting bool
+		mockBehavior    func(
+			userMgr *mocks.UserManager,
+			passwordMgr *mocks.PasswordManager,
+		)
+		expectedError error
+	}{
+		{
+			name:            "Success – Promote existing user",
+			credentials:     credentials,
+			promoteExisting: true,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().UserWithRoleExists(ctx, entity.RoleSuperadmin).
+					Once().
+					Return(false, nil)
+
+				userMgr.EXPECT().GetUserByName(ctx, credentials.Username).
+					Once().
+					Return(existingUser, nil)
+
+				passwordMgr.EXPECT().ValidatePassword(credentials.Password, existingUser.PasswordHash).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().SetUserRole(ctx, existingUser.ID, entity.RoleSuperadmin).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:        "Success – Create new user",
+			credentials: credentials,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().UserWithRoleExists(ctx, entity.RoleSuperadmin).
+					Once().
+					Return(false, nil)
+
+				userMgr.EXPECT().GetUserByName(ctx, credentials.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+
+				passwordMgr.EXPECT().CheckPasswordPolicy(credentials.Username, credentials.Password).
+					Once().
+					Return(nil)
+
+				passwordMgr.EXPECT().PasswordHash(credentials.Password).
+					Once().
+					Return("password_hash", nil)
+
+				userMgr.EXPECT().CreateUser(ctx, mock.AnythingOfType("entity.User")).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().SetUserRole(ctx, mock.AnythingOfType("string"), entity.RoleSuperadmin).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:        "Error – Superadmin already exists",
+			credentials: credentials,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().UserWithRoleExists(ctx, entity.RoleSuperadmin).
+					Once().
+					Return(true, nil)
+			},
+			expectedError: domain.ErrSuperadminAlreadyExists,
+		},
+		{
+			name:        "Error – Existing non-admin user",
+			credentials: credentials,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().UserWithRoleExists(ctx, entity.RoleSuperadmin).
+					Once().
+					Return(false, nil)
+
+				userMgr.EXPECT().GetUserByName(ctx, credentials.Username).
+					Once().
+					Return(existingUser, nil)
+			},
+			expectedError: domain.ErrBootstrapUserExists,
+		},
+		{
+			name:            "Error – Promote existing user with wrong password",
+			credentials:     credentials,
+			promoteExisting: true,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().UserWithRoleExists(ctx, entity.RoleSuperadmin).
+					Once().
+					Return(false, nil)
+
+				userMgr.EXPECT().GetUserByName(ctx, credentials.Username).
+					Once().
+					Return(existingUser, nil)
+
+				passwordMgr.EXPECT().ValidatePassword(credentials.Password, existingUser.PasswordHash).
+					Once().
+					Return(domain.ErrInvalidPassword)
+			},
+			expectedError: domain.ErrInvalidPassword,
+		},
+		{
+			name:            "Error – Promote existing user without password",
+			credentials:     entity.UserCredentials{Username: credentials.Username},
+			promoteExisting: true,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().UserWithRoleExists(ctx, entity.RoleSuperadmin).
+					Once().
+					Return(false, nil)
+
+				userMgr.EXPECT().GetUserByName(ctx, credentials.Username).
+					Once().
+					Return(existingUser, nil)
+			},
+			expectedError: domain.ErrFailedToBootstrapSuperadmin,
+		},
+		{
+			name:        "Error – New user without password",
+			credentials: entity.UserCredentials{Username: credentials.Username},
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				passwordMgr *mocks.PasswordManager,
+			) {
+				userMgr.EXPECT().UserWithRoleExists(ctx, entity.RoleSuperadmin).
+					Once().
+					Return(false, nil)
+
+				userMgr.EXPECT().GetUserByName(ctx, credentials.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrFailedToBootstrapSuperadmin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
 			userMgr := mocks.NewUserManager(t)
+			sessionMgr := mocks.NewSessionManager(t)
 			tokenMgr := mocks.NewTokenManager(t)
 			passwordMgr := mocks.NewPasswordManager(t)
+			txMgr := mocks.NewTransactionManager(t)
 
-			tt.mockBehavior(userMgr, tokenMgr, passwordMgr)
+			tt.mockBehavior(userMgr, passwordMgr)
 
-			usecase := NewUsecase(logger, userMgr, tokenMgr, passwordMgr)
-			token, err := usecase.Authenticate(ctx, tt.credentials)
+			usecase := NewUsecase(logger, identityMgr, userMgr, sessionMgr, tokenMgr, passwordMgr, mocks.NewLoginThrottle(t), nil, nil, txMgr, RegistrationConfig{})
+			err := usecase.BootstrapSuperadmin(ctx, tt.credentials, tt.promoteExisting)
 
 			if tt.expectedError != nil {
 				require.Error(t, err)
 				require.ErrorIs(t, err, tt.expectedError)
-				require.Empty(t, token)
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tt.expectedToken, token)
 			}
 		})
 	}
@@ -17,6 +17,53 @@ func (_m *PasswordManager) EXPECT() *PasswordManager_Expecter {
 	return &PasswordManager_Expecter{mock: &_m.Mock}
 }
 
+// CheckPasswordPolicy provides a mock function with given fields: username, password
+func (_m *PasswordManager) CheckPasswordPolicy(username string, password string) error {
+	ret := _m.Called(username, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckPasswordPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(username, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PasswordManager_CheckPasswordPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPasswordPolicy'
+type PasswordManager_CheckPasswordPolicy_Call struct {
+	*mock.Call
+}
+
+// CheckPasswordPolicy is a helper method to define mock.On call
+//   - username string
+//   - password string
+func (_e *PasswordManager_Expecter) CheckPasswordPolicy(username interface{}, password interface{}) *PasswordManager_CheckPasswordPolicy_Call {
+	return &PasswordManager_CheckPasswordPolicy_Call{Call: _e.mock.On("CheckPasswordPolicy", username, password)}
+}
+
+func (_c *PasswordManager_CheckPasswordPolicy_Call) Run(run func(username string, password string)) *PasswordManager_CheckPasswordPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PasswordManager_CheckPasswordPolicy_Call) Return(_a0 error) *PasswordManager_CheckPasswordPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PasswordManager_CheckPasswordPolicy_Call) RunAndReturn(run func(string, string) error) *PasswordManager_CheckPasswordPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PasswordHash provides a mock function with given fields: password
 func (_m *PasswordManager) PasswordHash(password string) (string, error) {
 	ret := _m.Called(password)
@@ -73,6 +120,52 @@ func (_c *PasswordManager_PasswordHash_Call) RunAndReturn(run func(string) (stri
 	return _c
 }
 
+// PasswordNeedsRehash provides a mock function with given fields: passwordHash
+func (_m *PasswordManager) PasswordNeedsRehash(passwordHash string) bool {
+	ret := _m.Called(passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PasswordNeedsRehash")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(passwordHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// PasswordManager_PasswordNeedsRehash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PasswordNeedsRehash'
+type PasswordManager_PasswordNeedsRehash_Call struct {
+	*mock.Call
+}
+
+// PasswordNeedsRehash is a helper method to define mock.On call
+//   - passwordHash string
+func (_e *PasswordManager_Expecter) PasswordNeedsRehash(passwordHash interface{}) *PasswordManager_PasswordNeedsRehash_Call {
+	return &PasswordManager_PasswordNeedsRehash_Call{Call: _e.mock.On("PasswordNeedsRehash", passwordHash)}
+}
+
+func (_c *PasswordManager_PasswordNeedsRehash_Call) Run(run func(passwordHash string)) *PasswordManager_PasswordNeedsRehash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PasswordManager_PasswordNeedsRehash_Call) Return(_a0 bool) *PasswordManager_PasswordNeedsRehash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PasswordManager_PasswordNeedsRehash_Call) RunAndReturn(run func(string) bool) *PasswordManager_PasswordNeedsRehash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidatePassword provides a mock function with given fields: providedPassword, passwordHash
 func (_m *PasswordManager) ValidatePassword(providedPassword string, passwordHash string) error {
 	ret := _m.Called(providedPassword, passwordHash)
@@ -2,7 +2,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	entity "github.com/rshelekhov/merch-store/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // TokenManager is an autogenerated mock type for the TokenManager type
 type TokenManager struct {
@@ -17,9 +20,73 @@ func (_m *TokenManager) EXPECT() *TokenManager_Expecter {
 	return &TokenManager_Expecter{mock: &_m.Mock}
 }
 
-// GenerateToken provides a mock function with given fields: userID
-func (_m *TokenManager) GenerateToken(userID string) (string, error) {
-	ret := _m.Called(userID)
+// GenerateRefreshToken provides a mock function with given fields: userID, familyID
+func (_m *TokenManager) GenerateRefreshToken(userID string, familyID string) (string, entity.RefreshToken, error) {
+	ret := _m.Called(userID, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateRefreshToken")
+	}
+
+	var r0 string
+	var r1 entity.RefreshToken
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string) (string, entity.RefreshToken, error)); ok {
+		return rf(userID, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(userID, familyID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) entity.RefreshToken); ok {
+		r1 = rf(userID, familyID)
+	} else {
+		r1 = ret.Get(1).(entity.RefreshToken)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string) error); ok {
+		r2 = rf(userID, familyID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// TokenManager_GenerateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateRefreshToken'
+type TokenManager_GenerateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// GenerateRefreshToken is a helper method to define mock.On call
+//   - userID string
+//   - familyID string
+func (_e *TokenManager_Expecter) GenerateRefreshToken(userID interface{}, familyID interface{}) *TokenManager_GenerateRefreshToken_Call {
+	return &TokenManager_GenerateRefreshToken_Call{Call: _e.mock.On("GenerateRefreshToken", userID, familyID)}
+}
+
+func (_c *TokenManager_GenerateRefreshToken_Call) Run(run func(userID string, familyID string)) *TokenManager_GenerateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TokenManager_GenerateRefreshToken_Call) Return(_a0 string, _a1 entity.RefreshToken, _a2 error) *TokenManager_GenerateRefreshToken_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *TokenManager_GenerateRefreshToken_Call) RunAndReturn(run func(string, string) (string, entity.RefreshToken, error)) *TokenManager_GenerateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateToken provides a mock function with given fields: userID, role
+func (_m *TokenManager) GenerateToken(userID string, role entity.Role) (string, error) {
+	ret := _m.Called(userID, role)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateToken")
@@ -27,17 +94,17 @@ func (_m *TokenManager) GenerateToken(userID string) (string, error) {
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(string, entity.Role) (string, error)); ok {
+		return rf(userID, role)
 	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(string, entity.Role) string); ok {
+		r0 = rf(userID, role)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(string, entity.Role) error); ok {
+		r1 = rf(userID, role)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,13 +119,14 @@ type TokenManager_GenerateToken_Call struct {
 
 // GenerateToken is a helper method to define mock.On call
 //   - userID string
-func (_e *TokenManager_Expecter) GenerateToken(userID interface{}) *TokenManager_GenerateToken_Call {
-	return &TokenManager_GenerateToken_Call{Call: _e.mock.On("GenerateToken", userID)}
+//   - role entity.Role
+func (_e *TokenManager_Expecter) GenerateToken(userID interface{}, role interface{}) *TokenManager_GenerateToken_Call {
+	return &TokenManager_GenerateToken_Call{Call: _e.mock.On("GenerateToken", userID, role)}
 }
 
-func (_c *TokenManager_GenerateToken_Call) Run(run func(userID string)) *TokenManager_GenerateToken_Call {
+func (_c *TokenManager_GenerateToken_Call) Run(run func(userID string, role entity.Role)) *TokenManager_GenerateToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(entity.Role))
 	})
 	return _c
 }
@@ -68,7 +136,53 @@ func (_c *TokenManager_GenerateToken_Call) Return(_a0 string, _a1 error) *TokenM
 	return _c
 }
 
-func (_c *TokenManager_GenerateToken_Call) RunAndReturn(run func(string) (string, error)) *TokenManager_GenerateToken_Call {
+func (_c *TokenManager_GenerateToken_Call) RunAndReturn(run func(string, entity.Role) (string, error)) *TokenManager_GenerateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HashRefreshToken provides a mock function with given fields: token
+func (_m *TokenManager) HashRefreshToken(token string) string {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HashRefreshToken")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TokenManager_HashRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HashRefreshToken'
+type TokenManager_HashRefreshToken_Call struct {
+	*mock.Call
+}
+
+// HashRefreshToken is a helper method to define mock.On call
+//   - token string
+func (_e *TokenManager_Expecter) HashRefreshToken(token interface{}) *TokenManager_HashRefreshToken_Call {
+	return &TokenManager_HashRefreshToken_Call{Call: _e.mock.On("HashRefreshToken", token)}
+}
+
+func (_c *TokenManager_HashRefreshToken_Call) Run(run func(token string)) *TokenManager_HashRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TokenManager_HashRefreshToken_Call) Return(_a0 string) *TokenManager_HashRefreshToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenManager_HashRefreshToken_Call) RunAndReturn(run func(string) string) *TokenManager_HashRefreshToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -69,6 +69,110 @@ func (_c *UserManager_CreateUser_Call) RunAndReturn(run func(context.Context, en
 	return _c
 }
 
+// CreateUserIdentity provides a mock function with given fields: ctx, identity
+func (_m *UserManager) CreateUserIdentity(ctx context.Context, identity entity.UserIdentity) error {
+	ret := _m.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.UserIdentity) error); ok {
+		r0 = rf(ctx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserManager_CreateUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserIdentity'
+type UserManager_CreateUserIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity entity.UserIdentity
+func (_e *UserManager_Expecter) CreateUserIdentity(ctx interface{}, identity interface{}) *UserManager_CreateUserIdentity_Call {
+	return &UserManager_CreateUserIdentity_Call{Call: _e.mock.On("CreateUserIdentity", ctx, identity)}
+}
+
+func (_c *UserManager_CreateUserIdentity_Call) Run(run func(ctx context.Context, identity entity.UserIdentity)) *UserManager_CreateUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.UserIdentity))
+	})
+	return _c
+}
+
+func (_c *UserManager_CreateUserIdentity_Call) Return(_a0 error) *UserManager_CreateUserIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserManager_CreateUserIdentity_Call) RunAndReturn(run func(context.Context, entity.UserIdentity) error) *UserManager_CreateUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function with given fields: ctx, userID
+func (_m *UserManager) GetUserByID(ctx context.Context, userID string) (entity.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.User, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(entity.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserManager_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type UserManager_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserManager_Expecter) GetUserByID(ctx interface{}, userID interface{}) *UserManager_GetUserByID_Call {
+	return &UserManager_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, userID)}
+}
+
+func (_c *UserManager_GetUserByID_Call) Run(run func(ctx context.Context, userID string)) *UserManager_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserManager_GetUserByID_Call) Return(_a0 entity.User, _a1 error) *UserManager_GetUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserManager_GetUserByID_Call) RunAndReturn(run func(context.Context, string) (entity.User, error)) *UserManager_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByName provides a mock function with given fields: ctx, username
 func (_m *UserManager) GetUserByName(ctx context.Context, username string) (entity.User, error) {
 	ret := _m.Called(ctx, username)
@@ -126,6 +230,285 @@ func (_c *UserManager_GetUserByName_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetUserIdentity provides a mock function with given fields: ctx, issuer, subject
+func (_m *UserManager) GetUserIdentity(ctx context.Context, issuer string, subject string) (entity.UserIdentity, error) {
+	ret := _m.Called(ctx, issuer, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserIdentity")
+	}
+
+	var r0 entity.UserIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (entity.UserIdentity, error)); ok {
+		return rf(ctx, issuer, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) entity.UserIdentity); ok {
+		r0 = rf(ctx, issuer, subject)
+	} else {
+		r0 = ret.Get(0).(entity.UserIdentity)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, issuer, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserManager_GetUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentity'
+type UserManager_GetUserIdentity_Call struct {
+	*mock.Call
+}
+
+// GetUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issuer string
+//   - subject string
+func (_e *UserManager_Expecter) GetUserIdentity(ctx interface{}, issuer interface{}, subject interface{}) *UserManager_GetUserIdentity_Call {
+	return &UserManager_GetUserIdentity_Call{Call: _e.mock.On("GetUserIdentity", ctx, issuer, subject)}
+}
+
+func (_c *UserManager_GetUserIdentity_Call) Run(run func(ctx context.Context, issuer string, subject string)) *UserManager_GetUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserManager_GetUserIdentity_Call) Return(_a0 entity.UserIdentity, _a1 error) *UserManager_GetUserIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserManager_GetUserIdentity_Call) RunAndReturn(run func(context.Context, string, string) (entity.UserIdentity, error)) *UserManager_GetUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRole provides a mock function with given fields: ctx, userID
+func (_m *UserManager) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRole")
+	}
+
+	var r0 entity.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Role, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Role); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(entity.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserManager_GetUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRole'
+type UserManager_GetUserRole_Call struct {
+	*mock.Call
+}
+
+// GetUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserManager_Expecter) GetUserRole(ctx interface{}, userID interface{}) *UserManager_GetUserRole_Call {
+	return &UserManager_GetUserRole_Call{Call: _e.mock.On("GetUserRole", ctx, userID)}
+}
+
+func (_c *UserManager_GetUserRole_Call) Run(run func(ctx context.Context, userID string)) *UserManager_GetUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserManager_GetUserRole_Call) Return(_a0 entity.Role, _a1 error) *UserManager_GetUserRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserManager_GetUserRole_Call) RunAndReturn(run func(context.Context, string) (entity.Role, error)) *UserManager_GetUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplacePasswordHash provides a mock function with given fields: ctx, userID, oldHash, newHash
+func (_m *UserManager) ReplacePasswordHash(ctx context.Context, userID string, oldHash string, newHash string) (bool, error) {
+	ret := _m.Called(ctx, userID, oldHash, newHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplacePasswordHash")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (bool, error)); ok {
+		return rf(ctx, userID, oldHash, newHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) bool); ok {
+		r0 = rf(ctx, userID, oldHash, newHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, userID, oldHash, newHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserManager_ReplacePasswordHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplacePasswordHash'
+type UserManager_ReplacePasswordHash_Call struct {
+	*mock.Call
+}
+
+// ReplacePasswordHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - oldHash string
+//   - newHash string
+func (_e *UserManager_Expecter) ReplacePasswordHash(ctx interface{}, userID interface{}, oldHash interface{}, newHash interface{}) *UserManager_ReplacePasswordHash_Call {
+	return &UserManager_ReplacePasswordHash_Call{Call: _e.mock.On("ReplacePasswordHash", ctx, userID, oldHash, newHash)}
+}
+
+func (_c *UserManager_ReplacePasswordHash_Call) Run(run func(ctx context.Context, userID string, oldHash string, newHash string)) *UserManager_ReplacePasswordHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *UserManager_ReplacePasswordHash_Call) Return(_a0 bool, _a1 error) *UserManager_ReplacePasswordHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserManager_ReplacePasswordHash_Call) RunAndReturn(run func(context.Context, string, string, string) (bool, error)) *UserManager_ReplacePasswordHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserRole provides a mock function with given fields: ctx, userID, role
+func (_m *UserManager) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	ret := _m.Called(ctx, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Role) error); ok {
+		r0 = rf(ctx, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserManager_SetUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserRole'
+type UserManager_SetUserRole_Call struct {
+	*mock.Call
+}
+
+// SetUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - role entity.Role
+func (_e *UserManager_Expecter) SetUserRole(ctx interface{}, userID interface{}, role interface{}) *UserManager_SetUserRole_Call {
+	return &UserManager_SetUserRole_Call{Call: _e.mock.On("SetUserRole", ctx, userID, role)}
+}
+
+func (_c *UserManager_SetUserRole_Call) Run(run func(ctx context.Context, userID string, role entity.Role)) *UserManager_SetUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(entity.Role))
+	})
+	return _c
+}
+
+func (_c *UserManager_SetUserRole_Call) Return(_a0 error) *UserManager_SetUserRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserManager_SetUserRole_Call) RunAndReturn(run func(context.Context, string, entity.Role) error) *UserManager_SetUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserWithRoleExists provides a mock function with given fields: ctx, role
+func (_m *UserManager) UserWithRoleExists(ctx context.Context, role entity.Role) (bool, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserWithRoleExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Role) (bool, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Role) bool); ok {
+		r0 = rf(ctx, role)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Role) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserManager_UserWithRoleExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserWithRoleExists'
+type UserManager_UserWithRoleExists_Call struct {
+	*mock.Call
+}
+
+// UserWithRoleExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role entity.Role
+func (_e *UserManager_Expecter) UserWithRoleExists(ctx interface{}, role interface{}) *UserManager_UserWithRoleExists_Call {
+	return &UserManager_UserWithRoleExists_Call{Call: _e.mock.On("UserWithRoleExists", ctx, role)}
+}
+
+func (_c *UserManager_UserWithRoleExists_Call) Run(run func(ctx context.Context, role entity.Role)) *UserManager_UserWithRoleExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Role))
+	})
+	return _c
+}
+
+func (_c *UserManager_UserWithRoleExists_Call) Return(_a0 bool, _a1 error) *UserManager_UserWithRoleExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserManager_UserWithRoleExists_Call) RunAndReturn(run func(context.Context, entity.Role) (bool, error)) *UserManager_UserWithRoleExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserManager creates a new instance of UserManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserManager(t interface {
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+)
+
+const (
+	minUsernameLength = 3
+	maxUsernameLength = 32
+)
+
+// reservedUsernames can't be taken on sign-up, since they could be mistaken for staff or system accounts
+var reservedUsernames = map[string]struct{}{
+	"admin":         {},
+	"administrator": {},
+	"api":           {},
+	"finance":       {},
+	"me":            {},
+	"moderator":     {},
+	"null":          {},
+	"root":          {},
+	"store":         {},
+	"superadmin":    {},
+	"support":       {},
+	"system":        {},
+}
+
+func validateUsername(username string) error {
+	if len(username) < minUsernameLength {
+		return domain.ErrUsernameTooShort
+	}
+
+	if len(username) > maxUsernameLength {
+		return domain.ErrUsernameTooLong
+	}
+
+	for i, r := range username {
+		switch {
+		case isLetter(r):
+		case i > 0 && (isDigit(r) || r == '.' || r == '-' || r == '_'):
+		default:
+			return domain.ErrUsernameInvalidCharacters
+		}
+	}
+
+	if _, ok := reservedUsernames[strings.ToLower(username)]; ok {
+		return domain.ErrUsernameReserved
+	}
+
+	return nil
+}
+
+func isLetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
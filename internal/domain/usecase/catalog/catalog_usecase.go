@@ -0,0 +1,172 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log      *slog.Logger
+	merchMgr MerchManager
+	txMgr    TransactionManager
+}
+
+type (
+	MerchManager interface {
+		ListCatalog(ctx context.Context) ([]entity.CatalogItem, error)
+		UpsertCatalogItem(ctx context.Context, item entity.CatalogItem) error
+		RetireMerch(ctx context.Context, name string) error
+	}
+
+	TransactionManager interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+)
+
+func NewUsecase(log *slog.Logger, merchMgr MerchManager, txMgr TransactionManager) *Usecase {
+	return &Usecase{
+		log:      log,
+		merchMgr: merchMgr,
+		txMgr:    txMgr,
+	}
+}
+
+func (u *Usecase) ExportCatalog(ctx context.Context) ([]entity.CatalogItem, error) {
+	const op = "usecase.Catalog.ExportCatalog"
+
+	log := u.log.With(slog.String("op", op))
+
+	items, err := u.merchMgr.ListCatalog(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListCatalog, err)
+		return nil, domain.ErrFailedToListCatalog
+	}
+
+	return items, nil
+}
+
+// ImportCatalog makes the catalog match the given items: items are matched by name,
+// missing ones are created, changed ones are updated and the ones absent from the import are retired.
+// In dry-run mode the report is built without touching the database
+func (u *Usecase) ImportCatalog(ctx context.Context, items []entity.CatalogItem, dryRun bool) (entity.CatalogReport, error) {
+	const op = "usecase.Catalog.ImportCatalog"
+
+	log := u.log.With(slog.String("op", op))
+
+	items, err := normalizeItems(items)
+	if err != nil {
+		err = fmt.Errorf("%s: %w", op, err)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.CatalogReport{}, err
+	}
+
+	current, err := u.merchMgr.ListCatalog(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListCatalog, err)
+		return entity.CatalogReport{}, domain.ErrFailedToListCatalog
+	}
+
+	report := diffCatalog(current, items)
+	report.DryRun = dryRun
+
+	if dryRun || !report.HasChanges() {
+		return report, nil
+	}
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		for _, item := range report.Created {
+			if err = u.merchMgr.UpsertCatalogItem(txCtx, item); err != nil {
+				e.LogError(txCtx, log, domain.ErrFailedToUpsertCatalogItem, err, slog.String("item", item.Name))
+				return domain.ErrFailedToUpsertCatalogItem
+			}
+		}
+
+		for _, update := range report.Updated {
+			if err = u.merchMgr.UpsertCatalogItem(txCtx, update.After); err != nil {
+				e.LogError(txCtx, log, domain.ErrFailedToUpsertCatalogItem, err, slog.String("item", update.After.Name))
+				return domain.ErrFailedToUpsertCatalogItem
+			}
+		}
+
+		for _, item := range report.Retired {
+			if err = u.merchMgr.RetireMerch(txCtx, item.Name); err != nil {
+				e.LogError(txCtx, log, domain.ErrFailedToRetireMerch, err, slog.String("item", item.Name))
+				return domain.ErrFailedToRetireMerch
+			}
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err)
+		return entity.CatalogReport{}, err
+	}
+
+	return report, nil
+}
+
+// normalizeItems trims the imported items and checks that they can be stored in the catalog
+func normalizeItems(items []entity.CatalogItem) ([]entity.CatalogItem, error) {
+	normalized := make([]entity.CatalogItem, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+
+	for i, item := range items {
+		item.Name = strings.TrimSpace(item.Name)
+		item.Category = strings.TrimSpace(item.Category)
+
+		switch {
+		case item.Name == "":
+			return nil, fmt.Errorf("%w: item #%d: name is empty", domain.ErrInvalidCatalogItem, i+1)
+		case item.Price <= 0:
+			return nil, fmt.Errorf("%w: item %q: price must be positive", domain.ErrInvalidCatalogItem, item.Name)
+		case item.Stock != nil && *item.Stock < 0:
+			return nil, fmt.Errorf("%w: item %q: stock must not be negative", domain.ErrInvalidCatalogItem, item.Name)
+		}
+
+		if _, ok := seen[item.Name]; ok {
+			return nil, fmt.Errorf("%w: item %q", domain.ErrDuplicateCatalogItem, item.Name)
+		}
+
+		seen[item.Name] = struct{}{}
+		normalized = append(normalized, item)
+	}
+
+	return normalized, nil
+}
+
+func diffCatalog(current, imported []entity.CatalogItem) entity.CatalogReport {
+	var report entity.CatalogReport
+
+	existing := make(map[string]entity.CatalogItem, len(current))
+	for _, item := range current {
+		existing[item.Name] = item
+	}
+
+	for _, item := range imported {
+		before, ok := existing[item.Name]
+		switch {
+		case !ok:
+			report.Created = append(report.Created, item)
+		case !before.Equal(item):
+			report.Updated = append(report.Updated, entity.CatalogUpdate{Before: before, After: item})
+		default:
+			report.Unchanged++
+		}
+
+		delete(existing, item.Name)
+	}
+
+	// Keep the order of the current catalog for a stable report
+	for _, item := range current {
+		if _, ok := existing[item.Name]; ok {
+			report.Retired = append(report.Retired, item)
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,184 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/catalog/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestUsecase_ImportCatalog(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	current := []entity.CatalogItem{
+		{Name: "cup", Price: 20, Category: "accessories"},
+		{Name: "hoody", Price: 300, Category: "clothing"},
+		{Name: "pen", Price: 10, Category: "stationery"},
+	}
+
+	imported := []entity.CatalogItem{
+		{Name: " cup ", Price: 20, Category: "accessories"},
+		{Name: "hoody", Price: 350, Category: "clothing", Stock: intPtr(20)},
+		{Name: "sticker", Price: 5, Category: "stationery", Stock: intPtr(100)},
+	}
+
+	tests := []struct {
+		name           string
+		items          []entity.CatalogItem
+		dryRun         bool
+		mockBehavior   func(merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager)
+		expectedReport entity.CatalogReport
+		expectedError  error
+	}{
+		{
+			name:  "Success",
+			items: imported,
+			mockBehavior: func(merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				merchMgr.EXPECT().ListCatalog(ctx).
+					Once().
+					Return(current, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				merchMgr.EXPECT().UpsertCatalogItem(ctx, imported[2]).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().UpsertCatalogItem(ctx, imported[1]).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().RetireMerch(ctx, "pen").
+					Once().
+					Return(nil)
+			},
+			expectedReport: entity.CatalogReport{
+				Created:   []entity.CatalogItem{imported[2]},
+				Updated:   []entity.CatalogUpdate{{Before: current[1], After: imported[1]}},
+				Retired:   []entity.CatalogItem{current[2]},
+				Unchanged: 1,
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Success - Dry run doesn't change the catalog",
+			items:  imported,
+			dryRun: true,
+			mockBehavior: func(merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				merchMgr.EXPECT().ListCatalog(ctx).
+					Once().
+					Return(current, nil)
+			},
+			expectedReport: entity.CatalogReport{
+				Created:   []entity.CatalogItem{imported[2]},
+				Updated:   []entity.CatalogUpdate{{Before: current[1], After: imported[1]}},
+				Retired:   []entity.CatalogItem{current[2]},
+				Unchanged: 1,
+				DryRun:    true,
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "Success - Nothing to change",
+			items: current,
+			mockBehavior: func(merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				merchMgr.EXPECT().ListCatalog(ctx).
+					Once().
+					Return(current, nil)
+			},
+			expectedReport: entity.CatalogReport{Unchanged: 3},
+			expectedError:  nil,
+		},
+		{
+			name:           "Error - Invalid price",
+			items:          []entity.CatalogItem{{Name: "cup", Price: 0}},
+			mockBehavior:   func(merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {},
+			expectedReport: entity.CatalogReport{},
+			expectedError:  domain.ErrInvalidCatalogItem,
+		},
+		{
+			name:           "Error - Negative stock",
+			items:          []entity.CatalogItem{{Name: "cup", Price: 20, Stock: intPtr(-1)}},
+			mockBehavior:   func(merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {},
+			expectedReport: entity.CatalogReport{},
+			expectedError:  domain.ErrInvalidCatalogItem,
+		},
+		{
+			name:           "Error - Duplicate item",
+			items:          []entity.CatalogItem{{Name: "cup", Price: 20}, {Name: "cup ", Price: 25}},
+			mockBehavior:   func(merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {},
+			expectedReport: entity.CatalogReport{},
+			expectedError:  domain.ErrDuplicateCatalogItem,
+		},
+		{
+			name:  "Error - Failed to list catalog",
+			items: imported,
+			mockBehavior: func(merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				merchMgr.EXPECT().ListCatalog(ctx).
+					Once().
+					Return(nil, errors.New("merch manager error"))
+			},
+			expectedReport: entity.CatalogReport{},
+			expectedError:  domain.ErrFailedToListCatalog,
+		},
+		{
+			name:  "Error - Failed to retire merch",
+			items: imported,
+			mockBehavior: func(merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				merchMgr.EXPECT().ListCatalog(ctx).
+					Once().
+					Return(current, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				merchMgr.EXPECT().UpsertCatalogItem(ctx, mock.AnythingOfType("entity.CatalogItem")).
+					Twice().
+					Return(nil)
+
+				merchMgr.EXPECT().RetireMerch(ctx, "pen").
+					Once().
+					Return(errors.New("merch manager error"))
+			},
+			expectedReport: entity.CatalogReport{},
+			expectedError:  domain.ErrFailedToRetireMerch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tt.mockBehavior(merchMgr, txMgr)
+
+			usecase := NewUsecase(logger, merchMgr, txMgr)
+			report, err := usecase.ImportCatalog(ctx, tt.items, tt.dryRun)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.expectedReport, report)
+		})
+	}
+}
@@ -13,32 +13,49 @@ import (
 )
 
 type Usecase struct {
-	log         *slog.Logger
-	identityMgr IdentityManager
-	userMgr     UserManager
-	coinsMgr    CoinManager
-	merchMgr    MerchManager
-	txMgr       TransactionManager
+	log           *slog.Logger
+	identityMgr   IdentityManager
+	userMgr       UserManager
+	coinsMgr      CoinManager
+	merchMgr      MerchManager
+	twoFactorMgr  TwoFactorManager
+	loginThrottle LoginThrottle
+	txMgr         TransactionManager
+	totpThreshold int
 }
 
 type (
 	IdentityManager interface {
 		ExtractUserIDFromContext(ctx context.Context) (string, error)
+		ExtractAPIKeyFromContext(ctx context.Context) (entity.APIKey, error)
 	}
 
 	UserManager interface {
+		GetUserByID(ctx context.Context, userID string) (entity.User, error)
 		GetUserInfoByID(ctx context.Context, userID string) (entity.UserInfo, error)
 		GetUserInfoByUsername(ctx context.Context, username string) (entity.UserInfo, error)
 	}
 
 	CoinManager interface {
-		UpdateUserCoins(ctx context.Context, senderID string, amount int) error
+		AdjustUserCoins(ctx context.Context, userID string, amount int) error
 		RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransfer) error
 	}
 
 	MerchManager interface {
 		GetMerchByName(ctx context.Context, itemName string) (entity.Merch, error)
-		AddToInventory(ctx context.Context, userID, merchID string) error
+		TakeFromStock(ctx context.Context, merchID string) error
+		AddToInventory(ctx context.Context, userID, merchID string, price int) error
+	}
+
+	TwoFactorManager interface {
+		IsEnabled(ctx context.Context, userID string) (bool, error)
+		VerifyCode(ctx context.Context, userID, code string) error
+	}
+
+	LoginThrottle interface {
+		CheckLogin(ctx context.Context, username, ip string) error
+		RecordLoginFailure(ctx context.Context, username, ip string) ([]entity.LoginFailures, error)
+		ResetLoginFailures(ctx context.Context, username string) error
 	}
 
 	TransactionManager interface {
@@ -52,15 +69,21 @@ func NewUsecase(
 	userSrv UserManager,
 	coinsSrv CoinManager,
 	merchSrv MerchManager,
+	twoFactorMgr TwoFactorManager,
+	loginThrottle LoginThrottle,
 	txMgr TransactionManager,
+	totpThreshold int,
 ) *Usecase {
 	return &Usecase{
-		log:         log,
-		identityMgr: identityMgr,
-		userMgr:     userSrv,
-		coinsMgr:    coinsSrv,
-		merchMgr:    merchSrv,
-		txMgr:       txMgr,
+		log:           log,
+		identityMgr:   identityMgr,
+		userMgr:       userSrv,
+		coinsMgr:      coinsSrv,
+		merchMgr:      merchSrv,
+		twoFactorMgr:  twoFactorMgr,
+		loginThrottle: loginThrottle,
+		txMgr:         txMgr,
+		totpThreshold: totpThreshold,
 	}
 }
 
@@ -89,7 +112,10 @@ func (u *Usecase) GetUserInfo(ctx context.Context) (entity.UserInfo, error) {
 	return userInfo, nil
 }
 
-func (u *Usecase) SendCoin(ctx context.Context, toUsername string, amount int) error {
+// SendCoin transfers coins to another user. Transfers above the TOTP threshold, unless it's 0, need
+// a fresh code of the sender's authenticator, so a stolen session can't drain the balance.
+// Too many wrong codes make it return a *domain.LoginThrottledError without checking the code
+func (u *Usecase) SendCoin(ctx context.Context, toUsername string, amount int, totpCode, clientIP string) error {
 	const op = "usecase.Coins.SendCoin"
 
 	log := u.log.With(slog.String("op", op))
@@ -106,8 +132,7 @@ func (u *Usecase) SendCoin(ctx context.Context, toUsername string, amount int) e
 		return domain.ErrFailedToExtractUserIDFromContext
 	}
 
-	senderInfo, err := u.userMgr.GetUserInfoByID(ctx, senderID)
-	if err != nil {
+	if _, err = u.userMgr.GetUserInfoByID(ctx, senderID); err != nil {
 		if errors.Is(err, domain.ErrUserNotFound) {
 			e.LogError(ctx, log, domain.ErrSenderNotFound, err)
 			return domain.ErrBadRequest
@@ -117,12 +142,6 @@ func (u *Usecase) SendCoin(ctx context.Context, toUsername string, amount int) e
 		return domain.ErrFailedToGetUserInfo
 	}
 
-	if senderInfo.Coins < amount {
-		err = fmt.Errorf("%s: %w", op, domain.ErrInsufficientCoins)
-		e.LogError(ctx, log, domain.ErrBadRequest, err)
-		return domain.ErrBadRequest
-	}
-
 	receiverUser, err := u.userMgr.GetUserInfoByUsername(ctx, toUsername)
 	if err != nil {
 		if errors.Is(err, domain.ErrUserNotFound) {
@@ -134,15 +153,27 @@ func (u *Usecase) SendCoin(ctx context.Context, toUsername string, amount int) e
 		return domain.ErrFailedToGetUserInfo
 	}
 
+	// The code is checked last, so a transfer rejected for another reason doesn't use it up
+	if u.totpThreshold > 0 && amount > u.totpThreshold {
+		if err = u.verifyTOTPCode(ctx, log, senderID, totpCode, clientIP); err != nil {
+			return err
+		}
+	}
+
 	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
-		// Update sender coins
-		if err = u.coinsMgr.UpdateUserCoins(txCtx, senderID, senderInfo.Coins-amount); err != nil {
+		// Debit the sender, the balance is checked by the update itself, so concurrent transfers can't overdraw it
+		if err = u.coinsMgr.AdjustUserCoins(txCtx, senderID, -amount); err != nil {
+			if errors.Is(err, domain.ErrInsufficientCoins) {
+				e.LogError(txCtx, log, domain.ErrInsufficientCoins, err)
+				return domain.ErrBadRequest
+			}
+
 			e.LogError(txCtx, log, domain.ErrFailedToUpdateUserCoins, err)
 			return domain.ErrFailedToUpdateUserCoins
 		}
 
-		// Update receiver coins
-		if err = u.coinsMgr.UpdateUserCoins(txCtx, receiverUser.ID, receiverUser.Coins+amount); err != nil {
+		// Credit the receiver
+		if err = u.coinsMgr.AdjustUserCoins(txCtx, receiverUser.ID, amount); err != nil {
 			e.LogError(txCtx, log, domain.ErrFailedToUpdateUserCoins, err)
 			return domain.ErrFailedToUpdateUserCoins
 		}
@@ -167,6 +198,96 @@ func (u *Usecase) SendCoin(ctx context.Context, toUsername string, amount int) e
 	return nil
 }
 
+// verifyTOTPCode checks the code of a user with two-factor authentication enabled.
+// Wrong codes count as failed logins, so the code can't be guessed with a stolen token
+func (u *Usecase) verifyTOTPCode(ctx context.Context, log *slog.Logger, userID, code, clientIP string) error {
+	enabled, err := u.twoFactorMgr.IsEnabled(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCheckTwoFactor, err, slog.String("userID", userID))
+		return domain.ErrFailedToCheckTwoFactor
+	}
+
+	if !enabled {
+		e.LogError(ctx, log, domain.ErrTwoFactorNotEnabled, domain.ErrTwoFactorNotEnabled, slog.String("userID", userID))
+		return domain.ErrTwoFactorNotEnabled
+	}
+
+	if code == "" {
+		e.LogError(ctx, log, domain.ErrTOTPCodeRequired, domain.ErrTOTPCodeRequired, slog.String("userID", userID))
+		return domain.ErrTOTPCodeRequired
+	}
+
+	user, err := u.userMgr.GetUserByID(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err, slog.String("userID", userID))
+		return domain.ErrFailedToGetUser
+	}
+
+	if err = u.checkLogin(ctx, log, user.Username, clientIP); err != nil {
+		return err
+	}
+
+	if err = u.twoFactorMgr.VerifyCode(ctx, userID, code); err != nil {
+		if errors.Is(err, domain.ErrInvalidTOTPCode) {
+			e.LogError(ctx, log, domain.ErrInvalidTOTPCode, err, slog.String("userID", userID))
+			u.recordLoginFailure(ctx, log, user.Username, clientIP)
+			return domain.ErrInvalidTOTPCode
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToVerifySecondFactor, err, slog.String("userID", userID))
+		return domain.ErrFailedToVerifySecondFactor
+	}
+
+	// Not being able to reset the counter only makes a later lockout come earlier, the transfer goes on
+	if err = u.loginThrottle.ResetLoginFailures(ctx, user.Username); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToResetLoginFailures, err, slog.String("userID", userID))
+	}
+
+	return nil
+}
+
+func (u *Usecase) checkLogin(ctx context.Context, log *slog.Logger, username, clientIP string) error {
+	if err := u.loginThrottle.CheckLogin(ctx, username, clientIP); err != nil {
+		var throttledErr *domain.LoginThrottledError
+		if errors.As(err, &throttledErr) {
+			log.Warn("coin transfer blocked",
+				slog.String("username", username),
+				slog.String("ip", clientIP),
+				slog.String("reason", throttledErr.Err.Error()),
+				slog.Duration("retryAfter", throttledErr.RetryAfter),
+			)
+			return err
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToCheckLoginThrottle, err)
+		return domain.ErrFailedToCheckLoginThrottle
+	}
+
+	return nil
+}
+
+func (u *Usecase) recordLoginFailure(ctx context.Context, log *slog.Logger, username, clientIP string) {
+	lockouts, err := u.loginThrottle.RecordLoginFailure(ctx, username, clientIP)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToRecordLoginFailure, err,
+			slog.String("username", username),
+			slog.String("ip", clientIP),
+		)
+		return
+	}
+
+	for _, lockout := range lockouts {
+		log.Warn("logins locked out",
+			slog.String("scope", string(lockout.Scope)),
+			slog.String("subject", lockout.Subject),
+			slog.Int("failures", lockout.Failures),
+			slog.Time("lockedUntil", lockout.BlockedUntil),
+		)
+	}
+}
+
+// BuyMerch buys the item for the current user. Restocked units go to the users in its waitlist first,
+// so the item can't be bought while someone is waiting for it
 func (u *Usecase) BuyMerch(ctx context.Context, itemName string) error {
 	const op = "usecase.Coins.BuyMerch"
 
@@ -178,8 +299,7 @@ func (u *Usecase) BuyMerch(ctx context.Context, itemName string) error {
 		return domain.ErrFailedToExtractUserIDFromContext
 	}
 
-	userInfo, err := u.userMgr.GetUserInfoByID(ctx, userID)
-	if err != nil {
+	if _, err = u.userMgr.GetUserInfoByID(ctx, userID); err != nil {
 		if errors.Is(err, domain.ErrUserNotFound) {
 			e.LogError(ctx, log, domain.ErrUserNotFound, err)
 			return domain.ErrBadRequest
@@ -195,19 +315,33 @@ func (u *Usecase) BuyMerch(ctx context.Context, itemName string) error {
 		return domain.ErrFailedToGetMerch
 	}
 
-	if userInfo.Coins < merch.Price {
-		err = fmt.Errorf("%s: %w", op, domain.ErrInsufficientCoins)
-		e.LogError(ctx, log, domain.ErrBadRequest, err)
-		return domain.ErrBadRequest
-	}
-
 	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
-		if err = u.coinsMgr.UpdateUserCoins(txCtx, userID, userInfo.Coins-merch.Price); err != nil {
+		if err = u.coinsMgr.AdjustUserCoins(txCtx, userID, -merch.Price); err != nil {
+			if errors.Is(err, domain.ErrInsufficientCoins) {
+				e.LogError(txCtx, log, domain.ErrInsufficientCoins, err)
+				return domain.ErrBadRequest
+			}
+
 			e.LogError(txCtx, log, domain.ErrFailedToUpdateUserCoins, err)
 			return domain.ErrFailedToUpdateUserCoins
 		}
 
-		if err = u.merchMgr.AddToInventory(txCtx, userID, merch.ID); err != nil {
+		if err = u.merchMgr.TakeFromStock(txCtx, merch.ID); err != nil {
+			if errors.Is(err, domain.ErrMerchOutOfStock) {
+				e.LogError(txCtx, log, domain.ErrMerchOutOfStock, err)
+				return domain.ErrBadRequest
+			}
+
+			if errors.Is(err, domain.ErrMerchReservedForWaitlist) {
+				e.LogError(txCtx, log, domain.ErrMerchReservedForWaitlist, err)
+				return fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToTakeMerchFromStock, err)
+			return domain.ErrFailedToTakeMerchFromStock
+		}
+
+		if err = u.merchMgr.AddToInventory(txCtx, userID, merch.ID, merch.Price); err != nil {
 			e.LogError(txCtx, log, domain.ErrFailedToAddMerchToInventory, err)
 			return domain.ErrFailedToAddMerchToInventory
 		}
@@ -229,3 +363,77 @@ func (u *Usecase) BuyMerch(ctx context.Context, itemName string) error {
 	}
 	return nil
 }
+
+// GrantCoins credits coins paid by the store, e.g. a bonus from the HR system.
+// It's made either by a finance admin or by a service account with the coins:grant scope
+func (u *Usecase) GrantCoins(ctx context.Context, toUsername string, amount int) error {
+	const op = "usecase.Coins.GrantCoins"
+
+	log := u.log.With(slog.String("op", op))
+
+	if amount <= 0 {
+		err := fmt.Errorf("%s: %w", op, domain.ErrAmountMustBePositive)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return domain.ErrBadRequest
+	}
+
+	grantedBy, err := u.grantor(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	receiverUser, err := u.userMgr.GetUserInfoByUsername(ctx, toUsername)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			e.LogError(ctx, log, domain.ErrReceiverNotFound, err)
+			return domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetUserInfo, err)
+		return domain.ErrFailedToGetUserInfo
+	}
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err = u.coinsMgr.AdjustUserCoins(txCtx, receiverUser.ID, amount); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToUpdateUserCoins, err)
+			return domain.ErrFailedToUpdateUserCoins
+		}
+
+		ct := entity.NewCoinTransfer("", receiverUser.ID, entity.TransactionTypeGrantCoins, amount, time.Now())
+
+		if err = u.coinsMgr.RegisterCoinTransfer(txCtx, ct); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToRegisterCoinTransfer, err)
+			return domain.ErrFailedToRegisterCoinTransfer
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err,
+			slog.Any("receiverID", receiverUser.ID),
+		)
+		return err
+	}
+
+	log.Info("coins granted",
+		slog.String("receiverID", receiverUser.ID),
+		slog.Int("amount", amount),
+		grantedBy,
+	)
+
+	return nil
+}
+
+// grantor names the service account or the user who makes a grant, for the audit log
+func (u *Usecase) grantor(ctx context.Context) (slog.Attr, error) {
+	if key, err := u.identityMgr.ExtractAPIKeyFromContext(ctx); err == nil {
+		return slog.String("serviceAccountID", key.ServiceAccountID), nil
+	}
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return slog.Attr{}, err
+	}
+
+	return slog.String("grantedBy", userID), nil
+}
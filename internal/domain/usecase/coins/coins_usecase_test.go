@@ -132,7 +132,7 @@ func TestUsecase_GetUserInfo(t *testing.T) {
 
 			tt.mockBehavior(identityMgr, userMgr)
 
-			usecase := NewUsecase(logger, identityMgr, userMgr, coinsMgr, merchMgr, txMgr)
+			usecase := NewUsecase(logger, identityMgr, userMgr, coinsMgr, merchMgr, mocks.NewTwoFactorManager(t), mocks.NewLoginThrottle(t), txMgr, 0)
 			info, err := usecase.GetUserInfo(ctx)
 
 			if tt.expectedError != nil {
@@ -251,11 +251,11 @@ func TestUsecase_SendCoin(t *testing.T) {
 						return fn(ctx)
 					})
 
-				coinsMgr.EXPECT().UpdateUserCoins(ctx, sender.ID, sender.Coins-50).
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, sender.ID, -50).
 					Once().
 					Return(nil)
 
-				coinsMgr.EXPECT().UpdateUserCoins(ctx, receiver.ID, receiver.Coins+50).
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, receiver.ID, 50).
 					Once().
 					Return(nil)
 
@@ -351,6 +351,19 @@ func TestUsecase_SendCoin(t *testing.T) {
 				userMgr.EXPECT().GetUserInfoByID(ctx, sender.ID).
 					Once().
 					Return(sender, nil)
+
+				userMgr.EXPECT().GetUserInfoByUsername(ctx, receiverUsername).
+					Once().
+					Return(receiver, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, sender.ID, -1500).
+					Once().
+					Return(domain.ErrInsufficientCoins)
 			},
 			expectedError: domain.ErrBadRequest,
 		},
@@ -429,7 +442,7 @@ func TestUsecase_SendCoin(t *testing.T) {
 						return fn(ctx)
 					})
 
-				coinsMgr.EXPECT().UpdateUserCoins(ctx, sender.ID, sender.Coins-50).
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, sender.ID, -50).
 					Once().
 					Return(errors.New("coins manager error"))
 			},
@@ -462,11 +475,11 @@ func TestUsecase_SendCoin(t *testing.T) {
 						return fn(ctx)
 					})
 
-				coinsMgr.EXPECT().UpdateUserCoins(ctx, sender.ID, sender.Coins-50).
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, sender.ID, -50).
 					Once().
 					Return(nil)
 
-				coinsMgr.EXPECT().UpdateUserCoins(ctx, receiver.ID, receiver.Coins+50).
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, receiver.ID, 50).
 					Once().
 					Return(errors.New("coins manager error"))
 			},
@@ -499,11 +512,11 @@ func TestUsecase_SendCoin(t *testing.T) {
 						return fn(ctx)
 					})
 
-				coinsMgr.EXPECT().UpdateUserCoins(ctx, sender.ID, sender.Coins-50).
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, sender.ID, -50).
 					Once().
 					Return(nil)
 
-				coinsMgr.EXPECT().UpdateUserCoins(ctx, receiver.ID, receiver.Coins+50).
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, receiver.ID, 50).
 					Once().
 					Return(nil)
 
@@ -525,8 +538,280 @@ func TestUsecase_SendCoin(t *testing.T) {
 
 			tt.mockBehavior(identityMgr, userMgr, coinsMgr, txMgr)
 
-			usecase := NewUsecase(logger, identityMgr, userMgr, coinsMgr, merchMgr, txMgr)
-			err := usecase.SendCoin(ctx, tt.toUsername, tt.amount)
+			usecase := NewUsecase(logger, identityMgr, userMgr, coinsMgr, merchMgr, mocks.NewTwoFactorManager(t), mocks.NewLoginThrottle(t), txMgr, 0)
+			err := usecase.SendCoin(ctx, tt.toUsername, tt.amount, "", "")
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_SendCoin_TOTP(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	const threshold = 100
+
+	sender := entity.UserInfo{
+		ID:    "test-sender-id",
+		Coins: 1000,
+	}
+
+	receiver := entity.UserInfo{
+		ID:    "test-receiver-id",
+		Coins: 1000,
+	}
+
+	senderUser := entity.User{
+		ID:       sender.ID,
+		Username: "test-sender-username",
+	}
+
+	receiverUsername := "test-receiver-username"
+	totpCode := "123456"
+	clientIP := "192.0.2.1"
+
+	expectCodeCheck := func(userMgr *mocks.UserManager, loginThrottle *mocks.LoginThrottle, throttleErr error) {
+		userMgr.EXPECT().GetUserByID(ctx, sender.ID).
+			Once().
+			Return(senderUser, nil)
+
+		loginThrottle.EXPECT().CheckLogin(ctx, senderUser.Username, clientIP).
+			Once().
+			Return(throttleErr)
+	}
+
+	expectTransfer := func(coinsMgr *mocks.CoinManager, txMgr *mocks.TransactionManager, amount int) {
+		txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		coinsMgr.EXPECT().AdjustUserCoins(ctx, sender.ID, -amount).
+			Once().
+			Return(nil)
+
+		coinsMgr.EXPECT().AdjustUserCoins(ctx, receiver.ID, amount).
+			Once().
+			Return(nil)
+
+		coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.AnythingOfType("entity.CoinTransfer")).
+			Once().
+			Return(nil)
+	}
+
+	tests := []struct {
+		name         string
+		amount       int
+		totpCode     string
+		mockBehavior func(
+			userMgr *mocks.UserManager,
+			twoFactorMgr *mocks.TwoFactorManager,
+			loginThrottle *mocks.LoginThrottle,
+			coinsMgr *mocks.CoinManager,
+			txMgr *mocks.TransactionManager,
+		)
+		expectedError error
+	}{
+		{
+			name:     "Success – At the threshold without a code",
+			amount:   threshold,
+			totpCode: "",
+			mockBehavior: func(
+				_ *mocks.UserManager,
+				_ *mocks.TwoFactorManager,
+				_ *mocks.LoginThrottle,
+				coinsMgr *mocks.CoinManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				expectTransfer(coinsMgr, txMgr, threshold)
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Success – Above the threshold with a code",
+			amount:   threshold + 1,
+			totpCode: totpCode,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				twoFactorMgr *mocks.TwoFactorManager,
+				loginThrottle *mocks.LoginThrottle,
+				coinsMgr *mocks.CoinManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				twoFactorMgr.EXPECT().IsEnabled(ctx, sender.ID).
+					Once().
+					Return(true, nil)
+
+				expectCodeCheck(userMgr, loginThrottle, nil)
+
+				twoFactorMgr.EXPECT().VerifyCode(ctx, sender.ID, totpCode).
+					Once().
+					Return(nil)
+
+				loginThrottle.EXPECT().ResetLoginFailures(ctx, senderUser.Username).
+					Once().
+					Return(nil)
+
+				expectTransfer(coinsMgr, txMgr, threshold+1)
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Error – Two-factor authentication not enabled",
+			amount:   threshold + 1,
+			totpCode: totpCode,
+			mockBehavior: func(
+				_ *mocks.UserManager,
+				twoFactorMgr *mocks.TwoFactorManager,
+				_ *mocks.LoginThrottle,
+				_ *mocks.CoinManager,
+				_ *mocks.TransactionManager,
+			) {
+				twoFactorMgr.EXPECT().IsEnabled(ctx, sender.ID).
+					Once().
+					Return(false, nil)
+			},
+			expectedError: domain.ErrTwoFactorNotEnabled,
+		},
+		{
+			name:     "Error – Code missing",
+			amount:   threshold + 1,
+			totpCode: "",
+			mockBehavior: func(
+				_ *mocks.UserManager,
+				twoFactorMgr *mocks.TwoFactorManager,
+				_ *mocks.LoginThrottle,
+				_ *mocks.CoinManager,
+				_ *mocks.TransactionManager,
+			) {
+				twoFactorMgr.EXPECT().IsEnabled(ctx, sender.ID).
+					Once().
+					Return(true, nil)
+			},
+			expectedError: domain.ErrTOTPCodeRequired,
+		},
+		{
+			name:     "Error – Invalid code",
+			amount:   threshold + 1,
+			totpCode: totpCode,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				twoFactorMgr *mocks.TwoFactorManager,
+				loginThrottle *mocks.LoginThrottle,
+				_ *mocks.CoinManager,
+				_ *mocks.TransactionManager,
+			) {
+				twoFactorMgr.EXPECT().IsEnabled(ctx, sender.ID).
+					Once().
+					Return(true, nil)
+
+				expectCodeCheck(userMgr, loginThrottle, nil)
+
+				twoFactorMgr.EXPECT().VerifyCode(ctx, sender.ID, totpCode).
+					Once().
+					Return(domain.ErrInvalidTOTPCode)
+
+				loginThrottle.EXPECT().RecordLoginFailure(ctx, senderUser.Username, clientIP).
+					Once().
+					Return(nil, nil)
+			},
+			expectedError: domain.ErrInvalidTOTPCode,
+		},
+		{
+			name:     "Error – Too many wrong codes",
+			amount:   threshold + 1,
+			totpCode: totpCode,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				twoFactorMgr *mocks.TwoFactorManager,
+				loginThrottle *mocks.LoginThrottle,
+				_ *mocks.CoinManager,
+				_ *mocks.TransactionManager,
+			) {
+				twoFactorMgr.EXPECT().IsEnabled(ctx, sender.ID).
+					Once().
+					Return(true, nil)
+
+				expectCodeCheck(userMgr, loginThrottle, &domain.LoginThrottledError{
+					Err:        domain.ErrTooManyLoginAttempts,
+					RetryAfter: time.Minute,
+				})
+			},
+			expectedError: domain.ErrTooManyLoginAttempts,
+		},
+		{
+			name:     "Error – Failed to check two-factor authentication",
+			amount:   threshold + 1,
+			totpCode: totpCode,
+			mockBehavior: func(
+				_ *mocks.UserManager,
+				twoFactorMgr *mocks.TwoFactorManager,
+				_ *mocks.LoginThrottle,
+				_ *mocks.CoinManager,
+				_ *mocks.TransactionManager,
+			) {
+				twoFactorMgr.EXPECT().IsEnabled(ctx, sender.ID).
+					Once().
+					Return(false, errors.New("two-factor manager error"))
+			},
+			expectedError: domain.ErrFailedToCheckTwoFactor,
+		},
+		{
+			name:     "Error – Failed to verify code",
+			amount:   threshold + 1,
+			totpCode: totpCode,
+			mockBehavior: func(
+				userMgr *mocks.UserManager,
+				twoFactorMgr *mocks.TwoFactorManager,
+				loginThrottle *mocks.LoginThrottle,
+				_ *mocks.CoinManager,
+				_ *mocks.TransactionManager,
+			) {
+				twoFactorMgr.EXPECT().IsEnabled(ctx, sender.ID).
+					Once().
+					Return(true, nil)
+
+				expectCodeCheck(userMgr, loginThrottle, nil)
+
+				twoFactorMgr.EXPECT().VerifyCode(ctx, sender.ID, totpCode).
+					Once().
+					Return(errors.New("two-factor manager error"))
+			},
+			expectedError: domain.ErrFailedToVerifySecondFactor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			twoFactorMgr := mocks.NewTwoFactorManager(t)
+			loginThrottle := mocks.NewLoginThrottle(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+				Once().
+				Return(sender.ID, nil)
+
+			userMgr.EXPECT().GetUserInfoByID(ctx, sender.ID).
+				Once().
+				Return(sender, nil)
+
+			userMgr.EXPECT().GetUserInfoByUsername(ctx, receiverUsername).
+				Once().
+				Return(receiver, nil)
+
+			tt.mockBehavior(userMgr, twoFactorMgr, loginThrottle, coinsMgr, txMgr)
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, coinsMgr, mocks.NewMerchManager(t), twoFactorMgr, loginThrottle, txMgr, threshold)
+			err := usecase.SendCoin(ctx, receiverUsername, tt.amount, tt.totpCode, clientIP)
 
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -616,11 +901,15 @@ func TestUsecase_BuyMerch(t *testing.T) {
 						return fn(ctx)
 					})
 
-				coinsMgr.EXPECT().UpdateUserCoins(ctx, testUserInfo.ID, testUserInfo.Coins-testMerch.Price).
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, testUserInfo.ID, -testMerch.Price).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().TakeFromStock(ctx, testMerch.ID).
 					Once().
 					Return(nil)
 
-				merchMgr.EXPECT().AddToInventory(ctx, testUserInfo.ID, testMerch.ID).
+				merchMgr.EXPECT().AddToInventory(ctx, testUserInfo.ID, testMerch.ID, testMerch.Price).
 					Once().
 					Return(nil)
 
@@ -731,6 +1020,15 @@ func TestUsecase_BuyMerch(t *testing.T) {
 				merchMgr.EXPECT().GetMerchByName(ctx, testMerch.Name).
 					Once().
 					Return(testMerch, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, testUserInfo.ID, -testMerch.Price).
+					Once().
+					Return(domain.ErrInsufficientCoins)
 			},
 			expectedError: domain.ErrBadRequest,
 		},
@@ -761,12 +1059,86 @@ func TestUsecase_BuyMerch(t *testing.T) {
 						return fn(ctx)
 					})
 
-				coinsMgr.EXPECT().UpdateUserCoins(ctx, testUserInfo.ID, testUserInfo.Coins-testMerch.Price).
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, testUserInfo.ID, -testMerch.Price).
 					Once().
 					Return(errors.New("coins manager error"))
 			},
 			expectedError: domain.ErrFailedToUpdateUserCoins,
 		},
+		{
+			name:     "Error - Merch out of stock",
+			itemName: testMerch.Name,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(testUserInfo.ID, nil)
+
+				userMgr.EXPECT().GetUserInfoByID(ctx, testUserInfo.ID).
+					Once().
+					Return(testUserInfo, nil)
+
+				merchMgr.EXPECT().GetMerchByName(ctx, testMerch.Name).
+					Once().
+					Return(testMerch, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, testUserInfo.ID, -testMerch.Price).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().TakeFromStock(ctx, testMerch.ID).
+					Once().
+					Return(domain.ErrMerchOutOfStock)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:     "Error - Merch reserved for waitlist",
+			itemName: testMerch.Name,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(testUserInfo.ID, nil)
+
+				userMgr.EXPECT().GetUserInfoByID(ctx, testUserInfo.ID).
+					Once().
+					Return(testUserInfo, nil)
+
+				merchMgr.EXPECT().GetMerchByName(ctx, testMerch.Name).
+					Once().
+					Return(testMerch, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, testUserInfo.ID, -testMerch.Price).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().TakeFromStock(ctx, testMerch.ID).
+					Once().
+					Return(domain.ErrMerchReservedForWaitlist)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
 		{
 			name:     "Error - Failed to add to inventory",
 			itemName: testMerch.Name,
@@ -794,11 +1166,15 @@ func TestUsecase_BuyMerch(t *testing.T) {
 						return fn(ctx)
 					})
 
-				coinsMgr.EXPECT().UpdateUserCoins(ctx, testUserInfo.ID, testUserInfo.Coins-testMerch.Price).
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, testUserInfo.ID, -testMerch.Price).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().TakeFromStock(ctx, testMerch.ID).
 					Once().
 					Return(nil)
 
-				merchMgr.EXPECT().AddToInventory(ctx, testUserInfo.ID, testMerch.ID).
+				merchMgr.EXPECT().AddToInventory(ctx, testUserInfo.ID, testMerch.ID, testMerch.Price).
 					Once().
 					Return(domain.ErrFailedToAddMerchToInventory)
 			},
@@ -831,11 +1207,15 @@ func TestUsecase_BuyMerch(t *testing.T) {
 						return fn(ctx)
 					})
 
-				coinsMgr.EXPECT().UpdateUserCoins(ctx, testUserInfo.ID, testUserInfo.Coins-testMerch.Price).
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, testUserInfo.ID, -testMerch.Price).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().TakeFromStock(ctx, testMerch.ID).
 					Once().
 					Return(nil)
 
-				merchMgr.EXPECT().AddToInventory(ctx, testUserInfo.ID, testMerch.ID).
+				merchMgr.EXPECT().AddToInventory(ctx, testUserInfo.ID, testMerch.ID, testMerch.Price).
 					Once().
 					Return(nil)
 
@@ -857,7 +1237,7 @@ func TestUsecase_BuyMerch(t *testing.T) {
 
 			tt.mockBehavior(identityMgr, userMgr, coinsMgr, merchMgr, txMgr)
 
-			usecase := NewUsecase(logger, identityMgr, userMgr, coinsMgr, merchMgr, txMgr)
+			usecase := NewUsecase(logger, identityMgr, userMgr, coinsMgr, merchMgr, mocks.NewTwoFactorManager(t), mocks.NewLoginThrottle(t), txMgr, 0)
 			err := usecase.BuyMerch(ctx, tt.itemName)
 
 			if tt.expectedError != nil {
@@ -869,3 +1249,193 @@ func TestUsecase_BuyMerch(t *testing.T) {
 		})
 	}
 }
+
+func TestUsecase_GrantCoins(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	receiverUsername := "test-receiver-username"
+	receiver := entity.UserInfo{
+		ID:    "test-receiver-id",
+		Coins: 1000,
+	}
+
+	apiKey := entity.APIKey{
+		ID:               "test-key-id",
+		ServiceAccountID: "test-service-account-id",
+		Scopes:           []entity.Scope{entity.ScopeCoinsGrant},
+	}
+
+	tests := []struct {
+		name         string
+		toUsername   string
+		amount       int
+		mockBehavior func(
+			identityMgr *mocks.IdentityManager,
+			userMgr *mocks.UserManager,
+			coinsMgr *mocks.CoinManager,
+			txMgr *mocks.TransactionManager,
+		)
+		expectedError error
+	}{
+		{
+			name:       "Success – Service account",
+			toUsername: receiverUsername,
+			amount:     50,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				coinsMgr *mocks.CoinManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractAPIKeyFromContext(ctx).
+					Once().
+					Return(apiKey, nil)
+
+				userMgr.EXPECT().GetUserInfoByUsername(ctx, receiverUsername).
+					Once().
+					Return(receiver, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, receiver.ID, 50).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+					return ct.SenderID == "" &&
+						ct.ReceiverID == receiver.ID &&
+						ct.TransactionType == entity.TransactionTypeGrantCoins &&
+						ct.Amount == 50
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:       "Success – Finance user",
+			toUsername: receiverUsername,
+			amount:     50,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				coinsMgr *mocks.CoinManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractAPIKeyFromContext(ctx).
+					Once().
+					Return(entity.APIKey{}, domain.ErrAPIKeyNotFoundInContext)
+
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return("test-finance-id", nil)
+
+				userMgr.EXPECT().GetUserInfoByUsername(ctx, receiverUsername).
+					Once().
+					Return(receiver, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, receiver.ID, 50).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.AnythingOfType("entity.CoinTransfer")).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:       "Error – Amount is not positive",
+			toUsername: receiverUsername,
+			amount:     0,
+			mockBehavior: func(
+				_ *mocks.IdentityManager,
+				_ *mocks.UserManager,
+				_ *mocks.CoinManager,
+				_ *mocks.TransactionManager,
+			) {
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:       "Error – Receiver not found",
+			toUsername: receiverUsername,
+			amount:     50,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				_ *mocks.CoinManager,
+				_ *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractAPIKeyFromContext(ctx).
+					Once().
+					Return(apiKey, nil)
+
+				userMgr.EXPECT().GetUserInfoByUsername(ctx, receiverUsername).
+					Once().
+					Return(entity.UserInfo{}, domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:       "Error – Failed to adjust coins",
+			toUsername: receiverUsername,
+			amount:     50,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				coinsMgr *mocks.CoinManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractAPIKeyFromContext(ctx).
+					Once().
+					Return(apiKey, nil)
+
+				userMgr.EXPECT().GetUserInfoByUsername(ctx, receiverUsername).
+					Once().
+					Return(receiver, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, receiver.ID, 50).
+					Once().
+					Return(errors.New("db error"))
+			},
+			expectedError: domain.ErrFailedToUpdateUserCoins,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tt.mockBehavior(identityMgr, userMgr, coinsMgr, txMgr)
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, coinsMgr, merchMgr, mocks.NewTwoFactorManager(t), mocks.NewLoginThrottle(t), txMgr, 0)
+			err := usecase.GrantCoins(ctx, tt.toUsername, tt.amount)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
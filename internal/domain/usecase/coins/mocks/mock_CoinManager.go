@@ -22,17 +22,17 @@ func (_m *CoinManager) EXPECT() *CoinManager_Expecter {
 	return &CoinManager_Expecter{mock: &_m.Mock}
 }
 
-// RegisterCoinTransfer provides a mock function with given fields: ctx, ct
-func (_m *CoinManager) RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransfer) error {
-	ret := _m.Called(ctx, ct)
+// AdjustUserCoins provides a mock function with given fields: ctx, userID, amount
+func (_m *CoinManager) AdjustUserCoins(ctx context.Context, userID string, amount int) error {
+	ret := _m.Called(ctx, userID, amount)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegisterCoinTransfer")
+		panic("no return value specified for AdjustUserCoins")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entity.CoinTransfer) error); ok {
-		r0 = rf(ctx, ct)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, userID, amount)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -40,46 +40,47 @@ func (_m *CoinManager) RegisterCoinTransfer(ctx context.Context, ct entity.CoinT
 	return r0
 }
 
-// CoinManager_RegisterCoinTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterCoinTransfer'
-type CoinManager_RegisterCoinTransfer_Call struct {
+// CoinManager_AdjustUserCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustUserCoins'
+type CoinManager_AdjustUserCoins_Call struct {
 	*mock.Call
 }
 
-// RegisterCoinTransfer is a helper method to define mock.On call
+// AdjustUserCoins is a helper method to define mock.On call
 //   - ctx context.Context
-//   - ct entity.CoinTransfer
-func (_e *CoinManager_Expecter) RegisterCoinTransfer(ctx interface{}, ct interface{}) *CoinManager_RegisterCoinTransfer_Call {
-	return &CoinManager_RegisterCoinTransfer_Call{Call: _e.mock.On("RegisterCoinTransfer", ctx, ct)}
+//   - userID string
+//   - amount int
+func (_e *CoinManager_Expecter) AdjustUserCoins(ctx interface{}, userID interface{}, amount interface{}) *CoinManager_AdjustUserCoins_Call {
+	return &CoinManager_AdjustUserCoins_Call{Call: _e.mock.On("AdjustUserCoins", ctx, userID, amount)}
 }
 
-func (_c *CoinManager_RegisterCoinTransfer_Call) Run(run func(ctx context.Context, ct entity.CoinTransfer)) *CoinManager_RegisterCoinTransfer_Call {
+func (_c *CoinManager_AdjustUserCoins_Call) Run(run func(ctx context.Context, userID string, amount int)) *CoinManager_AdjustUserCoins_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entity.CoinTransfer))
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *CoinManager_RegisterCoinTransfer_Call) Return(_a0 error) *CoinManager_RegisterCoinTransfer_Call {
+func (_c *CoinManager_AdjustUserCoins_Call) Return(_a0 error) *CoinManager_AdjustUserCoins_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *CoinManager_RegisterCoinTransfer_Call) RunAndReturn(run func(context.Context, entity.CoinTransfer) error) *CoinManager_RegisterCoinTransfer_Call {
+func (_c *CoinManager_AdjustUserCoins_Call) RunAndReturn(run func(context.Context, string, int) error) *CoinManager_AdjustUserCoins_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUserCoins provides a mock function with given fields: ctx, senderID, amount
-func (_m *CoinManager) UpdateUserCoins(ctx context.Context, senderID string, amount int) error {
-	ret := _m.Called(ctx, senderID, amount)
+// RegisterCoinTransfer provides a mock function with given fields: ctx, ct
+func (_m *CoinManager) RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransfer) error {
+	ret := _m.Called(ctx, ct)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUserCoins")
+		panic("no return value specified for RegisterCoinTransfer")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
-		r0 = rf(ctx, senderID, amount)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CoinTransfer) error); ok {
+		r0 = rf(ctx, ct)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -87,32 +88,31 @@ func (_m *CoinManager) UpdateUserCoins(ctx context.Context, senderID string, amo
 	return r0
 }
 
-// CoinManager_UpdateUserCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserCoins'
-type CoinManager_UpdateUserCoins_Call struct {
+// CoinManager_RegisterCoinTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterCoinTransfer'
+type CoinManager_RegisterCoinTransfer_Call struct {
 	*mock.Call
 }
 
-// UpdateUserCoins is a helper method to define mock.On call
+// RegisterCoinTransfer is a helper method to define mock.On call
 //   - ctx context.Context
-//   - senderID string
-//   - amount int
-func (_e *CoinManager_Expecter) UpdateUserCoins(ctx interface{}, senderID interface{}, amount interface{}) *CoinManager_UpdateUserCoins_Call {
-	return &CoinManager_UpdateUserCoins_Call{Call: _e.mock.On("UpdateUserCoins", ctx, senderID, amount)}
+//   - ct entity.CoinTransfer
+func (_e *CoinManager_Expecter) RegisterCoinTransfer(ctx interface{}, ct interface{}) *CoinManager_RegisterCoinTransfer_Call {
+	return &CoinManager_RegisterCoinTransfer_Call{Call: _e.mock.On("RegisterCoinTransfer", ctx, ct)}
 }
 
-func (_c *CoinManager_UpdateUserCoins_Call) Run(run func(ctx context.Context, senderID string, amount int)) *CoinManager_UpdateUserCoins_Call {
+func (_c *CoinManager_RegisterCoinTransfer_Call) Run(run func(ctx context.Context, ct entity.CoinTransfer)) *CoinManager_RegisterCoinTransfer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int))
+		run(args[0].(context.Context), args[1].(entity.CoinTransfer))
 	})
 	return _c
 }
 
-func (_c *CoinManager_UpdateUserCoins_Call) Return(_a0 error) *CoinManager_UpdateUserCoins_Call {
+func (_c *CoinManager_RegisterCoinTransfer_Call) Return(_a0 error) *CoinManager_RegisterCoinTransfer_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *CoinManager_UpdateUserCoins_Call) RunAndReturn(run func(context.Context, string, int) error) *CoinManager_UpdateUserCoins_Call {
+func (_c *CoinManager_RegisterCoinTransfer_Call) RunAndReturn(run func(context.Context, entity.CoinTransfer) error) *CoinManager_RegisterCoinTransfer_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	entity "github.com/rshelekhov/merch-store/internal/domain/entity"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,6 +22,62 @@ func (_m *IdentityManager) EXPECT() *IdentityManager_Expecter {
 	return &IdentityManager_Expecter{mock: &_m.Mock}
 }
 
+// ExtractAPIKeyFromContext provides a mock function with given fields: ctx
+func (_m *IdentityManager) ExtractAPIKeyFromContext(ctx context.Context) (entity.APIKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtractAPIKeyFromContext")
+	}
+
+	var r0 entity.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.APIKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.APIKey); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.APIKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdentityManager_ExtractAPIKeyFromContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtractAPIKeyFromContext'
+type IdentityManager_ExtractAPIKeyFromContext_Call struct {
+	*mock.Call
+}
+
+// ExtractAPIKeyFromContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IdentityManager_Expecter) ExtractAPIKeyFromContext(ctx interface{}) *IdentityManager_ExtractAPIKeyFromContext_Call {
+	return &IdentityManager_ExtractAPIKeyFromContext_Call{Call: _e.mock.On("ExtractAPIKeyFromContext", ctx)}
+}
+
+func (_c *IdentityManager_ExtractAPIKeyFromContext_Call) Run(run func(ctx context.Context)) *IdentityManager_ExtractAPIKeyFromContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IdentityManager_ExtractAPIKeyFromContext_Call) Return(_a0 entity.APIKey, _a1 error) *IdentityManager_ExtractAPIKeyFromContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdentityManager_ExtractAPIKeyFromContext_Call) RunAndReturn(run func(context.Context) (entity.APIKey, error)) *IdentityManager_ExtractAPIKeyFromContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExtractUserIDFromContext provides a mock function with given fields: ctx
 func (_m *IdentityManager) ExtractUserIDFromContext(ctx context.Context) (string, error) {
 	ret := _m.Called(ctx)
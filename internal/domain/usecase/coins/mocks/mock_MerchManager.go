@@ -22,17 +22,17 @@ func (_m *MerchManager) EXPECT() *MerchManager_Expecter {
 	return &MerchManager_Expecter{mock: &_m.Mock}
 }
 
-// AddToInventory provides a mock function with given fields: ctx, userID, merchID
-func (_m *MerchManager) AddToInventory(ctx context.Context, userID string, merchID string) error {
-	ret := _m.Called(ctx, userID, merchID)
+// AddToInventory provides a mock function with given fields: ctx, userID, merchID, price
+func (_m *MerchManager) AddToInventory(ctx context.Context, userID string, merchID string, price int) error {
+	ret := _m.Called(ctx, userID, merchID, price)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddToInventory")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, userID, merchID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) error); ok {
+		r0 = rf(ctx, userID, merchID, price)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -49,13 +49,14 @@ type MerchManager_AddToInventory_Call struct {
 //   - ctx context.Context
 //   - userID string
 //   - merchID string
-func (_e *MerchManager_Expecter) AddToInventory(ctx interface{}, userID interface{}, merchID interface{}) *MerchManager_AddToInventory_Call {
-	return &MerchManager_AddToInventory_Call{Call: _e.mock.On("AddToInventory", ctx, userID, merchID)}
+//   - price int
+func (_e *MerchManager_Expecter) AddToInventory(ctx interface{}, userID interface{}, merchID interface{}, price interface{}) *MerchManager_AddToInventory_Call {
+	return &MerchManager_AddToInventory_Call{Call: _e.mock.On("AddToInventory", ctx, userID, merchID, price)}
 }
 
-func (_c *MerchManager_AddToInventory_Call) Run(run func(ctx context.Context, userID string, merchID string)) *MerchManager_AddToInventory_Call {
+func (_c *MerchManager_AddToInventory_Call) Run(run func(ctx context.Context, userID string, merchID string, price int)) *MerchManager_AddToInventory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
 	})
 	return _c
 }
@@ -65,7 +66,7 @@ func (_c *MerchManager_AddToInventory_Call) Return(_a0 error) *MerchManager_AddT
 	return _c
 }
 
-func (_c *MerchManager_AddToInventory_Call) RunAndReturn(run func(context.Context, string, string) error) *MerchManager_AddToInventory_Call {
+func (_c *MerchManager_AddToInventory_Call) RunAndReturn(run func(context.Context, string, string, int) error) *MerchManager_AddToInventory_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -127,6 +128,53 @@ func (_c *MerchManager_GetMerchByName_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// TakeFromStock provides a mock function with given fields: ctx, merchID
+func (_m *MerchManager) TakeFromStock(ctx context.Context, merchID string) error {
+	ret := _m.Called(ctx, merchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeFromStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, merchID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MerchManager_TakeFromStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeFromStock'
+type MerchManager_TakeFromStock_Call struct {
+	*mock.Call
+}
+
+// TakeFromStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchID string
+func (_e *MerchManager_Expecter) TakeFromStock(ctx interface{}, merchID interface{}) *MerchManager_TakeFromStock_Call {
+	return &MerchManager_TakeFromStock_Call{Call: _e.mock.On("TakeFromStock", ctx, merchID)}
+}
+
+func (_c *MerchManager_TakeFromStock_Call) Run(run func(ctx context.Context, merchID string)) *MerchManager_TakeFromStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MerchManager_TakeFromStock_Call) Return(_a0 error) *MerchManager_TakeFromStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MerchManager_TakeFromStock_Call) RunAndReturn(run func(context.Context, string) error) *MerchManager_TakeFromStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMerchManager creates a new instance of MerchManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMerchManager(t interface {
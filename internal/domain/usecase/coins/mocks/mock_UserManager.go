@@ -22,6 +22,63 @@ func (_m *UserManager) EXPECT() *UserManager_Expecter {
 	return &UserManager_Expecter{mock: &_m.Mock}
 }
 
+// GetUserByID provides a mock function with given fields: ctx, userID
+func (_m *UserManager) GetUserByID(ctx context.Context, userID string) (entity.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.User, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(entity.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserManager_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type UserManager_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserManager_Expecter) GetUserByID(ctx interface{}, userID interface{}) *UserManager_GetUserByID_Call {
+	return &UserManager_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, userID)}
+}
+
+func (_c *UserManager_GetUserByID_Call) Run(run func(ctx context.Context, userID string)) *UserManager_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserManager_GetUserByID_Call) Return(_a0 entity.User, _a1 error) *UserManager_GetUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserManager_GetUserByID_Call) RunAndReturn(run func(context.Context, string) (entity.User, error)) *UserManager_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserInfoByID provides a mock function with given fields: ctx, userID
 func (_m *UserManager) GetUserInfoByID(ctx context.Context, userID string) (entity.UserInfo, error) {
 	ret := _m.Called(ctx, userID)
@@ -0,0 +1,150 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log         *slog.Logger
+	identityMgr IdentityManager
+	userMgr     UserManager
+	merchMgr    MerchManager
+	txMgr       TransactionManager
+}
+
+type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+	}
+
+	UserManager interface {
+		GetUserInfoByUsername(ctx context.Context, username string) (entity.UserInfo, error)
+	}
+
+	MerchManager interface {
+		GetMerchByName(ctx context.Context, itemName string) (entity.Merch, error)
+		TransferItems(ctx context.Context, transfer entity.ItemTransfer) error
+		ListItemTransfers(ctx context.Context, userID string) ([]entity.ItemTransfer, error)
+	}
+
+	TransactionManager interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+)
+
+func NewUsecase(
+	log *slog.Logger,
+	identityMgr IdentityManager,
+	userMgr UserManager,
+	merchMgr MerchManager,
+	txMgr TransactionManager,
+) *Usecase {
+	return &Usecase{
+		log:         log,
+		identityMgr: identityMgr,
+		userMgr:     userMgr,
+		merchMgr:    merchMgr,
+		txMgr:       txMgr,
+	}
+}
+
+// TransferItems moves the given number of owned items to another user. No coins are involved
+func (u *Usecase) TransferItems(ctx context.Context, toUsername, itemName string, quantity int) error {
+	const op = "usecase.Inventory.TransferItems"
+
+	log := u.log.With(slog.String("op", op))
+
+	if quantity <= 0 {
+		err := fmt.Errorf("%s: %w", op, domain.ErrQuantityMustBePositive)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return domain.ErrBadRequest
+	}
+
+	senderID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	receiver, err := u.userMgr.GetUserInfoByUsername(ctx, toUsername)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			e.LogError(ctx, log, domain.ErrReceiverNotFound, err)
+			return domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetUserInfo, err)
+		return domain.ErrFailedToGetUserInfo
+	}
+
+	if receiver.ID == senderID {
+		err = fmt.Errorf("%s: %w", op, domain.ErrCannotTransferToYourself)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return domain.ErrBadRequest
+	}
+
+	merch, err := u.merchMgr.GetMerchByName(ctx, itemName)
+	if err != nil {
+		if errors.Is(err, domain.ErrMerchNotFound) {
+			e.LogError(ctx, log, domain.ErrMerchNotFound, err, slog.String("item", itemName))
+			return domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetMerch, err)
+		return domain.ErrFailedToGetMerch
+	}
+
+	transfer := entity.NewItemTransfer(senderID, receiver.ID, merch, quantity)
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err = u.merchMgr.TransferItems(txCtx, transfer); err != nil {
+			if errors.Is(err, domain.ErrNotEnoughItems) {
+				e.LogError(txCtx, log, domain.ErrNotEnoughItems, err,
+					slog.String("item", itemName),
+					slog.Int("quantity", quantity),
+				)
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToTransferItems, err)
+			return domain.ErrFailedToTransferItems
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err,
+			slog.Any("senderID", senderID),
+			slog.Any("receiverID", receiver.ID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (u *Usecase) ListItemTransfers(ctx context.Context) ([]entity.ItemTransfer, error) {
+	const op = "usecase.Inventory.ListItemTransfers"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return nil, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	transfers, err := u.merchMgr.ListItemTransfers(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListItemTransfers, err)
+		return nil, domain.ErrFailedToListItemTransfers
+	}
+
+	return transfers, nil
+}
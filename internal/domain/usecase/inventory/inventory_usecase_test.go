@@ -0,0 +1,219 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/inventory/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_TransferItems(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	senderID := "test-sender-id"
+
+	receiver := entity.UserInfo{
+		ID: "test-receiver-id",
+	}
+
+	merch := entity.Merch{
+		ID:    "test-merch-id",
+		Name:  "umbrella",
+		Price: 200,
+	}
+
+	toUsername := "test-receiver"
+	quantity := 2
+
+	tests := []struct {
+		name         string
+		quantity     int
+		mockBehavior func(
+			identityMgr *mocks.IdentityManager,
+			userMgr *mocks.UserManager,
+			merchMgr *mocks.MerchManager,
+			txMgr *mocks.TransactionManager,
+		)
+		expectedError error
+	}{
+		{
+			name:     "Success",
+			quantity: quantity,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(senderID, nil)
+
+				userMgr.EXPECT().GetUserInfoByUsername(ctx, toUsername).
+					Once().
+					Return(receiver, nil)
+
+				merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(merch, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				merchMgr.EXPECT().TransferItems(ctx, mock.MatchedBy(func(transfer entity.ItemTransfer) bool {
+					return transfer.SenderID == senderID &&
+						transfer.ReceiverID == receiver.ID &&
+						transfer.MerchID == merch.ID &&
+						transfer.Quantity == quantity
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Error - Quantity is not positive",
+			quantity: 0,
+			mockBehavior: func(
+				_ *mocks.IdentityManager,
+				_ *mocks.UserManager,
+				_ *mocks.MerchManager,
+				_ *mocks.TransactionManager,
+			) {
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:     "Error - Receiver not found",
+			quantity: quantity,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				_ *mocks.MerchManager,
+				_ *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(senderID, nil)
+
+				userMgr.EXPECT().GetUserInfoByUsername(ctx, toUsername).
+					Once().
+					Return(entity.UserInfo{}, domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:     "Error - Transfer to yourself",
+			quantity: quantity,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				_ *mocks.MerchManager,
+				_ *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(receiver.ID, nil)
+
+				userMgr.EXPECT().GetUserInfoByUsername(ctx, toUsername).
+					Once().
+					Return(receiver, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:     "Error - Not enough items",
+			quantity: quantity,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(senderID, nil)
+
+				userMgr.EXPECT().GetUserInfoByUsername(ctx, toUsername).
+					Once().
+					Return(receiver, nil)
+
+				merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(merch, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				merchMgr.EXPECT().TransferItems(ctx, mock.AnythingOfType("entity.ItemTransfer")).
+					Once().
+					Return(domain.ErrNotEnoughItems)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:     "Error - Failed to transfer items",
+			quantity: quantity,
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(senderID, nil)
+
+				userMgr.EXPECT().GetUserInfoByUsername(ctx, toUsername).
+					Once().
+					Return(receiver, nil)
+
+				merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(merch, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				merchMgr.EXPECT().TransferItems(ctx, mock.AnythingOfType("entity.ItemTransfer")).
+					Once().
+					Return(errors.New("merch manager error"))
+			},
+			expectedError: domain.ErrFailedToTransferItems,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tt.mockBehavior(identityMgr, userMgr, merchMgr, txMgr)
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, merchMgr, txMgr)
+			err := usecase.TransferItems(ctx, toUsername, merch.Name, tt.quantity)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
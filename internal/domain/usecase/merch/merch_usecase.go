@@ -0,0 +1,220 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log      *slog.Logger
+	merchMgr MerchManager
+	imageMgr ImageManager
+}
+
+type (
+	MerchManager interface {
+		GetMerchByName(ctx context.Context, itemName string) (entity.Merch, error)
+		SearchMerch(ctx context.Context, filter entity.MerchFilter) (entity.MerchPage, error)
+		ListCategories(ctx context.Context) ([]string, error)
+		CreateMerchImage(ctx context.Context, image entity.MerchImage) error
+		GetMerchImageByID(ctx context.Context, imageID string) (entity.MerchImage, error)
+		ListMerchImages(ctx context.Context, merchIDs []string) ([]entity.MerchImage, error)
+		DeleteMerchImage(ctx context.Context, imageID string) error
+	}
+
+	ImageManager interface {
+		SaveMerchImage(ctx context.Context, merchID string, data []byte) (entity.MerchImage, error)
+		DeleteMerchImage(ctx context.Context, image entity.MerchImage) error
+		GetImage(ctx context.Context, key string) (entity.Blob, error)
+	}
+)
+
+func NewUsecase(log *slog.Logger, merchMgr MerchManager, imageMgr ImageManager) *Usecase {
+	return &Usecase{
+		log:      log,
+		merchMgr: merchMgr,
+		imageMgr: imageMgr,
+	}
+}
+
+func (u *Usecase) SearchMerch(ctx context.Context, filter entity.MerchFilter) (entity.MerchPage, error) {
+	const op = "usecase.Merch.SearchMerch"
+
+	log := u.log.With(slog.String("op", op))
+
+	filter.Query = strings.TrimSpace(filter.Query)
+
+	if filter.Limit == 0 {
+		filter.Limit = entity.DefaultMerchPageLimit
+	}
+
+	if filter.Limit < 0 || filter.Limit > entity.MaxMerchPageLimit || filter.Offset < 0 {
+		err := fmt.Errorf("%s: %w", op, domain.ErrInvalidPagination)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.MerchPage{}, domain.ErrBadRequest
+	}
+
+	if (filter.MinPrice != nil && *filter.MinPrice < 0) ||
+		(filter.MaxPrice != nil && *filter.MaxPrice < 0) ||
+		(filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice) {
+		err := fmt.Errorf("%s: %w", op, domain.ErrInvalidPriceRange)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.MerchPage{}, domain.ErrBadRequest
+	}
+
+	page, err := u.merchMgr.SearchMerch(ctx, filter)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToSearchMerch, err)
+		return entity.MerchPage{}, domain.ErrFailedToSearchMerch
+	}
+
+	if err = u.attachImages(ctx, page.Items); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListMerchImages, err)
+		return entity.MerchPage{}, domain.ErrFailedToListMerchImages
+	}
+
+	return page, nil
+}
+
+func (u *Usecase) ListCategories(ctx context.Context) ([]string, error) {
+	const op = "usecase.Merch.ListCategories"
+
+	log := u.log.With(slog.String("op", op))
+
+	categories, err := u.merchMgr.ListCategories(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListCategories, err)
+		return nil, domain.ErrFailedToListCategories
+	}
+
+	return categories, nil
+}
+
+func (u *Usecase) UploadMerchImage(ctx context.Context, itemName string, data []byte) (entity.MerchImage, error) {
+	const op = "usecase.Merch.UploadMerchImage"
+
+	log := u.log.With(slog.String("op", op))
+
+	merch, err := u.merchMgr.GetMerchByName(ctx, itemName)
+	if err != nil {
+		if errors.Is(err, domain.ErrMerchNotFound) {
+			e.LogError(ctx, log, domain.ErrMerchNotFound, err, slog.String("item", itemName))
+			return entity.MerchImage{}, domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetMerch, err)
+		return entity.MerchImage{}, domain.ErrFailedToGetMerch
+	}
+
+	image, err := u.imageMgr.SaveMerchImage(ctx, merch.ID, data)
+	if err != nil {
+		if errors.Is(err, domain.ErrImageTooLarge) ||
+			errors.Is(err, domain.ErrUnsupportedImageType) ||
+			errors.Is(err, domain.ErrInvalidImage) {
+			e.LogError(ctx, log, domain.ErrBadRequest, err)
+			return entity.MerchImage{}, domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToSaveImage, err)
+		return entity.MerchImage{}, domain.ErrFailedToSaveImage
+	}
+
+	if err = u.merchMgr.CreateMerchImage(ctx, image); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToSaveImage, err)
+
+		if err = u.imageMgr.DeleteMerchImage(ctx, image); err != nil {
+			e.LogError(ctx, log, domain.ErrFailedToDeleteImage, err, slog.String("imageID", image.ID))
+		}
+
+		return entity.MerchImage{}, domain.ErrFailedToSaveImage
+	}
+
+	return image, nil
+}
+
+func (u *Usecase) DeleteMerchImage(ctx context.Context, imageID string) error {
+	const op = "usecase.Merch.DeleteMerchImage"
+
+	log := u.log.With(slog.String("op", op))
+
+	image, err := u.merchMgr.GetMerchImageByID(ctx, imageID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMerchImageNotFound) {
+			e.LogError(ctx, log, domain.ErrMerchImageNotFound, err, slog.String("imageID", imageID))
+			return domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetMerchImage, err)
+		return domain.ErrFailedToGetMerchImage
+	}
+
+	if err = u.merchMgr.DeleteMerchImage(ctx, imageID); err != nil {
+		if errors.Is(err, domain.ErrMerchImageNotFound) {
+			e.LogError(ctx, log, domain.ErrMerchImageNotFound, err, slog.String("imageID", imageID))
+			return domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToDeleteImage, err)
+		return domain.ErrFailedToDeleteImage
+	}
+
+	// The image is already gone from the catalog, so leftover files are only logged
+	if err = u.imageMgr.DeleteMerchImage(ctx, image); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToDeleteImage, err, slog.String("imageID", imageID))
+	}
+
+	return nil
+}
+
+func (u *Usecase) GetImage(ctx context.Context, key string) (entity.Blob, error) {
+	const op = "usecase.Merch.GetImage"
+
+	log := u.log.With(slog.String("op", op))
+
+	blob, err := u.imageMgr.GetImage(ctx, key)
+	if err != nil {
+		if errors.Is(err, domain.ErrImageNotFound) {
+			return entity.Blob{}, domain.ErrImageNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetImage, err)
+		return entity.Blob{}, domain.ErrFailedToGetImage
+	}
+
+	return blob, nil
+}
+
+// attachImages loads images of all found items with a single query
+func (u *Usecase) attachImages(ctx context.Context, items []entity.Merch) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	merchIDs := make([]string, len(items))
+	for i, item := range items {
+		merchIDs[i] = item.ID
+	}
+
+	images, err := u.merchMgr.ListMerchImages(ctx, merchIDs)
+	if err != nil {
+		return err
+	}
+
+	byMerch := make(map[string][]entity.MerchImage, len(items))
+	for _, image := range images {
+		byMerch[image.MerchID] = append(byMerch[image.MerchID], image)
+	}
+
+	for i := range items {
+		items[i].Images = byMerch[items[i].ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,323 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/merch/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_SearchMerch(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	minPrice := 100
+	maxPrice := 10
+	negativePrice := -1
+
+	// The usecase fills images in place, so every call gets its own page
+	newPage := func() entity.MerchPage {
+		return entity.MerchPage{
+			Items: []entity.Merch{
+				{
+					ID:          "test-merch-id",
+					Name:        "hoody",
+					Description: "Warm hoody with the company logo",
+					Category:    "clothing",
+					Tags:        []string{"logo", "warm"},
+					Price:       300,
+				},
+			},
+			Total: 1,
+		}
+	}
+
+	image := entity.MerchImage{
+		ID:           "test-image-id",
+		MerchID:      "test-merch-id",
+		Key:          "merch/test-merch-id/test-image-id.jpg",
+		ThumbnailKey: "merch/test-merch-id/test-image-id_thumb.jpg",
+	}
+
+	expectedPage := newPage()
+	expectedPage.Items[0].Images = []entity.MerchImage{image}
+
+	tests := []struct {
+		name          string
+		filter        entity.MerchFilter
+		mockBehavior  func(merchMgr *mocks.MerchManager)
+		expectedPage  entity.MerchPage
+		expectedError error
+	}{
+		{
+			name:   "Success – Default limit applied",
+			filter: entity.MerchFilter{Query: "  hoody  ", Category: "clothing"},
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().SearchMerch(ctx, entity.MerchFilter{
+					Query:    "hoody",
+					Category: "clothing",
+					Limit:    entity.DefaultMerchPageLimit,
+				}).
+					Once().
+					Return(newPage(), nil)
+
+				merchMgr.EXPECT().ListMerchImages(ctx, []string{"test-merch-id"}).
+					Once().
+					Return([]entity.MerchImage{image}, nil)
+			},
+			expectedPage:  expectedPage,
+			expectedError: nil,
+		},
+		{
+			name:          "Error – Limit is too large",
+			filter:        entity.MerchFilter{Limit: entity.MaxMerchPageLimit + 1},
+			mockBehavior:  func(_ *mocks.MerchManager) {},
+			expectedPage:  entity.MerchPage{},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:          "Error – Negative offset",
+			filter:        entity.MerchFilter{Offset: -1},
+			mockBehavior:  func(_ *mocks.MerchManager) {},
+			expectedPage:  entity.MerchPage{},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:          "Error – Min price greater than max price",
+			filter:        entity.MerchFilter{MinPrice: &minPrice, MaxPrice: &maxPrice},
+			mockBehavior:  func(_ *mocks.MerchManager) {},
+			expectedPage:  entity.MerchPage{},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:          "Error – Negative price",
+			filter:        entity.MerchFilter{MinPrice: &negativePrice},
+			mockBehavior:  func(_ *mocks.MerchManager) {},
+			expectedPage:  entity.MerchPage{},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:   "Error – Failed to search merch",
+			filter: entity.MerchFilter{Query: "hoody"},
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().SearchMerch(ctx, entity.MerchFilter{
+					Query: "hoody",
+					Limit: entity.DefaultMerchPageLimit,
+				}).
+					Once().
+					Return(entity.MerchPage{}, errors.New("merch manager error"))
+			},
+			expectedPage:  entity.MerchPage{},
+			expectedError: domain.ErrFailedToSearchMerch,
+		},
+		{
+			name:   "Error – Failed to list merch images",
+			filter: entity.MerchFilter{Query: "hoody"},
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().SearchMerch(ctx, entity.MerchFilter{
+					Query: "hoody",
+					Limit: entity.DefaultMerchPageLimit,
+				}).
+					Once().
+					Return(newPage(), nil)
+
+				merchMgr.EXPECT().ListMerchImages(ctx, []string{"test-merch-id"}).
+					Once().
+					Return(nil, errors.New("merch manager error"))
+			},
+			expectedPage:  entity.MerchPage{},
+			expectedError: domain.ErrFailedToListMerchImages,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merchMgr := mocks.NewMerchManager(t)
+			imageMgr := mocks.NewImageManager(t)
+			tt.mockBehavior(merchMgr)
+
+			usecase := NewUsecase(logger, merchMgr, imageMgr)
+			page, err := usecase.SearchMerch(ctx, tt.filter)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, page)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedPage, page)
+			}
+		})
+	}
+}
+
+func TestUsecase_ListCategories(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	tests := []struct {
+		name               string
+		mockBehavior       func(merchMgr *mocks.MerchManager)
+		expectedCategories []string
+		expectedError      error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().ListCategories(ctx).
+					Once().
+					Return([]string{"clothing", "stationery"}, nil)
+			},
+			expectedCategories: []string{"clothing", "stationery"},
+			expectedError:      nil,
+		},
+		{
+			name: "Error – Failed to list categories",
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().ListCategories(ctx).
+					Once().
+					Return(nil, errors.New("merch manager error"))
+			},
+			expectedCategories: nil,
+			expectedError:      domain.ErrFailedToListCategories,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merchMgr := mocks.NewMerchManager(t)
+			imageMgr := mocks.NewImageManager(t)
+			tt.mockBehavior(merchMgr)
+
+			usecase := NewUsecase(logger, merchMgr, imageMgr)
+			categories, err := usecase.ListCategories(ctx)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, categories)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedCategories, categories)
+			}
+		})
+	}
+}
+
+func TestUsecase_UploadMerchImage(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	itemName := "hoody"
+	data := []byte("image-data")
+
+	merch := entity.Merch{
+		ID:    "test-merch-id",
+		Name:  itemName,
+		Price: 300,
+	}
+
+	image := entity.MerchImage{
+		ID:           "test-image-id",
+		MerchID:      merch.ID,
+		Key:          "merch/test-merch-id/test-image-id.jpg",
+		ThumbnailKey: "merch/test-merch-id/test-image-id_thumb.jpg",
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(merchMgr *mocks.MerchManager, imageMgr *mocks.ImageManager)
+		expectedImage entity.MerchImage
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(merchMgr *mocks.MerchManager, imageMgr *mocks.ImageManager) {
+				merchMgr.EXPECT().GetMerchByName(ctx, itemName).
+					Once().
+					Return(merch, nil)
+
+				imageMgr.EXPECT().SaveMerchImage(ctx, merch.ID, data).
+					Once().
+					Return(image, nil)
+
+				merchMgr.EXPECT().CreateMerchImage(ctx, image).
+					Once().
+					Return(nil)
+			},
+			expectedImage: image,
+			expectedError: nil,
+		},
+		{
+			name: "Error – Merch not found",
+			mockBehavior: func(merchMgr *mocks.MerchManager, _ *mocks.ImageManager) {
+				merchMgr.EXPECT().GetMerchByName(ctx, itemName).
+					Once().
+					Return(entity.Merch{}, domain.ErrMerchNotFound)
+			},
+			expectedImage: entity.MerchImage{},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Unsupported image type",
+			mockBehavior: func(merchMgr *mocks.MerchManager, imageMgr *mocks.ImageManager) {
+				merchMgr.EXPECT().GetMerchByName(ctx, itemName).
+					Once().
+					Return(merch, nil)
+
+				imageMgr.EXPECT().SaveMerchImage(ctx, merch.ID, data).
+					Once().
+					Return(entity.MerchImage{}, domain.ErrUnsupportedImageType)
+			},
+			expectedImage: entity.MerchImage{},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Failed to create merch image removes stored files",
+			mockBehavior: func(merchMgr *mocks.MerchManager, imageMgr *mocks.ImageManager) {
+				merchMgr.EXPECT().GetMerchByName(ctx, itemName).
+					Once().
+					Return(merch, nil)
+
+				imageMgr.EXPECT().SaveMerchImage(ctx, merch.ID, data).
+					Once().
+					Return(image, nil)
+
+				merchMgr.EXPECT().CreateMerchImage(ctx, image).
+					Once().
+					Return(errors.New("merch manager error"))
+
+				imageMgr.EXPECT().DeleteMerchImage(ctx, image).
+					Once().
+					Return(nil)
+			},
+			expectedImage: entity.MerchImage{},
+			expectedError: domain.ErrFailedToSaveImage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merchMgr := mocks.NewMerchManager(t)
+			imageMgr := mocks.NewImageManager(t)
+			tt.mockBehavior(merchMgr, imageMgr)
+
+			usecase := NewUsecase(logger, merchMgr, imageMgr)
+			result, err := usecase.UploadMerchImage(ctx, itemName, data)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedImage, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package offboarding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log         *slog.Logger
+	identityMgr IdentityManager
+	userMgr     UserManager
+	sessionMgr  SessionManager
+	coinsMgr    CoinManager
+	merchMgr    MerchManager
+	txMgr       TransactionManager
+}
+
+type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+	}
+
+	UserManager interface {
+		GetUserByName(ctx context.Context, username string) (entity.User, error)
+		DeactivateUser(ctx context.Context, userID string, keepBalance bool, deactivatedAt time.Time) (int, error)
+	}
+
+	SessionManager interface {
+		RevokeUserSessions(ctx context.Context, userID string) error
+	}
+
+	CoinManager interface {
+		AdjustUserCoins(ctx context.Context, userID string, amount int) error
+		RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransfer) error
+	}
+
+	MerchManager interface {
+		RefundUserBids(ctx context.Context, userID string) ([]entity.AuctionBid, error)
+		DeleteUserWaitlist(ctx context.Context, userID string) error
+	}
+
+	TransactionManager interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+)
+
+func NewUsecase(
+	log *slog.Logger,
+	identityMgr IdentityManager,
+	userMgr UserManager,
+	sessionMgr SessionManager,
+	coinsMgr CoinManager,
+	merchMgr MerchManager,
+	txMgr TransactionManager,
+) *Usecase {
+	return &Usecase{
+		log:         log,
+		identityMgr: identityMgr,
+		userMgr:     userMgr,
+		sessionMgr:  sessionMgr,
+		coinsMgr:    coinsMgr,
+		merchMgr:    merchMgr,
+		txMgr:       txMgr,
+	}
+}
+
+// DeactivateUser offboards a user on behalf of an admin. The account is soft-deleted, so the user
+// can't sign in and the username can be taken again, all sessions are revoked and the remaining
+// balance is handled by the policy. Bids held in escrow are refunded first, so they are part of that
+// balance, and the user leaves every waitlist. Transactions of the user are kept, counterparties see them
+// as made with a deactivated user
+func (u *Usecase) DeactivateUser(ctx context.Context, deactivation entity.Deactivation) (entity.DeactivationResult, error) {
+	const op = "usecase.Offboarding.DeactivateUser"
+
+	log := u.log.With(slog.String("op", op))
+
+	adminID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.DeactivationResult{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	deactivation.TransferTo = strings.TrimSpace(deactivation.TransferTo)
+
+	if err = validateDeactivation(deactivation); err != nil {
+		e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("userID", deactivation.UserID))
+		return entity.DeactivationResult{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+	}
+
+	// Otherwise the last superadmin could lock everyone out
+	if adminID == deactivation.UserID {
+		e.LogError(ctx, log, domain.ErrCannotDeactivateSelf, domain.ErrCannotDeactivateSelf, slog.String("userID", adminID))
+		return entity.DeactivationResult{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrCannotDeactivateSelf)
+	}
+
+	var receiver entity.User
+
+	if deactivation.BalancePolicy == entity.BalancePolicyTransfer {
+		receiver, err = u.userMgr.GetUserByName(ctx, deactivation.TransferTo)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				e.LogError(ctx, log, domain.ErrReceiverNotFound, err, slog.String("transferTo", deactivation.TransferTo))
+				return entity.DeactivationResult{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrReceiverNotFound)
+			}
+
+			e.LogError(ctx, log, domain.ErrFailedToGetUser, err, slog.String("transferTo", deactivation.TransferTo))
+			return entity.DeactivationResult{}, domain.ErrFailedToGetUser
+		}
+
+		if receiver.ID == deactivation.UserID {
+			e.LogError(ctx, log, domain.ErrInvalidTransferRecipient, domain.ErrInvalidTransferRecipient,
+				slog.String("userID", deactivation.UserID),
+			)
+			return entity.DeactivationResult{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrInvalidTransferRecipient)
+		}
+	}
+
+	result := entity.DeactivationResult{
+		UserID:        deactivation.UserID,
+		BalancePolicy: deactivation.BalancePolicy,
+		TransferredTo: receiver.Username,
+		DeactivatedAt: time.Now(),
+	}
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		// A deactivated account can't be credited, so this must happen while it's still active
+		if err = u.refundBids(txCtx, log, deactivation.UserID); err != nil {
+			return err
+		}
+
+		if err = u.merchMgr.DeleteUserWaitlist(txCtx, deactivation.UserID); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToLeaveWaitlist, err, slog.String("userID", deactivation.UserID))
+			return domain.ErrFailedToLeaveWaitlist
+		}
+
+		keepBalance := deactivation.BalancePolicy == entity.BalancePolicyFreeze
+
+		result.Balance, err = u.userMgr.DeactivateUser(txCtx, deactivation.UserID, keepBalance, result.DeactivatedAt)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				e.LogError(txCtx, log, domain.ErrUserNotFound, err, slog.String("userID", deactivation.UserID))
+				return domain.ErrUserNotFound
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToDeactivateUser, err, slog.String("userID", deactivation.UserID))
+			return domain.ErrFailedToDeactivateUser
+		}
+
+		if err = u.sessionMgr.RevokeUserSessions(txCtx, deactivation.UserID); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToRevokeSessions, err, slog.String("userID", deactivation.UserID))
+			return domain.ErrFailedToRevokeSessions
+		}
+
+		return u.settleBalance(txCtx, log, result, receiver.ID)
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err, slog.String("userID", deactivation.UserID))
+		return entity.DeactivationResult{}, err
+	}
+
+	log.Info("user deactivated",
+		slog.String("userID", deactivation.UserID),
+		slog.String("deactivatedBy", adminID),
+		slog.String("balancePolicy", string(deactivation.BalancePolicy)),
+		slog.Int("balance", result.Balance),
+		slog.String("transferredTo", receiver.ID),
+	)
+
+	return result, nil
+}
+
+// refundBids returns the coins of the bids the user holds to the balance. The auctions the user leads
+// are open to new bids again, otherwise a deactivated account could win them
+func (u *Usecase) refundBids(ctx context.Context, log *slog.Logger, userID string) error {
+	bids, err := u.merchMgr.RefundUserBids(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToRefundBids, err, slog.String("userID", userID))
+		return domain.ErrFailedToRefundBids
+	}
+
+	for _, bid := range bids {
+		if err = u.coinsMgr.AdjustUserCoins(ctx, userID, bid.Amount); err != nil {
+			e.LogError(ctx, log, domain.ErrFailedToUpdateUserCoins, err, slog.String("userID", userID))
+			return domain.ErrFailedToUpdateUserCoins
+		}
+
+		ct := entity.NewCoinTransfer("", userID, entity.TransactionTypeAuctionRefund, bid.Amount, bid.ResolvedAt)
+
+		if err = u.coinsMgr.RegisterCoinTransfer(ctx, ct); err != nil {
+			e.LogError(ctx, log, domain.ErrFailedToRegisterCoinTransfer, err, slog.String("userID", userID))
+			return domain.ErrFailedToRegisterCoinTransfer
+		}
+
+		log.Info("auction bid refunded",
+			slog.String("userID", userID),
+			slog.String("auctionID", bid.AuctionID),
+			slog.Int("amount", bid.Amount),
+		)
+	}
+
+	return nil
+}
+
+// settleBalance moves the coins left on the account. Frozen coins stay where they are
+func (u *Usecase) settleBalance(ctx context.Context, log *slog.Logger, result entity.DeactivationResult, receiverID string) error {
+	if result.Balance == 0 || result.BalancePolicy == entity.BalancePolicyFreeze {
+		return nil
+	}
+
+	var ct entity.CoinTransfer
+
+	switch result.BalancePolicy {
+	case entity.BalancePolicyTransfer:
+		if err := u.coinsMgr.AdjustUserCoins(ctx, receiverID, result.Balance); err != nil {
+			e.LogError(ctx, log, domain.ErrFailedToUpdateUserCoins, err, slog.String("receiverID", receiverID))
+			return domain.ErrFailedToUpdateUserCoins
+		}
+
+		ct = entity.NewCoinTransfer(result.UserID, receiverID, entity.TransactionTypeTransferCoins, result.Balance, result.DeactivatedAt)
+	default:
+		ct = entity.NewCoinTransfer(result.UserID, "", entity.TransactionTypeForfeitCoins, result.Balance, result.DeactivatedAt)
+	}
+
+	if err := u.coinsMgr.RegisterCoinTransfer(ctx, ct); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToRegisterCoinTransfer, err, slog.String("userID", result.UserID))
+		return domain.ErrFailedToRegisterCoinTransfer
+	}
+
+	return nil
+}
+
+func validateDeactivation(deactivation entity.Deactivation) error {
+	if !deactivation.BalancePolicy.IsValid() {
+		return domain.ErrInvalidBalancePolicy
+	}
+
+	if deactivation.BalancePolicy == entity.BalancePolicyTransfer {
+		if deactivation.TransferTo == "" {
+			return domain.ErrTransferRecipientRequired
+		}
+	} else if deactivation.TransferTo != "" {
+		return domain.ErrUnexpectedTransferRecipient
+	}
+
+	return nil
+}
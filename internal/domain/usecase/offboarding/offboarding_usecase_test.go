@@ -0,0 +1,364 @@
+package offboarding
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/offboarding/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_DeactivateUser(t *testing.T) {
+	ctx := context.Background()
+
+	adminID := "test-admin-id"
+	userID := "test-user-id"
+	balance := 250
+
+	receiver := entity.User{
+		ID:       "test-receiver-id",
+		Username: "receiver",
+	}
+
+	type mockBehavior func(
+		userMgr *mocks.UserManager,
+		sessionMgr *mocks.SessionManager,
+		coinsMgr *mocks.CoinManager,
+		merchMgr *mocks.MerchManager,
+		txMgr *mocks.TransactionManager,
+	)
+
+	withinTransaction := func(txMgr *mocks.TransactionManager) {
+		txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+	}
+
+	// Bids are refunded and waitlists left before the account is deactivated
+	expectLeftMerch := func(merchMgr *mocks.MerchManager, bids ...entity.AuctionBid) {
+		merchMgr.EXPECT().RefundUserBids(ctx, userID).
+			Once().
+			Return(bids, nil)
+
+		merchMgr.EXPECT().DeleteUserWaitlist(ctx, userID).
+			Once().
+			Return(nil)
+	}
+
+	coinTransfer := func(senderID, receiverID string, tt entity.TransactionType, amount int) any {
+		return mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+			return ct.SenderID == senderID &&
+				ct.ReceiverID == receiverID &&
+				ct.TransactionType == tt &&
+				ct.Amount == int32(amount)
+		})
+	}
+
+	tests := []struct {
+		name            string
+		deactivation    entity.Deactivation
+		mockBehavior    mockBehavior
+		expectedBalance int
+		expectedError   error
+	}{
+		{
+			name:         "Success – Forfeit",
+			deactivation: entity.Deactivation{UserID: userID, BalancePolicy: entity.BalancePolicyForfeit},
+			mockBehavior: func(userMgr *mocks.UserManager, sessionMgr *mocks.SessionManager, coinsMgr *mocks.CoinManager, merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				withinTransaction(txMgr)
+				expectLeftMerch(merchMgr)
+
+				userMgr.EXPECT().DeactivateUser(ctx, userID, false, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(balance, nil)
+
+				sessionMgr.EXPECT().RevokeUserSessions(ctx, userID).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, coinTransfer(userID, "", entity.TransactionTypeForfeitCoins, balance)).
+					Once().
+					Return(nil)
+			},
+			expectedBalance: balance,
+			expectedError:   nil,
+		},
+		{
+			name: "Success – Transfer",
+			deactivation: entity.Deactivation{
+				UserID:        userID,
+				BalancePolicy: entity.BalancePolicyTransfer,
+				TransferTo:    " receiver ",
+			},
+			mockBehavior: func(userMgr *mocks.UserManager, sessionMgr *mocks.SessionManager, coinsMgr *mocks.CoinManager, merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				userMgr.EXPECT().GetUserByName(ctx, receiver.Username).
+					Once().
+					Return(receiver, nil)
+
+				withinTransaction(txMgr)
+				expectLeftMerch(merchMgr)
+
+				userMgr.EXPECT().DeactivateUser(ctx, userID, false, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(balance, nil)
+
+				sessionMgr.EXPECT().RevokeUserSessions(ctx, userID).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, receiver.ID, balance).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, coinTransfer(userID, receiver.ID, entity.TransactionTypeTransferCoins, balance)).
+					Once().
+					Return(nil)
+			},
+			expectedBalance: balance,
+			expectedError:   nil,
+		},
+		{
+			name:         "Success – Highest bidder gets the bid back before the forfeit",
+			deactivation: entity.Deactivation{UserID: userID, BalancePolicy: entity.BalancePolicyForfeit},
+			mockBehavior: func(userMgr *mocks.UserManager, sessionMgr *mocks.SessionManager, coinsMgr *mocks.CoinManager, merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				bid := entity.AuctionBid{
+					ID:        "test-bid-id",
+					AuctionID: "test-auction-id",
+					UserID:    userID,
+					Amount:    100,
+					Status:    entity.BidStatusRefunded,
+				}
+
+				withinTransaction(txMgr)
+				expectLeftMerch(merchMgr, bid)
+
+				// The escrow is credited while the account is still active
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, userID, bid.Amount).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, coinTransfer("", userID, entity.TransactionTypeAuctionRefund, bid.Amount)).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().DeactivateUser(ctx, userID, false, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(balance+bid.Amount, nil)
+
+				sessionMgr.EXPECT().RevokeUserSessions(ctx, userID).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, coinTransfer(userID, "", entity.TransactionTypeForfeitCoins, balance+bid.Amount)).
+					Once().
+					Return(nil)
+			},
+			expectedBalance: balance + 100,
+			expectedError:   nil,
+		},
+		{
+			name:         "Error – Failed to refund bids",
+			deactivation: entity.Deactivation{UserID: userID, BalancePolicy: entity.BalancePolicyFreeze},
+			mockBehavior: func(_ *mocks.UserManager, _ *mocks.SessionManager, _ *mocks.CoinManager, merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				withinTransaction(txMgr)
+
+				merchMgr.EXPECT().RefundUserBids(ctx, userID).
+					Once().
+					Return(nil, errors.New("merch manager error"))
+			},
+			expectedError: domain.ErrFailedToRefundBids,
+		},
+		{
+			name:         "Success – Freeze keeps the balance",
+			deactivation: entity.Deactivation{UserID: userID, BalancePolicy: entity.BalancePolicyFreeze},
+			mockBehavior: func(userMgr *mocks.UserManager, sessionMgr *mocks.SessionManager, _ *mocks.CoinManager, merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				withinTransaction(txMgr)
+				expectLeftMerch(merchMgr)
+
+				userMgr.EXPECT().DeactivateUser(ctx, userID, true, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(balance, nil)
+
+				sessionMgr.EXPECT().RevokeUserSessions(ctx, userID).
+					Once().
+					Return(nil)
+			},
+			expectedBalance: balance,
+			expectedError:   nil,
+		},
+		{
+			name:         "Success – Nothing to forfeit",
+			deactivation: entity.Deactivation{UserID: userID, BalancePolicy: entity.BalancePolicyForfeit},
+			mockBehavior: func(userMgr *mocks.UserManager, sessionMgr *mocks.SessionManager, _ *mocks.CoinManager, merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				withinTransaction(txMgr)
+				expectLeftMerch(merchMgr)
+
+				userMgr.EXPECT().DeactivateUser(ctx, userID, false, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(0, nil)
+
+				sessionMgr.EXPECT().RevokeUserSessions(ctx, userID).
+					Once().
+					Return(nil)
+			},
+			expectedBalance: 0,
+			expectedError:   nil,
+		},
+		{
+			name:         "Error – Unknown balance policy",
+			deactivation: entity.Deactivation{UserID: userID, BalancePolicy: "donate"},
+			mockBehavior: func(*mocks.UserManager, *mocks.SessionManager, *mocks.CoinManager, *mocks.MerchManager, *mocks.TransactionManager) {
+			},
+			expectedError: domain.ErrInvalidBalancePolicy,
+		},
+		{
+			name:         "Error – Transfer without a recipient",
+			deactivation: entity.Deactivation{UserID: userID, BalancePolicy: entity.BalancePolicyTransfer},
+			mockBehavior: func(*mocks.UserManager, *mocks.SessionManager, *mocks.CoinManager, *mocks.MerchManager, *mocks.TransactionManager) {
+			},
+			expectedError: domain.ErrTransferRecipientRequired,
+		},
+		{
+			name: "Error – Recipient with another policy",
+			deactivation: entity.Deactivation{
+				UserID:        userID,
+				BalancePolicy: entity.BalancePolicyForfeit,
+				TransferTo:    receiver.Username,
+			},
+			mockBehavior: func(*mocks.UserManager, *mocks.SessionManager, *mocks.CoinManager, *mocks.MerchManager, *mocks.TransactionManager) {
+			},
+			expectedError: domain.ErrUnexpectedTransferRecipient,
+		},
+		{
+			name:         "Error – Deactivating own account",
+			deactivation: entity.Deactivation{UserID: adminID, BalancePolicy: entity.BalancePolicyFreeze},
+			mockBehavior: func(*mocks.UserManager, *mocks.SessionManager, *mocks.CoinManager, *mocks.MerchManager, *mocks.TransactionManager) {
+			},
+			expectedError: domain.ErrCannotDeactivateSelf,
+		},
+		{
+			name: "Error – Recipient not found",
+			deactivation: entity.Deactivation{
+				UserID:        userID,
+				BalancePolicy: entity.BalancePolicyTransfer,
+				TransferTo:    receiver.Username,
+			},
+			mockBehavior: func(userMgr *mocks.UserManager, _ *mocks.SessionManager, _ *mocks.CoinManager, _ *mocks.MerchManager, _ *mocks.TransactionManager) {
+				userMgr.EXPECT().GetUserByName(ctx, receiver.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrReceiverNotFound,
+		},
+		{
+			name: "Error – Transfer to the deactivated user",
+			deactivation: entity.Deactivation{
+				UserID:        receiver.ID,
+				BalancePolicy: entity.BalancePolicyTransfer,
+				TransferTo:    receiver.Username,
+			},
+			mockBehavior: func(userMgr *mocks.UserManager, _ *mocks.SessionManager, _ *mocks.CoinManager, _ *mocks.MerchManager, _ *mocks.TransactionManager) {
+				userMgr.EXPECT().GetUserByName(ctx, receiver.Username).
+					Once().
+					Return(receiver, nil)
+			},
+			expectedError: domain.ErrInvalidTransferRecipient,
+		},
+		{
+			name:         "Error – User not found",
+			deactivation: entity.Deactivation{UserID: userID, BalancePolicy: entity.BalancePolicyForfeit},
+			mockBehavior: func(userMgr *mocks.UserManager, _ *mocks.SessionManager, _ *mocks.CoinManager, merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				withinTransaction(txMgr)
+				expectLeftMerch(merchMgr)
+
+				userMgr.EXPECT().DeactivateUser(ctx, userID, false, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(0, domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrUserNotFound,
+		},
+		{
+			name:         "Error – Failed to revoke sessions",
+			deactivation: entity.Deactivation{UserID: userID, BalancePolicy: entity.BalancePolicyForfeit},
+			mockBehavior: func(userMgr *mocks.UserManager, sessionMgr *mocks.SessionManager, _ *mocks.CoinManager, merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				withinTransaction(txMgr)
+				expectLeftMerch(merchMgr)
+
+				userMgr.EXPECT().DeactivateUser(ctx, userID, false, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(balance, nil)
+
+				sessionMgr.EXPECT().RevokeUserSessions(ctx, userID).
+					Once().
+					Return(errors.New("session manager error"))
+			},
+			expectedError: domain.ErrFailedToRevokeSessions,
+		},
+		{
+			name: "Error – Failed to credit the recipient",
+			deactivation: entity.Deactivation{
+				UserID:        userID,
+				BalancePolicy: entity.BalancePolicyTransfer,
+				TransferTo:    receiver.Username,
+			},
+			mockBehavior: func(userMgr *mocks.UserManager, sessionMgr *mocks.SessionManager, coinsMgr *mocks.CoinManager, merchMgr *mocks.MerchManager, txMgr *mocks.TransactionManager) {
+				userMgr.EXPECT().GetUserByName(ctx, receiver.Username).
+					Once().
+					Return(receiver, nil)
+
+				withinTransaction(txMgr)
+				expectLeftMerch(merchMgr)
+
+				userMgr.EXPECT().DeactivateUser(ctx, userID, false, mock.AnythingOfType("time.Time")).
+					Once().
+					Return(balance, nil)
+
+				sessionMgr.EXPECT().RevokeUserSessions(ctx, userID).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, receiver.ID, balance).
+					Once().
+					Return(errors.New("coins manager error"))
+			},
+			expectedError: domain.ErrFailedToUpdateUserCoins,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			sessionMgr := mocks.NewSessionManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+				Once().
+				Return(adminID, nil)
+
+			tt.mockBehavior(userMgr, sessionMgr, coinsMgr, merchMgr, txMgr)
+
+			usecase := NewUsecase(slogdiscard.NewDiscardLogger(), identityMgr, userMgr, sessionMgr, coinsMgr, merchMgr, txMgr)
+
+			result, err := usecase.DeactivateUser(ctx, tt.deactivation)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.deactivation.UserID, result.UserID)
+			require.Equal(t, tt.deactivation.BalancePolicy, result.BalancePolicy)
+			require.Equal(t, tt.expectedBalance, result.Balance)
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log           *slog.Logger
+	identityMgr   IdentityManager
+	userMgr       UserManager
+	sessionMgr    SessionManager
+	tokenMgr      TokenManager
+	passwordMgr   PasswordManager
+	loginThrottle LoginThrottle
+	txMgr         TransactionManager
+}
+
+type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+	}
+
+	UserManager interface {
+		GetUserByID(ctx context.Context, userID string) (entity.User, error)
+		UpdatePasswordHash(ctx context.Context, userID, passwordHash string) error
+		CreatePasswordResetToken(ctx context.Context, token entity.PasswordResetToken) error
+		UsePasswordResetToken(ctx context.Context, tokenHash string) (string, error)
+	}
+
+	SessionManager interface {
+		RevokeUserSessions(ctx context.Context, userID string) error
+	}
+
+	TokenManager interface {
+		GeneratePasswordResetToken(userID, createdBy string) (string, entity.PasswordResetToken, error)
+		HashPasswordResetToken(token string) string
+	}
+
+	PasswordManager interface {
+		CheckPasswordPolicy(username, password string) error
+		PasswordHash(password string) (string, error)
+		ValidatePassword(providedPassword, passwordHash string) error
+	}
+
+	LoginThrottle interface {
+		CheckLogin(ctx context.Context, username, ip string) error
+		RecordLoginFailure(ctx context.Context, username, ip string) ([]entity.LoginFailures, error)
+		ResetLoginFailures(ctx context.Context, username string) error
+	}
+
+	TransactionManager interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+)
+
+func NewUsecase(
+	log *slog.Logger,
+	identityMgr IdentityManager,
+	userMgr UserManager,
+	sessionMgr SessionManager,
+	tokenMgr TokenManager,
+	passwordMgr PasswordManager,
+	loginThrottle LoginThrottle,
+	txMgr TransactionManager,
+) *Usecase {
+	return &Usecase{
+		log:           log,
+		identityMgr:   identityMgr,
+		userMgr:       userMgr,
+		sessionMgr:    sessionMgr,
+		tokenMgr:      tokenMgr,
+		passwordMgr:   passwordMgr,
+		loginThrottle: loginThrottle,
+		txMgr:         txMgr,
+	}
+}
+
+// ChangePassword sets a new password for the current user after checking the old one.
+// All sessions are revoked, including the current one, so the user has to log in again.
+// Wrong old passwords count as failed logins, so a stolen token can't be used to guess the password
+func (u *Usecase) ChangePassword(ctx context.Context, oldPassword, newPassword, clientIP string) error {
+	const op = "usecase.Password.ChangePassword"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	user, err := u.userMgr.GetUserByID(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err, slog.String("userID", userID))
+		return domain.ErrFailedToGetUser
+	}
+
+	// There is no old password to check for users created by single sign-on, an admin can issue a reset
+	if user.PasswordHash == "" {
+		e.LogError(ctx, log, domain.ErrPasswordNotSet, domain.ErrPasswordNotSet, slog.String("userID", userID))
+		return fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrPasswordNotSet)
+	}
+
+	if err = u.checkLogin(ctx, log, user.Username, clientIP); err != nil {
+		return err
+	}
+
+	if err = u.passwordMgr.ValidatePassword(oldPassword, user.PasswordHash); err != nil {
+		if errors.Is(err, domain.ErrInvalidPassword) {
+			e.LogError(ctx, log, domain.ErrInvalidPassword, err, slog.String("userID", userID))
+			u.recordLoginFailure(ctx, log, user.Username, clientIP)
+			return fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrInvalidPassword)
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToValidatePassword, err)
+		return domain.ErrFailedToValidatePassword
+	}
+
+	// Not being able to reset the counter only makes a later lockout come earlier, the change goes on
+	if err = u.loginThrottle.ResetLoginFailures(ctx, user.Username); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToResetLoginFailures, err, slog.String("userID", userID))
+	}
+
+	if err = u.setPassword(ctx, log, user, newPassword); err != nil {
+		return err
+	}
+
+	log.Info("password changed", slog.String("userID", userID))
+
+	return nil
+}
+
+// IssuePasswordReset returns a one-time token the user can set a new password with.
+// Previously issued tokens stop working
+func (u *Usecase) IssuePasswordReset(ctx context.Context, userID string) (string, entity.PasswordResetToken, error) {
+	const op = "usecase.Password.IssuePasswordReset"
+
+	log := u.log.With(slog.String("op", op))
+
+	adminID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return "", entity.PasswordResetToken{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	resetToken, token, err := u.tokenMgr.GeneratePasswordResetToken(userID, adminID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToIssuePasswordReset, err)
+		return "", entity.PasswordResetToken{}, domain.ErrFailedToIssuePasswordReset
+	}
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err = u.userMgr.CreatePasswordResetToken(txCtx, token); err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				e.LogError(txCtx, log, domain.ErrUserNotFound, err, slog.String("userID", userID))
+				return domain.ErrUserNotFound
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToIssuePasswordReset, err, slog.String("userID", userID))
+			return domain.ErrFailedToIssuePasswordReset
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err)
+		return "", entity.PasswordResetToken{}, err
+	}
+
+	log.Info("password reset issued",
+		slog.String("userID", userID),
+		slog.String("issuedBy", adminID),
+	)
+
+	return resetToken, token, nil
+}
+
+// ResetPassword sets a new password using a reset token and revokes all sessions of the user
+func (u *Usecase) ResetPassword(ctx context.Context, resetToken, newPassword string) error {
+	const op = "usecase.Password.ResetPassword"
+
+	log := u.log.With(slog.String("op", op))
+
+	tokenHash := u.tokenMgr.HashPasswordResetToken(resetToken)
+
+	var userID string
+
+	// The token is only spent if the password is changed
+	if err := u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+
+		userID, err = u.userMgr.UsePasswordResetToken(txCtx, tokenHash)
+		if err != nil {
+			if errors.Is(err, domain.ErrPasswordResetTokenInvalid) {
+				e.LogError(txCtx, log, domain.ErrPasswordResetTokenInvalid, err)
+				return fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrPasswordResetTokenInvalid)
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToResetPassword, err)
+			return domain.ErrFailedToResetPassword
+		}
+
+		user, err := u.userMgr.GetUserByID(txCtx, userID)
+		if err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToGetUser, err, slog.String("userID", userID))
+			return domain.ErrFailedToResetPassword
+		}
+
+		return u.updatePassword(txCtx, log, user, newPassword)
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err)
+		return err
+	}
+
+	log.Info("password reset", slog.String("userID", userID))
+
+	return nil
+}
+
+func (u *Usecase) setPassword(ctx context.Context, log *slog.Logger, user entity.User, newPassword string) error {
+	if err := u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		return u.updatePassword(txCtx, log, user, newPassword)
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err)
+		return err
+	}
+
+	return nil
+}
+
+// updatePassword must be called within a transaction, so the password and the sessions change together
+func (u *Usecase) updatePassword(ctx context.Context, log *slog.Logger, user entity.User, newPassword string) error {
+	userID := user.ID
+
+	if err := u.passwordMgr.CheckPasswordPolicy(user.Username, newPassword); err != nil {
+		e.LogError(ctx, log, domain.ErrPasswordPolicyViolation, err, slog.String("userID", userID))
+		return fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+	}
+
+	passwordHash, err := u.passwordMgr.PasswordHash(newPassword)
+	if err != nil {
+		if errors.Is(err, domain.ErrPasswordIsNotAllowed) {
+			e.LogError(ctx, log, domain.ErrPasswordIsNotAllowed, err)
+			return fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrPasswordIsNotAllowed)
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGeneratePasswordHash, err)
+		return domain.ErrFailedToGeneratePasswordHash
+	}
+
+	if err = u.userMgr.UpdatePasswordHash(ctx, userID, passwordHash); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			e.LogError(ctx, log, domain.ErrUserNotFound, err, slog.String("userID", userID))
+			return domain.ErrUserNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToChangePassword, err, slog.String("userID", userID))
+		return domain.ErrFailedToChangePassword
+	}
+
+	if err = u.sessionMgr.RevokeUserSessions(ctx, userID); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToRevokeSessions, err, slog.String("userID", userID))
+		return domain.ErrFailedToRevokeSessions
+	}
+
+	return nil
+}
+
+// checkLogin applies the lockouts of the login, the old password is as good as a login to guess
+func (u *Usecase) checkLogin(ctx context.Context, log *slog.Logger, username, clientIP string) error {
+	if err := u.loginThrottle.CheckLogin(ctx, username, clientIP); err != nil {
+		var throttledErr *domain.LoginThrottledError
+		if errors.As(err, &throttledErr) {
+			log.Warn("password change blocked",
+				slog.String("username", username),
+				slog.String("ip", clientIP),
+				slog.String("reason", throttledErr.Err.Error()),
+				slog.Duration("retryAfter", throttledErr.RetryAfter),
+			)
+			return err
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToCheckLoginThrottle, err)
+		return domain.ErrFailedToCheckLoginThrottle
+	}
+
+	return nil
+}
+
+func (u *Usecase) recordLoginFailure(ctx context.Context, log *slog.Logger, username, clientIP string) {
+	lockouts, err := u.loginThrottle.RecordLoginFailure(ctx, username, clientIP)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToRecordLoginFailure, err,
+			slog.String("username", username),
+			slog.String("ip", clientIP),
+		)
+		return
+	}
+
+	for _, lockout := range lockouts {
+		log.Warn("logins locked out",
+			slog.String("scope", string(lockout.Scope)),
+			slog.String("subject", lockout.Subject),
+			slog.Int("failures", lockout.Failures),
+			slog.Time("lockedUntil", lockout.BlockedUntil),
+		)
+	}
+}
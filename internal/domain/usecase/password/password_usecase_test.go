@@ -0,0 +1,443 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/password/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testMocks struct {
+	identityMgr   *mocks.IdentityManager
+	userMgr       *mocks.UserManager
+	sessionMgr    *mocks.SessionManager
+	tokenMgr      *mocks.TokenManager
+	passwordMgr   *mocks.PasswordManager
+	loginThrottle *mocks.LoginThrottle
+	txMgr         *mocks.TransactionManager
+}
+
+func newTestUsecase(t *testing.T) (*Usecase, testMocks) {
+	m := testMocks{
+		identityMgr:   mocks.NewIdentityManager(t),
+		userMgr:       mocks.NewUserManager(t),
+		sessionMgr:    mocks.NewSessionManager(t),
+		tokenMgr:      mocks.NewTokenManager(t),
+		passwordMgr:   mocks.NewPasswordManager(t),
+		loginThrottle: mocks.NewLoginThrottle(t),
+		txMgr:         mocks.NewTransactionManager(t),
+	}
+
+	usecase := NewUsecase(
+		slogdiscard.NewDiscardLogger(),
+		m.identityMgr,
+		m.userMgr,
+		m.sessionMgr,
+		m.tokenMgr,
+		m.passwordMgr,
+		m.loginThrottle,
+		m.txMgr,
+	)
+
+	return usecase, m
+}
+
+func expectTransaction(ctx context.Context, txMgr *mocks.TransactionManager) {
+	txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+func TestUsecase_ChangePassword(t *testing.T) {
+	ctx := context.Background()
+
+	user := entity.User{
+		ID:           "test-user-id",
+		Username:     "test-user",
+		PasswordHash: "old_password_hash",
+	}
+
+	clientIP := "192.0.2.1"
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				m.loginThrottle.EXPECT().CheckLogin(ctx, user.Username, clientIP).
+					Once().
+					Return(nil)
+
+				m.passwordMgr.EXPECT().ValidatePassword("old_password", user.PasswordHash).
+					Once().
+					Return(nil)
+
+				m.loginThrottle.EXPECT().ResetLoginFailures(ctx, user.Username).
+					Once().
+					Return(nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.passwordMgr.EXPECT().CheckPasswordPolicy(user.Username, "new_password").
+					Once().
+					Return(nil)
+
+				m.passwordMgr.EXPECT().PasswordHash("new_password").
+					Once().
+					Return("new_password_hash", nil)
+
+				m.userMgr.EXPECT().UpdatePasswordHash(ctx, user.ID, "new_password_hash").
+					Once().
+					Return(nil)
+
+				m.sessionMgr.EXPECT().RevokeUserSessions(ctx, user.ID).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Wrong old password",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				m.loginThrottle.EXPECT().CheckLogin(ctx, user.Username, clientIP).
+					Once().
+					Return(nil)
+
+				m.passwordMgr.EXPECT().ValidatePassword("old_password", user.PasswordHash).
+					Once().
+					Return(domain.ErrInvalidPassword)
+
+				m.loginThrottle.EXPECT().RecordLoginFailure(ctx, user.Username, clientIP).
+					Once().
+					Return(nil, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Too many wrong old passwords",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				m.loginThrottle.EXPECT().CheckLogin(ctx, user.Username, clientIP).
+					Once().
+					Return(&domain.LoginThrottledError{Err: domain.ErrAccountLocked, RetryAfter: time.Minute})
+			},
+			expectedError: domain.ErrAccountLocked,
+		},
+		{
+			name: "Error – User has no password",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				ssoUser := user
+				ssoUser.PasswordHash = ""
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(ssoUser, nil)
+			},
+			expectedError: domain.ErrPasswordNotSet,
+		},
+		{
+			name: "Error – Password policy violation",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				m.loginThrottle.EXPECT().CheckLogin(ctx, user.Username, clientIP).
+					Once().
+					Return(nil)
+
+				m.passwordMgr.EXPECT().ValidatePassword("old_password", user.PasswordHash).
+					Once().
+					Return(nil)
+
+				m.loginThrottle.EXPECT().ResetLoginFailures(ctx, user.Username).
+					Once().
+					Return(nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.passwordMgr.EXPECT().CheckPasswordPolicy(user.Username, "new_password").
+					Once().
+					Return(&domain.PasswordPolicyError{Violations: []domain.PasswordViolation{
+						{Rule: domain.PasswordRuleCommon, Message: "password is too common"},
+					}})
+			},
+			expectedError: domain.ErrPasswordPolicyViolation,
+		},
+		{
+			name: "Error – Failed to revoke sessions",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				m.loginThrottle.EXPECT().CheckLogin(ctx, user.Username, clientIP).
+					Once().
+					Return(nil)
+
+				m.passwordMgr.EXPECT().ValidatePassword("old_password", user.PasswordHash).
+					Once().
+					Return(nil)
+
+				m.loginThrottle.EXPECT().ResetLoginFailures(ctx, user.Username).
+					Once().
+					Return(nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.passwordMgr.EXPECT().CheckPasswordPolicy(user.Username, "new_password").
+					Once().
+					Return(nil)
+
+				m.passwordMgr.EXPECT().PasswordHash("new_password").
+					Once().
+					Return("new_password_hash", nil)
+
+				m.userMgr.EXPECT().UpdatePasswordHash(ctx, user.ID, "new_password_hash").
+					Once().
+					Return(nil)
+
+				m.sessionMgr.EXPECT().RevokeUserSessions(ctx, user.ID).
+					Once().
+					Return(errors.New("session manager error"))
+			},
+			expectedError: domain.ErrFailedToRevokeSessions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+
+			tt.mockBehavior(m)
+
+			err := usecase.ChangePassword(ctx, "old_password", "new_password", clientIP)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_IssuePasswordReset(t *testing.T) {
+	ctx := context.Background()
+
+	adminID := "test-admin-id"
+	userID := "test-user-id"
+
+	token := entity.PasswordResetToken{
+		ID:        "test-reset-token-id",
+		UserID:    userID,
+		TokenHash: "reset_token_hash",
+		CreatedBy: adminID,
+		ExpiresAt: time.Now().Add(time.Hour),
+		CreatedAt: time.Now(),
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(m testMocks) {
+				m.userMgr.EXPECT().CreatePasswordResetToken(ctx, token).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – User not found",
+			mockBehavior: func(m testMocks) {
+				m.userMgr.EXPECT().CreatePasswordResetToken(ctx, token).
+					Once().
+					Return(domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+
+			m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+				Once().
+				Return(adminID, nil)
+
+			m.tokenMgr.EXPECT().GeneratePasswordResetToken(userID, adminID).
+				Once().
+				Return("reset_token", token, nil)
+
+			expectTransaction(ctx, m.txMgr)
+
+			tt.mockBehavior(m)
+
+			resetToken, issued, err := usecase.IssuePasswordReset(ctx, userID)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, resetToken)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "reset_token", resetToken)
+				require.Equal(t, token, issued)
+			}
+		})
+	}
+}
+
+func TestUsecase_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+
+	user := entity.User{
+		ID:       "test-user-id",
+		Username: "test-user",
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(m testMocks) {
+				m.userMgr.EXPECT().UsePasswordResetToken(ctx, "reset_token_hash").
+					Once().
+					Return(user.ID, nil)
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				m.passwordMgr.EXPECT().CheckPasswordPolicy(user.Username, "new_password").
+					Once().
+					Return(nil)
+
+				m.passwordMgr.EXPECT().PasswordHash("new_password").
+					Once().
+					Return("new_password_hash", nil)
+
+				m.userMgr.EXPECT().UpdatePasswordHash(ctx, user.ID, "new_password_hash").
+					Once().
+					Return(nil)
+
+				m.sessionMgr.EXPECT().RevokeUserSessions(ctx, user.ID).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Invalid reset token",
+			mockBehavior: func(m testMocks) {
+				m.userMgr.EXPECT().UsePasswordResetToken(ctx, "reset_token_hash").
+					Once().
+					Return("", domain.ErrPasswordResetTokenInvalid)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Failed to update password",
+			mockBehavior: func(m testMocks) {
+				m.userMgr.EXPECT().UsePasswordResetToken(ctx, "reset_token_hash").
+					Once().
+					Return(user.ID, nil)
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				m.passwordMgr.EXPECT().CheckPasswordPolicy(user.Username, "new_password").
+					Once().
+					Return(nil)
+
+				m.passwordMgr.EXPECT().PasswordHash("new_password").
+					Once().
+					Return("new_password_hash", nil)
+
+				m.userMgr.EXPECT().UpdatePasswordHash(ctx, user.ID, "new_password_hash").
+					Once().
+					Return(errors.New("user manager error"))
+			},
+			expectedError: domain.ErrFailedToChangePassword,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+
+			m.tokenMgr.EXPECT().HashPasswordResetToken("reset_token").
+				Once().
+				Return("reset_token_hash")
+
+			expectTransaction(ctx, m.txMgr)
+
+			tt.mockBehavior(m)
+
+			err := usecase.ResetPassword(ctx, "reset_token", "new_password")
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log         *slog.Logger
+	identityMgr IdentityManager
+	userMgr     UserManager
+}
+
+type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+	}
+
+	UserManager interface {
+		GetUserProfile(ctx context.Context, userID string) (entity.UserProfile, error)
+		UpdateUserProfile(ctx context.Context, userID string, update entity.UserProfileUpdate) error
+		SearchUsers(ctx context.Context, filter entity.UserFilter) (entity.UserPage, error)
+	}
+)
+
+func NewUsecase(log *slog.Logger, identityMgr IdentityManager, userMgr UserManager) *Usecase {
+	return &Usecase{
+		log:         log,
+		identityMgr: identityMgr,
+		userMgr:     userMgr,
+	}
+}
+
+// GetProfile returns the profile of the current user
+func (u *Usecase) GetProfile(ctx context.Context) (entity.UserProfile, error) {
+	const op = "usecase.Profile.GetProfile"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.UserProfile{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	return u.getProfile(ctx, log, userID)
+}
+
+// UpdateProfile changes the profile of the current user and returns the updated profile
+func (u *Usecase) UpdateProfile(ctx context.Context, update entity.UserProfileUpdate) (entity.UserProfile, error) {
+	const op = "usecase.Profile.UpdateProfile"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.UserProfile{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	return u.updateProfile(ctx, log, userID, update)
+}
+
+// GetUserProfile returns the profile of any user, it's meant for admins
+func (u *Usecase) GetUserProfile(ctx context.Context, userID string) (entity.UserProfile, error) {
+	const op = "usecase.Profile.GetUserProfile"
+
+	log := u.log.With(slog.String("op", op))
+
+	return u.getProfile(ctx, log, userID)
+}
+
+// UpdateUserProfile changes the profile of another user on behalf of an admin
+func (u *Usecase) UpdateUserProfile(ctx context.Context, userID string, update entity.UserProfileUpdate) (entity.UserProfile, error) {
+	const op = "usecase.Profile.UpdateUserProfile"
+
+	log := u.log.With(slog.String("op", op))
+
+	adminID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.UserProfile{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	profile, err := u.updateProfile(ctx, log, userID, update)
+	if err != nil {
+		return entity.UserProfile{}, err
+	}
+
+	log.Info("user profile updated by admin",
+		slog.String("userID", userID),
+		slog.String("updatedBy", adminID),
+	)
+
+	return profile, nil
+}
+
+// SearchUsers looks users up in the directory, so senders can find who to transfer coins to
+func (u *Usecase) SearchUsers(ctx context.Context, filter entity.UserFilter) (entity.UserPage, error) {
+	const op = "usecase.Profile.SearchUsers"
+
+	log := u.log.With(slog.String("op", op))
+
+	filter.Query = strings.TrimSpace(filter.Query)
+	filter.Department = strings.TrimSpace(filter.Department)
+
+	if filter.Limit == 0 {
+		filter.Limit = entity.DefaultUserPageLimit
+	}
+
+	if filter.Limit < 0 || filter.Limit > entity.MaxUserPageLimit || filter.Offset < 0 {
+		err := fmt.Errorf("%s: %w", op, domain.ErrInvalidPagination)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.UserPage{}, domain.ErrBadRequest
+	}
+
+	page, err := u.userMgr.SearchUsers(ctx, filter)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToSearchUsers, err)
+		return entity.UserPage{}, domain.ErrFailedToSearchUsers
+	}
+
+	return page, nil
+}
+
+func (u *Usecase) getProfile(ctx context.Context, log *slog.Logger, userID string) (entity.UserProfile, error) {
+	profile, err := u.userMgr.GetUserProfile(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			e.LogError(ctx, log, domain.ErrUserNotFound, err, slog.String("userID", userID))
+			return entity.UserProfile{}, domain.ErrUserNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetUserProfile, err, slog.String("userID", userID))
+		return entity.UserProfile{}, domain.ErrFailedToGetUserProfile
+	}
+
+	return profile, nil
+}
+
+func (u *Usecase) updateProfile(
+	ctx context.Context,
+	log *slog.Logger,
+	userID string,
+	update entity.UserProfileUpdate,
+) (entity.UserProfile, error) {
+	update, err := normalizeUpdate(update)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("userID", userID))
+		return entity.UserProfile{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+	}
+
+	if err = u.userMgr.UpdateUserProfile(ctx, userID, update); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			e.LogError(ctx, log, domain.ErrUserNotFound, err, slog.String("userID", userID))
+			return entity.UserProfile{}, domain.ErrUserNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToUpdateUserProfile, err, slog.String("userID", userID))
+		return entity.UserProfile{}, domain.ErrFailedToUpdateUserProfile
+	}
+
+	return u.getProfile(ctx, log, userID)
+}
@@ -0,0 +1,278 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/profile/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr(value string) *string {
+	return &value
+}
+
+func TestUsecase_UpdateProfile(t *testing.T) {
+	ctx := context.Background()
+
+	userID := "test-user-id"
+
+	profile := entity.UserProfile{
+		UserID:      userID,
+		Username:    "testuser",
+		DisplayName: "Test User",
+		Department:  "Engineering",
+		UpdatedAt:   time.Now(),
+	}
+
+	tests := []struct {
+		name          string
+		update        entity.UserProfileUpdate
+		mockBehavior  func(userMgr *mocks.UserManager, update entity.UserProfileUpdate)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			update: entity.UserProfileUpdate{
+				DisplayName: ptr("  Test User  "),
+				Department:  ptr("Engineering"),
+				Email:       ptr("test.user@example.com"),
+				AvatarURL:   ptr("https://cdn.example.com/avatars/test.png"),
+			},
+			mockBehavior: func(userMgr *mocks.UserManager, update entity.UserProfileUpdate) {
+				normalized := update
+				normalized.DisplayName = ptr("Test User")
+
+				userMgr.EXPECT().UpdateUserProfile(ctx, userID, normalized).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().GetUserProfile(ctx, userID).
+					Once().
+					Return(profile, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Success – Empty value clears the field",
+			update: entity.UserProfileUpdate{Title: ptr("")},
+			mockBehavior: func(userMgr *mocks.UserManager, update entity.UserProfileUpdate) {
+				userMgr.EXPECT().UpdateUserProfile(ctx, userID, update).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().GetUserProfile(ctx, userID).
+					Once().
+					Return(profile, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Error – Nothing to update",
+			update:        entity.UserProfileUpdate{},
+			mockBehavior:  func(*mocks.UserManager, entity.UserProfileUpdate) {},
+			expectedError: domain.ErrProfileUpdateEmpty,
+		},
+		{
+			name:          "Error – Display name is too long",
+			update:        entity.UserProfileUpdate{DisplayName: ptr(strings.Repeat("a", maxTextFieldLength+1))},
+			mockBehavior:  func(*mocks.UserManager, entity.UserProfileUpdate) {},
+			expectedError: domain.ErrProfileFieldTooLong,
+		},
+		{
+			name:          "Error – Email with a name",
+			update:        entity.UserProfileUpdate{Email: ptr("Test User <test.user@example.com>")},
+			mockBehavior:  func(*mocks.UserManager, entity.UserProfileUpdate) {},
+			expectedError: domain.ErrInvalidEmail,
+		},
+		{
+			name:          "Error – Avatar URL with a script scheme",
+			update:        entity.UserProfileUpdate{AvatarURL: ptr("javascript:alert(1)")},
+			mockBehavior:  func(*mocks.UserManager, entity.UserProfileUpdate) {},
+			expectedError: domain.ErrInvalidAvatarURL,
+		},
+		{
+			name:          "Error – Relative avatar URL",
+			update:        entity.UserProfileUpdate{AvatarURL: ptr("/avatars/test.png")},
+			mockBehavior:  func(*mocks.UserManager, entity.UserProfileUpdate) {},
+			expectedError: domain.ErrInvalidAvatarURL,
+		},
+		{
+			name:   "Error – Failed to update profile",
+			update: entity.UserProfileUpdate{Title: ptr("Engineer")},
+			mockBehavior: func(userMgr *mocks.UserManager, update entity.UserProfileUpdate) {
+				userMgr.EXPECT().UpdateUserProfile(ctx, userID, update).
+					Once().
+					Return(errors.New("user manager error"))
+			},
+			expectedError: domain.ErrFailedToUpdateUserProfile,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+
+			identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+				Once().
+				Return(userID, nil)
+
+			tt.mockBehavior(userMgr, tt.update)
+
+			usecase := NewUsecase(slogdiscard.NewDiscardLogger(), identityMgr, userMgr)
+
+			updated, err := usecase.UpdateProfile(ctx, tt.update)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, profile, updated)
+		})
+	}
+}
+
+func TestUsecase_UpdateUserProfile(t *testing.T) {
+	ctx := context.Background()
+
+	adminID := "test-admin-id"
+	userID := "test-user-id"
+	update := entity.UserProfileUpdate{Department: ptr("Finance")}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(userMgr *mocks.UserManager)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(userMgr *mocks.UserManager) {
+				userMgr.EXPECT().UpdateUserProfile(ctx, userID, update).
+					Once().
+					Return(nil)
+
+				userMgr.EXPECT().GetUserProfile(ctx, userID).
+					Once().
+					Return(entity.UserProfile{UserID: userID, Department: "Finance"}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – User not found",
+			mockBehavior: func(userMgr *mocks.UserManager) {
+				userMgr.EXPECT().UpdateUserProfile(ctx, userID, update).
+					Once().
+					Return(domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+
+			identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+				Once().
+				Return(adminID, nil)
+
+			tt.mockBehavior(userMgr)
+
+			usecase := NewUsecase(slogdiscard.NewDiscardLogger(), identityMgr, userMgr)
+
+			updated, err := usecase.UpdateUserProfile(ctx, userID, update)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "Finance", updated.Department)
+		})
+	}
+}
+
+func TestUsecase_SearchUsers(t *testing.T) {
+	ctx := context.Background()
+
+	page := entity.UserPage{
+		Users: []entity.UserProfile{{UserID: "test-user-id", Username: "jdoe", DisplayName: "John Doe"}},
+		Total: 1,
+	}
+
+	tests := []struct {
+		name          string
+		filter        entity.UserFilter
+		mockBehavior  func(userMgr *mocks.UserManager)
+		expectedError error
+	}{
+		{
+			name:   "Success",
+			filter: entity.UserFilter{Query: "  jo  ", Department: " Engineering "},
+			mockBehavior: func(userMgr *mocks.UserManager) {
+				userMgr.EXPECT().SearchUsers(ctx, entity.UserFilter{
+					Query:      "jo",
+					Department: "Engineering",
+					Limit:      entity.DefaultUserPageLimit,
+				}).
+					Once().
+					Return(page, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Error – Limit is too large",
+			filter:        entity.UserFilter{Limit: entity.MaxUserPageLimit + 1},
+			mockBehavior:  func(*mocks.UserManager) {},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:          "Error – Negative offset",
+			filter:        entity.UserFilter{Offset: -1},
+			mockBehavior:  func(*mocks.UserManager) {},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:   "Error – Failed to search users",
+			filter: entity.UserFilter{Query: "jo", Limit: 10},
+			mockBehavior: func(userMgr *mocks.UserManager) {
+				userMgr.EXPECT().SearchUsers(ctx, entity.UserFilter{Query: "jo", Limit: 10}).
+					Once().
+					Return(entity.UserPage{}, errors.New("user manager error"))
+			},
+			expectedError: domain.ErrFailedToSearchUsers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+
+			tt.mockBehavior(userMgr)
+
+			usecase := NewUsecase(slogdiscard.NewDiscardLogger(), identityMgr, userMgr)
+
+			result, err := usecase.SearchUsers(ctx, tt.filter)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, page, result)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package profile
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+const (
+	maxTextFieldLength = 100
+	maxEmailLength     = 254
+	maxAvatarURLLength = 2048
+)
+
+// normalizeUpdate trims the fields and checks them. Empty values are allowed, they clear the field
+func normalizeUpdate(update entity.UserProfileUpdate) (entity.UserProfileUpdate, error) {
+	if update.IsEmpty() {
+		return entity.UserProfileUpdate{}, domain.ErrProfileUpdateEmpty
+	}
+
+	normalized := entity.UserProfileUpdate{
+		DisplayName: trimmed(update.DisplayName),
+		Email:       trimmed(update.Email),
+		Department:  trimmed(update.Department),
+		Title:       trimmed(update.Title),
+		AvatarURL:   trimmed(update.AvatarURL),
+	}
+
+	textFields := []struct {
+		name  string
+		value *string
+	}{
+		{name: "displayName", value: normalized.DisplayName},
+		{name: "department", value: normalized.Department},
+		{name: "title", value: normalized.Title},
+	}
+
+	for _, field := range textFields {
+		if field.value != nil && utf8.RuneCountInString(*field.value) > maxTextFieldLength {
+			return entity.UserProfileUpdate{}, fmt.Errorf("%w: %s is longer than %d characters",
+				domain.ErrProfileFieldTooLong, field.name, maxTextFieldLength)
+		}
+	}
+
+	if normalized.Email != nil {
+		if err := validateEmail(*normalized.Email); err != nil {
+			return entity.UserProfileUpdate{}, err
+		}
+	}
+
+	if normalized.AvatarURL != nil {
+		if err := validateAvatarURL(*normalized.AvatarURL); err != nil {
+			return entity.UserProfileUpdate{}, err
+		}
+	}
+
+	return normalized, nil
+}
+
+func trimmed(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	trimmedValue := strings.TrimSpace(*value)
+
+	return &trimmedValue
+}
+
+func validateEmail(email string) error {
+	if email == "" {
+		return nil
+	}
+
+	if len(email) > maxEmailLength {
+		return fmt.Errorf("%w: email is longer than %d characters", domain.ErrProfileFieldTooLong, maxEmailLength)
+	}
+
+	// Only a bare address is accepted, not "Name <address>"
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return domain.ErrInvalidEmail
+	}
+
+	return nil
+}
+
+// validateAvatarURL only accepts web URLs, so clients can't be made to render javascript: or data: URLs
+func validateAvatarURL(avatarURL string) error {
+	if avatarURL == "" {
+		return nil
+	}
+
+	if len(avatarURL) > maxAvatarURLLength {
+		return fmt.Errorf("%w: avatarUrl is longer than %d characters", domain.ErrProfileFieldTooLong, maxAvatarURLLength)
+	}
+
+	parsed, err := url.Parse(avatarURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return domain.ErrInvalidAvatarURL
+	}
+
+	return nil
+}
@@ -0,0 +1,382 @@
+package raffle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/draw"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log         *slog.Logger
+	identityMgr IdentityManager
+	coinsMgr    CoinManager
+	merchMgr    MerchManager
+	txMgr       TransactionManager
+}
+
+type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+	}
+
+	CoinManager interface {
+		AdjustUserCoins(ctx context.Context, userID string, amount int) error
+		RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransfer) error
+	}
+
+	MerchManager interface {
+		GetMerchByName(ctx context.Context, itemName string) (entity.Merch, error)
+		AddToInventory(ctx context.Context, userID, merchID string, price int) error
+		CreateRaffle(ctx context.Context, raffle entity.Raffle) error
+		GetRaffleByID(ctx context.Context, raffleID string) (entity.Raffle, error)
+		LockRaffle(ctx context.Context, raffleID string) (entity.Raffle, error)
+		ListRaffles(ctx context.Context, filter entity.RaffleFilter) ([]entity.Raffle, error)
+		ListDueRaffles(ctx context.Context) ([]string, error)
+		CountRaffleTickets(ctx context.Context, raffleID, userID string) (total, owned int, err error)
+		CreateRaffleTickets(ctx context.Context, tickets []entity.RaffleTicket) error
+		ListRaffleTickets(ctx context.Context, raffleID string) ([]entity.RaffleTicket, error)
+		ListRaffleWinners(ctx context.Context, raffleID string) ([]entity.RaffleWinner, error)
+		CompleteRaffleDraw(ctx context.Context, raffleID string, winners []entity.RaffleWinner) error
+	}
+
+	TransactionManager interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+)
+
+func NewUsecase(
+	log *slog.Logger,
+	identityMgr IdentityManager,
+	coinsMgr CoinManager,
+	merchMgr MerchManager,
+	txMgr TransactionManager,
+) *Usecase {
+	return &Usecase{
+		log:         log,
+		identityMgr: identityMgr,
+		coinsMgr:    coinsMgr,
+		merchMgr:    merchMgr,
+		txMgr:       txMgr,
+	}
+}
+
+// CreateRaffle generates the secret draw seed and publishes only its commitment
+func (u *Usecase) CreateRaffle(
+	ctx context.Context,
+	itemName string,
+	ticketPrice, maxTicketsPerUser, winnersCount int,
+	drawAt time.Time,
+) (entity.Raffle, error) {
+	const op = "usecase.Raffle.CreateRaffle"
+
+	log := u.log.With(slog.String("op", op))
+
+	adminID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.Raffle{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	if ticketPrice <= 0 || maxTicketsPerUser <= 0 || winnersCount <= 0 {
+		err = fmt.Errorf("%s: %w", op, domain.ErrInvalidRaffleSettings)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.Raffle{}, domain.ErrBadRequest
+	}
+
+	if !drawAt.After(time.Now()) {
+		err = fmt.Errorf("%s: %w", op, domain.ErrDrawTimeMustBeInFuture)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.Raffle{}, domain.ErrBadRequest
+	}
+
+	merch, err := u.merchMgr.GetMerchByName(ctx, itemName)
+	if err != nil {
+		if errors.Is(err, domain.ErrMerchNotFound) {
+			e.LogError(ctx, log, domain.ErrMerchNotFound, err, slog.String("item", itemName))
+			return entity.Raffle{}, domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetMerch, err)
+		return entity.Raffle{}, domain.ErrFailedToGetMerch
+	}
+
+	seed, err := draw.NewSeed()
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCreateRaffle, err)
+		return entity.Raffle{}, domain.ErrFailedToCreateRaffle
+	}
+
+	commitment, err := draw.Commit(seed)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCreateRaffle, err)
+		return entity.Raffle{}, domain.ErrFailedToCreateRaffle
+	}
+
+	raffle := entity.NewRaffle(adminID, merch, ticketPrice, maxTicketsPerUser, winnersCount, drawAt, seed, commitment)
+
+	if err = u.merchMgr.CreateRaffle(ctx, raffle); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCreateRaffle, err)
+		return entity.Raffle{}, domain.ErrFailedToCreateRaffle
+	}
+
+	return hideSeed(raffle), nil
+}
+
+func (u *Usecase) ListRaffles(ctx context.Context, status entity.RaffleStatus) ([]entity.Raffle, error) {
+	const op = "usecase.Raffle.ListRaffles"
+
+	log := u.log.With(slog.String("op", op))
+
+	if status != "" && !status.IsValid() {
+		err := fmt.Errorf("%s: %w", op, domain.ErrInvalidRaffleStatus)
+		e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("status", status.String()))
+		return nil, domain.ErrBadRequest
+	}
+
+	raffles, err := u.merchMgr.ListRaffles(ctx, entity.RaffleFilter{Status: status})
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListRaffles, err)
+		return nil, domain.ErrFailedToListRaffles
+	}
+
+	for i := range raffles {
+		raffles[i] = hideSeed(raffles[i])
+	}
+
+	return raffles, nil
+}
+
+// GetRaffle returns the raffle with its outcome. Once drawn, the revealed seed and the full
+// list of tickets are included, which is everything needed to replay and verify the draw
+func (u *Usecase) GetRaffle(ctx context.Context, raffleID string) (entity.Raffle, []entity.RaffleWinner, []entity.RaffleTicket, error) {
+	const op = "usecase.Raffle.GetRaffle"
+
+	log := u.log.With(slog.String("op", op))
+
+	raffle, err := u.merchMgr.GetRaffleByID(ctx, raffleID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRaffleNotFound) {
+			e.LogError(ctx, log, domain.ErrRaffleNotFound, err, slog.String("raffleID", raffleID))
+			return entity.Raffle{}, nil, nil, domain.ErrRaffleNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetRaffle, err)
+		return entity.Raffle{}, nil, nil, domain.ErrFailedToGetRaffle
+	}
+
+	if !raffle.IsDrawn() {
+		return hideSeed(raffle), nil, nil, nil
+	}
+
+	winners, err := u.merchMgr.ListRaffleWinners(ctx, raffleID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListRaffleWinners, err)
+		return entity.Raffle{}, nil, nil, domain.ErrFailedToListRaffleWinners
+	}
+
+	tickets, err := u.merchMgr.ListRaffleTickets(ctx, raffleID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListRaffleTickets, err)
+		return entity.Raffle{}, nil, nil, domain.ErrFailedToListRaffleTickets
+	}
+
+	return raffle, winners, tickets, nil
+}
+
+// BuyTickets charges the user for the tickets and numbers them after the ones already sold.
+// The raffle row stays locked for the whole transaction, so the per-user limit can't be bypassed
+// with concurrent requests
+func (u *Usecase) BuyTickets(ctx context.Context, raffleID string, quantity int) ([]entity.RaffleTicket, error) {
+	const op = "usecase.Raffle.BuyTickets"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return nil, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	if quantity <= 0 {
+		err = fmt.Errorf("%s: %w", op, domain.ErrQuantityMustBePositive)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return nil, domain.ErrBadRequest
+	}
+
+	var tickets []entity.RaffleTicket
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		raffle, err := u.merchMgr.LockRaffle(txCtx, raffleID)
+		if err != nil {
+			if errors.Is(err, domain.ErrRaffleNotFound) {
+				e.LogError(txCtx, log, domain.ErrRaffleNotFound, err, slog.String("raffleID", raffleID))
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToGetRaffle, err)
+			return domain.ErrFailedToGetRaffle
+		}
+
+		if !raffle.AcceptsTickets(time.Now()) {
+			err = fmt.Errorf("%s: %w", op, domain.ErrRaffleNotOpen)
+			e.LogError(txCtx, log, domain.ErrBadRequest, err, slog.String("raffleID", raffleID))
+			return domain.ErrBadRequest
+		}
+
+		total, owned, err := u.merchMgr.CountRaffleTickets(txCtx, raffle.ID, userID)
+		if err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToBuyRaffleTickets, err)
+			return domain.ErrFailedToBuyRaffleTickets
+		}
+
+		if owned+quantity > raffle.MaxTicketsPerUser {
+			err = fmt.Errorf("%s: %w", op, domain.ErrTicketLimitExceeded)
+			e.LogError(txCtx, log, domain.ErrBadRequest, err,
+				slog.Int("owned", owned),
+				slog.Int("quantity", quantity),
+				slog.Int("limit", raffle.MaxTicketsPerUser),
+			)
+			return domain.ErrBadRequest
+		}
+
+		cost := raffle.TicketPrice * quantity
+
+		if err = u.coinsMgr.AdjustUserCoins(txCtx, userID, -cost); err != nil {
+			if errors.Is(err, domain.ErrInsufficientCoins) {
+				e.LogError(txCtx, log, domain.ErrInsufficientCoins, err)
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToUpdateUserCoins, err)
+			return domain.ErrFailedToUpdateUserCoins
+		}
+
+		ct := entity.NewCoinTransfer(userID, "", entity.TransactionTypeRaffleTicket, cost, time.Now())
+
+		if err = u.coinsMgr.RegisterCoinTransfer(txCtx, ct); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToRegisterCoinTransfer, err)
+			return domain.ErrFailedToRegisterCoinTransfer
+		}
+
+		tickets = entity.NewRaffleTickets(raffle.ID, userID, total, quantity)
+
+		if err = u.merchMgr.CreateRaffleTickets(txCtx, tickets); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToBuyRaffleTickets, err)
+			return domain.ErrFailedToBuyRaffleTickets
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err,
+			slog.Any("userID", userID),
+		)
+		return nil, err
+	}
+
+	return tickets, nil
+}
+
+// DrawDueRaffles is run periodically by the worker. Each raffle is drawn in its own
+// transaction, so one failing raffle doesn't hold back the others
+func (u *Usecase) DrawDueRaffles(ctx context.Context) error {
+	const op = "usecase.Raffle.DrawDueRaffles"
+
+	log := u.log.With(slog.String("op", op))
+
+	raffleIDs, err := u.merchMgr.ListDueRaffles(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToDrawRaffles, err)
+		return domain.ErrFailedToDrawRaffles
+	}
+
+	var failed int
+
+	for _, raffleID := range raffleIDs {
+		if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+			return u.drawRaffle(txCtx, log, raffleID)
+		}); err != nil {
+			failed++
+			e.LogError(ctx, log, domain.ErrFailedToDrawRaffles, err, slog.String("raffleID", raffleID))
+		}
+	}
+
+	if failed > 0 {
+		return domain.ErrFailedToDrawRaffles
+	}
+
+	return nil
+}
+
+func (u *Usecase) drawRaffle(ctx context.Context, log *slog.Logger, raffleID string) error {
+	raffle, err := u.merchMgr.LockRaffle(ctx, raffleID)
+	if err != nil {
+		return err
+	}
+
+	// Already drawn by another worker between listing and locking
+	if raffle.Status != entity.RaffleStatusOpen {
+		return nil
+	}
+
+	tickets, err := u.merchMgr.ListRaffleTickets(ctx, raffle.ID)
+	if err != nil {
+		return err
+	}
+
+	owners := make([]string, len(tickets))
+	for i, ticket := range tickets {
+		owners[i] = ticket.UserID
+	}
+
+	picks, err := draw.Winners(raffle.Seed, raffle.ID, owners, raffle.WinnersCount)
+	if err != nil {
+		return err
+	}
+
+	winners := make([]entity.RaffleWinner, len(picks))
+	for i, pick := range picks {
+		ticket := tickets[pick]
+
+		winners[i] = entity.RaffleWinner{
+			RaffleID:     raffle.ID,
+			TicketID:     ticket.ID,
+			TicketNumber: ticket.Number,
+			UserID:       ticket.UserID,
+			Username:     ticket.Username,
+			Position:     i + 1,
+		}
+
+		// Prizes are free, so there is nothing to refund if one is returned
+		if err = u.merchMgr.AddToInventory(ctx, ticket.UserID, raffle.MerchID, 0); err != nil {
+			return err
+		}
+	}
+
+	if err = u.merchMgr.CompleteRaffleDraw(ctx, raffle.ID, winners); err != nil {
+		return err
+	}
+
+	log.Info("raffle drawn",
+		slog.String("raffleID", raffle.ID),
+		slog.Int("tickets", len(tickets)),
+		slog.Int("winners", len(winners)),
+	)
+
+	return nil
+}
+
+// hideSeed keeps the seed secret until the draw, only the commitment is public before that
+func hideSeed(raffle entity.Raffle) entity.Raffle {
+	if !raffle.IsDrawn() {
+		raffle.Seed = ""
+	}
+
+	return raffle
+}
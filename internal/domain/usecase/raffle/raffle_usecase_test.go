@@ -0,0 +1,337 @@
+package raffle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/raffle/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/draw"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_BuyTickets(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	userID := "test-user-id"
+	quantity := 2
+
+	raffle := entity.Raffle{
+		ID:                "test-raffle-id",
+		MerchID:           "test-merch-id",
+		TicketPrice:       10,
+		MaxTicketsPerUser: 3,
+		WinnersCount:      1,
+		Status:            entity.RaffleStatusOpen,
+		DrawAt:            time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(
+			coinsMgr *mocks.CoinManager,
+			merchMgr *mocks.MerchManager,
+		)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				merchMgr.EXPECT().LockRaffle(ctx, raffle.ID).
+					Once().
+					Return(raffle, nil)
+
+				merchMgr.EXPECT().CountRaffleTickets(ctx, raffle.ID, userID).
+					Once().
+					Return(5, 1, nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, userID, -raffle.TicketPrice*quantity).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+					return ct.SenderID == userID &&
+						ct.TransactionType == entity.TransactionTypeRaffleTicket &&
+						int(ct.Amount) == raffle.TicketPrice*quantity
+				})).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().CreateRaffleTickets(ctx, mock.MatchedBy(func(tickets []entity.RaffleTicket) bool {
+					return len(tickets) == quantity &&
+						tickets[0].Number == 6 &&
+						tickets[1].Number == 7
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Raffle not found",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				merchMgr.EXPECT().LockRaffle(ctx, raffle.ID).
+					Once().
+					Return(entity.Raffle{}, domain.ErrRaffleNotFound)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Raffle is closed",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				closed := raffle
+				closed.DrawAt = time.Now().Add(-time.Minute)
+
+				merchMgr.EXPECT().LockRaffle(ctx, raffle.ID).
+					Once().
+					Return(closed, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Ticket limit exceeded",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				merchMgr.EXPECT().LockRaffle(ctx, raffle.ID).
+					Once().
+					Return(raffle, nil)
+
+				merchMgr.EXPECT().CountRaffleTickets(ctx, raffle.ID, userID).
+					Once().
+					Return(5, 2, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Insufficient coins",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				merchMgr.EXPECT().LockRaffle(ctx, raffle.ID).
+					Once().
+					Return(raffle, nil)
+
+				merchMgr.EXPECT().CountRaffleTickets(ctx, raffle.ID, userID).
+					Once().
+					Return(0, 0, nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, userID, -raffle.TicketPrice*quantity).
+					Once().
+					Return(domain.ErrInsufficientCoins)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Failed to create tickets",
+			mockBehavior: func(
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				merchMgr.EXPECT().LockRaffle(ctx, raffle.ID).
+					Once().
+					Return(raffle, nil)
+
+				merchMgr.EXPECT().CountRaffleTickets(ctx, raffle.ID, userID).
+					Once().
+					Return(0, 0, nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, userID, -raffle.TicketPrice*quantity).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.AnythingOfType("entity.CoinTransfer")).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().CreateRaffleTickets(ctx, mock.AnythingOfType("[]entity.RaffleTicket")).
+					Once().
+					Return(errors.New("merch manager error"))
+			},
+			expectedError: domain.ErrFailedToBuyRaffleTickets,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+				Once().
+				Return(userID, nil)
+
+			txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+
+			tt.mockBehavior(coinsMgr, merchMgr)
+
+			usecase := NewUsecase(logger, identityMgr, coinsMgr, merchMgr, txMgr)
+			tickets, err := usecase.BuyTickets(ctx, raffle.ID, quantity)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, tickets, quantity)
+			}
+		})
+	}
+}
+
+func TestUsecase_DrawDueRaffles(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	seed, err := draw.NewSeed()
+	require.NoError(t, err)
+
+	raffle := entity.Raffle{
+		ID:           "test-raffle-id",
+		MerchID:      "test-merch-id",
+		TicketPrice:  10,
+		WinnersCount: 1,
+		Status:       entity.RaffleStatusOpen,
+		Seed:         seed,
+		DrawAt:       time.Now().Add(-time.Minute),
+	}
+
+	tickets := []entity.RaffleTicket{
+		{ID: "test-ticket-1", RaffleID: raffle.ID, UserID: "test-user-id", Number: 1},
+		{ID: "test-ticket-2", RaffleID: raffle.ID, UserID: "test-user-id", Number: 2},
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(merchMgr *mocks.MerchManager)
+		expectedError error
+	}{
+		{
+			name: "Success – Winner gets the prize",
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().LockRaffle(ctx, raffle.ID).
+					Once().
+					Return(raffle, nil)
+
+				merchMgr.EXPECT().ListRaffleTickets(ctx, raffle.ID).
+					Once().
+					Return(tickets, nil)
+
+				merchMgr.EXPECT().AddToInventory(ctx, "test-user-id", raffle.MerchID, 0).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().CompleteRaffleDraw(ctx, raffle.ID, mock.MatchedBy(func(winners []entity.RaffleWinner) bool {
+					return len(winners) == 1 &&
+						winners[0].UserID == "test-user-id" &&
+						winners[0].Position == 1
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success – No tickets sold",
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().LockRaffle(ctx, raffle.ID).
+					Once().
+					Return(raffle, nil)
+
+				merchMgr.EXPECT().ListRaffleTickets(ctx, raffle.ID).
+					Once().
+					Return(nil, nil)
+
+				merchMgr.EXPECT().CompleteRaffleDraw(ctx, raffle.ID, []entity.RaffleWinner{}).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success – Already drawn",
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				drawn := raffle
+				drawn.Status = entity.RaffleStatusDrawn
+
+				merchMgr.EXPECT().LockRaffle(ctx, raffle.ID).
+					Once().
+					Return(drawn, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Failed to complete draw",
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().LockRaffle(ctx, raffle.ID).
+					Once().
+					Return(raffle, nil)
+
+				merchMgr.EXPECT().ListRaffleTickets(ctx, raffle.ID).
+					Once().
+					Return(tickets, nil)
+
+				merchMgr.EXPECT().AddToInventory(ctx, "test-user-id", raffle.MerchID, 0).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().CompleteRaffleDraw(ctx, raffle.ID, mock.AnythingOfType("[]entity.RaffleWinner")).
+					Once().
+					Return(errors.New("merch manager error"))
+			},
+			expectedError: domain.ErrFailedToDrawRaffles,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			merchMgr.EXPECT().ListDueRaffles(ctx).
+				Once().
+				Return([]string{raffle.ID}, nil)
+
+			txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+
+			tt.mockBehavior(merchMgr)
+
+			usecase := NewUsecase(logger, identityMgr, coinsMgr, merchMgr, txMgr)
+			err := usecase.DrawDueRaffles(ctx)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
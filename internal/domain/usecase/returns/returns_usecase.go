@@ -0,0 +1,342 @@
+package returns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log          *slog.Logger
+	identityMgr  IdentityManager
+	userMgr      UserManager
+	coinsMgr     CoinManager
+	merchMgr     MerchManager
+	txMgr        TransactionManager
+	returnWindow time.Duration
+}
+
+type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+	}
+
+	UserManager interface {
+		GetUserInfoByID(ctx context.Context, userID string) (entity.UserInfo, error)
+	}
+
+	CoinManager interface {
+		AdjustUserCoins(ctx context.Context, userID string, amount int) error
+		RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransfer) error
+	}
+
+	MerchManager interface {
+		GetPurchaseByID(ctx context.Context, purchaseID string) (entity.Purchase, error)
+		ListUserPurchases(ctx context.Context, userID string) ([]entity.Purchase, error)
+		MarkPurchaseReturned(ctx context.Context, purchaseID string) error
+		Restock(ctx context.Context, merchID string) error
+		CreateMerchReturn(ctx context.Context, merchReturn entity.MerchReturn) error
+		GetMerchReturnByID(ctx context.Context, returnID string) (entity.MerchReturn, error)
+		ListMerchReturns(ctx context.Context, filter entity.MerchReturnFilter) ([]entity.MerchReturn, error)
+		ResolveMerchReturn(ctx context.Context, merchReturn entity.MerchReturn) error
+	}
+
+	TransactionManager interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+)
+
+func NewUsecase(
+	log *slog.Logger,
+	identityMgr IdentityManager,
+	userMgr UserManager,
+	coinsMgr CoinManager,
+	merchMgr MerchManager,
+	txMgr TransactionManager,
+	returnWindow time.Duration,
+) *Usecase {
+	return &Usecase{
+		log:          log,
+		identityMgr:  identityMgr,
+		userMgr:      userMgr,
+		coinsMgr:     coinsMgr,
+		merchMgr:     merchMgr,
+		txMgr:        txMgr,
+		returnWindow: returnWindow,
+	}
+}
+
+func (u *Usecase) ListPurchases(ctx context.Context) ([]entity.Purchase, error) {
+	const op = "usecase.Returns.ListPurchases"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return nil, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	purchases, err := u.merchMgr.ListUserPurchases(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListPurchases, err)
+		return nil, domain.ErrFailedToListPurchases
+	}
+
+	return purchases, nil
+}
+
+func (u *Usecase) RequestReturn(ctx context.Context, purchaseID, reason string) (entity.MerchReturn, error) {
+	const op = "usecase.Returns.RequestReturn"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.MerchReturn{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	purchase, err := u.merchMgr.GetPurchaseByID(ctx, purchaseID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPurchaseNotFound) {
+			e.LogError(ctx, log, domain.ErrPurchaseNotFound, err)
+			return entity.MerchReturn{}, domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetPurchase, err)
+		return entity.MerchReturn{}, domain.ErrFailedToGetPurchase
+	}
+
+	// Users must not be able to find out whether someone else's purchase exists
+	if purchase.UserID != userID {
+		err = fmt.Errorf("%s: %w", op, domain.ErrPurchaseNotFound)
+		e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("purchaseID", purchaseID))
+		return entity.MerchReturn{}, domain.ErrBadRequest
+	}
+
+	if purchase.IsReturned() {
+		err = fmt.Errorf("%s: %w", op, domain.ErrPurchaseAlreadyReturned)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.MerchReturn{}, domain.ErrBadRequest
+	}
+
+	// The refund would go to someone who never paid for the item
+	if purchase.IsTransferred() {
+		err = fmt.Errorf("%s: %w", op, domain.ErrPurchaseTransferred)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.MerchReturn{}, domain.ErrBadRequest
+	}
+
+	if time.Since(purchase.CreatedAt) > u.returnWindow {
+		err = fmt.Errorf("%s: %w", op, domain.ErrReturnWindowExpired)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.MerchReturn{}, domain.ErrBadRequest
+	}
+
+	merchReturn := entity.NewMerchReturn(purchase, reason)
+
+	if err = u.merchMgr.CreateMerchReturn(ctx, merchReturn); err != nil {
+		if errors.Is(err, domain.ErrReturnAlreadyRequested) {
+			e.LogError(ctx, log, domain.ErrReturnAlreadyRequested, err)
+			return entity.MerchReturn{}, domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToRequestReturn, err)
+		return entity.MerchReturn{}, domain.ErrFailedToRequestReturn
+	}
+
+	return merchReturn, nil
+}
+
+func (u *Usecase) ListUserReturns(ctx context.Context) ([]entity.MerchReturn, error) {
+	const op = "usecase.Returns.ListUserReturns"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return nil, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	returns, err := u.merchMgr.ListMerchReturns(ctx, entity.MerchReturnFilter{UserID: userID})
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListMerchReturns, err)
+		return nil, domain.ErrFailedToListMerchReturns
+	}
+
+	return returns, nil
+}
+
+func (u *Usecase) ListReturns(ctx context.Context, status entity.ReturnStatus) ([]entity.MerchReturn, error) {
+	const op = "usecase.Returns.ListReturns"
+
+	log := u.log.With(slog.String("op", op))
+
+	if status != "" && !status.IsValid() {
+		err := fmt.Errorf("%s: %w", op, domain.ErrInvalidReturnStatus)
+		e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("status", status.String()))
+		return nil, domain.ErrBadRequest
+	}
+
+	returns, err := u.merchMgr.ListMerchReturns(ctx, entity.MerchReturnFilter{Status: status})
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListMerchReturns, err)
+		return nil, domain.ErrFailedToListMerchReturns
+	}
+
+	return returns, nil
+}
+
+func (u *Usecase) ApproveReturn(ctx context.Context, returnID string) error {
+	const op = "usecase.Returns.ApproveReturn"
+
+	log := u.log.With(slog.String("op", op))
+
+	merchReturn, err := u.getPendingReturn(ctx, log, returnID)
+	if err != nil {
+		return err
+	}
+
+	userInfo, err := u.userMgr.GetUserInfoByID(ctx, merchReturn.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			e.LogError(ctx, log, domain.ErrUserNotFound, err)
+			return domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetUserInfo, err)
+		return domain.ErrFailedToGetUserInfo
+	}
+
+	merchReturn.Status = entity.ReturnStatusApproved
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		// Resolving first guarantees that concurrent approvals can't refund the same purchase twice
+		if err = u.merchMgr.ResolveMerchReturn(txCtx, merchReturn); err != nil {
+			if errors.Is(err, domain.ErrReturnAlreadyResolved) {
+				e.LogError(txCtx, log, domain.ErrReturnAlreadyResolved, err)
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToResolveReturn, err)
+			return domain.ErrFailedToResolveReturn
+		}
+
+		if err = u.merchMgr.MarkPurchaseReturned(txCtx, merchReturn.PurchaseID); err != nil {
+			if errors.Is(err, domain.ErrPurchaseAlreadyReturned) {
+				e.LogError(txCtx, log, domain.ErrPurchaseAlreadyReturned, err)
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToMarkPurchaseReturned, err)
+			return domain.ErrFailedToMarkPurchaseReturned
+		}
+
+		if err = u.merchMgr.Restock(txCtx, merchReturn.MerchID); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToRestockMerch, err)
+			return domain.ErrFailedToRestockMerch
+		}
+
+		if merchReturn.RefundAmount == 0 {
+			return nil
+		}
+
+		if err = u.coinsMgr.AdjustUserCoins(txCtx, userInfo.ID, merchReturn.RefundAmount); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToUpdateUserCoins, err)
+			return domain.ErrFailedToUpdateUserCoins
+		}
+
+		// Register refund transfer from the store to the user
+		ct := entity.NewCoinTransfer("", userInfo.ID, entity.TransactionTypeRefundMerch, merchReturn.RefundAmount, time.Now())
+
+		if err = u.coinsMgr.RegisterCoinTransfer(txCtx, ct); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToRegisterCoinTransfer, err)
+			return domain.ErrFailedToRegisterCoinTransfer
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err,
+			slog.Any("returnID", returnID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (u *Usecase) RejectReturn(ctx context.Context, returnID string) error {
+	const op = "usecase.Returns.RejectReturn"
+
+	log := u.log.With(slog.String("op", op))
+
+	merchReturn, err := u.getPendingReturn(ctx, log, returnID)
+	if err != nil {
+		return err
+	}
+
+	merchReturn.Status = entity.ReturnStatusRejected
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err = u.merchMgr.ResolveMerchReturn(txCtx, merchReturn); err != nil {
+			if errors.Is(err, domain.ErrReturnAlreadyResolved) {
+				e.LogError(txCtx, log, domain.ErrReturnAlreadyResolved, err)
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToResolveReturn, err)
+			return domain.ErrFailedToResolveReturn
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err,
+			slog.Any("returnID", returnID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// getPendingReturn loads a return request that is still waiting for a decision
+// and stamps it with the admin who is resolving it
+func (u *Usecase) getPendingReturn(ctx context.Context, log *slog.Logger, returnID string) (entity.MerchReturn, error) {
+	adminID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.MerchReturn{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	merchReturn, err := u.merchMgr.GetMerchReturnByID(ctx, returnID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMerchReturnNotFound) {
+			e.LogError(ctx, log, domain.ErrMerchReturnNotFound, err)
+			return entity.MerchReturn{}, domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetMerchReturn, err)
+		return entity.MerchReturn{}, domain.ErrFailedToGetMerchReturn
+	}
+
+	if merchReturn.Status != entity.ReturnStatusPending {
+		e.LogError(ctx, log, domain.ErrBadRequest, domain.ErrReturnAlreadyResolved,
+			slog.String("returnID", returnID),
+			slog.String("status", merchReturn.Status.String()),
+		)
+		return entity.MerchReturn{}, domain.ErrBadRequest
+	}
+
+	merchReturn.ResolvedBy = adminID
+	merchReturn.ResolvedAt = time.Now()
+
+	return merchReturn, nil
+}
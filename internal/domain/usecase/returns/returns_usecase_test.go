@@ -0,0 +1,532 @@
+package returns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/returns/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const returnWindow = 14 * 24 * time.Hour
+
+func TestUsecase_RequestReturn(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	userID := "test-user-id"
+
+	purchase := entity.Purchase{
+		ID:        "test-purchase-id",
+		UserID:    userID,
+		MerchID:   "test-merch-id",
+		Item:      "t-shirt",
+		Price:     80,
+		CreatedAt: time.Now().Add(-time.Hour),
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(
+			identityMgr *mocks.IdentityManager,
+			merchMgr *mocks.MerchManager,
+		)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				merchMgr.EXPECT().GetPurchaseByID(ctx, purchase.ID).
+					Once().
+					Return(purchase, nil)
+
+				merchMgr.EXPECT().CreateMerchReturn(ctx, mock.MatchedBy(func(mr entity.MerchReturn) bool {
+					return mr.PurchaseID == purchase.ID &&
+						mr.RefundAmount == purchase.Price &&
+						mr.Status == entity.ReturnStatusPending
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error - Purchase not found",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				merchMgr.EXPECT().GetPurchaseByID(ctx, purchase.ID).
+					Once().
+					Return(entity.Purchase{}, domain.ErrPurchaseNotFound)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Purchase belongs to another user",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return("another-user-id", nil)
+
+				merchMgr.EXPECT().GetPurchaseByID(ctx, purchase.ID).
+					Once().
+					Return(purchase, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Purchase already returned",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				returned := purchase
+				returned.ReturnedAt = time.Now()
+
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				merchMgr.EXPECT().GetPurchaseByID(ctx, purchase.ID).
+					Once().
+					Return(returned, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Purchase was transferred from another user",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				transferred := purchase
+				transferred.TransferID = "test-transfer-id"
+
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				merchMgr.EXPECT().GetPurchaseByID(ctx, purchase.ID).
+					Once().
+					Return(transferred, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Return window expired",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				old := purchase
+				old.CreatedAt = time.Now().Add(-returnWindow - time.Hour)
+
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				merchMgr.EXPECT().GetPurchaseByID(ctx, purchase.ID).
+					Once().
+					Return(old, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Return already requested",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				merchMgr.EXPECT().GetPurchaseByID(ctx, purchase.ID).
+					Once().
+					Return(purchase, nil)
+
+				merchMgr.EXPECT().CreateMerchReturn(ctx, mock.AnythingOfType("entity.MerchReturn")).
+					Once().
+					Return(domain.ErrReturnAlreadyRequested)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Failed to create return",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				merchMgr.EXPECT().GetPurchaseByID(ctx, purchase.ID).
+					Once().
+					Return(purchase, nil)
+
+				merchMgr.EXPECT().CreateMerchReturn(ctx, mock.AnythingOfType("entity.MerchReturn")).
+					Once().
+					Return(errors.New("merch manager error"))
+			},
+			expectedError: domain.ErrFailedToRequestReturn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tt.mockBehavior(identityMgr, merchMgr)
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, coinsMgr, merchMgr, txMgr, returnWindow)
+			merchReturn, err := usecase.RequestReturn(ctx, purchase.ID, "wrong size")
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, merchReturn.ID)
+			} else {
+				require.NoError(t, err)
+				require.NotEmpty(t, merchReturn.ID)
+				require.Equal(t, purchase.Price, merchReturn.RefundAmount)
+			}
+		})
+	}
+}
+
+func TestUsecase_ApproveReturn(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	adminID := "test-admin-id"
+
+	user := entity.UserInfo{
+		ID:    "test-user-id",
+		Coins: 100,
+	}
+
+	merchReturn := entity.MerchReturn{
+		ID:           "test-return-id",
+		PurchaseID:   "test-purchase-id",
+		UserID:       user.ID,
+		MerchID:      "test-merch-id",
+		Item:         "t-shirt",
+		RefundAmount: 80,
+		Status:       entity.ReturnStatusPending,
+	}
+
+	isApproved := mock.MatchedBy(func(mr entity.MerchReturn) bool {
+		return mr.ID == merchReturn.ID &&
+			mr.Status == entity.ReturnStatusApproved &&
+			mr.ResolvedBy == adminID
+	})
+
+	tests := []struct {
+		name         string
+		mockBehavior func(
+			identityMgr *mocks.IdentityManager,
+			userMgr *mocks.UserManager,
+			coinsMgr *mocks.CoinManager,
+			merchMgr *mocks.MerchManager,
+			txMgr *mocks.TransactionManager,
+		)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				merchMgr.EXPECT().GetMerchReturnByID(ctx, merchReturn.ID).
+					Once().
+					Return(merchReturn, nil)
+
+				userMgr.EXPECT().GetUserInfoByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				merchMgr.EXPECT().ResolveMerchReturn(ctx, isApproved).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().MarkPurchaseReturned(ctx, merchReturn.PurchaseID).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().Restock(ctx, merchReturn.MerchID).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, user.ID, merchReturn.RefundAmount).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+					return ct.SenderID == "" &&
+						ct.ReceiverID == user.ID &&
+						ct.TransactionType == entity.TransactionTypeRefundMerch &&
+						int(ct.Amount) == merchReturn.RefundAmount
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error - Return not found",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				merchMgr.EXPECT().GetMerchReturnByID(ctx, merchReturn.ID).
+					Once().
+					Return(entity.MerchReturn{}, domain.ErrMerchReturnNotFound)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Return already resolved",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				rejected := merchReturn
+				rejected.Status = entity.ReturnStatusRejected
+
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				merchMgr.EXPECT().GetMerchReturnByID(ctx, merchReturn.ID).
+					Once().
+					Return(rejected, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Return resolved concurrently",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				merchMgr.EXPECT().GetMerchReturnByID(ctx, merchReturn.ID).
+					Once().
+					Return(merchReturn, nil)
+
+				userMgr.EXPECT().GetUserInfoByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				merchMgr.EXPECT().ResolveMerchReturn(ctx, isApproved).
+					Once().
+					Return(domain.ErrReturnAlreadyResolved)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Failed to refund coins",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				userMgr *mocks.UserManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				merchMgr.EXPECT().GetMerchReturnByID(ctx, merchReturn.ID).
+					Once().
+					Return(merchReturn, nil)
+
+				userMgr.EXPECT().GetUserInfoByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				merchMgr.EXPECT().ResolveMerchReturn(ctx, isApproved).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().MarkPurchaseReturned(ctx, merchReturn.PurchaseID).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().Restock(ctx, merchReturn.MerchID).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, user.ID, merchReturn.RefundAmount).
+					Once().
+					Return(errors.New("coins manager error"))
+			},
+			expectedError: domain.ErrFailedToUpdateUserCoins,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tt.mockBehavior(identityMgr, userMgr, coinsMgr, merchMgr, txMgr)
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, coinsMgr, merchMgr, txMgr, returnWindow)
+			err := usecase.ApproveReturn(ctx, merchReturn.ID)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_RejectReturn(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	adminID := "test-admin-id"
+
+	merchReturn := entity.MerchReturn{
+		ID:           "test-return-id",
+		PurchaseID:   "test-purchase-id",
+		UserID:       "test-user-id",
+		MerchID:      "test-merch-id",
+		RefundAmount: 80,
+		Status:       entity.ReturnStatusPending,
+	}
+
+	tests := []struct {
+		name          string
+		resolveErr    error
+		expectedError error
+	}{
+		{
+			name:          "Success",
+			resolveErr:    nil,
+			expectedError: nil,
+		},
+		{
+			name:          "Error - Return resolved concurrently",
+			resolveErr:    domain.ErrReturnAlreadyResolved,
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:          "Error - Failed to resolve return",
+			resolveErr:    errors.New("merch manager error"),
+			expectedError: domain.ErrFailedToResolveReturn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			userMgr := mocks.NewUserManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+				Once().
+				Return(adminID, nil)
+
+			merchMgr.EXPECT().GetMerchReturnByID(ctx, merchReturn.ID).
+				Once().
+				Return(merchReturn, nil)
+
+			txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+
+			// Rejected returns must never touch stock or balances
+			merchMgr.EXPECT().ResolveMerchReturn(ctx, mock.MatchedBy(func(mr entity.MerchReturn) bool {
+				return mr.Status == entity.ReturnStatusRejected && mr.ResolvedBy == adminID
+			})).
+				Once().
+				Return(tt.resolveErr)
+
+			usecase := NewUsecase(logger, identityMgr, userMgr, coinsMgr, merchMgr, txMgr, returnWindow)
+			err := usecase.RejectReturn(ctx, merchReturn.ID)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package serviceaccount
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log               *slog.Logger
+	identityMgr       IdentityManager
+	serviceAccountMgr ServiceAccountManager
+	tokenMgr          TokenManager
+}
+
+type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+	}
+
+	ServiceAccountManager interface {
+		CreateServiceAccount(ctx context.Context, account entity.ServiceAccount) error
+		GetServiceAccountByID(ctx context.Context, serviceAccountID string) (entity.ServiceAccount, error)
+		ListServiceAccounts(ctx context.Context) ([]entity.ServiceAccount, error)
+		CreateAPIKey(ctx context.Context, key entity.APIKey) error
+		GetAPIKeyByHash(ctx context.Context, keyHash string) (entity.APIKey, error)
+		ListAPIKeys(ctx context.Context, serviceAccountID string) ([]entity.APIKey, error)
+		TouchAPIKey(ctx context.Context, key entity.APIKey) error
+		RevokeAPIKey(ctx context.Context, serviceAccountID, keyID string) error
+	}
+
+	TokenManager interface {
+		GenerateAPIKey(serviceAccountID, createdBy string, scopes []entity.Scope, expiresAt time.Time) (string, entity.APIKey, error)
+		HashAPIKey(apiKey string) string
+	}
+)
+
+func NewUsecase(
+	log *slog.Logger,
+	identityMgr IdentityManager,
+	serviceAccountMgr ServiceAccountManager,
+	tokenMgr TokenManager,
+) *Usecase {
+	return &Usecase{
+		log:               log,
+		identityMgr:       identityMgr,
+		serviceAccountMgr: serviceAccountMgr,
+		tokenMgr:          tokenMgr,
+	}
+}
+
+func (u *Usecase) CreateServiceAccount(ctx context.Context, name, description string) (entity.ServiceAccount, error) {
+	const op = "usecase.ServiceAccount.CreateServiceAccount"
+
+	log := u.log.With(slog.String("op", op))
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		err := fmt.Errorf("%s: %w", op, domain.ErrServiceAccountNameRequired)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.ServiceAccount{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrServiceAccountNameRequired)
+	}
+
+	adminID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.ServiceAccount{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	account := entity.NewServiceAccount(name, description, adminID)
+
+	if err = u.serviceAccountMgr.CreateServiceAccount(ctx, account); err != nil {
+		if errors.Is(err, domain.ErrServiceAccountAlreadyExists) {
+			e.LogError(ctx, log, domain.ErrServiceAccountAlreadyExists, err, slog.String("name", name))
+			return entity.ServiceAccount{}, domain.ErrServiceAccountAlreadyExists
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToCreateServiceAccount, err)
+		return entity.ServiceAccount{}, domain.ErrFailedToCreateServiceAccount
+	}
+
+	log.Info("service account created",
+		slog.String("serviceAccountID", account.ID),
+		slog.String("name", account.Name),
+		slog.String("createdBy", adminID),
+	)
+
+	return account, nil
+}
+
+func (u *Usecase) ListServiceAccounts(ctx context.Context) ([]entity.ServiceAccount, error) {
+	const op = "usecase.ServiceAccount.ListServiceAccounts"
+
+	log := u.log.With(slog.String("op", op))
+
+	accounts, err := u.serviceAccountMgr.ListServiceAccounts(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListServiceAccounts, err)
+		return nil, domain.ErrFailedToListServiceAccounts
+	}
+
+	return accounts, nil
+}
+
+// CreateAPIKey returns a new key of the service account. The plain key is only returned here,
+// it can't be recovered later. A zero expiresAt creates a key that doesn't expire
+func (u *Usecase) CreateAPIKey(
+	ctx context.Context,
+	serviceAccountID string,
+	scopes []entity.Scope,
+	expiresAt time.Time,
+) (string, entity.APIKey, error) {
+	const op = "usecase.ServiceAccount.CreateAPIKey"
+
+	log := u.log.With(slog.String("op", op))
+
+	if err := validateAPIKey(scopes, expiresAt); err != nil {
+		e.LogError(ctx, log, domain.ErrBadRequest, fmt.Errorf("%s: %w", op, err))
+		return "", entity.APIKey{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+	}
+
+	adminID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return "", entity.APIKey{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	apiKey, key, err := u.tokenMgr.GenerateAPIKey(serviceAccountID, adminID, scopes, expiresAt)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCreateAPIKey, err)
+		return "", entity.APIKey{}, domain.ErrFailedToCreateAPIKey
+	}
+
+	if err = u.serviceAccountMgr.CreateAPIKey(ctx, key); err != nil {
+		if errors.Is(err, domain.ErrServiceAccountNotFound) {
+			e.LogError(ctx, log, domain.ErrServiceAccountNotFound, err, slog.String("serviceAccountID", serviceAccountID))
+			return "", entity.APIKey{}, domain.ErrServiceAccountNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToCreateAPIKey, err, slog.String("serviceAccountID", serviceAccountID))
+		return "", entity.APIKey{}, domain.ErrFailedToCreateAPIKey
+	}
+
+	log.Info("api key created",
+		slog.String("serviceAccountID", serviceAccountID),
+		slog.String("keyID", key.ID),
+		slog.Any("scopes", scopes),
+		slog.String("createdBy", adminID),
+	)
+
+	return apiKey, key, nil
+}
+
+func validateAPIKey(scopes []entity.Scope, expiresAt time.Time) error {
+	if len(scopes) == 0 {
+		return domain.ErrScopesRequired
+	}
+
+	for _, scope := range scopes {
+		if !scope.IsValid() {
+			return fmt.Errorf("%w: %q", domain.ErrInvalidScope, scope)
+		}
+	}
+
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		return domain.ErrAPIKeyExpiryInPast
+	}
+
+	return nil
+}
+
+func (u *Usecase) ListAPIKeys(ctx context.Context, serviceAccountID string) ([]entity.APIKey, error) {
+	const op = "usecase.ServiceAccount.ListAPIKeys"
+
+	log := u.log.With(slog.String("op", op))
+
+	if _, err := u.serviceAccountMgr.GetServiceAccountByID(ctx, serviceAccountID); err != nil {
+		if errors.Is(err, domain.ErrServiceAccountNotFound) {
+			e.LogError(ctx, log, domain.ErrServiceAccountNotFound, err, slog.String("serviceAccountID", serviceAccountID))
+			return nil, domain.ErrServiceAccountNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToListAPIKeys, err, slog.String("serviceAccountID", serviceAccountID))
+		return nil, domain.ErrFailedToListAPIKeys
+	}
+
+	keys, err := u.serviceAccountMgr.ListAPIKeys(ctx, serviceAccountID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListAPIKeys, err, slog.String("serviceAccountID", serviceAccountID))
+		return nil, domain.ErrFailedToListAPIKeys
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey takes effect on the next request made with the key
+func (u *Usecase) RevokeAPIKey(ctx context.Context, serviceAccountID, keyID string) error {
+	const op = "usecase.ServiceAccount.RevokeAPIKey"
+
+	log := u.log.With(slog.String("op", op))
+
+	if err := u.serviceAccountMgr.RevokeAPIKey(ctx, serviceAccountID, keyID); err != nil {
+		if errors.Is(err, domain.ErrAPIKeyNotFound) {
+			e.LogError(ctx, log, domain.ErrAPIKeyNotFound, err, slog.String("keyID", keyID))
+			return domain.ErrAPIKeyNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToRevokeAPIKey, err, slog.String("keyID", keyID))
+		return domain.ErrFailedToRevokeAPIKey
+	}
+
+	log.Info("api key revoked",
+		slog.String("serviceAccountID", serviceAccountID),
+		slog.String("keyID", keyID),
+	)
+
+	return nil
+}
+
+// AuthenticateAPIKey returns the record of a valid key. Unknown, expired and revoked keys
+// are not told apart, all of them are ErrInvalidAPIKey
+func (u *Usecase) AuthenticateAPIKey(ctx context.Context, apiKey string) (entity.APIKey, error) {
+	const op = "usecase.ServiceAccount.AuthenticateAPIKey"
+
+	log := u.log.With(slog.String("op", op))
+
+	key, err := u.serviceAccountMgr.GetAPIKeyByHash(ctx, u.tokenMgr.HashAPIKey(apiKey))
+	if err != nil {
+		if errors.Is(err, domain.ErrAPIKeyNotFound) {
+			e.LogError(ctx, log, domain.ErrInvalidAPIKey, err)
+			return entity.APIKey{}, domain.ErrInvalidAPIKey
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToAuthenticateAPIKey, err)
+		return entity.APIKey{}, domain.ErrFailedToAuthenticateAPIKey
+	}
+
+	if key.IsRevoked() || key.IsExpired(time.Now()) {
+		e.LogError(ctx, log, domain.ErrInvalidAPIKey, fmt.Errorf("%s: %w", op, domain.ErrInvalidAPIKey),
+			slog.String("keyID", key.ID),
+			slog.Bool("revoked", key.IsRevoked()),
+		)
+		return entity.APIKey{}, domain.ErrInvalidAPIKey
+	}
+
+	// The request doesn't depend on the last-used time, it's only informational
+	if err = u.serviceAccountMgr.TouchAPIKey(ctx, key); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToAuthenticateAPIKey, err, slog.String("keyID", key.ID))
+	}
+
+	return key, nil
+}
@@ -0,0 +1,253 @@
+package serviceaccount
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/serviceaccount/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/require"
+)
+
+type testMocks struct {
+	identityMgr       *mocks.IdentityManager
+	serviceAccountMgr *mocks.ServiceAccountManager
+	tokenMgr          *mocks.TokenManager
+}
+
+func newTestUsecase(t *testing.T) (*Usecase, testMocks) {
+	m := testMocks{
+		identityMgr:       mocks.NewIdentityManager(t),
+		serviceAccountMgr: mocks.NewServiceAccountManager(t),
+		tokenMgr:          mocks.NewTokenManager(t),
+	}
+
+	usecase := NewUsecase(
+		slogdiscard.NewDiscardLogger(),
+		m.identityMgr,
+		m.serviceAccountMgr,
+		m.tokenMgr,
+	)
+
+	return usecase, m
+}
+
+func TestUsecase_CreateAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	adminID := "test-admin-id"
+	serviceAccountID := "test-service-account-id"
+	scopes := []entity.Scope{entity.ScopeMerchRead}
+	key := entity.NewAPIKey(serviceAccountID, adminID, "test-key-hash", scopes, time.Time{})
+
+	tests := []struct {
+		name          string
+		scopes        []entity.Scope
+		expiresAt     time.Time
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name:   "Success",
+			scopes: scopes,
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				m.tokenMgr.EXPECT().GenerateAPIKey(serviceAccountID, adminID, scopes, time.Time{}).
+					Once().
+					Return("msk_test-api-key", key, nil)
+
+				m.serviceAccountMgr.EXPECT().CreateAPIKey(ctx, key).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Error – No scopes",
+			scopes:        nil,
+			mockBehavior:  func(_ testMocks) {},
+			expectedError: domain.ErrScopesRequired,
+		},
+		{
+			name:          "Error – Unknown scope",
+			scopes:        []entity.Scope{"coins:burn"},
+			mockBehavior:  func(_ testMocks) {},
+			expectedError: domain.ErrInvalidScope,
+		},
+		{
+			name:          "Error – Expiry in the past",
+			scopes:        scopes,
+			expiresAt:     time.Now().Add(-time.Hour),
+			mockBehavior:  func(_ testMocks) {},
+			expectedError: domain.ErrAPIKeyExpiryInPast,
+		},
+		{
+			name:   "Error – Service account not found",
+			scopes: scopes,
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				m.tokenMgr.EXPECT().GenerateAPIKey(serviceAccountID, adminID, scopes, time.Time{}).
+					Once().
+					Return("msk_test-api-key", key, nil)
+
+				m.serviceAccountMgr.EXPECT().CreateAPIKey(ctx, key).
+					Once().
+					Return(domain.ErrServiceAccountNotFound)
+			},
+			expectedError: domain.ErrServiceAccountNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+			tt.mockBehavior(m)
+
+			apiKey, created, err := usecase.CreateAPIKey(ctx, serviceAccountID, tt.scopes, tt.expiresAt)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "msk_test-api-key", apiKey)
+				require.Equal(t, key, created)
+			}
+		})
+	}
+}
+
+func TestUsecase_AuthenticateAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	apiKey := "msk_test-api-key"
+	keyHash := "test-key-hash"
+
+	activeKey := entity.NewAPIKey("test-service-account-id", "test-admin-id", keyHash, []entity.Scope{entity.ScopeMerchRead}, time.Time{})
+
+	revokedKey := activeKey
+	revokedKey.RevokedAt = time.Now().Add(-time.Minute)
+
+	expiredKey := activeKey
+	expiredKey.ExpiresAt = time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(m testMocks) {
+				m.tokenMgr.EXPECT().HashAPIKey(apiKey).
+					Once().
+					Return(keyHash)
+
+				m.serviceAccountMgr.EXPECT().GetAPIKeyByHash(ctx, keyHash).
+					Once().
+					Return(activeKey, nil)
+
+				m.serviceAccountMgr.EXPECT().TouchAPIKey(ctx, activeKey).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success – Failed to record last use",
+			mockBehavior: func(m testMocks) {
+				m.tokenMgr.EXPECT().HashAPIKey(apiKey).
+					Once().
+					Return(keyHash)
+
+				m.serviceAccountMgr.EXPECT().GetAPIKeyByHash(ctx, keyHash).
+					Once().
+					Return(activeKey, nil)
+
+				m.serviceAccountMgr.EXPECT().TouchAPIKey(ctx, activeKey).
+					Once().
+					Return(errors.New("db error"))
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Unknown key",
+			mockBehavior: func(m testMocks) {
+				m.tokenMgr.EXPECT().HashAPIKey(apiKey).
+					Once().
+					Return(keyHash)
+
+				m.serviceAccountMgr.EXPECT().GetAPIKeyByHash(ctx, keyHash).
+					Once().
+					Return(entity.APIKey{}, domain.ErrAPIKeyNotFound)
+			},
+			expectedError: domain.ErrInvalidAPIKey,
+		},
+		{
+			name: "Error – Revoked key",
+			mockBehavior: func(m testMocks) {
+				m.tokenMgr.EXPECT().HashAPIKey(apiKey).
+					Once().
+					Return(keyHash)
+
+				m.serviceAccountMgr.EXPECT().GetAPIKeyByHash(ctx, keyHash).
+					Once().
+					Return(revokedKey, nil)
+			},
+			expectedError: domain.ErrInvalidAPIKey,
+		},
+		{
+			name: "Error – Expired key",
+			mockBehavior: func(m testMocks) {
+				m.tokenMgr.EXPECT().HashAPIKey(apiKey).
+					Once().
+					Return(keyHash)
+
+				m.serviceAccountMgr.EXPECT().GetAPIKeyByHash(ctx, keyHash).
+					Once().
+					Return(expiredKey, nil)
+			},
+			expectedError: domain.ErrInvalidAPIKey,
+		},
+		{
+			name: "Error – Failed to get key",
+			mockBehavior: func(m testMocks) {
+				m.tokenMgr.EXPECT().HashAPIKey(apiKey).
+					Once().
+					Return(keyHash)
+
+				m.serviceAccountMgr.EXPECT().GetAPIKeyByHash(ctx, keyHash).
+					Once().
+					Return(entity.APIKey{}, errors.New("db error"))
+			},
+			expectedError: domain.ErrFailedToAuthenticateAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+			tt.mockBehavior(m)
+
+			key, err := usecase.AuthenticateAPIKey(ctx, apiKey)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, activeKey, key)
+			}
+		})
+	}
+}
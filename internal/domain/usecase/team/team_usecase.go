@@ -0,0 +1,515 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+// transactionsLimit is the number of the latest wallet movements shown with a team
+const transactionsLimit = 50
+
+type Usecase struct {
+	log         *slog.Logger
+	identityMgr IdentityManager
+	userMgr     UserManager
+	teamMgr     TeamManager
+	coinsMgr    CoinManager
+	merchMgr    MerchManager
+	txMgr       TransactionManager
+}
+
+type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+	}
+
+	UserManager interface {
+		GetUserByID(ctx context.Context, userID string) (entity.User, error)
+		GetUserByName(ctx context.Context, username string) (entity.User, error)
+	}
+
+	TeamManager interface {
+		CreateTeam(ctx context.Context, team entity.Team) error
+		GetTeamByID(ctx context.Context, teamID string) (entity.Team, error)
+		ListTeams(ctx context.Context) ([]entity.Team, error)
+		ListUserTeams(ctx context.Context, userID string) ([]entity.Team, error)
+		SaveTeamMember(ctx context.Context, member entity.TeamMember) error
+		RemoveTeamMember(ctx context.Context, teamID, userID string) error
+		GetTeamMember(ctx context.Context, teamID, userID string) (entity.TeamMember, error)
+		ListTeamMembers(ctx context.Context, teamID string) ([]entity.TeamMember, error)
+		ListTeamTransactions(ctx context.Context, teamID string, limit int) ([]entity.TeamTransaction, error)
+	}
+
+	CoinManager interface {
+		AdjustUserCoins(ctx context.Context, userID string, amount int) error
+		AdjustTeamCoins(ctx context.Context, teamID string, amount int) error
+		RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransfer) error
+	}
+
+	MerchManager interface {
+		GetMerchByName(ctx context.Context, itemName string) (entity.Merch, error)
+		TakeFromStock(ctx context.Context, merchID string) error
+		AddToInventory(ctx context.Context, userID, merchID string, price int) error
+	}
+
+	TransactionManager interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+)
+
+func NewUsecase(
+	log *slog.Logger,
+	identityMgr IdentityManager,
+	userMgr UserManager,
+	teamMgr TeamManager,
+	coinsMgr CoinManager,
+	merchMgr MerchManager,
+	txMgr TransactionManager,
+) *Usecase {
+	return &Usecase{
+		log:         log,
+		identityMgr: identityMgr,
+		userMgr:     userMgr,
+		teamMgr:     teamMgr,
+		coinsMgr:    coinsMgr,
+		merchMgr:    merchMgr,
+		txMgr:       txMgr,
+	}
+}
+
+// CreateTeam creates a team with an empty wallet, members are added with SetTeamMember
+func (u *Usecase) CreateTeam(ctx context.Context, name, department string) (entity.Team, error) {
+	const op = "usecase.Team.CreateTeam"
+
+	log := u.log.With(slog.String("op", op))
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		err := fmt.Errorf("%s: %w", op, domain.ErrTeamNameRequired)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return entity.Team{}, fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrTeamNameRequired)
+	}
+
+	adminID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.Team{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	team := entity.NewTeam(name, strings.TrimSpace(department), adminID)
+
+	if err = u.teamMgr.CreateTeam(ctx, team); err != nil {
+		if errors.Is(err, domain.ErrTeamAlreadyExists) {
+			e.LogError(ctx, log, domain.ErrTeamAlreadyExists, err, slog.String("name", name))
+			return entity.Team{}, domain.ErrTeamAlreadyExists
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToCreateTeam, err)
+		return entity.Team{}, domain.ErrFailedToCreateTeam
+	}
+
+	log.Info("team created",
+		slog.String("teamID", team.ID),
+		slog.String("name", team.Name),
+		slog.String("createdBy", adminID),
+	)
+
+	return team, nil
+}
+
+func (u *Usecase) ListTeams(ctx context.Context) ([]entity.Team, error) {
+	const op = "usecase.Team.ListTeams"
+
+	log := u.log.With(slog.String("op", op))
+
+	teams, err := u.teamMgr.ListTeams(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListTeams, err)
+		return nil, domain.ErrFailedToListTeams
+	}
+
+	return teams, nil
+}
+
+// SetTeamMember adds the user to the team with the role, or changes the role of a member
+func (u *Usecase) SetTeamMember(ctx context.Context, teamID, userID string, role entity.TeamRole) error {
+	const op = "usecase.Team.SetTeamMember"
+
+	log := u.log.With(slog.String("op", op))
+
+	if !role.IsValid() {
+		err := fmt.Errorf("%s: %w", op, domain.ErrInvalidTeamRole)
+		e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("role", string(role)))
+		return fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrInvalidTeamRole)
+	}
+
+	if _, err := u.userMgr.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			e.LogError(ctx, log, domain.ErrUserNotFound, err, slog.String("userID", userID))
+			return domain.ErrUserNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err, slog.String("userID", userID))
+		return domain.ErrFailedToGetUser
+	}
+
+	if err := u.teamMgr.SaveTeamMember(ctx, entity.NewTeamMember(teamID, userID, role)); err != nil {
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			e.LogError(ctx, log, domain.ErrTeamNotFound, err, slog.String("teamID", teamID))
+			return domain.ErrTeamNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToSaveTeamMember, err, slog.String("teamID", teamID))
+		return domain.ErrFailedToSaveTeamMember
+	}
+
+	log.Info("team member saved",
+		slog.String("teamID", teamID),
+		slog.String("userID", userID),
+		slog.String("role", string(role)),
+	)
+
+	return nil
+}
+
+func (u *Usecase) RemoveTeamMember(ctx context.Context, teamID, userID string) error {
+	const op = "usecase.Team.RemoveTeamMember"
+
+	log := u.log.With(slog.String("op", op))
+
+	if err := u.teamMgr.RemoveTeamMember(ctx, teamID, userID); err != nil {
+		if errors.Is(err, domain.ErrTeamMemberNotFound) {
+			e.LogError(ctx, log, domain.ErrTeamMemberNotFound, err,
+				slog.String("teamID", teamID),
+				slog.String("userID", userID),
+			)
+			return domain.ErrTeamMemberNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToRemoveTeamMember, err, slog.String("teamID", teamID))
+		return domain.ErrFailedToRemoveTeamMember
+	}
+
+	log.Info("team member removed",
+		slog.String("teamID", teamID),
+		slog.String("userID", userID),
+	)
+
+	return nil
+}
+
+// ListUserTeams returns the teams of the current user
+func (u *Usecase) ListUserTeams(ctx context.Context) ([]entity.Team, error) {
+	const op = "usecase.Team.ListUserTeams"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return nil, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	teams, err := u.teamMgr.ListUserTeams(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListTeams, err, slog.String("userID", userID))
+		return nil, domain.ErrFailedToListTeams
+	}
+
+	return teams, nil
+}
+
+// GetTeam returns the team with its members and the latest movements of the wallet.
+// Only members can see it
+func (u *Usecase) GetTeam(ctx context.Context, teamID string) (entity.TeamDetails, error) {
+	const op = "usecase.Team.GetTeam"
+
+	log := u.log.With(slog.String("op", op))
+
+	if _, err := u.currentMember(ctx, log, teamID); err != nil {
+		return entity.TeamDetails{}, err
+	}
+
+	team, err := u.teamMgr.GetTeamByID(ctx, teamID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToGetTeam, err, slog.String("teamID", teamID))
+		return entity.TeamDetails{}, domain.ErrFailedToGetTeam
+	}
+
+	members, err := u.teamMgr.ListTeamMembers(ctx, teamID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToGetTeam, err, slog.String("teamID", teamID))
+		return entity.TeamDetails{}, domain.ErrFailedToGetTeam
+	}
+
+	transactions, err := u.teamMgr.ListTeamTransactions(ctx, teamID, transactionsLimit)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToGetTeam, err, slog.String("teamID", teamID))
+		return entity.TeamDetails{}, domain.ErrFailedToGetTeam
+	}
+
+	return entity.TeamDetails{
+		Team:         team,
+		Members:      members,
+		Transactions: transactions,
+	}, nil
+}
+
+// Contribute moves coins of the current user to the team wallet. Any member can contribute
+func (u *Usecase) Contribute(ctx context.Context, teamID string, amount int) error {
+	const op = "usecase.Team.Contribute"
+
+	log := u.log.With(slog.String("op", op))
+
+	if amount <= 0 {
+		err := fmt.Errorf("%s: %w", op, domain.ErrAmountMustBePositive)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return domain.ErrBadRequest
+	}
+
+	member, err := u.currentMember(ctx, log, teamID)
+	if err != nil {
+		return err
+	}
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err = u.coinsMgr.AdjustUserCoins(txCtx, member.UserID, -amount); err != nil {
+			if errors.Is(err, domain.ErrInsufficientCoins) {
+				e.LogError(txCtx, log, domain.ErrBadRequest, err)
+				return fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToUpdateUserCoins, err)
+			return domain.ErrFailedToUpdateUserCoins
+		}
+
+		if err = u.coinsMgr.AdjustTeamCoins(txCtx, teamID, amount); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToUpdateTeamCoins, err)
+			return domain.ErrFailedToUpdateTeamCoins
+		}
+
+		ct := entity.NewTeamContribution(member.UserID, teamID, amount, time.Now())
+
+		if err = u.coinsMgr.RegisterCoinTransfer(txCtx, ct); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToRegisterCoinTransfer, err)
+			return domain.ErrFailedToRegisterCoinTransfer
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err,
+			slog.String("teamID", teamID),
+			slog.String("userID", member.UserID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// Payout moves coins from the team wallet to a member of the team. Only managers can do it
+func (u *Usecase) Payout(ctx context.Context, teamID, toUsername string, amount int) error {
+	const op = "usecase.Team.Payout"
+
+	log := u.log.With(slog.String("op", op))
+
+	if amount <= 0 {
+		err := fmt.Errorf("%s: %w", op, domain.ErrAmountMustBePositive)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return domain.ErrBadRequest
+	}
+
+	manager, err := u.currentManager(ctx, log, teamID)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := u.userMgr.GetUserByName(ctx, toUsername)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			e.LogError(ctx, log, domain.ErrReceiverNotFound, err)
+			return fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrReceiverNotFound)
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err)
+		return domain.ErrFailedToGetUser
+	}
+
+	// The wallet is a budget of the team, so it's only paid out to its members
+	if _, err = u.teamMgr.GetTeamMember(ctx, teamID, receiver.ID); err != nil {
+		if errors.Is(err, domain.ErrTeamMemberNotFound) {
+			e.LogError(ctx, log, domain.ErrTeamMemberNotFound, err, slog.String("receiverID", receiver.ID))
+			return fmt.Errorf("%w: %w", domain.ErrBadRequest, domain.ErrTeamMemberNotFound)
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetTeamMember, err, slog.String("receiverID", receiver.ID))
+		return domain.ErrFailedToGetTeamMember
+	}
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err = u.coinsMgr.AdjustTeamCoins(txCtx, teamID, -amount); err != nil {
+			if errors.Is(err, domain.ErrInsufficientCoins) {
+				e.LogError(txCtx, log, domain.ErrBadRequest, err)
+				return fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToUpdateTeamCoins, err)
+			return domain.ErrFailedToUpdateTeamCoins
+		}
+
+		if err = u.coinsMgr.AdjustUserCoins(txCtx, receiver.ID, amount); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToUpdateUserCoins, err)
+			return domain.ErrFailedToUpdateUserCoins
+		}
+
+		ct := entity.NewTeamPayout(teamID, receiver.ID, amount, time.Now())
+
+		if err = u.coinsMgr.RegisterCoinTransfer(txCtx, ct); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToRegisterCoinTransfer, err)
+			return domain.ErrFailedToRegisterCoinTransfer
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err,
+			slog.String("teamID", teamID),
+			slog.String("receiverID", receiver.ID),
+		)
+		return err
+	}
+
+	log.Info("team coins paid out",
+		slog.String("teamID", teamID),
+		slog.String("receiverID", receiver.ID),
+		slog.Int("amount", amount),
+		slog.String("managerID", manager.UserID),
+	)
+
+	return nil
+}
+
+// BuyMerch pays for an item from the team wallet. Only managers can do it, the item goes
+// to the inventory of the manager who bought it
+func (u *Usecase) BuyMerch(ctx context.Context, teamID, itemName string) error {
+	const op = "usecase.Team.BuyMerch"
+
+	log := u.log.With(slog.String("op", op))
+
+	manager, err := u.currentManager(ctx, log, teamID)
+	if err != nil {
+		return err
+	}
+
+	merch, err := u.merchMgr.GetMerchByName(ctx, itemName)
+	if err != nil {
+		if errors.Is(err, domain.ErrMerchNotFound) {
+			e.LogError(ctx, log, domain.ErrMerchNotFound, err, slog.String("item", itemName))
+			return fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetMerch, err)
+		return domain.ErrFailedToGetMerch
+	}
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err = u.coinsMgr.AdjustTeamCoins(txCtx, teamID, -merch.Price); err != nil {
+			if errors.Is(err, domain.ErrInsufficientCoins) {
+				e.LogError(txCtx, log, domain.ErrBadRequest, err)
+				return fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToUpdateTeamCoins, err)
+			return domain.ErrFailedToUpdateTeamCoins
+		}
+
+		if err = u.merchMgr.TakeFromStock(txCtx, merch.ID); err != nil {
+			if errors.Is(err, domain.ErrMerchOutOfStock) || errors.Is(err, domain.ErrMerchReservedForWaitlist) {
+				e.LogError(txCtx, log, domain.ErrBadRequest, err)
+				return fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToTakeMerchFromStock, err)
+			return domain.ErrFailedToTakeMerchFromStock
+		}
+
+		// The team paid for the item, so returning it must not refund the manager
+		if err = u.merchMgr.AddToInventory(txCtx, manager.UserID, merch.ID, 0); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToAddMerchToInventory, err)
+			return domain.ErrFailedToAddMerchToInventory
+		}
+
+		ct := entity.NewTeamPurchase(teamID, merch.Price, time.Now())
+
+		if err = u.coinsMgr.RegisterCoinTransfer(txCtx, ct); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToRegisterCoinTransfer, err)
+			return domain.ErrFailedToRegisterCoinTransfer
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err,
+			slog.String("teamID", teamID),
+			slog.String("managerID", manager.UserID),
+		)
+		return err
+	}
+
+	log.Info("merch bought for team",
+		slog.String("teamID", teamID),
+		slog.String("merchID", merch.ID),
+		slog.String("managerID", manager.UserID),
+	)
+
+	return nil
+}
+
+// currentMember returns the membership of the current user. Teams the user isn't a member of
+// are reported as not found, so their existence isn't revealed
+func (u *Usecase) currentMember(ctx context.Context, log *slog.Logger, teamID string) (entity.TeamMember, error) {
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.TeamMember{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	member, err := u.teamMgr.GetTeamMember(ctx, teamID, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrTeamMemberNotFound) {
+			e.LogError(ctx, log, domain.ErrNotTeamMember, err,
+				slog.String("teamID", teamID),
+				slog.String("userID", userID),
+			)
+			return entity.TeamMember{}, domain.ErrTeamNotFound
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetTeamMember, err, slog.String("teamID", teamID))
+		return entity.TeamMember{}, domain.ErrFailedToGetTeamMember
+	}
+
+	return member, nil
+}
+
+func (u *Usecase) currentManager(ctx context.Context, log *slog.Logger, teamID string) (entity.TeamMember, error) {
+	member, err := u.currentMember(ctx, log, teamID)
+	if err != nil {
+		return entity.TeamMember{}, err
+	}
+
+	if !member.IsManager() {
+		e.LogError(ctx, log, domain.ErrNotTeamManager, domain.ErrNotTeamManager,
+			slog.String("teamID", teamID),
+			slog.String("userID", member.UserID),
+		)
+		return entity.TeamMember{}, domain.ErrNotTeamManager
+	}
+
+	return member, nil
+}
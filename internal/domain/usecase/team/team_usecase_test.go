@@ -0,0 +1,737 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/team/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testMocks struct {
+	identityMgr *mocks.IdentityManager
+	userMgr     *mocks.UserManager
+	teamMgr     *mocks.TeamManager
+	coinsMgr    *mocks.CoinManager
+	merchMgr    *mocks.MerchManager
+	txMgr       *mocks.TransactionManager
+}
+
+func newTestUsecase(t *testing.T) (*Usecase, testMocks) {
+	m := testMocks{
+		identityMgr: mocks.NewIdentityManager(t),
+		userMgr:     mocks.NewUserManager(t),
+		teamMgr:     mocks.NewTeamManager(t),
+		coinsMgr:    mocks.NewCoinManager(t),
+		merchMgr:    mocks.NewMerchManager(t),
+		txMgr:       mocks.NewTransactionManager(t),
+	}
+
+	usecase := NewUsecase(
+		slogdiscard.NewDiscardLogger(),
+		m.identityMgr,
+		m.userMgr,
+		m.teamMgr,
+		m.coinsMgr,
+		m.merchMgr,
+		m.txMgr,
+	)
+
+	return usecase, m
+}
+
+func expectTransaction(ctx context.Context, txMgr *mocks.TransactionManager) {
+	txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+func TestUsecase_CreateTeam(t *testing.T) {
+	ctx := context.Background()
+
+	adminID := "test-admin-id"
+	name := "Platform"
+	department := "Engineering"
+
+	tests := []struct {
+		name          string
+		teamName      string
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name:     "Success",
+			teamName: name,
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				m.teamMgr.EXPECT().CreateTeam(ctx, mock.MatchedBy(func(team entity.Team) bool {
+					return team.Name == name && team.Department == department &&
+						team.CreatedBy == adminID && team.Balance == 0
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Error – Name required",
+			teamName:      "   ",
+			mockBehavior:  func(m testMocks) {},
+			expectedError: domain.ErrTeamNameRequired,
+		},
+		{
+			name:     "Error – Team already exists",
+			teamName: name,
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				m.teamMgr.EXPECT().CreateTeam(ctx, mock.AnythingOfType("entity.Team")).
+					Once().
+					Return(domain.ErrTeamAlreadyExists)
+			},
+			expectedError: domain.ErrTeamAlreadyExists,
+		},
+		{
+			name:     "Error – Failed to create team",
+			teamName: name,
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(adminID, nil)
+
+				m.teamMgr.EXPECT().CreateTeam(ctx, mock.AnythingOfType("entity.Team")).
+					Once().
+					Return(errors.New("storage error"))
+			},
+			expectedError: domain.ErrFailedToCreateTeam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+			tt.mockBehavior(m)
+
+			team, err := usecase.CreateTeam(ctx, tt.teamName, department)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.NotEmpty(t, team.ID)
+				require.Equal(t, name, team.Name)
+			}
+		})
+	}
+}
+
+func TestUsecase_SetTeamMember(t *testing.T) {
+	ctx := context.Background()
+
+	teamID := "test-team-id"
+	userID := "test-user-id"
+
+	tests := []struct {
+		name          string
+		role          entity.TeamRole
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			role: entity.TeamRoleManager,
+			mockBehavior: func(m testMocks) {
+				m.userMgr.EXPECT().GetUserByID(ctx, userID).
+					Once().
+					Return(entity.User{ID: userID}, nil)
+
+				m.teamMgr.EXPECT().SaveTeamMember(ctx, mock.MatchedBy(func(member entity.TeamMember) bool {
+					return member.TeamID == teamID && member.UserID == userID &&
+						member.Role == entity.TeamRoleManager
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Error – Invalid role",
+			role:          entity.TeamRole("owner"),
+			mockBehavior:  func(m testMocks) {},
+			expectedError: domain.ErrInvalidTeamRole,
+		},
+		{
+			name: "Error – User not found",
+			role: entity.TeamRoleMember,
+			mockBehavior: func(m testMocks) {
+				m.userMgr.EXPECT().GetUserByID(ctx, userID).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrUserNotFound,
+		},
+		{
+			name: "Error – Team not found",
+			role: entity.TeamRoleMember,
+			mockBehavior: func(m testMocks) {
+				m.userMgr.EXPECT().GetUserByID(ctx, userID).
+					Once().
+					Return(entity.User{ID: userID}, nil)
+
+				m.teamMgr.EXPECT().SaveTeamMember(ctx, mock.AnythingOfType("entity.TeamMember")).
+					Once().
+					Return(domain.ErrTeamNotFound)
+			},
+			expectedError: domain.ErrTeamNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+			tt.mockBehavior(m)
+
+			err := usecase.SetTeamMember(ctx, teamID, userID, tt.role)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_GetTeam(t *testing.T) {
+	ctx := context.Background()
+
+	teamID := "test-team-id"
+	userID := "test-user-id"
+
+	member := entity.TeamMember{
+		TeamID:   teamID,
+		UserID:   userID,
+		Username: "test-user",
+		Role:     entity.TeamRoleMember,
+	}
+
+	team := entity.Team{
+		ID:      teamID,
+		Name:    "Platform",
+		Balance: 300,
+	}
+
+	transactions := []entity.TeamTransaction{
+		{
+			ID:       "test-transaction-id",
+			Type:     entity.TransactionTypeTeamContribution,
+			Username: member.Username,
+			Amount:   300,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, userID).
+					Once().
+					Return(member, nil)
+
+				m.teamMgr.EXPECT().GetTeamByID(ctx, teamID).
+					Once().
+					Return(team, nil)
+
+				m.teamMgr.EXPECT().ListTeamMembers(ctx, teamID).
+					Once().
+					Return([]entity.TeamMember{member}, nil)
+
+				m.teamMgr.EXPECT().ListTeamTransactions(ctx, teamID, transactionsLimit).
+					Once().
+					Return(transactions, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Not a member",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, userID).
+					Once().
+					Return(entity.TeamMember{}, domain.ErrTeamMemberNotFound)
+			},
+			expectedError: domain.ErrTeamNotFound,
+		},
+		{
+			name: "Error – Failed to list transactions",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, userID).
+					Once().
+					Return(member, nil)
+
+				m.teamMgr.EXPECT().GetTeamByID(ctx, teamID).
+					Once().
+					Return(team, nil)
+
+				m.teamMgr.EXPECT().ListTeamMembers(ctx, teamID).
+					Once().
+					Return([]entity.TeamMember{member}, nil)
+
+				m.teamMgr.EXPECT().ListTeamTransactions(ctx, teamID, transactionsLimit).
+					Once().
+					Return(nil, errors.New("storage error"))
+			},
+			expectedError: domain.ErrFailedToGetTeam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+			tt.mockBehavior(m)
+
+			details, err := usecase.GetTeam(ctx, teamID)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, team, details.Team)
+				require.Equal(t, []entity.TeamMember{member}, details.Members)
+				require.Equal(t, transactions, details.Transactions)
+			}
+		})
+	}
+}
+
+func TestUsecase_Contribute(t *testing.T) {
+	ctx := context.Background()
+
+	teamID := "test-team-id"
+	userID := "test-user-id"
+	amount := 100
+
+	member := entity.TeamMember{
+		TeamID: teamID,
+		UserID: userID,
+		Role:   entity.TeamRoleMember,
+	}
+
+	tests := []struct {
+		name          string
+		amount        int
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name:   "Success",
+			amount: amount,
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, userID).
+					Once().
+					Return(member, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.coinsMgr.EXPECT().AdjustUserCoins(ctx, userID, -amount).
+					Once().
+					Return(nil)
+
+				m.coinsMgr.EXPECT().AdjustTeamCoins(ctx, teamID, amount).
+					Once().
+					Return(nil)
+
+				m.coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+					return ct.SenderID == userID && ct.ReceiverTeamID == teamID &&
+						ct.TransactionType == entity.TransactionTypeTeamContribution && int(ct.Amount) == amount
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "Error – Amount is not positive",
+			amount:        0,
+			mockBehavior:  func(m testMocks) {},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:   "Error – Not a member",
+			amount: amount,
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, userID).
+					Once().
+					Return(entity.TeamMember{}, domain.ErrTeamMemberNotFound)
+			},
+			expectedError: domain.ErrTeamNotFound,
+		},
+		{
+			name:   "Error – Insufficient coins",
+			amount: amount,
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, userID).
+					Once().
+					Return(member, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.coinsMgr.EXPECT().AdjustUserCoins(ctx, userID, -amount).
+					Once().
+					Return(domain.ErrInsufficientCoins)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name:   "Error – Failed to register transfer",
+			amount: amount,
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, userID).
+					Once().
+					Return(member, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.coinsMgr.EXPECT().AdjustUserCoins(ctx, userID, -amount).
+					Once().
+					Return(nil)
+
+				m.coinsMgr.EXPECT().AdjustTeamCoins(ctx, teamID, amount).
+					Once().
+					Return(nil)
+
+				m.coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.AnythingOfType("entity.CoinTransfer")).
+					Once().
+					Return(errors.New("storage error"))
+			},
+			expectedError: domain.ErrFailedToRegisterCoinTransfer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+			tt.mockBehavior(m)
+
+			err := usecase.Contribute(ctx, teamID, tt.amount)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_Payout(t *testing.T) {
+	ctx := context.Background()
+
+	teamID := "test-team-id"
+	managerID := "test-manager-id"
+	amount := 50
+
+	manager := entity.TeamMember{
+		TeamID: teamID,
+		UserID: managerID,
+		Role:   entity.TeamRoleManager,
+	}
+
+	receiver := entity.User{
+		ID:       "test-receiver-id",
+		Username: "test-receiver",
+	}
+
+	expectManager := func(m testMocks, member entity.TeamMember) {
+		m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+			Once().
+			Return(managerID, nil)
+
+		m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, managerID).
+			Once().
+			Return(member, nil)
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(m testMocks) {
+				expectManager(m, manager)
+
+				m.userMgr.EXPECT().GetUserByName(ctx, receiver.Username).
+					Once().
+					Return(receiver, nil)
+
+				m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, receiver.ID).
+					Once().
+					Return(entity.TeamMember{TeamID: teamID, UserID: receiver.ID}, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.coinsMgr.EXPECT().AdjustTeamCoins(ctx, teamID, -amount).
+					Once().
+					Return(nil)
+
+				m.coinsMgr.EXPECT().AdjustUserCoins(ctx, receiver.ID, amount).
+					Once().
+					Return(nil)
+
+				m.coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+					return ct.SenderTeamID == teamID && ct.ReceiverID == receiver.ID &&
+						ct.TransactionType == entity.TransactionTypeTeamPayout && int(ct.Amount) == amount
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Not a manager",
+			mockBehavior: func(m testMocks) {
+				expectManager(m, entity.TeamMember{TeamID: teamID, UserID: managerID, Role: entity.TeamRoleMember})
+			},
+			expectedError: domain.ErrNotTeamManager,
+		},
+		{
+			name: "Error – Receiver not found",
+			mockBehavior: func(m testMocks) {
+				expectManager(m, manager)
+
+				m.userMgr.EXPECT().GetUserByName(ctx, receiver.Username).
+					Once().
+					Return(entity.User{}, domain.ErrUserNotFound)
+			},
+			expectedError: domain.ErrReceiverNotFound,
+		},
+		{
+			name: "Error – Receiver is not a member",
+			mockBehavior: func(m testMocks) {
+				expectManager(m, manager)
+
+				m.userMgr.EXPECT().GetUserByName(ctx, receiver.Username).
+					Once().
+					Return(receiver, nil)
+
+				m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, receiver.ID).
+					Once().
+					Return(entity.TeamMember{}, domain.ErrTeamMemberNotFound)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Insufficient team coins",
+			mockBehavior: func(m testMocks) {
+				expectManager(m, manager)
+
+				m.userMgr.EXPECT().GetUserByName(ctx, receiver.Username).
+					Once().
+					Return(receiver, nil)
+
+				m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, receiver.ID).
+					Once().
+					Return(entity.TeamMember{TeamID: teamID, UserID: receiver.ID}, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.coinsMgr.EXPECT().AdjustTeamCoins(ctx, teamID, -amount).
+					Once().
+					Return(domain.ErrInsufficientCoins)
+			},
+			expectedError: domain.ErrInsufficientCoins,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+			tt.mockBehavior(m)
+
+			err := usecase.Payout(ctx, teamID, receiver.Username, amount)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_BuyMerch(t *testing.T) {
+	ctx := context.Background()
+
+	teamID := "test-team-id"
+	managerID := "test-manager-id"
+
+	manager := entity.TeamMember{
+		TeamID: teamID,
+		UserID: managerID,
+		Role:   entity.TeamRoleManager,
+	}
+
+	merch := entity.Merch{
+		ID:    "test-merch-id",
+		Name:  "t-shirt",
+		Price: 80,
+	}
+
+	expectManager := func(m testMocks) {
+		m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+			Once().
+			Return(managerID, nil)
+
+		m.teamMgr.EXPECT().GetTeamMember(ctx, teamID, managerID).
+			Once().
+			Return(manager, nil)
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(m testMocks) {
+				expectManager(m)
+
+				m.merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(merch, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.coinsMgr.EXPECT().AdjustTeamCoins(ctx, teamID, -merch.Price).
+					Once().
+					Return(nil)
+
+				m.merchMgr.EXPECT().TakeFromStock(ctx, merch.ID).
+					Once().
+					Return(nil)
+
+				// Added at no price, so a return doesn't refund the manager
+				m.merchMgr.EXPECT().AddToInventory(ctx, managerID, merch.ID, 0).
+					Once().
+					Return(nil)
+
+				m.coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+					return ct.SenderTeamID == teamID && ct.ReceiverID == "" &&
+						ct.TransactionType == entity.TransactionTypeTeamPurchase && int(ct.Amount) == merch.Price
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Merch not found",
+			mockBehavior: func(m testMocks) {
+				expectManager(m)
+
+				m.merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(entity.Merch{}, domain.ErrMerchNotFound)
+			},
+			expectedError: domain.ErrMerchNotFound,
+		},
+		{
+			name: "Error – Insufficient team coins",
+			mockBehavior: func(m testMocks) {
+				expectManager(m)
+
+				m.merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(merch, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.coinsMgr.EXPECT().AdjustTeamCoins(ctx, teamID, -merch.Price).
+					Once().
+					Return(domain.ErrInsufficientCoins)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Out of stock",
+			mockBehavior: func(m testMocks) {
+				expectManager(m)
+
+				m.merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(merch, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.coinsMgr.EXPECT().AdjustTeamCoins(ctx, teamID, -merch.Price).
+					Once().
+					Return(nil)
+
+				m.merchMgr.EXPECT().TakeFromStock(ctx, merch.ID).
+					Once().
+					Return(domain.ErrMerchOutOfStock)
+			},
+			expectedError: domain.ErrMerchOutOfStock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+			tt.mockBehavior(m)
+
+			err := usecase.BuyMerch(ctx, teamID, merch.Name)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log           *slog.Logger
+	identityMgr   IdentityManager
+	userMgr       UserManager
+	twoFactorMgr  TwoFactorManager
+	loginThrottle LoginThrottle
+	txMgr         TransactionManager
+}
+
+type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+	}
+
+	UserManager interface {
+		GetUserByID(ctx context.Context, userID string) (entity.User, error)
+	}
+
+	TwoFactorManager interface {
+		Enroll(ctx context.Context, userID, accountName string) (entity.TOTPEnrollment, error)
+		Confirm(ctx context.Context, userID, code string) ([]string, error)
+		VerifySecondFactor(ctx context.Context, userID, code string) error
+		Disable(ctx context.Context, userID string) error
+	}
+
+	LoginThrottle interface {
+		CheckLogin(ctx context.Context, username, ip string) error
+		RecordLoginFailure(ctx context.Context, username, ip string) ([]entity.LoginFailures, error)
+		ResetLoginFailures(ctx context.Context, username string) error
+	}
+
+	TransactionManager interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+)
+
+func NewUsecase(
+	log *slog.Logger,
+	identityMgr IdentityManager,
+	userMgr UserManager,
+	twoFactorMgr TwoFactorManager,
+	loginThrottle LoginThrottle,
+	txMgr TransactionManager,
+) *Usecase {
+	return &Usecase{
+		log:           log,
+		identityMgr:   identityMgr,
+		userMgr:       userMgr,
+		twoFactorMgr:  twoFactorMgr,
+		loginThrottle: loginThrottle,
+		txMgr:         txMgr,
+	}
+}
+
+// Enroll generates a TOTP secret for the current user. Two-factor authentication is enabled
+// only after the secret is confirmed with a code, enrolling again before that replaces the secret
+func (u *Usecase) Enroll(ctx context.Context) (entity.TOTPEnrollment, error) {
+	const op = "usecase.TwoFactor.Enroll"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return entity.TOTPEnrollment{}, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	user, err := u.userMgr.GetUserByID(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err, slog.String("userID", userID))
+		return entity.TOTPEnrollment{}, domain.ErrFailedToGetUser
+	}
+
+	enrollment, err := u.twoFactorMgr.Enroll(ctx, userID, user.Username)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTwoFactorNotConfigured):
+			e.LogError(ctx, log, domain.ErrTwoFactorNotConfigured, err)
+			return entity.TOTPEnrollment{}, domain.ErrTwoFactorNotConfigured
+		case errors.Is(err, domain.ErrTwoFactorAlreadyEnabled):
+			e.LogError(ctx, log, domain.ErrTwoFactorAlreadyEnabled, err, slog.String("userID", userID))
+			return entity.TOTPEnrollment{}, domain.ErrTwoFactorAlreadyEnabled
+		default:
+			e.LogError(ctx, log, domain.ErrFailedToEnrollTwoFactor, err, slog.String("userID", userID))
+			return entity.TOTPEnrollment{}, domain.ErrFailedToEnrollTwoFactor
+		}
+	}
+
+	log.Info("authenticator enrolled", slog.String("userID", userID))
+
+	return enrollment, nil
+}
+
+// Confirm enables two-factor authentication with a code of the enrolled authenticator
+// and returns the recovery codes. They are shown once, only their hashes are kept
+func (u *Usecase) Confirm(ctx context.Context, code string) ([]string, error) {
+	const op = "usecase.TwoFactor.Confirm"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return nil, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	var recoveryCodes []string
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		recoveryCodes, err = u.twoFactorMgr.Confirm(txCtx, userID, code)
+		return err
+	}); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidTOTPCode),
+			errors.Is(err, domain.ErrTwoFactorNotEnrolled):
+			e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("userID", userID))
+			return nil, fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+		case errors.Is(err, domain.ErrTwoFactorAlreadyEnabled):
+			e.LogError(ctx, log, domain.ErrTwoFactorAlreadyEnabled, err, slog.String("userID", userID))
+			return nil, domain.ErrTwoFactorAlreadyEnabled
+		default:
+			e.LogError(ctx, log, domain.ErrFailedToConfirmTwoFactor, err, slog.String("userID", userID))
+			return nil, domain.ErrFailedToConfirmTwoFactor
+		}
+	}
+
+	log.Info("two-factor authentication enabled", slog.String("userID", userID))
+
+	return recoveryCodes, nil
+}
+
+// Disable turns two-factor authentication off. It takes a code of the authenticator or a recovery code,
+// so a stolen session alone can't remove the second factor
+func (u *Usecase) Disable(ctx context.Context, code, clientIP string) error {
+	const op = "usecase.TwoFactor.Disable"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	user, err := u.userMgr.GetUserByID(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToGetUser, err, slog.String("userID", userID))
+		return domain.ErrFailedToGetUser
+	}
+
+	if err = u.checkLogin(ctx, log, user.Username, clientIP); err != nil {
+		return err
+	}
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err = u.twoFactorMgr.VerifySecondFactor(txCtx, userID, code); err != nil {
+			return err
+		}
+
+		return u.twoFactorMgr.Disable(txCtx, userID)
+	}); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidTOTPCode):
+			e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("userID", userID))
+			u.recordLoginFailure(ctx, log, user.Username, clientIP)
+			return fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+		case errors.Is(err, domain.ErrTwoFactorNotEnabled):
+			e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("userID", userID))
+			return fmt.Errorf("%w: %w", domain.ErrBadRequest, err)
+		default:
+			e.LogError(ctx, log, domain.ErrFailedToDisableTwoFactor, err, slog.String("userID", userID))
+			return domain.ErrFailedToDisableTwoFactor
+		}
+	}
+
+	// Not being able to reset the counter only makes a later lockout come earlier
+	if err = u.loginThrottle.ResetLoginFailures(ctx, user.Username); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToResetLoginFailures, err, slog.String("userID", userID))
+	}
+
+	log.Info("two-factor authentication disabled", slog.String("userID", userID))
+
+	return nil
+}
+
+func (u *Usecase) checkLogin(ctx context.Context, log *slog.Logger, username, clientIP string) error {
+	if err := u.loginThrottle.CheckLogin(ctx, username, clientIP); err != nil {
+		var throttledErr *domain.LoginThrottledError
+		if errors.As(err, &throttledErr) {
+			log.Warn("disabling two-factor authentication blocked",
+				slog.String("username", username),
+				slog.String("ip", clientIP),
+				slog.String("reason", throttledErr.Err.Error()),
+				slog.Duration("retryAfter", throttledErr.RetryAfter),
+			)
+			return err
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToCheckLoginThrottle, err)
+		return domain.ErrFailedToCheckLoginThrottle
+	}
+
+	return nil
+}
+
+func (u *Usecase) recordLoginFailure(ctx context.Context, log *slog.Logger, username, clientIP string) {
+	lockouts, err := u.loginThrottle.RecordLoginFailure(ctx, username, clientIP)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToRecordLoginFailure, err,
+			slog.String("username", username),
+			slog.String("ip", clientIP),
+		)
+		return
+	}
+
+	for _, lockout := range lockouts {
+		log.Warn("logins locked out",
+			slog.String("scope", string(lockout.Scope)),
+			slog.String("subject", lockout.Subject),
+			slog.Int("failures", lockout.Failures),
+			slog.Time("lockedUntil", lockout.BlockedUntil),
+		)
+	}
+}
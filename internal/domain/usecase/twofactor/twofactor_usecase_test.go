@@ -0,0 +1,406 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/twofactor/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testMocks struct {
+	identityMgr   *mocks.IdentityManager
+	userMgr       *mocks.UserManager
+	twoFactorMgr  *mocks.TwoFactorManager
+	loginThrottle *mocks.LoginThrottle
+	txMgr         *mocks.TransactionManager
+}
+
+func newTestUsecase(t *testing.T) (*Usecase, testMocks) {
+	m := testMocks{
+		identityMgr:   mocks.NewIdentityManager(t),
+		userMgr:       mocks.NewUserManager(t),
+		twoFactorMgr:  mocks.NewTwoFactorManager(t),
+		loginThrottle: mocks.NewLoginThrottle(t),
+		txMgr:         mocks.NewTransactionManager(t),
+	}
+
+	usecase := NewUsecase(
+		slogdiscard.NewDiscardLogger(),
+		m.identityMgr,
+		m.userMgr,
+		m.twoFactorMgr,
+		m.loginThrottle,
+		m.txMgr,
+	)
+
+	return usecase, m
+}
+
+func expectTransaction(ctx context.Context, txMgr *mocks.TransactionManager) {
+	txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+func TestUsecase_Enroll(t *testing.T) {
+	ctx := context.Background()
+
+	user := entity.User{
+		ID:       "test-user-id",
+		Username: "test-user",
+	}
+
+	enrollment := entity.TOTPEnrollment{
+		Secret:          "JBSWY3DPEHPK3PXP",
+		ProvisioningURI: "otpauth://totp/Merch%20Store:test-user?issuer=Merch+Store&secret=JBSWY3DPEHPK3PXP",
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				m.twoFactorMgr.EXPECT().Enroll(ctx, user.ID, user.Username).
+					Once().
+					Return(enrollment, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Already enabled",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				m.twoFactorMgr.EXPECT().Enroll(ctx, user.ID, user.Username).
+					Once().
+					Return(entity.TOTPEnrollment{}, domain.ErrTwoFactorAlreadyEnabled)
+			},
+			expectedError: domain.ErrTwoFactorAlreadyEnabled,
+		},
+		{
+			name: "Error – Not configured",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				m.twoFactorMgr.EXPECT().Enroll(ctx, user.ID, user.Username).
+					Once().
+					Return(entity.TOTPEnrollment{}, domain.ErrTwoFactorNotConfigured)
+			},
+			expectedError: domain.ErrTwoFactorNotConfigured,
+		},
+		{
+			name: "Error – Failed to enroll",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				m.userMgr.EXPECT().GetUserByID(ctx, user.ID).
+					Once().
+					Return(user, nil)
+
+				m.twoFactorMgr.EXPECT().Enroll(ctx, user.ID, user.Username).
+					Once().
+					Return(entity.TOTPEnrollment{}, errors.New("storage error"))
+			},
+			expectedError: domain.ErrFailedToEnrollTwoFactor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+
+			tt.mockBehavior(m)
+
+			result, err := usecase.Enroll(ctx)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, enrollment, result)
+			}
+		})
+	}
+}
+
+func TestUsecase_Confirm(t *testing.T) {
+	ctx := context.Background()
+
+	userID := "test-user-id"
+	code := "123456"
+	recoveryCodes := []string{"abcd-efgh-ijkl-mnop", "qrst-uvwx-yz23-4567"}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.twoFactorMgr.EXPECT().Confirm(ctx, userID, code).
+					Once().
+					Return(recoveryCodes, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Invalid code",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.twoFactorMgr.EXPECT().Confirm(ctx, userID, code).
+					Once().
+					Return(nil, domain.ErrInvalidTOTPCode)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Not enrolled",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.twoFactorMgr.EXPECT().Confirm(ctx, userID, code).
+					Once().
+					Return(nil, domain.ErrTwoFactorNotEnrolled)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Already enabled",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.twoFactorMgr.EXPECT().Confirm(ctx, userID, code).
+					Once().
+					Return(nil, domain.ErrTwoFactorAlreadyEnabled)
+			},
+			expectedError: domain.ErrTwoFactorAlreadyEnabled,
+		},
+		{
+			name: "Error – Failed to confirm",
+			mockBehavior: func(m testMocks) {
+				m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.twoFactorMgr.EXPECT().Confirm(ctx, userID, code).
+					Once().
+					Return(nil, errors.New("storage error"))
+			},
+			expectedError: domain.ErrFailedToConfirmTwoFactor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+
+			tt.mockBehavior(m)
+
+			result, err := usecase.Confirm(ctx, code)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, recoveryCodes, result)
+			}
+		})
+	}
+}
+
+func TestUsecase_Disable(t *testing.T) {
+	ctx := context.Background()
+
+	user := entity.User{
+		ID:       "test-user-id",
+		Username: "test-username",
+	}
+
+	userID := user.ID
+	code := "123456"
+	clientIP := "192.0.2.1"
+
+	expectUser := func(m testMocks) {
+		m.identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+			Once().
+			Return(userID, nil)
+
+		m.userMgr.EXPECT().GetUserByID(ctx, userID).
+			Once().
+			Return(user, nil)
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(m testMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(m testMocks) {
+				expectUser(m)
+
+				m.loginThrottle.EXPECT().CheckLogin(ctx, user.Username, clientIP).
+					Once().
+					Return(nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.twoFactorMgr.EXPECT().VerifySecondFactor(ctx, userID, code).
+					Once().
+					Return(nil)
+
+				m.twoFactorMgr.EXPECT().Disable(ctx, userID).
+					Once().
+					Return(nil)
+
+				m.loginThrottle.EXPECT().ResetLoginFailures(ctx, user.Username).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Invalid code",
+			mockBehavior: func(m testMocks) {
+				expectUser(m)
+
+				m.loginThrottle.EXPECT().CheckLogin(ctx, user.Username, clientIP).
+					Once().
+					Return(nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.twoFactorMgr.EXPECT().VerifySecondFactor(ctx, userID, code).
+					Once().
+					Return(domain.ErrInvalidTOTPCode)
+
+				m.loginThrottle.EXPECT().RecordLoginFailure(ctx, user.Username, clientIP).
+					Once().
+					Return(nil, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Too many wrong codes",
+			mockBehavior: func(m testMocks) {
+				expectUser(m)
+
+				m.loginThrottle.EXPECT().CheckLogin(ctx, user.Username, clientIP).
+					Once().
+					Return(&domain.LoginThrottledError{Err: domain.ErrAccountLocked, RetryAfter: time.Minute})
+			},
+			expectedError: domain.ErrAccountLocked,
+		},
+		{
+			name: "Error – Not enabled",
+			mockBehavior: func(m testMocks) {
+				expectUser(m)
+
+				m.loginThrottle.EXPECT().CheckLogin(ctx, user.Username, clientIP).
+					Once().
+					Return(nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.twoFactorMgr.EXPECT().VerifySecondFactor(ctx, userID, code).
+					Once().
+					Return(domain.ErrTwoFactorNotEnabled)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error – Failed to disable",
+			mockBehavior: func(m testMocks) {
+				expectUser(m)
+
+				m.loginThrottle.EXPECT().CheckLogin(ctx, user.Username, clientIP).
+					Once().
+					Return(nil)
+
+				expectTransaction(ctx, m.txMgr)
+
+				m.twoFactorMgr.EXPECT().VerifySecondFactor(ctx, userID, code).
+					Once().
+					Return(nil)
+
+				m.twoFactorMgr.EXPECT().Disable(ctx, userID).
+					Once().
+					Return(errors.New("storage error"))
+			},
+			expectedError: domain.ErrFailedToDisableTwoFactor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := newTestUsecase(t)
+
+			tt.mockBehavior(m)
+
+			err := usecase.Disable(ctx, code, clientIP)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
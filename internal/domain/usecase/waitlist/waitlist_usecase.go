@@ -0,0 +1,343 @@
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/lib/e"
+)
+
+type Usecase struct {
+	log            *slog.Logger
+	identityMgr    IdentityManager
+	coinsMgr       CoinManager
+	merchMgr       MerchManager
+	txMgr          TransactionManager
+	reservationTTL time.Duration
+}
+
+type (
+	IdentityManager interface {
+		ExtractUserIDFromContext(ctx context.Context) (string, error)
+	}
+
+	CoinManager interface {
+		AdjustUserCoins(ctx context.Context, userID string, amount int) error
+		RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransfer) error
+	}
+
+	MerchManager interface {
+		GetMerchByName(ctx context.Context, itemName string) (entity.Merch, error)
+		AddToInventory(ctx context.Context, userID, merchID string, price int) error
+		Restock(ctx context.Context, merchID string) error
+		JoinWaitlist(ctx context.Context, entry entity.WaitlistEntry) error
+		LeaveWaitlist(ctx context.Context, userID, merchID string) error
+		ListUserWaitlist(ctx context.Context, userID string) ([]entity.WaitlistEntry, error)
+		ListRestockedMerch(ctx context.Context) ([]entity.RestockedMerch, error)
+		PopWaitlist(ctx context.Context, merchID string, limit int) ([]entity.WaitlistEntry, error)
+		ReserveStock(ctx context.Context, merchID string, quantity int) error
+		CreateReservation(ctx context.Context, reservation entity.Reservation) error
+		GetReservationByID(ctx context.Context, reservationID string) (entity.Reservation, error)
+		ListUserReservations(ctx context.Context, userID string) ([]entity.Reservation, error)
+		CompleteReservation(ctx context.Context, reservationID string) error
+		ExpireReservations(ctx context.Context) ([]entity.Reservation, error)
+	}
+
+	TransactionManager interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+)
+
+func NewUsecase(
+	log *slog.Logger,
+	identityMgr IdentityManager,
+	coinsMgr CoinManager,
+	merchMgr MerchManager,
+	txMgr TransactionManager,
+	reservationTTL time.Duration,
+) *Usecase {
+	return &Usecase{
+		log:            log,
+		identityMgr:    identityMgr,
+		coinsMgr:       coinsMgr,
+		merchMgr:       merchMgr,
+		txMgr:          txMgr,
+		reservationTTL: reservationTTL,
+	}
+}
+
+func (u *Usecase) JoinWaitlist(ctx context.Context, itemName string) error {
+	const op = "usecase.Waitlist.JoinWaitlist"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	merch, err := u.getMerch(ctx, log, itemName)
+	if err != nil {
+		return err
+	}
+
+	// Items without a stock limit never run out, there is nothing to wait for
+	if !merch.IsStockTracked() {
+		err = fmt.Errorf("%s: %w", op, domain.ErrMerchNotStockTracked)
+		e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("item", itemName))
+		return domain.ErrBadRequest
+	}
+
+	entry := entity.NewWaitlistEntry(userID, merch)
+
+	if err = u.merchMgr.JoinWaitlist(ctx, entry); err != nil {
+		if errors.Is(err, domain.ErrAlreadyInWaitlist) {
+			e.LogError(ctx, log, domain.ErrAlreadyInWaitlist, err)
+			return domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToJoinWaitlist, err)
+		return domain.ErrFailedToJoinWaitlist
+	}
+
+	return nil
+}
+
+func (u *Usecase) LeaveWaitlist(ctx context.Context, itemName string) error {
+	const op = "usecase.Waitlist.LeaveWaitlist"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	merch, err := u.getMerch(ctx, log, itemName)
+	if err != nil {
+		return err
+	}
+
+	if err = u.merchMgr.LeaveWaitlist(ctx, userID, merch.ID); err != nil {
+		if errors.Is(err, domain.ErrWaitlistEntryNotFound) {
+			e.LogError(ctx, log, domain.ErrWaitlistEntryNotFound, err)
+			return domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToLeaveWaitlist, err)
+		return domain.ErrFailedToLeaveWaitlist
+	}
+
+	return nil
+}
+
+func (u *Usecase) ListWaitlist(ctx context.Context) ([]entity.WaitlistEntry, error) {
+	const op = "usecase.Waitlist.ListWaitlist"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return nil, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	entries, err := u.merchMgr.ListUserWaitlist(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListWaitlist, err)
+		return nil, domain.ErrFailedToListWaitlist
+	}
+
+	return entries, nil
+}
+
+func (u *Usecase) ListReservations(ctx context.Context) ([]entity.Reservation, error) {
+	const op = "usecase.Waitlist.ListReservations"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return nil, domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	reservations, err := u.merchMgr.ListUserReservations(ctx, userID)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToListReservations, err)
+		return nil, domain.ErrFailedToListReservations
+	}
+
+	return reservations, nil
+}
+
+// PurchaseReservation buys the reserved item at the price fixed when the reservation was made.
+// The unit was already taken from stock by the worker, so stock is not touched here
+func (u *Usecase) PurchaseReservation(ctx context.Context, reservationID string) error {
+	const op = "usecase.Waitlist.PurchaseReservation"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, err := u.identityMgr.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToExtractUserIDFromContext, err)
+		return domain.ErrFailedToExtractUserIDFromContext
+	}
+
+	reservation, err := u.merchMgr.GetReservationByID(ctx, reservationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrReservationNotFound) {
+			e.LogError(ctx, log, domain.ErrReservationNotFound, err)
+			return domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetReservation, err)
+		return domain.ErrFailedToGetReservation
+	}
+
+	// Users must not be able to find out whether someone else's reservation exists
+	if reservation.UserID != userID {
+		err = fmt.Errorf("%s: %w", op, domain.ErrReservationNotFound)
+		e.LogError(ctx, log, domain.ErrBadRequest, err, slog.String("reservationID", reservationID))
+		return domain.ErrBadRequest
+	}
+
+	if !reservation.IsActive(time.Now()) {
+		err = fmt.Errorf("%s: %w", op, domain.ErrReservationNotActive)
+		e.LogError(ctx, log, domain.ErrBadRequest, err)
+		return domain.ErrBadRequest
+	}
+
+	if err = u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		// Completing the reservation first guards against the worker expiring it concurrently
+		if err = u.merchMgr.CompleteReservation(txCtx, reservation.ID); err != nil {
+			if errors.Is(err, domain.ErrReservationNotActive) {
+				e.LogError(txCtx, log, domain.ErrReservationNotActive, err)
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToCompleteReservation, err)
+			return domain.ErrFailedToCompleteReservation
+		}
+
+		// The balance is debited atomically, so concurrent purchases can't spend the same coins twice
+		if err = u.coinsMgr.AdjustUserCoins(txCtx, userID, -reservation.Price); err != nil {
+			if errors.Is(err, domain.ErrInsufficientCoins) {
+				e.LogError(txCtx, log, domain.ErrInsufficientCoins, err)
+				return domain.ErrBadRequest
+			}
+
+			e.LogError(txCtx, log, domain.ErrFailedToUpdateUserCoins, err)
+			return domain.ErrFailedToUpdateUserCoins
+		}
+
+		if err = u.merchMgr.AddToInventory(txCtx, userID, reservation.MerchID, reservation.Price); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToAddMerchToInventory, err)
+			return domain.ErrFailedToAddMerchToInventory
+		}
+
+		ct := entity.NewCoinTransfer(userID, "", entity.TransactionTypePurchaseMerch, reservation.Price, time.Now())
+
+		if err = u.coinsMgr.RegisterCoinTransfer(txCtx, ct); err != nil {
+			e.LogError(txCtx, log, domain.ErrFailedToRegisterCoinTransfer, err)
+			return domain.ErrFailedToRegisterCoinTransfer
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToCommitTransaction, err,
+			slog.Any("userID", userID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// ProcessWaitlists is run periodically by the worker. It first returns the units of expired
+// reservations to stock, then hands out everything on stock to the users at the head of each waitlist.
+// Both happen in one transaction, so a returned unit is never on sale before the next user in line got it
+func (u *Usecase) ProcessWaitlists(ctx context.Context) error {
+	const op = "usecase.Waitlist.ProcessWaitlists"
+
+	log := u.log.With(slog.String("op", op))
+
+	if err := u.txMgr.WithinTransaction(ctx, func(txCtx context.Context) error {
+		expired, err := u.merchMgr.ExpireReservations(txCtx)
+		if err != nil {
+			return err
+		}
+
+		for _, reservation := range expired {
+			if err = u.merchMgr.Restock(txCtx, reservation.MerchID); err != nil {
+				return err
+			}
+		}
+
+		if len(expired) > 0 {
+			log.Info("reservations expired", slog.Int("count", len(expired)))
+		}
+
+		restocked, err := u.merchMgr.ListRestockedMerch(txCtx)
+		if err != nil {
+			return err
+		}
+
+		for _, merch := range restocked {
+			entries, err := u.merchMgr.PopWaitlist(txCtx, merch.MerchID, merch.Stock)
+			if err != nil {
+				return err
+			}
+
+			if len(entries) == 0 {
+				continue
+			}
+
+			if err = u.merchMgr.ReserveStock(txCtx, merch.MerchID, len(entries)); err != nil {
+				return err
+			}
+
+			for _, entry := range entries {
+				reservation := entity.NewReservation(entry, merch.Price, u.reservationTTL)
+
+				if err = u.merchMgr.CreateReservation(txCtx, reservation); err != nil {
+					return err
+				}
+			}
+
+			log.Info("stock reserved for waitlist",
+				slog.String("merchID", merch.MerchID),
+				slog.Int("count", len(entries)),
+			)
+		}
+
+		return nil
+	}); err != nil {
+		e.LogError(ctx, log, domain.ErrFailedToProcessWaitlists, err)
+		return domain.ErrFailedToProcessWaitlists
+	}
+
+	return nil
+}
+
+func (u *Usecase) getMerch(ctx context.Context, log *slog.Logger, itemName string) (entity.Merch, error) {
+	merch, err := u.merchMgr.GetMerchByName(ctx, itemName)
+	if err != nil {
+		if errors.Is(err, domain.ErrMerchNotFound) {
+			e.LogError(ctx, log, domain.ErrMerchNotFound, err, slog.String("item", itemName))
+			return entity.Merch{}, domain.ErrBadRequest
+		}
+
+		e.LogError(ctx, log, domain.ErrFailedToGetMerch, err)
+		return entity.Merch{}, domain.ErrFailedToGetMerch
+	}
+
+	return merch, nil
+}
@@ -0,0 +1,476 @@
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/domain/usecase/waitlist/mocks"
+	"github.com/rshelekhov/merch-store/internal/lib/logger/handler/slogdiscard"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const reservationTTL = 24 * time.Hour
+
+func TestUsecase_JoinWaitlist(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	userID := "test-user-id"
+	stock := 0
+
+	merch := entity.Merch{
+		ID:    "test-merch-id",
+		Name:  "hoody",
+		Price: 300,
+		Stock: &stock,
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(
+			identityMgr *mocks.IdentityManager,
+			merchMgr *mocks.MerchManager,
+		)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(merch, nil)
+
+				merchMgr.EXPECT().JoinWaitlist(ctx, mock.MatchedBy(func(entry entity.WaitlistEntry) bool {
+					return entry.UserID == userID && entry.MerchID == merch.ID
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error - Merch not found",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(entity.Merch{}, domain.ErrMerchNotFound)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Merch is not stock-tracked",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				unlimited := merch
+				unlimited.Stock = nil
+
+				merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(unlimited, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Already in waitlist",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(merch, nil)
+
+				merchMgr.EXPECT().JoinWaitlist(ctx, mock.AnythingOfType("entity.WaitlistEntry")).
+					Once().
+					Return(domain.ErrAlreadyInWaitlist)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Failed to join waitlist",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				merchMgr *mocks.MerchManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(userID, nil)
+
+				merchMgr.EXPECT().GetMerchByName(ctx, merch.Name).
+					Once().
+					Return(merch, nil)
+
+				merchMgr.EXPECT().JoinWaitlist(ctx, mock.AnythingOfType("entity.WaitlistEntry")).
+					Once().
+					Return(errors.New("merch manager error"))
+			},
+			expectedError: domain.ErrFailedToJoinWaitlist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tt.mockBehavior(identityMgr, merchMgr)
+
+			usecase := NewUsecase(logger, identityMgr, coinsMgr, merchMgr, txMgr, reservationTTL)
+			err := usecase.JoinWaitlist(ctx, merch.Name)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_PurchaseReservation(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	user := entity.UserInfo{
+		ID: "test-user-id",
+	}
+
+	reservation := entity.Reservation{
+		ID:        "test-reservation-id",
+		MerchID:   "test-merch-id",
+		UserID:    user.ID,
+		Item:      "hoody",
+		Price:     300,
+		Status:    entity.ReservationStatusActive,
+		CreatedAt: time.Now().Add(-time.Hour),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(
+			identityMgr *mocks.IdentityManager,
+			coinsMgr *mocks.CoinManager,
+			merchMgr *mocks.MerchManager,
+			txMgr *mocks.TransactionManager,
+		)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				merchMgr.EXPECT().GetReservationByID(ctx, reservation.ID).
+					Once().
+					Return(reservation, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				merchMgr.EXPECT().CompleteReservation(ctx, reservation.ID).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, user.ID, -reservation.Price).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().AddToInventory(ctx, user.ID, reservation.MerchID, reservation.Price).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().RegisterCoinTransfer(ctx, mock.MatchedBy(func(ct entity.CoinTransfer) bool {
+					return ct.SenderID == user.ID &&
+						ct.TransactionType == entity.TransactionTypePurchaseMerch &&
+						int(ct.Amount) == reservation.Price
+				})).
+					Once().
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error - Reservation belongs to another user",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return("another-user-id", nil)
+
+				merchMgr.EXPECT().GetReservationByID(ctx, reservation.ID).
+					Once().
+					Return(reservation, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Reservation expired",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				expired := reservation
+				expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+				merchMgr.EXPECT().GetReservationByID(ctx, reservation.ID).
+					Once().
+					Return(expired, nil)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Insufficient coins",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				merchMgr.EXPECT().GetReservationByID(ctx, reservation.ID).
+					Once().
+					Return(reservation, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				merchMgr.EXPECT().CompleteReservation(ctx, reservation.ID).
+					Once().
+					Return(nil)
+
+				coinsMgr.EXPECT().AdjustUserCoins(ctx, user.ID, -reservation.Price).
+					Once().
+					Return(domain.ErrInsufficientCoins)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+		{
+			name: "Error - Reservation expired concurrently",
+			mockBehavior: func(
+				identityMgr *mocks.IdentityManager,
+				coinsMgr *mocks.CoinManager,
+				merchMgr *mocks.MerchManager,
+				txMgr *mocks.TransactionManager,
+			) {
+				identityMgr.EXPECT().ExtractUserIDFromContext(ctx).
+					Once().
+					Return(user.ID, nil)
+
+				merchMgr.EXPECT().GetReservationByID(ctx, reservation.ID).
+					Once().
+					Return(reservation, nil)
+
+				txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+
+				merchMgr.EXPECT().CompleteReservation(ctx, reservation.ID).
+					Once().
+					Return(domain.ErrReservationNotActive)
+			},
+			expectedError: domain.ErrBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			tt.mockBehavior(identityMgr, coinsMgr, merchMgr, txMgr)
+
+			usecase := NewUsecase(logger, identityMgr, coinsMgr, merchMgr, txMgr, reservationTTL)
+			err := usecase.PurchaseReservation(ctx, reservation.ID)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_ProcessWaitlists(t *testing.T) {
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+
+	expired := entity.Reservation{
+		ID:      "test-expired-reservation-id",
+		MerchID: "test-merch-id",
+		UserID:  "test-user-id",
+		Price:   300,
+		Status:  entity.ReservationStatusExpired,
+	}
+
+	restocked := entity.RestockedMerch{
+		MerchID: "test-merch-id",
+		Price:   350,
+		Stock:   3,
+	}
+
+	entries := []entity.WaitlistEntry{
+		{ID: "test-entry-1", MerchID: restocked.MerchID, UserID: "test-user-1"},
+		{ID: "test-entry-2", MerchID: restocked.MerchID, UserID: "test-user-2"},
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(merchMgr *mocks.MerchManager)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().ExpireReservations(ctx).
+					Once().
+					Return([]entity.Reservation{expired}, nil)
+
+				merchMgr.EXPECT().Restock(ctx, expired.MerchID).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().ListRestockedMerch(ctx).
+					Once().
+					Return([]entity.RestockedMerch{restocked}, nil)
+
+				merchMgr.EXPECT().PopWaitlist(ctx, restocked.MerchID, restocked.Stock).
+					Once().
+					Return(entries, nil)
+
+				merchMgr.EXPECT().ReserveStock(ctx, restocked.MerchID, len(entries)).
+					Once().
+					Return(nil)
+
+				merchMgr.EXPECT().CreateReservation(ctx, mock.MatchedBy(func(r entity.Reservation) bool {
+					return r.MerchID == restocked.MerchID &&
+						r.Price == restocked.Price &&
+						r.Status == entity.ReservationStatusActive &&
+						r.ExpiresAt.After(time.Now())
+				})).
+					Times(len(entries)).
+					Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error - Failed to expire reservations",
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().ExpireReservations(ctx).
+					Once().
+					Return(nil, errors.New("merch manager error"))
+			},
+			expectedError: domain.ErrFailedToProcessWaitlists,
+		},
+		{
+			name: "Error - Failed to reserve stock",
+			mockBehavior: func(merchMgr *mocks.MerchManager) {
+				merchMgr.EXPECT().ExpireReservations(ctx).
+					Once().
+					Return(nil, nil)
+
+				merchMgr.EXPECT().ListRestockedMerch(ctx).
+					Once().
+					Return([]entity.RestockedMerch{restocked}, nil)
+
+				merchMgr.EXPECT().PopWaitlist(ctx, restocked.MerchID, restocked.Stock).
+					Once().
+					Return(entries, nil)
+
+				merchMgr.EXPECT().ReserveStock(ctx, restocked.MerchID, len(entries)).
+					Once().
+					Return(domain.ErrMerchOutOfStock)
+			},
+			expectedError: domain.ErrFailedToProcessWaitlists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityMgr := mocks.NewIdentityManager(t)
+			coinsMgr := mocks.NewCoinManager(t)
+			merchMgr := mocks.NewMerchManager(t)
+			txMgr := mocks.NewTransactionManager(t)
+
+			// Expired units are handed out in the same transaction, so they can't be bought in between
+			txMgr.EXPECT().WithinTransaction(ctx, mock.AnythingOfType("func(context.Context) error")).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).
+				Once()
+
+			tt.mockBehavior(merchMgr)
+
+			usecase := NewUsecase(logger, identityMgr, coinsMgr, merchMgr, txMgr, reservationTTL)
+			err := usecase.ProcessWaitlists(ctx)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+)
+
+// keyPattern restricts keys to slash-separated path segments,
+// so a key can never point outside the storage root
+var keyPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+(\.[a-zA-Z0-9]+)?(/[a-zA-Z0-9_-]+(\.[a-zA-Z0-9]+)?)*$`)
+
+// LocalStorage keeps blobs as files under the root directory
+type LocalStorage struct {
+	root string
+}
+
+func NewLocalStorage(root string) (*LocalStorage, error) {
+	const op = "storage.blob.NewLocalStorage"
+
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("%s: failed to create root directory: %w", op, err)
+	}
+
+	return &LocalStorage{root: root}, nil
+}
+
+func (s *LocalStorage) Put(_ context.Context, key string, content io.Reader) error {
+	const op = "storage.blob.Put"
+
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("%s: failed to create directory: %w", op, err)
+	}
+
+	// Write to a temporary file first, so readers never see a partially written blob
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("%s: failed to create temporary file: %w", op, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return fmt.Errorf("%s: failed to write blob: %w", op, err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("%s: failed to close blob: %w", op, err)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("%s: failed to move blob: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *LocalStorage) Get(_ context.Context, key string) (entity.Blob, error) {
+	const op = "storage.blob.Get"
+
+	path, err := s.path(key)
+	if err != nil {
+		return entity.Blob{}, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return entity.Blob{}, storage.ErrBlobNotFound
+		}
+		return entity.Blob{}, fmt.Errorf("%s: failed to open blob: %w", op, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return entity.Blob{}, fmt.Errorf("%s: failed to stat blob: %w", op, err)
+	}
+
+	return entity.Blob{
+		Content:     file,
+		ContentType: mime.TypeByExtension(filepath.Ext(path)),
+		Size:        info.Size(),
+		ModTime:     info.ModTime(),
+	}, nil
+}
+
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	const op = "storage.blob.Delete"
+
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%s: failed to delete blob: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	if !keyPattern.MatchString(key) {
+		return "", storage.ErrInvalidBlobKey
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
@@ -2,10 +2,12 @@ package coins
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -52,19 +54,41 @@ func (s *Storage) RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransf
 
 	params := sqlc.RegisterCoinTransferParams{
 		ID:              ct.ID,
-		SenderID:        ct.SenderID,
 		TransactionType: ct.TransactionType.String(),
 		Amount:          ct.Amount,
 		CreatedAt:       ct.Date,
 	}
 
-	if ct.TransactionType == entity.TransactionTypeTransferCoins {
+	// The store itself is not a user, so purchases have no receiver and refunds have no sender.
+	// Movements of team wallets have the team on one side instead of a user
+	if ct.SenderID != "" {
+		params.SenderID = pgtype.Text{
+			String: ct.SenderID,
+			Valid:  true,
+		}
+	}
+
+	if ct.SenderTeamID != "" {
+		params.SenderTeamID = pgtype.Text{
+			String: ct.SenderTeamID,
+			Valid:  true,
+		}
+	}
+
+	if ct.ReceiverID != "" {
 		params.ReceiverID = pgtype.Text{
 			String: ct.ReceiverID,
 			Valid:  true,
 		}
 	}
 
+	if ct.ReceiverTeamID != "" {
+		params.ReceiverTeamID = pgtype.Text{
+			String: ct.ReceiverTeamID,
+			Valid:  true,
+		}
+	}
+
 	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
 		return s.queries.WithTx(tx).RegisterCoinTransfer(ctx, params)
 	}); err != nil {
@@ -73,3 +97,64 @@ func (s *Storage) RegisterCoinTransfer(ctx context.Context, ct entity.CoinTransf
 
 	return nil
 }
+
+// AdjustUserCoins atomically adds amount to the user's balance. A negative amount is a debit,
+// which is rejected if the balance would become negative
+func (s *Storage) AdjustUserCoins(ctx context.Context, userID string, amount int32) error {
+	const op = "storage.coins.AdjustUserCoins"
+
+	params := sqlc.AdjustUserCoinsParams{
+		Amount: amount,
+		ID:     userID,
+	}
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		rowsAffected, err := s.queries.WithTx(tx).AdjustUserCoins(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return storage.ErrInsufficientCoins
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, storage.ErrInsufficientCoins) {
+			return storage.ErrInsufficientCoins
+		}
+		return fmt.Errorf("%s: failed to adjust user coins: %w", op, err)
+	}
+
+	return nil
+}
+
+// AdjustTeamCoins atomically adds amount to the team wallet, like AdjustUserCoins does for users
+func (s *Storage) AdjustTeamCoins(ctx context.Context, teamID string, amount int32) error {
+	const op = "storage.coins.AdjustTeamCoins"
+
+	params := sqlc.AdjustTeamCoinsParams{
+		Amount: amount,
+		ID:     teamID,
+	}
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		rowsAffected, err := s.queries.WithTx(tx).AdjustTeamCoins(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return storage.ErrInsufficientCoins
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, storage.ErrInsufficientCoins) {
+			return storage.ErrInsufficientCoins
+		}
+		return fmt.Errorf("%s: failed to adjust team coins: %w", op, err)
+	}
+
+	return nil
+}
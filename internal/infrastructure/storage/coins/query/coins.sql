@@ -1,9 +1,11 @@
 -- name: RegisterCoinTransfer :exec
-INSERT INTO transactions (id, sender_id, receiver_id, transaction_type_id, amount, created_at)
+INSERT INTO transactions (id, sender_id, sender_team_id, receiver_id, receiver_team_id, transaction_type_id, amount, created_at)
 VALUES (
            @id,
            @sender_id,
+           @sender_team_id,
            @receiver_id,
+           @receiver_team_id,
            (SELECT id FROM transaction_types WHERE title = @transaction_type),
            @amount,
            @created_at
@@ -15,4 +17,20 @@ SET
     balance = $2,
     updated_at = now()
 WHERE id = $1
-  AND deleted_at IS NULL;
\ No newline at end of file
+  AND deleted_at IS NULL;
+-- name: AdjustUserCoins :execrows
+UPDATE users
+SET
+    balance = balance + @amount::int,
+    updated_at = now()
+WHERE id = @id
+  AND deleted_at IS NULL
+  AND balance + @amount::int >= 0;
+
+-- name: AdjustTeamCoins :execrows
+UPDATE teams
+SET
+    balance = balance + @amount::int,
+    updated_at = now()
+WHERE id = @id
+  AND balance + @amount::int >= 0;
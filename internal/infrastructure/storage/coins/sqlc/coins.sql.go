@@ -12,22 +12,71 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const adjustTeamCoins = `-- name: AdjustTeamCoins :execrows
+UPDATE teams
+SET
+    balance = balance + $1::int,
+    updated_at = now()
+WHERE id = $2
+  AND balance + $1::int >= 0
+`
+
+type AdjustTeamCoinsParams struct {
+	Amount int32  `db:"amount"`
+	ID     string `db:"id"`
+}
+
+func (q *Queries) AdjustTeamCoins(ctx context.Context, arg AdjustTeamCoinsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, adjustTeamCoins, arg.Amount, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const adjustUserCoins = `-- name: AdjustUserCoins :execrows
+UPDATE users
+SET
+    balance = balance + $1::int,
+    updated_at = now()
+WHERE id = $2
+  AND deleted_at IS NULL
+  AND balance + $1::int >= 0
+`
+
+type AdjustUserCoinsParams struct {
+	Amount int32  `db:"amount"`
+	ID     string `db:"id"`
+}
+
+func (q *Queries) AdjustUserCoins(ctx context.Context, arg AdjustUserCoinsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, adjustUserCoins, arg.Amount, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const registerCoinTransfer = `-- name: RegisterCoinTransfer :exec
-INSERT INTO transactions (id, sender_id, receiver_id, transaction_type_id, amount, created_at)
+INSERT INTO transactions (id, sender_id, sender_team_id, receiver_id, receiver_team_id, transaction_type_id, amount, created_at)
 VALUES (
            $1,
            $2,
            $3,
-           (SELECT id FROM transaction_types WHERE title = $4),
+           $4,
            $5,
-           $6
+           (SELECT id FROM transaction_types WHERE title = $6),
+           $7,
+           $8
        )
 `
 
 type RegisterCoinTransferParams struct {
 	ID              string      `db:"id"`
-	SenderID        string      `db:"sender_id"`
+	SenderID        pgtype.Text `db:"sender_id"`
+	SenderTeamID    pgtype.Text `db:"sender_team_id"`
 	ReceiverID      pgtype.Text `db:"receiver_id"`
+	ReceiverTeamID  pgtype.Text `db:"receiver_team_id"`
 	TransactionType string      `db:"transaction_type"`
 	Amount          int32       `db:"amount"`
 	CreatedAt       time.Time   `db:"created_at"`
@@ -37,7 +86,9 @@ func (q *Queries) RegisterCoinTransfer(ctx context.Context, arg RegisterCoinTran
 	_, err := q.db.Exec(ctx, registerCoinTransfer,
 		arg.ID,
 		arg.SenderID,
+		arg.SenderTeamID,
 		arg.ReceiverID,
+		arg.ReceiverTeamID,
 		arg.TransactionType,
 		arg.Amount,
 		arg.CreatedAt,
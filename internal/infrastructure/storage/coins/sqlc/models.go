@@ -10,29 +10,240 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiKey struct {
+	ID               string             `db:"id"`
+	ServiceAccountID string             `db:"service_account_id"`
+	KeyHash          string             `db:"key_hash"`
+	Scopes           []string           `db:"scopes"`
+	CreatedBy        string             `db:"created_by"`
+	ExpiresAt        pgtype.Timestamptz `db:"expires_at"`
+	LastUsedAt       pgtype.Timestamptz `db:"last_used_at"`
+	CreatedAt        time.Time          `db:"created_at"`
+	RevokedAt        pgtype.Timestamptz `db:"revoked_at"`
+}
+
+type Auction struct {
+	ID           string             `db:"id"`
+	MerchID      string             `db:"merch_id"`
+	CreatedBy    string             `db:"created_by"`
+	ReservePrice int32              `db:"reserve_price"`
+	Status       string             `db:"status"`
+	HighestBidID pgtype.Text        `db:"highest_bid_id"`
+	StartsAt     time.Time          `db:"starts_at"`
+	EndsAt       time.Time          `db:"ends_at"`
+	CreatedAt    time.Time          `db:"created_at"`
+	ClosedAt     pgtype.Timestamptz `db:"closed_at"`
+}
+
+type AuctionBid struct {
+	ID         string             `db:"id"`
+	AuctionID  string             `db:"auction_id"`
+	UserID     string             `db:"user_id"`
+	Amount     int32              `db:"amount"`
+	Status     string             `db:"status"`
+	CreatedAt  time.Time          `db:"created_at"`
+	ResolvedAt pgtype.Timestamptz `db:"resolved_at"`
+}
+
+type Category struct {
+	ID        string    `db:"id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type ItemTransfer struct {
+	ID         string    `db:"id"`
+	SenderID   string    `db:"sender_id"`
+	ReceiverID string    `db:"receiver_id"`
+	MerchID    string    `db:"merch_id"`
+	Quantity   int32     `db:"quantity"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+type LoginFailure struct {
+	Scope        string    `db:"scope"`
+	Subject      string    `db:"subject"`
+	Failures     int32     `db:"failures"`
+	Locked       bool      `db:"locked"`
+	BlockedUntil time.Time `db:"blocked_until"`
+	LastFailedAt time.Time `db:"last_failed_at"`
+}
+
 type Merch struct {
+	ID          string             `db:"id"`
+	Name        string             `db:"name"`
+	Price       int32              `db:"price"`
+	CreatedAt   time.Time          `db:"created_at"`
+	UpdatedAt   time.Time          `db:"updated_at"`
+	DeletedAt   pgtype.Timestamptz `db:"deleted_at"`
+	Description string             `db:"description"`
+	CategoryID  pgtype.Text        `db:"category_id"`
+	Stock       pgtype.Int4        `db:"stock"`
+}
+
+type MerchImage struct {
+	ID           string    `db:"id"`
+	MerchID      string    `db:"merch_id"`
+	ContentType  string    `db:"content_type"`
+	ImageKey     string    `db:"image_key"`
+	ThumbnailKey string    `db:"thumbnail_key"`
+	Width        int32     `db:"width"`
+	Height       int32     `db:"height"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+type MerchReturn struct {
+	ID           string             `db:"id"`
+	PurchaseID   string             `db:"purchase_id"`
+	UserID       string             `db:"user_id"`
+	MerchID      string             `db:"merch_id"`
+	RefundAmount int32              `db:"refund_amount"`
+	Reason       string             `db:"reason"`
+	Status       string             `db:"status"`
+	ResolvedBy   pgtype.Text        `db:"resolved_by"`
+	CreatedAt    time.Time          `db:"created_at"`
+	ResolvedAt   pgtype.Timestamptz `db:"resolved_at"`
+}
+
+type MerchTag struct {
+	MerchID string `db:"merch_id"`
+	Tag     string `db:"tag"`
+}
+
+type MfaChallenge struct {
+	TokenHash string    `db:"token_hash"`
+	UserID    string    `db:"user_id"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type OidcLoginState struct {
+	State        string    `db:"state"`
+	Nonce        string    `db:"nonce"`
+	CodeVerifier string    `db:"code_verifier"`
+	ExpiresAt    time.Time `db:"expires_at"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+type PasswordResetToken struct {
 	ID        string             `db:"id"`
-	Name      string             `db:"name"`
-	Price     int32              `db:"price"`
+	UserID    string             `db:"user_id"`
+	TokenHash string             `db:"token_hash"`
+	CreatedBy string             `db:"created_by"`
+	ExpiresAt time.Time          `db:"expires_at"`
 	CreatedAt time.Time          `db:"created_at"`
-	UpdatedAt time.Time          `db:"updated_at"`
-	DeletedAt pgtype.Timestamptz `db:"deleted_at"`
+	UsedAt    pgtype.Timestamptz `db:"used_at"`
 }
 
 type Purchase struct {
+	ID             string             `db:"id"`
+	UserID         string             `db:"user_id"`
+	MerchID        string             `db:"merch_id"`
+	CreatedAt      time.Time          `db:"created_at"`
+	Price          int32              `db:"price"`
+	ReturnedAt     pgtype.Timestamptz `db:"returned_at"`
+	LastTransferID pgtype.Text        `db:"last_transfer_id"`
+}
+
+type Raffle struct {
+	ID                string             `db:"id"`
+	MerchID           string             `db:"merch_id"`
+	CreatedBy         string             `db:"created_by"`
+	TicketPrice       int32              `db:"ticket_price"`
+	MaxTicketsPerUser int32              `db:"max_tickets_per_user"`
+	WinnersCount      int32              `db:"winners_count"`
+	Status            string             `db:"status"`
+	SeedCommitment    string             `db:"seed_commitment"`
+	Seed              string             `db:"seed"`
+	DrawAt            time.Time          `db:"draw_at"`
+	CreatedAt         time.Time          `db:"created_at"`
+	DrawnAt           pgtype.Timestamptz `db:"drawn_at"`
+}
+
+type RaffleTicket struct {
 	ID        string    `db:"id"`
+	RaffleID  string    `db:"raffle_id"`
 	UserID    string    `db:"user_id"`
-	MerchID   string    `db:"merch_id"`
+	Number    int32     `db:"number"`
 	CreatedAt time.Time `db:"created_at"`
 }
 
+type RaffleWinner struct {
+	RaffleID string `db:"raffle_id"`
+	TicketID string `db:"ticket_id"`
+	UserID   string `db:"user_id"`
+	Position int32  `db:"position"`
+}
+
+type RefreshToken struct {
+	ID        string             `db:"id"`
+	UserID    string             `db:"user_id"`
+	FamilyID  string             `db:"family_id"`
+	TokenHash string             `db:"token_hash"`
+	ExpiresAt time.Time          `db:"expires_at"`
+	CreatedAt time.Time          `db:"created_at"`
+	RotatedAt pgtype.Timestamptz `db:"rotated_at"`
+	RevokedAt pgtype.Timestamptz `db:"revoked_at"`
+}
+
+type Reservation struct {
+	ID         string             `db:"id"`
+	MerchID    string             `db:"merch_id"`
+	UserID     string             `db:"user_id"`
+	Price      int32              `db:"price"`
+	Status     string             `db:"status"`
+	CreatedAt  time.Time          `db:"created_at"`
+	ExpiresAt  time.Time          `db:"expires_at"`
+	ResolvedAt pgtype.Timestamptz `db:"resolved_at"`
+}
+
+type RevokedToken struct {
+	Jti       string    `db:"jti"`
+	UserID    string    `db:"user_id"`
+	ExpiresAt time.Time `db:"expires_at"`
+	RevokedAt time.Time `db:"revoked_at"`
+}
+
+type ServiceAccount struct {
+	ID          string    `db:"id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	CreatedBy   string    `db:"created_by"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type SessionRevocation struct {
+	UserID        string    `db:"user_id"`
+	RevokedBefore time.Time `db:"revoked_before"`
+	ExpiresAt     time.Time `db:"expires_at"`
+}
+
+type Team struct {
+	ID         string    `db:"id"`
+	Name       string    `db:"name"`
+	Department string    `db:"department"`
+	Balance    int32     `db:"balance"`
+	CreatedBy  string    `db:"created_by"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+type TeamMember struct {
+	TeamID   string    `db:"team_id"`
+	UserID   string    `db:"user_id"`
+	Role     string    `db:"role"`
+	JoinedAt time.Time `db:"joined_at"`
+}
+
 type Transaction struct {
 	ID                string      `db:"id"`
-	SenderID          string      `db:"sender_id"`
+	SenderID          pgtype.Text `db:"sender_id"`
 	ReceiverID        pgtype.Text `db:"receiver_id"`
 	TransactionTypeID int32       `db:"transaction_type_id"`
 	Amount            int32       `db:"amount"`
 	CreatedAt         time.Time   `db:"created_at"`
+	SenderTeamID      pgtype.Text `db:"sender_team_id"`
+	ReceiverTeamID    pgtype.Text `db:"receiver_team_id"`
 }
 
 type TransactionType struct {
@@ -49,3 +260,50 @@ type User struct {
 	UpdatedAt    time.Time          `db:"updated_at"`
 	DeletedAt    pgtype.Timestamptz `db:"deleted_at"`
 }
+
+type UserIdentity struct {
+	Issuer    string    `db:"issuer"`
+	Subject   string    `db:"subject"`
+	UserID    string    `db:"user_id"`
+	Email     string    `db:"email"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type UserProfile struct {
+	UserID      string    `db:"user_id"`
+	DisplayName string    `db:"display_name"`
+	Email       string    `db:"email"`
+	Department  string    `db:"department"`
+	Title       string    `db:"title"`
+	AvatarUrl   string    `db:"avatar_url"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+type UserRecoveryCode struct {
+	ID        string             `db:"id"`
+	UserID    string             `db:"user_id"`
+	CodeHash  string             `db:"code_hash"`
+	UsedAt    pgtype.Timestamptz `db:"used_at"`
+	CreatedAt time.Time          `db:"created_at"`
+}
+
+type UserRole struct {
+	UserID    string    `db:"user_id"`
+	Role      string    `db:"role"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type UserTotp struct {
+	UserID          string             `db:"user_id"`
+	SecretEncrypted string             `db:"secret_encrypted"`
+	LastUsedStep    int64              `db:"last_used_step"`
+	ConfirmedAt     pgtype.Timestamptz `db:"confirmed_at"`
+	CreatedAt       time.Time          `db:"created_at"`
+}
+
+type WaitlistEntry struct {
+	ID        string    `db:"id"`
+	MerchID   string    `db:"merch_id"`
+	UserID    string    `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
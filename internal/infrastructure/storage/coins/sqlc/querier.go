@@ -9,6 +9,8 @@ import (
 )
 
 type Querier interface {
+	AdjustTeamCoins(ctx context.Context, arg AdjustTeamCoinsParams) (int64, error)
+	AdjustUserCoins(ctx context.Context, arg AdjustUserCoinsParams) (int64, error)
 	RegisterCoinTransfer(ctx context.Context, arg RegisterCoinTransferParams) error
 	UpdateUserCoins(ctx context.Context, arg UpdateUserCoinsParams) error
 }
@@ -1,8 +1,66 @@
 package storage
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
 
 var (
-	ErrUserNotFound  = errors.New("user not found")
-	ErrMerchNotFound = errors.New("merch not found")
+	ErrUserNotFound               = errors.New("user not found")
+	ErrUserAlreadyExists          = errors.New("user already exists")
+	ErrMerchNotFound              = errors.New("merch not found")
+	ErrMerchOutOfStock            = errors.New("merch is out of stock")
+	ErrMerchReservedForWaitlist   = errors.New("merch is reserved for the waitlist")
+	ErrPurchaseNotFound           = errors.New("purchase not found")
+	ErrPurchaseAlreadyReturned    = errors.New("purchase already returned")
+	ErrMerchReturnNotFound        = errors.New("merch return not found")
+	ErrMerchReturnAlreadyExists   = errors.New("merch return already exists")
+	ErrMerchReturnAlreadyResolved = errors.New("merch return already resolved")
+	ErrMerchImageNotFound         = errors.New("merch image not found")
+	ErrBlobNotFound               = errors.New("blob not found")
+	ErrInvalidBlobKey             = errors.New("invalid blob key")
+	ErrNotEnoughItems             = errors.New("not enough items")
+	ErrAlreadyInWaitlist          = errors.New("user is already in the waitlist")
+	ErrWaitlistEntryNotFound      = errors.New("waitlist entry not found")
+	ErrReservationNotFound        = errors.New("reservation not found")
+	ErrReservationNotActive       = errors.New("reservation is not active")
+	ErrInsufficientCoins          = errors.New("insufficient coins")
+	ErrAuctionNotFound            = errors.New("auction not found")
+	ErrAuctionNotOpen             = errors.New("auction is not open")
+	ErrAuctionBidNotHeld          = errors.New("auction bid is not held")
+	ErrRaffleNotFound             = errors.New("raffle not found")
+	ErrRaffleNotOpen              = errors.New("raffle is not open")
+	ErrRefreshTokenNotFound       = errors.New("refresh token not found")
+	ErrRefreshTokenNotActive      = errors.New("refresh token is not active")
+	ErrPasswordResetTokenInvalid  = errors.New("password reset token is invalid")
+	ErrLoginFailuresNotFound      = errors.New("login failures not found")
+	ErrServiceAccountNotFound     = errors.New("service account not found")
+	ErrServiceAccountExists       = errors.New("service account already exists")
+	ErrAPIKeyNotFound             = errors.New("api key not found")
+	ErrOIDCLoginStateNotFound     = errors.New("oidc login state not found")
+	ErrUserIdentityNotFound       = errors.New("user identity not found")
+	ErrUserIdentityExists         = errors.New("user identity already exists")
+	ErrTOTPNotFound               = errors.New("totp authenticator not found")
+	ErrMFAChallengeNotFound       = errors.New("mfa challenge not found")
+	ErrTeamNotFound               = errors.New("team not found")
+	ErrTeamExists                 = errors.New("team already exists")
+	ErrTeamMemberNotFound         = errors.New("team member not found")
+)
+
+const (
+	// uniqueViolationCode is the PostgreSQL error code for unique_violation
+	uniqueViolationCode = "23505"
+	// foreignKeyViolationCode is the PostgreSQL error code for foreign_key_violation
+	foreignKeyViolationCode = "23503"
 )
+
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+func IsForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode
+}
@@ -0,0 +1,303 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/merch/sqlc"
+)
+
+func (s *Storage) CreateAuction(ctx context.Context, auction entity.Auction) error {
+	const op = "storage.merch.CreateAuction"
+
+	params := sqlc.CreateAuctionParams{
+		ID:           auction.ID,
+		MerchID:      auction.MerchID,
+		CreatedBy:    auction.CreatedBy,
+		ReservePrice: int32(auction.ReservePrice),
+		Status:       auction.Status.String(),
+		StartsAt:     auction.StartsAt,
+		EndsAt:       auction.EndsAt,
+		CreatedAt:    auction.CreatedAt,
+	}
+
+	if err := s.queries.CreateAuction(ctx, params); err != nil {
+		return fmt.Errorf("%s: failed to create auction: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetAuctionByID(ctx context.Context, auctionID string) (entity.Auction, error) {
+	const op = "storage.merch.GetAuctionByID"
+
+	row, err := s.queries.GetAuctionByID(ctx, auctionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Auction{}, storage.ErrAuctionNotFound
+		}
+		return entity.Auction{}, fmt.Errorf("%s: failed to get auction: %w", op, err)
+	}
+
+	return entity.Auction{
+		ID:           row.ID,
+		MerchID:      row.MerchID,
+		Item:         row.Item,
+		CreatedBy:    row.CreatedBy,
+		ReservePrice: int(row.ReservePrice),
+		Status:       entity.AuctionStatus(row.Status),
+		HighestBid:   toHighestBid(row.ID, row.HighestBidID, row.HighestBidderID, row.HighestBidder, row.HighestBid),
+		StartsAt:     row.StartsAt,
+		EndsAt:       row.EndsAt,
+		CreatedAt:    row.CreatedAt,
+		ClosedAt:     row.ClosedAt.Time,
+	}, nil
+}
+
+// LockAuction loads the auction and locks it until the transaction ends,
+// so concurrent bids and closing of the same auction are applied one at a time
+func (s *Storage) LockAuction(ctx context.Context, auctionID string) (entity.Auction, error) {
+	const op = "storage.merch.LockAuction"
+
+	var row sqlc.LockAuctionRow
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		row, err = s.queries.WithTx(tx).LockAuction(ctx, auctionID)
+		return err
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Auction{}, storage.ErrAuctionNotFound
+		}
+		return entity.Auction{}, fmt.Errorf("%s: failed to lock auction: %w", op, err)
+	}
+
+	return entity.Auction{
+		ID:           row.ID,
+		MerchID:      row.MerchID,
+		ReservePrice: int(row.ReservePrice),
+		Status:       entity.AuctionStatus(row.Status),
+		HighestBid:   toHighestBid(row.ID, row.HighestBidID, row.HighestBidderID, pgtype.Text{}, row.HighestBid),
+		StartsAt:     row.StartsAt,
+		EndsAt:       row.EndsAt,
+	}, nil
+}
+
+func (s *Storage) ListAuctions(ctx context.Context, filter entity.AuctionFilter) ([]entity.Auction, error) {
+	const op = "storage.merch.ListAuctions"
+
+	var status pgtype.Text
+	if filter.Status != "" {
+		status = pgtype.Text{String: filter.Status.String(), Valid: true}
+	}
+
+	rows, err := s.queries.ListAuctions(ctx, status)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list auctions: %w", op, err)
+	}
+
+	auctions := make([]entity.Auction, len(rows))
+	for i, row := range rows {
+		auctions[i] = entity.Auction{
+			ID:           row.ID,
+			MerchID:      row.MerchID,
+			Item:         row.Item,
+			CreatedBy:    row.CreatedBy,
+			ReservePrice: int(row.ReservePrice),
+			Status:       entity.AuctionStatus(row.Status),
+			HighestBid:   toHighestBid(row.ID, row.HighestBidID, row.HighestBidderID, row.HighestBidder, row.HighestBid),
+			StartsAt:     row.StartsAt,
+			EndsAt:       row.EndsAt,
+			CreatedAt:    row.CreatedAt,
+			ClosedAt:     row.ClosedAt.Time,
+		}
+	}
+
+	return auctions, nil
+}
+
+func (s *Storage) ListDueAuctions(ctx context.Context, now time.Time) ([]string, error) {
+	const op = "storage.merch.ListDueAuctions"
+
+	auctionIDs, err := s.queries.ListDueAuctions(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list due auctions: %w", op, err)
+	}
+
+	return auctionIDs, nil
+}
+
+func (s *Storage) ListAuctionBids(ctx context.Context, auctionID string) ([]entity.AuctionBid, error) {
+	const op = "storage.merch.ListAuctionBids"
+
+	rows, err := s.queries.ListAuctionBids(ctx, auctionID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list auction bids: %w", op, err)
+	}
+
+	bids := make([]entity.AuctionBid, len(rows))
+	for i, row := range rows {
+		bids[i] = entity.AuctionBid{
+			ID:         row.ID,
+			AuctionID:  row.AuctionID,
+			UserID:     row.UserID,
+			Username:   row.Username,
+			Amount:     int(row.Amount),
+			Status:     entity.BidStatus(row.Status),
+			CreatedAt:  row.CreatedAt,
+			ResolvedAt: row.ResolvedAt.Time,
+		}
+	}
+
+	return bids, nil
+}
+
+// PlaceAuctionBid stores the bid and makes it the highest one of its auction
+func (s *Storage) PlaceAuctionBid(ctx context.Context, bid entity.AuctionBid) error {
+	const op = "storage.merch.PlaceAuctionBid"
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		if err := queries.CreateAuctionBid(ctx, sqlc.CreateAuctionBidParams{
+			ID:        bid.ID,
+			AuctionID: bid.AuctionID,
+			UserID:    bid.UserID,
+			Amount:    int32(bid.Amount),
+			Status:    bid.Status.String(),
+			CreatedAt: bid.CreatedAt,
+		}); err != nil {
+			return err
+		}
+
+		return queries.SetAuctionHighestBid(ctx, sqlc.SetAuctionHighestBidParams{
+			BidID: pgtype.Text{String: bid.ID, Valid: true},
+			ID:    bid.AuctionID,
+		})
+	}); err != nil {
+		return fmt.Errorf("%s: failed to place auction bid: %w", op, err)
+	}
+
+	return nil
+}
+
+// ResolveAuctionBid releases the escrow of a held bid, either by refunding or capturing it
+func (s *Storage) ResolveAuctionBid(ctx context.Context, bidID string, status entity.BidStatus, now time.Time) error {
+	const op = "storage.merch.ResolveAuctionBid"
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		rowsAffected, err := s.queries.WithTx(tx).ResolveAuctionBid(ctx, sqlc.ResolveAuctionBidParams{
+			Status:     status.String(),
+			ResolvedAt: pgtype.Timestamptz{Time: now, Valid: true},
+			ID:         bidID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return storage.ErrAuctionBidNotHeld
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, storage.ErrAuctionBidNotHeld) {
+			return storage.ErrAuctionBidNotHeld
+		}
+		return fmt.Errorf("%s: failed to resolve auction bid: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) CloseAuction(ctx context.Context, auctionID string, status entity.AuctionStatus, now time.Time) error {
+	const op = "storage.merch.CloseAuction"
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		rowsAffected, err := s.queries.WithTx(tx).CloseAuction(ctx, sqlc.CloseAuctionParams{
+			Status:   status.String(),
+			ClosedAt: pgtype.Timestamptz{Time: now, Valid: true},
+			ID:       auctionID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return storage.ErrAuctionNotOpen
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, storage.ErrAuctionNotOpen) {
+			return storage.ErrAuctionNotOpen
+		}
+		return fmt.Errorf("%s: failed to close auction: %w", op, err)
+	}
+
+	return nil
+}
+
+func toHighestBid(auctionID string, bidID, bidderID, bidder pgtype.Text, amount pgtype.Int4) entity.AuctionBid {
+	if !bidID.Valid {
+		return entity.AuctionBid{}
+	}
+
+	return entity.AuctionBid{
+		ID:        bidID.String,
+		AuctionID: auctionID,
+		UserID:    bidderID.String,
+		Username:  bidder.String,
+		Amount:    int(amount.Int32),
+		Status:    entity.BidStatusHeld,
+	}
+}
+
+// RefundUserBids marks the held bids of the user as refunded and takes them off the auctions they lead,
+// so the next bid on such an auction doesn't try to refund them again
+func (s *Storage) RefundUserBids(ctx context.Context, userID string, now time.Time) ([]entity.AuctionBid, error) {
+	const op = "storage.merch.RefundUserBids"
+
+	var rows []sqlc.AuctionBid
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		if err := queries.ClearUserHighestBids(ctx, userID); err != nil {
+			return err
+		}
+
+		var err error
+
+		rows, err = queries.RefundUserBids(ctx, sqlc.RefundUserBidsParams{
+			Status:     entity.BidStatusRefunded.String(),
+			ResolvedAt: pgtype.Timestamptz{Time: now, Valid: true},
+			UserID:     userID,
+		})
+
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("%s: failed to refund user bids: %w", op, err)
+	}
+
+	bids := make([]entity.AuctionBid, len(rows))
+	for i, row := range rows {
+		bids[i] = entity.AuctionBid{
+			ID:         row.ID,
+			AuctionID:  row.AuctionID,
+			UserID:     row.UserID,
+			Amount:     int(row.Amount),
+			Status:     entity.BidStatus(row.Status),
+			CreatedAt:  row.CreatedAt,
+			ResolvedAt: row.ResolvedAt.Time,
+		}
+	}
+
+	return bids, nil
+}
@@ -0,0 +1,88 @@
+package merch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/merch/sqlc"
+)
+
+func (s *Storage) ListCatalog(ctx context.Context) ([]entity.CatalogItem, error) {
+	const op = "storage.merch.ListCatalog"
+
+	rows, err := s.queries.ListCatalog(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list catalog: %w", op, err)
+	}
+
+	items := make([]entity.CatalogItem, len(rows))
+	for i, row := range rows {
+		items[i] = entity.CatalogItem{
+			Name:     row.Name,
+			Price:    int(row.Price),
+			Category: row.Category,
+		}
+
+		if row.Stock.Valid {
+			stock := int(row.Stock.Int32)
+			items[i].Stock = &stock
+		}
+	}
+
+	return items, nil
+}
+
+// UpsertCatalogItem creates or updates the merch item with the same name together with its category.
+// The passed ids are used only when a new merch item or category has to be inserted
+func (s *Storage) UpsertCatalogItem(ctx context.Context, merchID, categoryID string, item entity.CatalogItem) error {
+	const op = "storage.merch.UpsertCatalogItem"
+
+	err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		params := sqlc.UpsertMerchParams{
+			ID:    merchID,
+			Name:  item.Name,
+			Price: int32(item.Price),
+		}
+
+		if item.Category != "" {
+			id, err := queries.UpsertCategory(ctx, sqlc.UpsertCategoryParams{
+				ID:   categoryID,
+				Name: item.Category,
+			})
+			if err != nil {
+				return err
+			}
+
+			params.CategoryID = pgtype.Text{String: id, Valid: true}
+		}
+
+		if item.Stock != nil {
+			params.Stock = pgtype.Int4{Int32: int32(*item.Stock), Valid: true}
+		}
+
+		return queries.UpsertMerch(ctx, params)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to upsert catalog item: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) RetireMerch(ctx context.Context, name string) error {
+	const op = "storage.merch.RetireMerch"
+
+	err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		return s.queries.WithTx(tx).RetireMerch(ctx, name)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to retire merch: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/merch/sqlc"
+)
+
+func (s *Storage) CreateMerchImage(ctx context.Context, image entity.MerchImage) error {
+	const op = "storage.merch.CreateMerchImage"
+
+	params := sqlc.CreateMerchImageParams{
+		ID:           image.ID,
+		MerchID:      image.MerchID,
+		ContentType:  image.ContentType,
+		ImageKey:     image.Key,
+		ThumbnailKey: image.ThumbnailKey,
+		Width:        int32(image.Width),
+		Height:       int32(image.Height),
+		CreatedAt:    image.CreatedAt,
+	}
+
+	if err := s.queries.CreateMerchImage(ctx, params); err != nil {
+		return fmt.Errorf("%s: failed to create merch image: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetMerchImageByID(ctx context.Context, imageID string) (entity.MerchImage, error) {
+	const op = "storage.merch.GetMerchImageByID"
+
+	image, err := s.queries.GetMerchImageByID(ctx, imageID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.MerchImage{}, storage.ErrMerchImageNotFound
+		}
+		return entity.MerchImage{}, fmt.Errorf("%s: failed to get merch image: %w", op, err)
+	}
+
+	return toMerchImageEntity(image), nil
+}
+
+func (s *Storage) ListMerchImages(ctx context.Context, merchIDs []string) ([]entity.MerchImage, error) {
+	const op = "storage.merch.ListMerchImages"
+
+	rows, err := s.queries.ListMerchImages(ctx, merchIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list merch images: %w", op, err)
+	}
+
+	images := make([]entity.MerchImage, len(rows))
+	for i, row := range rows {
+		images[i] = toMerchImageEntity(row)
+	}
+
+	return images, nil
+}
+
+func (s *Storage) DeleteMerchImage(ctx context.Context, imageID string) error {
+	const op = "storage.merch.DeleteMerchImage"
+
+	rows, err := s.queries.DeleteMerchImage(ctx, imageID)
+	if err != nil {
+		return fmt.Errorf("%s: failed to delete merch image: %w", op, err)
+	}
+
+	if rows == 0 {
+		return storage.ErrMerchImageNotFound
+	}
+
+	return nil
+}
+
+func toMerchImageEntity(image sqlc.MerchImage) entity.MerchImage {
+	return entity.MerchImage{
+		ID:           image.ID,
+		MerchID:      image.MerchID,
+		ContentType:  image.ContentType,
+		Key:          image.ImageKey,
+		ThumbnailKey: image.ThumbnailKey,
+		Width:        int(image.Width),
+		Height:       int(image.Height),
+		CreatedAt:    image.CreatedAt,
+	}
+}
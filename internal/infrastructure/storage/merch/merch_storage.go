@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rshelekhov/merch-store/internal/domain/entity"
 	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
@@ -41,11 +42,18 @@ func (s *Storage) GetMerchByName(ctx context.Context, itemName string) (entity.M
 		return entity.Merch{}, fmt.Errorf("%s: failed to get merch: %w", op, err)
 	}
 
-	return entity.Merch{
+	result := entity.Merch{
 		ID:    merch.ID,
 		Name:  merch.Name,
 		Price: int(merch.Price),
-	}, nil
+	}
+
+	if merch.Stock.Valid {
+		stock := int(merch.Stock.Int32)
+		result.Stock = &stock
+	}
+
+	return result, nil
 }
 
 func (s *Storage) AddToInventory(ctx context.Context, purchase entity.Purchase) error {
@@ -55,6 +63,7 @@ func (s *Storage) AddToInventory(ctx context.Context, purchase entity.Purchase)
 		ID:        purchase.ID,
 		UserID:    purchase.UserID,
 		MerchID:   purchase.MerchID,
+		Price:     int32(purchase.Price),
 		CreatedAt: purchase.CreatedAt,
 	}
 
@@ -66,3 +75,125 @@ func (s *Storage) AddToInventory(ctx context.Context, purchase entity.Purchase)
 
 	return nil
 }
+
+// TakeFromStock takes a unit of the item. Units on stock aren't sold while users are waiting for the item,
+// they are reserved for the waitlist by the worker, and that case is reported as ErrMerchReservedForWaitlist
+func (s *Storage) TakeFromStock(ctx context.Context, merchID string) error {
+	const op = "storage.merch.TakeFromStock"
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		rowsAffected, err := queries.TakeFromStock(ctx, merchID)
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected > 0 {
+			return nil
+		}
+
+		waiting, err := queries.HasWaitlist(ctx, merchID)
+		if err != nil {
+			return err
+		}
+
+		if waiting {
+			return storage.ErrMerchReservedForWaitlist
+		}
+
+		return storage.ErrMerchOutOfStock
+	}); err != nil {
+		if errors.Is(err, storage.ErrMerchOutOfStock) || errors.Is(err, storage.ErrMerchReservedForWaitlist) {
+			return err
+		}
+		return fmt.Errorf("%s: failed to take merch from stock: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) Restock(ctx context.Context, merchID string) error {
+	const op = "storage.merch.Restock"
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		return s.queries.WithTx(tx).Restock(ctx, merchID)
+	}); err != nil {
+		return fmt.Errorf("%s: failed to restock merch: %w", op, err)
+	}
+
+	return nil
+}
+
+// SearchMerch returns a page of the matching merch. The total is counted separately,
+// so it's still known for a page past the end
+func (s *Storage) SearchMerch(ctx context.Context, filter entity.MerchFilter) (entity.MerchPage, error) {
+	const op = "storage.merch.SearchMerch"
+
+	countParams := sqlc.CountMerchParams{
+		Query: filter.Query,
+	}
+
+	if filter.Category != "" {
+		countParams.Category = pgtype.Text{String: filter.Category, Valid: true}
+	}
+
+	if filter.Tag != "" {
+		countParams.Tag = pgtype.Text{String: filter.Tag, Valid: true}
+	}
+
+	if filter.MinPrice != nil {
+		countParams.MinPrice = pgtype.Int4{Int32: int32(*filter.MinPrice), Valid: true}
+	}
+
+	if filter.MaxPrice != nil {
+		countParams.MaxPrice = pgtype.Int4{Int32: int32(*filter.MaxPrice), Valid: true}
+	}
+
+	total, err := s.queries.CountMerch(ctx, countParams)
+	if err != nil {
+		return entity.MerchPage{}, fmt.Errorf("%s: failed to count merch: %w", op, err)
+	}
+
+	rows, err := s.queries.SearchMerch(ctx, sqlc.SearchMerchParams{
+		Category:   countParams.Category,
+		Tag:        countParams.Tag,
+		MinPrice:   countParams.MinPrice,
+		MaxPrice:   countParams.MaxPrice,
+		Query:      countParams.Query,
+		PageLimit:  int32(filter.Limit),
+		PageOffset: int32(filter.Offset),
+	})
+	if err != nil {
+		return entity.MerchPage{}, fmt.Errorf("%s: failed to search merch: %w", op, err)
+	}
+
+	page := entity.MerchPage{
+		Items: make([]entity.Merch, len(rows)),
+		Total: int(total),
+	}
+
+	for i, row := range rows {
+		page.Items[i] = entity.Merch{
+			ID:          row.ID,
+			Name:        row.Name,
+			Description: row.Description,
+			Category:    row.Category,
+			Tags:        row.Tags,
+			Price:       int(row.Price),
+		}
+	}
+
+	return page, nil
+}
+
+func (s *Storage) ListCategories(ctx context.Context) ([]string, error) {
+	const op = "storage.merch.ListCategories"
+
+	categories, err := s.queries.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list categories: %w", op, err)
+	}
+
+	return categories, nil
+}
@@ -0,0 +1,132 @@
+-- name: CreateAuction :exec
+INSERT INTO auctions (id, merch_id, created_by, reserve_price, status, starts_at, ends_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetAuctionByID :one
+SELECT
+    a.id,
+    a.merch_id,
+    m.name AS item,
+    a.created_by,
+    a.reserve_price,
+    a.status,
+    a.starts_at,
+    a.ends_at,
+    a.created_at,
+    a.closed_at,
+    b.id AS highest_bid_id,
+    b.user_id AS highest_bidder_id,
+    u.username AS highest_bidder,
+    b.amount AS highest_bid
+FROM auctions a
+    JOIN merch m ON a.merch_id = m.id
+    LEFT JOIN auction_bids b ON a.highest_bid_id = b.id
+    LEFT JOIN users u ON b.user_id = u.id
+WHERE a.id = $1;
+
+-- name: LockAuction :one
+-- Serializes bids and closing of the same auction
+SELECT
+    a.id,
+    a.merch_id,
+    a.reserve_price,
+    a.status,
+    a.starts_at,
+    a.ends_at,
+    b.id AS highest_bid_id,
+    b.user_id AS highest_bidder_id,
+    b.amount AS highest_bid
+FROM auctions a
+    LEFT JOIN auction_bids b ON a.highest_bid_id = b.id
+WHERE a.id = $1
+FOR UPDATE OF a;
+
+-- name: ListAuctions :many
+SELECT
+    a.id,
+    a.merch_id,
+    m.name AS item,
+    a.created_by,
+    a.reserve_price,
+    a.status,
+    a.starts_at,
+    a.ends_at,
+    a.created_at,
+    a.closed_at,
+    b.id AS highest_bid_id,
+    b.user_id AS highest_bidder_id,
+    u.username AS highest_bidder,
+    b.amount AS highest_bid
+FROM auctions a
+    JOIN merch m ON a.merch_id = m.id
+    LEFT JOIN auction_bids b ON a.highest_bid_id = b.id
+    LEFT JOIN users u ON b.user_id = u.id
+WHERE (sqlc.narg('status')::varchar IS NULL OR a.status = sqlc.narg('status'))
+ORDER BY a.ends_at;
+
+-- name: ListDueAuctions :many
+SELECT id
+FROM auctions
+WHERE status = 'open'
+  AND ends_at <= @now::timestamptz
+ORDER BY ends_at;
+
+-- name: ListAuctionBids :many
+SELECT
+    b.id,
+    b.auction_id,
+    b.user_id,
+    u.username,
+    b.amount,
+    b.status,
+    b.created_at,
+    b.resolved_at
+FROM auction_bids b
+    JOIN users u ON b.user_id = u.id
+WHERE b.auction_id = $1
+ORDER BY b.created_at DESC;
+
+-- name: CreateAuctionBid :exec
+INSERT INTO auction_bids (id, auction_id, user_id, amount, status, created_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: SetAuctionHighestBid :exec
+UPDATE auctions
+SET highest_bid_id = @bid_id
+WHERE id = @id;
+
+-- name: ResolveAuctionBid :execrows
+UPDATE auction_bids
+SET
+    status = @status,
+    resolved_at = @resolved_at::timestamptz
+WHERE id = @id
+  AND status = 'held';
+
+-- name: CloseAuction :execrows
+UPDATE auctions
+SET
+    status = @status,
+    closed_at = @closed_at::timestamptz
+WHERE id = @id
+  AND status = 'open';
+
+-- name: ClearUserHighestBids :exec
+-- The auctions are locked before the bids, in the same order as bids are placed
+UPDATE auctions
+SET highest_bid_id = NULL
+WHERE highest_bid_id IN (
+    SELECT b.id
+    FROM auction_bids b
+    WHERE b.user_id = @user_id
+      AND b.status = 'held'
+);
+
+-- name: RefundUserBids :many
+UPDATE auction_bids
+SET
+    status = @status,
+    resolved_at = @resolved_at::timestamptz
+WHERE user_id = @user_id
+  AND status = 'held'
+RETURNING id, auction_id, user_id, amount, status, created_at, resolved_at;
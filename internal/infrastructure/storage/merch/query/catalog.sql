@@ -0,0 +1,37 @@
+-- name: ListCatalog :many
+SELECT
+    m.id,
+    m.name,
+    m.price,
+    COALESCE(c.name, '')::varchar AS category,
+    m.stock
+FROM merch m
+    LEFT JOIN categories c ON m.category_id = c.id
+WHERE m.deleted_at IS NULL
+ORDER BY m.name;
+
+-- name: UpsertCategory :one
+INSERT INTO categories (id, name)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE
+SET name = EXCLUDED.name
+RETURNING id;
+
+-- name: UpsertMerch :exec
+INSERT INTO merch (id, name, price, category_id, stock)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (name) DO UPDATE
+SET
+    price = EXCLUDED.price,
+    category_id = EXCLUDED.category_id,
+    stock = EXCLUDED.stock,
+    updated_at = now(),
+    deleted_at = NULL;
+
+-- name: RetireMerch :exec
+UPDATE merch
+SET
+    deleted_at = now(),
+    updated_at = now()
+WHERE name = $1
+  AND deleted_at IS NULL;
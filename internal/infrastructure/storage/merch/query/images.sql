@@ -0,0 +1,34 @@
+-- name: CreateMerchImage :exec
+INSERT INTO merch_images (id, merch_id, content_type, image_key, thumbnail_key, width, height, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetMerchImageByID :one
+SELECT
+    id,
+    merch_id,
+    content_type,
+    image_key,
+    thumbnail_key,
+    width,
+    height,
+    created_at
+FROM merch_images
+WHERE id = $1;
+
+-- name: ListMerchImages :many
+SELECT
+    id,
+    merch_id,
+    content_type,
+    image_key,
+    thumbnail_key,
+    width,
+    height,
+    created_at
+FROM merch_images
+WHERE merch_id = ANY(@merch_ids::varchar[])
+ORDER BY merch_id, created_at;
+
+-- name: DeleteMerchImage :execrows
+DELETE FROM merch_images
+WHERE id = $1;
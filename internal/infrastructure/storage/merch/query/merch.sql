@@ -2,11 +2,101 @@
 SELECT
     id,
     name,
-    price
+    price,
+    stock
 FROM merch
 WHERE name = $1
   AND deleted_at IS NULL;
 
 -- name: AddToInventory :exec
-INSERT INTO purchases (id, user_id, merch_id, created_at)
-VALUES ($1, $2, $3, $4);
\ No newline at end of file
+INSERT INTO purchases (id, user_id, merch_id, price, created_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: TakeFromStock :execrows
+-- Replenished stock belongs to the waitlist first, so it can't be bought while someone is waiting
+UPDATE merch
+SET
+    stock = stock - 1,
+    updated_at = now()
+WHERE merch.id = $1
+  AND deleted_at IS NULL
+  AND (stock IS NULL OR (
+        stock > 0
+        AND NOT EXISTS (
+            SELECT 1
+            FROM waitlist_entries w
+            WHERE w.merch_id = merch.id
+        )
+      ));
+
+-- name: Restock :exec
+UPDATE merch
+SET
+    stock = stock + 1,
+    updated_at = now()
+WHERE id = $1
+  AND stock IS NOT NULL;
+
+-- name: SearchMerch :many
+SELECT
+    m.id,
+    m.name,
+    m.description,
+    m.price,
+    COALESCE(c.name, '')::varchar AS category,
+    ARRAY(
+        SELECT mt.tag
+        FROM merch_tags mt
+        WHERE mt.merch_id = m.id
+        ORDER BY mt.tag
+    )::varchar[] AS tags
+FROM merch m
+    LEFT JOIN categories c ON m.category_id = c.id
+WHERE m.deleted_at IS NULL
+  AND (sqlc.narg(category)::varchar IS NULL OR c.name = sqlc.narg(category)::varchar)
+  AND (sqlc.narg(tag)::varchar IS NULL OR EXISTS (
+        SELECT 1
+        FROM merch_tags mt
+        WHERE mt.merch_id = m.id
+          AND mt.tag = sqlc.narg(tag)::varchar
+      ))
+  AND (sqlc.narg(min_price)::int IS NULL OR m.price >= sqlc.narg(min_price)::int)
+  AND (sqlc.narg(max_price)::int IS NULL OR m.price <= sqlc.narg(max_price)::int)
+  AND (
+        @query::varchar = ''
+        OR to_tsvector('simple', m.name || ' ' || m.description) @@ plainto_tsquery('simple', @query::varchar)
+        OR m.name % @query::varchar
+        OR m.description % @query::varchar
+      )
+ORDER BY
+    ts_rank(to_tsvector('simple', m.name || ' ' || m.description), plainto_tsquery('simple', @query::varchar))
+        + similarity(m.name, @query::varchar) DESC,
+    m.name
+LIMIT @page_limit::int
+OFFSET @page_offset::int;
+
+-- name: CountMerch :one
+SELECT COUNT(*)
+FROM merch m
+    LEFT JOIN categories c ON m.category_id = c.id
+WHERE m.deleted_at IS NULL
+  AND (sqlc.narg(category)::varchar IS NULL OR c.name = sqlc.narg(category)::varchar)
+  AND (sqlc.narg(tag)::varchar IS NULL OR EXISTS (
+        SELECT 1
+        FROM merch_tags mt
+        WHERE mt.merch_id = m.id
+          AND mt.tag = sqlc.narg(tag)::varchar
+      ))
+  AND (sqlc.narg(min_price)::int IS NULL OR m.price >= sqlc.narg(min_price)::int)
+  AND (sqlc.narg(max_price)::int IS NULL OR m.price <= sqlc.narg(max_price)::int)
+  AND (
+        @query::varchar = ''
+        OR to_tsvector('simple', m.name || ' ' || m.description) @@ plainto_tsquery('simple', @query::varchar)
+        OR m.name % @query::varchar
+        OR m.description % @query::varchar
+      );
+
+-- name: ListCategories :many
+SELECT name
+FROM categories
+ORDER BY name;
@@ -0,0 +1,129 @@
+-- name: CreateRaffle :exec
+INSERT INTO raffles (
+    id,
+    merch_id,
+    created_by,
+    ticket_price,
+    max_tickets_per_user,
+    winners_count,
+    status,
+    seed_commitment,
+    seed,
+    draw_at,
+    created_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: GetRaffleByID :one
+SELECT
+    r.id,
+    r.merch_id,
+    m.name AS item,
+    r.created_by,
+    r.ticket_price,
+    r.max_tickets_per_user,
+    r.winners_count,
+    r.status,
+    r.seed_commitment,
+    r.seed,
+    r.draw_at,
+    r.created_at,
+    r.drawn_at,
+    (SELECT count(*) FROM raffle_tickets t WHERE t.raffle_id = r.id)::int AS tickets_sold
+FROM raffles r
+    JOIN merch m ON r.merch_id = m.id
+WHERE r.id = $1;
+
+-- name: LockRaffle :one
+-- Serializes ticket sales and the draw of the same raffle
+SELECT
+    r.id,
+    r.merch_id,
+    r.ticket_price,
+    r.max_tickets_per_user,
+    r.winners_count,
+    r.status,
+    r.seed_commitment,
+    r.seed,
+    r.draw_at
+FROM raffles r
+WHERE r.id = $1
+FOR UPDATE;
+
+-- name: ListRaffles :many
+SELECT
+    r.id,
+    r.merch_id,
+    m.name AS item,
+    r.created_by,
+    r.ticket_price,
+    r.max_tickets_per_user,
+    r.winners_count,
+    r.status,
+    r.seed_commitment,
+    r.seed,
+    r.draw_at,
+    r.created_at,
+    r.drawn_at,
+    (SELECT count(*) FROM raffle_tickets t WHERE t.raffle_id = r.id)::int AS tickets_sold
+FROM raffles r
+    JOIN merch m ON r.merch_id = m.id
+WHERE (sqlc.narg('status')::varchar IS NULL OR r.status = sqlc.narg('status'))
+ORDER BY r.draw_at;
+
+-- name: ListDueRaffles :many
+SELECT id
+FROM raffles
+WHERE status = 'open'
+  AND draw_at <= @now::timestamptz
+ORDER BY draw_at;
+
+-- name: CountRaffleTickets :one
+SELECT
+    count(*)::int AS total,
+    (count(*) FILTER (WHERE user_id = @user_id))::int AS owned
+FROM raffle_tickets
+WHERE raffle_id = @raffle_id;
+
+-- name: CreateRaffleTicket :exec
+INSERT INTO raffle_tickets (id, raffle_id, user_id, number, created_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListRaffleTickets :many
+SELECT
+    t.id,
+    t.raffle_id,
+    t.user_id,
+    u.username,
+    t.number,
+    t.created_at
+FROM raffle_tickets t
+    JOIN users u ON t.user_id = u.id
+WHERE t.raffle_id = $1
+ORDER BY t.number;
+
+-- name: CreateRaffleWinner :exec
+INSERT INTO raffle_winners (raffle_id, ticket_id, user_id, position)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListRaffleWinners :many
+SELECT
+    w.raffle_id,
+    w.ticket_id,
+    t.number AS ticket_number,
+    w.user_id,
+    u.username,
+    w.position
+FROM raffle_winners w
+    JOIN raffle_tickets t ON w.ticket_id = t.id
+    JOIN users u ON w.user_id = u.id
+WHERE w.raffle_id = $1
+ORDER BY w.position;
+
+-- name: CompleteRaffleDraw :execrows
+UPDATE raffles
+SET
+    status = 'drawn',
+    drawn_at = @drawn_at::timestamptz
+WHERE id = @id
+  AND status = 'open';
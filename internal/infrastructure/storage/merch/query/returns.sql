@@ -0,0 +1,83 @@
+-- name: GetPurchaseByID :one
+SELECT
+    p.id,
+    p.user_id,
+    p.merch_id,
+    m.name AS item,
+    p.price,
+    p.created_at,
+    p.returned_at,
+    p.last_transfer_id
+FROM purchases p
+    JOIN merch m ON p.merch_id = m.id
+WHERE p.id = $1;
+
+-- name: ListUserPurchases :many
+SELECT
+    p.id,
+    p.user_id,
+    p.merch_id,
+    m.name AS item,
+    p.price,
+    p.created_at,
+    p.returned_at,
+    p.last_transfer_id
+FROM purchases p
+    JOIN merch m ON p.merch_id = m.id
+WHERE p.user_id = $1
+ORDER BY p.created_at DESC;
+
+-- name: MarkPurchaseReturned :execrows
+UPDATE purchases
+SET returned_at = now()
+WHERE id = $1
+  AND returned_at IS NULL;
+
+-- name: CreateMerchReturn :exec
+INSERT INTO merch_returns (id, purchase_id, user_id, merch_id, refund_amount, reason, status, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetMerchReturnByID :one
+SELECT
+    r.id,
+    r.purchase_id,
+    r.user_id,
+    r.merch_id,
+    m.name AS item,
+    r.refund_amount,
+    r.reason,
+    r.status,
+    r.resolved_by,
+    r.created_at,
+    r.resolved_at
+FROM merch_returns r
+    JOIN merch m ON r.merch_id = m.id
+WHERE r.id = $1;
+
+-- name: ListMerchReturns :many
+SELECT
+    r.id,
+    r.purchase_id,
+    r.user_id,
+    r.merch_id,
+    m.name AS item,
+    r.refund_amount,
+    r.reason,
+    r.status,
+    r.resolved_by,
+    r.created_at,
+    r.resolved_at
+FROM merch_returns r
+    JOIN merch m ON r.merch_id = m.id
+WHERE (sqlc.narg(status)::varchar IS NULL OR r.status = sqlc.narg(status)::varchar)
+  AND (sqlc.narg(user_id)::varchar IS NULL OR r.user_id = sqlc.narg(user_id)::varchar)
+ORDER BY r.created_at;
+
+-- name: ResolveMerchReturn :execrows
+UPDATE merch_returns
+SET
+    status = @status,
+    resolved_by = @resolved_by,
+    resolved_at = @resolved_at
+WHERE id = @id
+  AND status = 'pending';
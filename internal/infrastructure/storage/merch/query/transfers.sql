@@ -0,0 +1,45 @@
+-- name: CreateItemTransfer :exec
+INSERT INTO item_transfers (id, sender_id, receiver_id, merch_id, quantity, created_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: MovePurchases :execrows
+-- Moves the oldest items of the sender, skipping returned ones and the ones waiting for a return decision
+UPDATE purchases
+SET
+    user_id = @receiver_id,
+    last_transfer_id = @transfer_id
+WHERE id IN (
+    SELECT p.id
+    FROM purchases p
+    WHERE p.user_id = @sender_id
+      AND p.merch_id = @merch_id
+      AND p.returned_at IS NULL
+      AND NOT EXISTS (
+            SELECT 1
+            FROM merch_returns r
+            WHERE r.purchase_id = p.id
+              AND r.status = 'pending'
+          )
+    ORDER BY p.created_at
+    LIMIT @quantity::int
+    FOR UPDATE
+);
+
+-- name: ListItemTransfers :many
+SELECT
+    t.id,
+    t.sender_id,
+    t.receiver_id,
+    t.merch_id,
+    m.name AS item,
+    s.username AS from_user,
+    r.username AS to_user,
+    t.quantity,
+    t.created_at
+FROM item_transfers t
+    JOIN merch m ON t.merch_id = m.id
+    JOIN users s ON t.sender_id = s.id
+    JOIN users r ON t.receiver_id = r.id
+WHERE t.sender_id = @user_id
+   OR t.receiver_id = @user_id
+ORDER BY t.created_at DESC;
@@ -0,0 +1,136 @@
+-- name: JoinWaitlist :exec
+INSERT INTO waitlist_entries (id, merch_id, user_id, created_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: LeaveWaitlist :execrows
+DELETE FROM waitlist_entries
+WHERE merch_id = $1
+  AND user_id = $2;
+
+-- name: ListUserWaitlist :many
+SELECT
+    q.id,
+    q.merch_id,
+    q.user_id,
+    m.name AS item,
+    q.position::int AS position,
+    q.created_at
+FROM (
+    SELECT
+        w.*,
+        ROW_NUMBER() OVER (PARTITION BY w.merch_id ORDER BY w.created_at) AS position
+    FROM waitlist_entries w
+    WHERE w.merch_id IN (
+        SELECT merch_id
+        FROM waitlist_entries
+        WHERE waitlist_entries.user_id = @user_id
+    )
+) q
+    JOIN merch m ON q.merch_id = m.id
+WHERE q.user_id = @user_id
+ORDER BY q.created_at;
+
+-- name: ListRestockedMerch :many
+-- Locks the restocked items, so concurrent workers never hand out the same units
+SELECT
+    m.id,
+    m.price,
+    m.stock::int AS stock
+FROM merch m
+WHERE m.deleted_at IS NULL
+  AND m.stock > 0
+  AND EXISTS (
+        SELECT 1
+        FROM waitlist_entries w
+        WHERE w.merch_id = m.id
+      )
+FOR UPDATE OF m SKIP LOCKED;
+
+-- name: HasWaitlist :one
+SELECT EXISTS (
+    SELECT 1
+    FROM waitlist_entries
+    WHERE merch_id = $1
+);
+
+-- name: PopWaitlist :many
+DELETE FROM waitlist_entries
+WHERE id IN (
+    SELECT w.id
+    FROM waitlist_entries w
+    WHERE w.merch_id = @merch_id
+    ORDER BY w.created_at
+    LIMIT @page_limit::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, merch_id, user_id, created_at;
+
+-- name: ReserveStock :execrows
+UPDATE merch
+SET
+    stock = stock - @quantity::int,
+    updated_at = now()
+WHERE id = @id
+  AND stock >= @quantity::int;
+
+-- name: CreateReservation :exec
+INSERT INTO reservations (id, merch_id, user_id, price, status, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetReservationByID :one
+SELECT
+    r.id,
+    r.merch_id,
+    r.user_id,
+    m.name AS item,
+    r.price,
+    r.status,
+    r.created_at,
+    r.expires_at,
+    r.resolved_at
+FROM reservations r
+    JOIN merch m ON r.merch_id = m.id
+WHERE r.id = $1;
+
+-- name: ListUserReservations :many
+SELECT
+    r.id,
+    r.merch_id,
+    r.user_id,
+    m.name AS item,
+    r.price,
+    r.status,
+    r.created_at,
+    r.expires_at,
+    r.resolved_at
+FROM reservations r
+    JOIN merch m ON r.merch_id = m.id
+WHERE r.user_id = $1
+ORDER BY r.created_at DESC;
+
+-- name: CompleteReservation :execrows
+UPDATE reservations
+SET
+    status = 'purchased',
+    resolved_at = @resolved_at::timestamptz
+WHERE id = @id
+  AND status = 'active'
+  AND expires_at > @resolved_at::timestamptz;
+
+-- name: ExpireReservations :many
+UPDATE reservations
+SET
+    status = 'expired',
+    resolved_at = @now::timestamptz
+WHERE id IN (
+    SELECT r.id
+    FROM reservations r
+    WHERE r.status = 'active'
+      AND r.expires_at <= @now::timestamptz
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, merch_id, user_id, price, status, created_at, expires_at, resolved_at;
+
+-- name: DeleteUserWaitlist :exec
+DELETE FROM waitlist_entries
+WHERE user_id = $1;
@@ -0,0 +1,282 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/merch/sqlc"
+)
+
+func (s *Storage) CreateRaffle(ctx context.Context, raffle entity.Raffle) error {
+	const op = "storage.merch.CreateRaffle"
+
+	params := sqlc.CreateRaffleParams{
+		ID:                raffle.ID,
+		MerchID:           raffle.MerchID,
+		CreatedBy:         raffle.CreatedBy,
+		TicketPrice:       int32(raffle.TicketPrice),
+		MaxTicketsPerUser: int32(raffle.MaxTicketsPerUser),
+		WinnersCount:      int32(raffle.WinnersCount),
+		Status:            raffle.Status.String(),
+		SeedCommitment:    raffle.SeedCommitment,
+		Seed:              raffle.Seed,
+		DrawAt:            raffle.DrawAt,
+		CreatedAt:         raffle.CreatedAt,
+	}
+
+	if err := s.queries.CreateRaffle(ctx, params); err != nil {
+		return fmt.Errorf("%s: failed to create raffle: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetRaffleByID(ctx context.Context, raffleID string) (entity.Raffle, error) {
+	const op = "storage.merch.GetRaffleByID"
+
+	row, err := s.queries.GetRaffleByID(ctx, raffleID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Raffle{}, storage.ErrRaffleNotFound
+		}
+		return entity.Raffle{}, fmt.Errorf("%s: failed to get raffle: %w", op, err)
+	}
+
+	return entity.Raffle{
+		ID:                row.ID,
+		MerchID:           row.MerchID,
+		Item:              row.Item,
+		CreatedBy:         row.CreatedBy,
+		TicketPrice:       int(row.TicketPrice),
+		MaxTicketsPerUser: int(row.MaxTicketsPerUser),
+		WinnersCount:      int(row.WinnersCount),
+		Status:            entity.RaffleStatus(row.Status),
+		SeedCommitment:    row.SeedCommitment,
+		Seed:              row.Seed,
+		TicketsSold:       int(row.TicketsSold),
+		DrawAt:            row.DrawAt,
+		CreatedAt:         row.CreatedAt,
+		DrawnAt:           row.DrawnAt.Time,
+	}, nil
+}
+
+// LockRaffle loads the raffle and locks it until the transaction ends,
+// so ticket numbers and per-user limits stay consistent under concurrent purchases
+func (s *Storage) LockRaffle(ctx context.Context, raffleID string) (entity.Raffle, error) {
+	const op = "storage.merch.LockRaffle"
+
+	var row sqlc.LockRaffleRow
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		row, err = s.queries.WithTx(tx).LockRaffle(ctx, raffleID)
+		return err
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Raffle{}, storage.ErrRaffleNotFound
+		}
+		return entity.Raffle{}, fmt.Errorf("%s: failed to lock raffle: %w", op, err)
+	}
+
+	return entity.Raffle{
+		ID:                row.ID,
+		MerchID:           row.MerchID,
+		TicketPrice:       int(row.TicketPrice),
+		MaxTicketsPerUser: int(row.MaxTicketsPerUser),
+		WinnersCount:      int(row.WinnersCount),
+		Status:            entity.RaffleStatus(row.Status),
+		SeedCommitment:    row.SeedCommitment,
+		Seed:              row.Seed,
+		DrawAt:            row.DrawAt,
+	}, nil
+}
+
+func (s *Storage) ListRaffles(ctx context.Context, filter entity.RaffleFilter) ([]entity.Raffle, error) {
+	const op = "storage.merch.ListRaffles"
+
+	var status pgtype.Text
+	if filter.Status != "" {
+		status = pgtype.Text{String: filter.Status.String(), Valid: true}
+	}
+
+	rows, err := s.queries.ListRaffles(ctx, status)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list raffles: %w", op, err)
+	}
+
+	raffles := make([]entity.Raffle, len(rows))
+	for i, row := range rows {
+		raffles[i] = entity.Raffle{
+			ID:                row.ID,
+			MerchID:           row.MerchID,
+			Item:              row.Item,
+			CreatedBy:         row.CreatedBy,
+			TicketPrice:       int(row.TicketPrice),
+			MaxTicketsPerUser: int(row.MaxTicketsPerUser),
+			WinnersCount:      int(row.WinnersCount),
+			Status:            entity.RaffleStatus(row.Status),
+			SeedCommitment:    row.SeedCommitment,
+			Seed:              row.Seed,
+			TicketsSold:       int(row.TicketsSold),
+			DrawAt:            row.DrawAt,
+			CreatedAt:         row.CreatedAt,
+			DrawnAt:           row.DrawnAt.Time,
+		}
+	}
+
+	return raffles, nil
+}
+
+func (s *Storage) ListDueRaffles(ctx context.Context, now time.Time) ([]string, error) {
+	const op = "storage.merch.ListDueRaffles"
+
+	raffleIDs, err := s.queries.ListDueRaffles(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list due raffles: %w", op, err)
+	}
+
+	return raffleIDs, nil
+}
+
+// CountRaffleTickets returns the number of tickets sold in total and to the given user
+func (s *Storage) CountRaffleTickets(ctx context.Context, raffleID, userID string) (total, owned int, err error) {
+	const op = "storage.merch.CountRaffleTickets"
+
+	var row sqlc.CountRaffleTicketsRow
+
+	if err = s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		row, err = s.queries.WithTx(tx).CountRaffleTickets(ctx, sqlc.CountRaffleTicketsParams{
+			UserID:   userID,
+			RaffleID: raffleID,
+		})
+		return err
+	}); err != nil {
+		return 0, 0, fmt.Errorf("%s: failed to count raffle tickets: %w", op, err)
+	}
+
+	return int(row.Total), int(row.Owned), nil
+}
+
+func (s *Storage) CreateRaffleTickets(ctx context.Context, tickets []entity.RaffleTicket) error {
+	const op = "storage.merch.CreateRaffleTickets"
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		for _, ticket := range tickets {
+			if err := queries.CreateRaffleTicket(ctx, sqlc.CreateRaffleTicketParams{
+				ID:        ticket.ID,
+				RaffleID:  ticket.RaffleID,
+				UserID:    ticket.UserID,
+				Number:    int32(ticket.Number),
+				CreatedAt: ticket.CreatedAt,
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("%s: failed to create raffle tickets: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) ListRaffleTickets(ctx context.Context, raffleID string) ([]entity.RaffleTicket, error) {
+	const op = "storage.merch.ListRaffleTickets"
+
+	var rows []sqlc.ListRaffleTicketsRow
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		rows, err = s.queries.WithTx(tx).ListRaffleTickets(ctx, raffleID)
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("%s: failed to list raffle tickets: %w", op, err)
+	}
+
+	tickets := make([]entity.RaffleTicket, len(rows))
+	for i, row := range rows {
+		tickets[i] = entity.RaffleTicket{
+			ID:        row.ID,
+			RaffleID:  row.RaffleID,
+			UserID:    row.UserID,
+			Username:  row.Username,
+			Number:    int(row.Number),
+			CreatedAt: row.CreatedAt,
+		}
+	}
+
+	return tickets, nil
+}
+
+func (s *Storage) ListRaffleWinners(ctx context.Context, raffleID string) ([]entity.RaffleWinner, error) {
+	const op = "storage.merch.ListRaffleWinners"
+
+	rows, err := s.queries.ListRaffleWinners(ctx, raffleID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list raffle winners: %w", op, err)
+	}
+
+	winners := make([]entity.RaffleWinner, len(rows))
+	for i, row := range rows {
+		winners[i] = entity.RaffleWinner{
+			RaffleID:     row.RaffleID,
+			TicketID:     row.TicketID,
+			TicketNumber: int(row.TicketNumber),
+			UserID:       row.UserID,
+			Username:     row.Username,
+			Position:     int(row.Position),
+		}
+	}
+
+	return winners, nil
+}
+
+// CompleteRaffleDraw stores the winners and marks the raffle as drawn, which reveals its seed
+func (s *Storage) CompleteRaffleDraw(ctx context.Context, raffleID string, winners []entity.RaffleWinner, now time.Time) error {
+	const op = "storage.merch.CompleteRaffleDraw"
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		for _, winner := range winners {
+			if err := queries.CreateRaffleWinner(ctx, sqlc.CreateRaffleWinnerParams{
+				RaffleID: raffleID,
+				TicketID: winner.TicketID,
+				UserID:   winner.UserID,
+				Position: int32(winner.Position),
+			}); err != nil {
+				return err
+			}
+		}
+
+		rowsAffected, err := queries.CompleteRaffleDraw(ctx, sqlc.CompleteRaffleDrawParams{
+			DrawnAt: pgtype.Timestamptz{Time: now, Valid: true},
+			ID:      raffleID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return storage.ErrRaffleNotOpen
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, storage.ErrRaffleNotOpen) {
+			return storage.ErrRaffleNotOpen
+		}
+		return fmt.Errorf("%s: failed to complete raffle draw: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/merch/sqlc"
+)
+
+func (s *Storage) GetPurchaseByID(ctx context.Context, purchaseID string) (entity.Purchase, error) {
+	const op = "storage.merch.GetPurchaseByID"
+
+	purchase, err := s.queries.GetPurchaseByID(ctx, purchaseID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Purchase{}, storage.ErrPurchaseNotFound
+		}
+		return entity.Purchase{}, fmt.Errorf("%s: failed to get purchase: %w", op, err)
+	}
+
+	return entity.Purchase{
+		ID:         purchase.ID,
+		UserID:     purchase.UserID,
+		MerchID:    purchase.MerchID,
+		Item:       purchase.Item,
+		Price:      int(purchase.Price),
+		CreatedAt:  purchase.CreatedAt,
+		ReturnedAt: purchase.ReturnedAt.Time,
+		TransferID: purchase.LastTransferID.String,
+	}, nil
+}
+
+func (s *Storage) ListUserPurchases(ctx context.Context, userID string) ([]entity.Purchase, error) {
+	const op = "storage.merch.ListUserPurchases"
+
+	rows, err := s.queries.ListUserPurchases(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list user purchases: %w", op, err)
+	}
+
+	purchases := make([]entity.Purchase, len(rows))
+	for i, row := range rows {
+		purchases[i] = entity.Purchase{
+			ID:         row.ID,
+			UserID:     row.UserID,
+			MerchID:    row.MerchID,
+			Item:       row.Item,
+			Price:      int(row.Price),
+			CreatedAt:  row.CreatedAt,
+			ReturnedAt: row.ReturnedAt.Time,
+			TransferID: row.LastTransferID.String,
+		}
+	}
+
+	return purchases, nil
+}
+
+func (s *Storage) MarkPurchaseReturned(ctx context.Context, purchaseID string) error {
+	const op = "storage.merch.MarkPurchaseReturned"
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		rowsAffected, err := s.queries.WithTx(tx).MarkPurchaseReturned(ctx, purchaseID)
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return storage.ErrPurchaseAlreadyReturned
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, storage.ErrPurchaseAlreadyReturned) {
+			return storage.ErrPurchaseAlreadyReturned
+		}
+		return fmt.Errorf("%s: failed to mark purchase returned: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) CreateMerchReturn(ctx context.Context, merchReturn entity.MerchReturn) error {
+	const op = "storage.merch.CreateMerchReturn"
+
+	params := sqlc.CreateMerchReturnParams{
+		ID:           merchReturn.ID,
+		PurchaseID:   merchReturn.PurchaseID,
+		UserID:       merchReturn.UserID,
+		MerchID:      merchReturn.MerchID,
+		RefundAmount: int32(merchReturn.RefundAmount),
+		Reason:       merchReturn.Reason,
+		Status:       merchReturn.Status.String(),
+		CreatedAt:    merchReturn.CreatedAt,
+	}
+
+	if err := s.queries.CreateMerchReturn(ctx, params); err != nil {
+		if storage.IsUniqueViolation(err) {
+			return storage.ErrMerchReturnAlreadyExists
+		}
+		return fmt.Errorf("%s: failed to create merch return: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetMerchReturnByID(ctx context.Context, returnID string) (entity.MerchReturn, error) {
+	const op = "storage.merch.GetMerchReturnByID"
+
+	row, err := s.queries.GetMerchReturnByID(ctx, returnID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.MerchReturn{}, storage.ErrMerchReturnNotFound
+		}
+		return entity.MerchReturn{}, fmt.Errorf("%s: failed to get merch return: %w", op, err)
+	}
+
+	return toMerchReturnEntity(sqlc.ListMerchReturnsRow(row)), nil
+}
+
+func (s *Storage) ListMerchReturns(ctx context.Context, filter entity.MerchReturnFilter) ([]entity.MerchReturn, error) {
+	const op = "storage.merch.ListMerchReturns"
+
+	params := sqlc.ListMerchReturnsParams{}
+
+	if filter.Status != "" {
+		params.Status = pgtype.Text{String: filter.Status.String(), Valid: true}
+	}
+
+	if filter.UserID != "" {
+		params.UserID = pgtype.Text{String: filter.UserID, Valid: true}
+	}
+
+	rows, err := s.queries.ListMerchReturns(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list merch returns: %w", op, err)
+	}
+
+	returns := make([]entity.MerchReturn, len(rows))
+	for i, row := range rows {
+		returns[i] = toMerchReturnEntity(row)
+	}
+
+	return returns, nil
+}
+
+func (s *Storage) ResolveMerchReturn(ctx context.Context, merchReturn entity.MerchReturn) error {
+	const op = "storage.merch.ResolveMerchReturn"
+
+	params := sqlc.ResolveMerchReturnParams{
+		ID:         merchReturn.ID,
+		Status:     merchReturn.Status.String(),
+		ResolvedBy: pgtype.Text{String: merchReturn.ResolvedBy, Valid: true},
+		ResolvedAt: pgtype.Timestamptz{Time: merchReturn.ResolvedAt, Valid: true},
+	}
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		rowsAffected, err := s.queries.WithTx(tx).ResolveMerchReturn(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return storage.ErrMerchReturnAlreadyResolved
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, storage.ErrMerchReturnAlreadyResolved) {
+			return storage.ErrMerchReturnAlreadyResolved
+		}
+		return fmt.Errorf("%s: failed to resolve merch return: %w", op, err)
+	}
+
+	return nil
+}
+
+func toMerchReturnEntity(row sqlc.ListMerchReturnsRow) entity.MerchReturn {
+	return entity.MerchReturn{
+		ID:           row.ID,
+		PurchaseID:   row.PurchaseID,
+		UserID:       row.UserID,
+		MerchID:      row.MerchID,
+		Item:         row.Item,
+		RefundAmount: int(row.RefundAmount),
+		Reason:       row.Reason,
+		Status:       entity.ReturnStatus(row.Status),
+		ResolvedBy:   row.ResolvedBy.String,
+		CreatedAt:    row.CreatedAt,
+		ResolvedAt:   row.ResolvedAt.Time,
+	}
+}
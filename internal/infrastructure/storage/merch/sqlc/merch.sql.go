@@ -8,17 +8,20 @@ package sqlc
 import (
 	"context"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const addToInventory = `-- name: AddToInventory :exec
-INSERT INTO purchases (id, user_id, merch_id, created_at)
-VALUES ($1, $2, $3, $4)
+INSERT INTO purchases (id, user_id, merch_id, price, created_at)
+VALUES ($1, $2, $3, $4, $5)
 `
 
 type AddToInventoryParams struct {
 	ID        string    `db:"id"`
 	UserID    string    `db:"user_id"`
 	MerchID   string    `db:"merch_id"`
+	Price     int32     `db:"price"`
 	CreatedAt time.Time `db:"created_at"`
 }
 
@@ -27,30 +30,240 @@ func (q *Queries) AddToInventory(ctx context.Context, arg AddToInventoryParams)
 		arg.ID,
 		arg.UserID,
 		arg.MerchID,
+		arg.Price,
 		arg.CreatedAt,
 	)
 	return err
 }
 
+const countMerch = `-- name: CountMerch :one
+SELECT COUNT(*)
+FROM merch m
+    LEFT JOIN categories c ON m.category_id = c.id
+WHERE m.deleted_at IS NULL
+  AND ($1::varchar IS NULL OR c.name = $1::varchar)
+  AND ($2::varchar IS NULL OR EXISTS (
+        SELECT 1
+        FROM merch_tags mt
+        WHERE mt.merch_id = m.id
+          AND mt.tag = $2::varchar
+      ))
+  AND ($3::int IS NULL OR m.price >= $3::int)
+  AND ($4::int IS NULL OR m.price <= $4::int)
+  AND (
+        $5::varchar = ''
+        OR to_tsvector('simple', m.name || ' ' || m.description) @@ plainto_tsquery('simple', $5::varchar)
+        OR m.name % $5::varchar
+        OR m.description % $5::varchar
+      )
+`
+
+type CountMerchParams struct {
+	Category pgtype.Text `db:"category"`
+	Tag      pgtype.Text `db:"tag"`
+	MinPrice pgtype.Int4 `db:"min_price"`
+	MaxPrice pgtype.Int4 `db:"max_price"`
+	Query    string      `db:"query"`
+}
+
+func (q *Queries) CountMerch(ctx context.Context, arg CountMerchParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countMerch,
+		arg.Category,
+		arg.Tag,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.Query,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getMerchByName = `-- name: GetMerchByName :one
 SELECT
     id,
     name,
-    price
+    price,
+    stock
 FROM merch
 WHERE name = $1
   AND deleted_at IS NULL
 `
 
 type GetMerchByNameRow struct {
-	ID    string `db:"id"`
-	Name  string `db:"name"`
-	Price int32  `db:"price"`
+	ID    string      `db:"id"`
+	Name  string      `db:"name"`
+	Price int32       `db:"price"`
+	Stock pgtype.Int4 `db:"stock"`
 }
 
 func (q *Queries) GetMerchByName(ctx context.Context, name string) (GetMerchByNameRow, error) {
 	row := q.db.QueryRow(ctx, getMerchByName, name)
 	var i GetMerchByNameRow
-	err := row.Scan(&i.ID, &i.Name, &i.Price)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Price,
+		&i.Stock,
+	)
 	return i, err
 }
+
+const listCategories = `-- name: ListCategories :many
+SELECT name
+FROM categories
+ORDER BY name
+`
+
+func (q *Queries) ListCategories(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listCategories)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restock = `-- name: Restock :exec
+UPDATE merch
+SET
+    stock = stock + 1,
+    updated_at = now()
+WHERE id = $1
+  AND stock IS NOT NULL
+`
+
+func (q *Queries) Restock(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, restock, id)
+	return err
+}
+
+const searchMerch = `-- name: SearchMerch :many
+SELECT
+    m.id,
+    m.name,
+    m.description,
+    m.price,
+    COALESCE(c.name, '')::varchar AS category,
+    ARRAY(
+        SELECT mt.tag
+        FROM merch_tags mt
+        WHERE mt.merch_id = m.id
+        ORDER BY mt.tag
+    )::varchar[] AS tags
+FROM merch m
+    LEFT JOIN categories c ON m.category_id = c.id
+WHERE m.deleted_at IS NULL
+  AND ($1::varchar IS NULL OR c.name = $1::varchar)
+  AND ($2::varchar IS NULL OR EXISTS (
+        SELECT 1
+        FROM merch_tags mt
+        WHERE mt.merch_id = m.id
+          AND mt.tag = $2::varchar
+      ))
+  AND ($3::int IS NULL OR m.price >= $3::int)
+  AND ($4::int IS NULL OR m.price <= $4::int)
+  AND (
+        $5::varchar = ''
+        OR to_tsvector('simple', m.name || ' ' || m.description) @@ plainto_tsquery('simple', $5::varchar)
+        OR m.name % $5::varchar
+        OR m.description % $5::varchar
+      )
+ORDER BY
+    ts_rank(to_tsvector('simple', m.name || ' ' || m.description), plainto_tsquery('simple', $5::varchar))
+        + similarity(m.name, $5::varchar) DESC,
+    m.name
+LIMIT $7::int
+OFFSET $6::int
+`
+
+type SearchMerchParams struct {
+	Category   pgtype.Text `db:"category"`
+	Tag        pgtype.Text `db:"tag"`
+	MinPrice   pgtype.Int4 `db:"min_price"`
+	MaxPrice   pgtype.Int4 `db:"max_price"`
+	Query      string      `db:"query"`
+	PageOffset int32       `db:"page_offset"`
+	PageLimit  int32       `db:"page_limit"`
+}
+
+type SearchMerchRow struct {
+	ID          string   `db:"id"`
+	Name        string   `db:"name"`
+	Description string   `db:"description"`
+	Price       int32    `db:"price"`
+	Category    string   `db:"category"`
+	Tags        []string `db:"tags"`
+}
+
+func (q *Queries) SearchMerch(ctx context.Context, arg SearchMerchParams) ([]SearchMerchRow, error) {
+	rows, err := q.db.Query(ctx, searchMerch,
+		arg.Category,
+		arg.Tag,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.Query,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchMerchRow{}
+	for rows.Next() {
+		var i SearchMerchRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Price,
+			&i.Category,
+			&i.Tags,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const takeFromStock = `-- name: TakeFromStock :execrows
+UPDATE merch
+SET
+    stock = stock - 1,
+    updated_at = now()
+WHERE merch.id = $1
+  AND deleted_at IS NULL
+  AND (stock IS NULL OR (
+        stock > 0
+        AND NOT EXISTS (
+            SELECT 1
+            FROM waitlist_entries w
+            WHERE w.merch_id = merch.id
+        )
+      ))
+`
+
+// Replenished stock belongs to the waitlist first, so it can't be bought while someone is waiting
+func (q *Queries) TakeFromStock(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, takeFromStock, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -6,11 +6,78 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
 	AddToInventory(ctx context.Context, arg AddToInventoryParams) error
+	// The auctions are locked before the bids, in the same order as bids are placed
+	ClearUserHighestBids(ctx context.Context, userID string) error
+	CloseAuction(ctx context.Context, arg CloseAuctionParams) (int64, error)
+	CompleteRaffleDraw(ctx context.Context, arg CompleteRaffleDrawParams) (int64, error)
+	CompleteReservation(ctx context.Context, arg CompleteReservationParams) (int64, error)
+	CountMerch(ctx context.Context, arg CountMerchParams) (int64, error)
+	CountRaffleTickets(ctx context.Context, arg CountRaffleTicketsParams) (CountRaffleTicketsRow, error)
+	CreateAuction(ctx context.Context, arg CreateAuctionParams) error
+	CreateAuctionBid(ctx context.Context, arg CreateAuctionBidParams) error
+	CreateItemTransfer(ctx context.Context, arg CreateItemTransferParams) error
+	CreateMerchImage(ctx context.Context, arg CreateMerchImageParams) error
+	CreateMerchReturn(ctx context.Context, arg CreateMerchReturnParams) error
+	CreateRaffle(ctx context.Context, arg CreateRaffleParams) error
+	CreateRaffleTicket(ctx context.Context, arg CreateRaffleTicketParams) error
+	CreateRaffleWinner(ctx context.Context, arg CreateRaffleWinnerParams) error
+	CreateReservation(ctx context.Context, arg CreateReservationParams) error
+	DeleteMerchImage(ctx context.Context, id string) (int64, error)
+	DeleteUserWaitlist(ctx context.Context, userID string) error
+	ExpireReservations(ctx context.Context, now pgtype.Timestamptz) ([]Reservation, error)
+	GetAuctionByID(ctx context.Context, id string) (GetAuctionByIDRow, error)
 	GetMerchByName(ctx context.Context, name string) (GetMerchByNameRow, error)
+	GetMerchImageByID(ctx context.Context, id string) (MerchImage, error)
+	GetMerchReturnByID(ctx context.Context, id string) (GetMerchReturnByIDRow, error)
+	GetPurchaseByID(ctx context.Context, id string) (GetPurchaseByIDRow, error)
+	GetRaffleByID(ctx context.Context, id string) (GetRaffleByIDRow, error)
+	GetReservationByID(ctx context.Context, id string) (GetReservationByIDRow, error)
+	HasWaitlist(ctx context.Context, merchID string) (bool, error)
+	JoinWaitlist(ctx context.Context, arg JoinWaitlistParams) error
+	LeaveWaitlist(ctx context.Context, arg LeaveWaitlistParams) (int64, error)
+	ListAuctionBids(ctx context.Context, auctionID string) ([]ListAuctionBidsRow, error)
+	ListAuctions(ctx context.Context, status pgtype.Text) ([]ListAuctionsRow, error)
+	ListCatalog(ctx context.Context) ([]ListCatalogRow, error)
+	ListCategories(ctx context.Context) ([]string, error)
+	ListDueAuctions(ctx context.Context, now pgtype.Timestamptz) ([]string, error)
+	ListDueRaffles(ctx context.Context, now pgtype.Timestamptz) ([]string, error)
+	ListItemTransfers(ctx context.Context, userID string) ([]ListItemTransfersRow, error)
+	ListMerchImages(ctx context.Context, merchIds []string) ([]MerchImage, error)
+	ListMerchReturns(ctx context.Context, arg ListMerchReturnsParams) ([]ListMerchReturnsRow, error)
+	ListRaffleTickets(ctx context.Context, raffleID string) ([]ListRaffleTicketsRow, error)
+	ListRaffleWinners(ctx context.Context, raffleID string) ([]ListRaffleWinnersRow, error)
+	ListRaffles(ctx context.Context, status pgtype.Text) ([]ListRafflesRow, error)
+	// Locks the restocked items, so concurrent workers never hand out the same units
+	ListRestockedMerch(ctx context.Context) ([]ListRestockedMerchRow, error)
+	ListUserPurchases(ctx context.Context, userID string) ([]ListUserPurchasesRow, error)
+	ListUserReservations(ctx context.Context, userID string) ([]ListUserReservationsRow, error)
+	ListUserWaitlist(ctx context.Context, userID string) ([]ListUserWaitlistRow, error)
+	// Serializes bids and closing of the same auction
+	LockAuction(ctx context.Context, id string) (LockAuctionRow, error)
+	// Serializes ticket sales and the draw of the same raffle
+	LockRaffle(ctx context.Context, id string) (LockRaffleRow, error)
+	MarkPurchaseReturned(ctx context.Context, id string) (int64, error)
+	// Moves the oldest items of the sender, skipping returned ones and the ones waiting for a return decision
+	MovePurchases(ctx context.Context, arg MovePurchasesParams) (int64, error)
+	PopWaitlist(ctx context.Context, arg PopWaitlistParams) ([]WaitlistEntry, error)
+	RefundUserBids(ctx context.Context, arg RefundUserBidsParams) ([]AuctionBid, error)
+	ReserveStock(ctx context.Context, arg ReserveStockParams) (int64, error)
+	ResolveAuctionBid(ctx context.Context, arg ResolveAuctionBidParams) (int64, error)
+	ResolveMerchReturn(ctx context.Context, arg ResolveMerchReturnParams) (int64, error)
+	Restock(ctx context.Context, id string) error
+	RetireMerch(ctx context.Context, name string) error
+	SearchMerch(ctx context.Context, arg SearchMerchParams) ([]SearchMerchRow, error)
+	SetAuctionHighestBid(ctx context.Context, arg SetAuctionHighestBidParams) error
+	// Replenished stock belongs to the waitlist first, so it can't be bought while someone is waiting
+	TakeFromStock(ctx context.Context, id string) (int64, error)
+	UpsertCategory(ctx context.Context, arg UpsertCategoryParams) (string, error)
+	UpsertMerch(ctx context.Context, arg UpsertMerchParams) error
 }
 
 var _ Querier = (*Queries)(nil)
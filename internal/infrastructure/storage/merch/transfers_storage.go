@@ -0,0 +1,85 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/merch/sqlc"
+)
+
+// TransferItems records the transfer and moves the requested number of items to the receiver.
+// If the sender owns fewer transferable items, nothing is moved
+func (s *Storage) TransferItems(ctx context.Context, transfer entity.ItemTransfer) error {
+	const op = "storage.merch.TransferItems"
+
+	err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		if err := queries.CreateItemTransfer(ctx, sqlc.CreateItemTransferParams{
+			ID:         transfer.ID,
+			SenderID:   transfer.SenderID,
+			ReceiverID: transfer.ReceiverID,
+			MerchID:    transfer.MerchID,
+			Quantity:   int32(transfer.Quantity),
+			CreatedAt:  transfer.CreatedAt,
+		}); err != nil {
+			return err
+		}
+
+		rowsAffected, err := queries.MovePurchases(ctx, sqlc.MovePurchasesParams{
+			ReceiverID: transfer.ReceiverID,
+			TransferID: pgtype.Text{String: transfer.ID, Valid: true},
+			SenderID:   transfer.SenderID,
+			MerchID:    transfer.MerchID,
+			Quantity:   int32(transfer.Quantity),
+		})
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected < int64(transfer.Quantity) {
+			return storage.ErrNotEnoughItems
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotEnoughItems) {
+			return storage.ErrNotEnoughItems
+		}
+		return fmt.Errorf("%s: failed to transfer items: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) ListItemTransfers(ctx context.Context, userID string) ([]entity.ItemTransfer, error) {
+	const op = "storage.merch.ListItemTransfers"
+
+	rows, err := s.queries.ListItemTransfers(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list item transfers: %w", op, err)
+	}
+
+	transfers := make([]entity.ItemTransfer, len(rows))
+	for i, row := range rows {
+		transfers[i] = entity.ItemTransfer{
+			ID:         row.ID,
+			SenderID:   row.SenderID,
+			ReceiverID: row.ReceiverID,
+			MerchID:    row.MerchID,
+			Item:       row.Item,
+			FromUser:   row.FromUser,
+			ToUser:     row.ToUser,
+			Quantity:   int(row.Quantity),
+			CreatedAt:  row.CreatedAt,
+		}
+	}
+
+	return transfers, nil
+}
@@ -0,0 +1,300 @@
+package merch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/merch/sqlc"
+)
+
+func (s *Storage) JoinWaitlist(ctx context.Context, entry entity.WaitlistEntry) error {
+	const op = "storage.merch.JoinWaitlist"
+
+	params := sqlc.JoinWaitlistParams{
+		ID:        entry.ID,
+		MerchID:   entry.MerchID,
+		UserID:    entry.UserID,
+		CreatedAt: entry.CreatedAt,
+	}
+
+	if err := s.queries.JoinWaitlist(ctx, params); err != nil {
+		if storage.IsUniqueViolation(err) {
+			return storage.ErrAlreadyInWaitlist
+		}
+		return fmt.Errorf("%s: failed to join waitlist: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) LeaveWaitlist(ctx context.Context, userID, merchID string) error {
+	const op = "storage.merch.LeaveWaitlist"
+
+	rowsAffected, err := s.queries.LeaveWaitlist(ctx, sqlc.LeaveWaitlistParams{
+		MerchID: merchID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to leave waitlist: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return storage.ErrWaitlistEntryNotFound
+	}
+
+	return nil
+}
+
+func (s *Storage) ListUserWaitlist(ctx context.Context, userID string) ([]entity.WaitlistEntry, error) {
+	const op = "storage.merch.ListUserWaitlist"
+
+	rows, err := s.queries.ListUserWaitlist(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list user waitlist: %w", op, err)
+	}
+
+	entries := make([]entity.WaitlistEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = entity.WaitlistEntry{
+			ID:        row.ID,
+			MerchID:   row.MerchID,
+			UserID:    row.UserID,
+			Item:      row.Item,
+			Position:  int(row.Position),
+			CreatedAt: row.CreatedAt,
+		}
+	}
+
+	return entries, nil
+}
+
+// ListRestockedMerch locks stock-tracked items that have units on hand and a non-empty waitlist.
+// Must be called within a transaction, the locks are held until it's finished
+func (s *Storage) ListRestockedMerch(ctx context.Context) ([]entity.RestockedMerch, error) {
+	const op = "storage.merch.ListRestockedMerch"
+
+	var rows []sqlc.ListRestockedMerchRow
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		rows, err = s.queries.WithTx(tx).ListRestockedMerch(ctx)
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("%s: failed to list restocked merch: %w", op, err)
+	}
+
+	items := make([]entity.RestockedMerch, len(rows))
+	for i, row := range rows {
+		items[i] = entity.RestockedMerch{
+			MerchID: row.ID,
+			Price:   int(row.Price),
+			Stock:   int(row.Stock),
+		}
+	}
+
+	return items, nil
+}
+
+// PopWaitlist removes up to limit users from the head of the item's waitlist and returns them in queue order
+func (s *Storage) PopWaitlist(ctx context.Context, merchID string, limit int) ([]entity.WaitlistEntry, error) {
+	const op = "storage.merch.PopWaitlist"
+
+	var rows []sqlc.WaitlistEntry
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		rows, err = s.queries.WithTx(tx).PopWaitlist(ctx, sqlc.PopWaitlistParams{
+			MerchID:   merchID,
+			PageLimit: int32(limit),
+		})
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("%s: failed to pop waitlist: %w", op, err)
+	}
+
+	entries := make([]entity.WaitlistEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = entity.WaitlistEntry{
+			ID:        row.ID,
+			MerchID:   row.MerchID,
+			UserID:    row.UserID,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *Storage) ReserveStock(ctx context.Context, merchID string, quantity int) error {
+	const op = "storage.merch.ReserveStock"
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		rowsAffected, err := s.queries.WithTx(tx).ReserveStock(ctx, sqlc.ReserveStockParams{
+			Quantity: int32(quantity),
+			ID:       merchID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return storage.ErrMerchOutOfStock
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, storage.ErrMerchOutOfStock) {
+			return storage.ErrMerchOutOfStock
+		}
+		return fmt.Errorf("%s: failed to reserve stock: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) CreateReservation(ctx context.Context, reservation entity.Reservation) error {
+	const op = "storage.merch.CreateReservation"
+
+	params := sqlc.CreateReservationParams{
+		ID:        reservation.ID,
+		MerchID:   reservation.MerchID,
+		UserID:    reservation.UserID,
+		Price:     int32(reservation.Price),
+		Status:    reservation.Status.String(),
+		CreatedAt: reservation.CreatedAt,
+		ExpiresAt: reservation.ExpiresAt,
+	}
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		return s.queries.WithTx(tx).CreateReservation(ctx, params)
+	}); err != nil {
+		return fmt.Errorf("%s: failed to create reservation: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetReservationByID(ctx context.Context, reservationID string) (entity.Reservation, error) {
+	const op = "storage.merch.GetReservationByID"
+
+	row, err := s.queries.GetReservationByID(ctx, reservationID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Reservation{}, storage.ErrReservationNotFound
+		}
+		return entity.Reservation{}, fmt.Errorf("%s: failed to get reservation: %w", op, err)
+	}
+
+	return entity.Reservation{
+		ID:         row.ID,
+		MerchID:    row.MerchID,
+		UserID:     row.UserID,
+		Item:       row.Item,
+		Price:      int(row.Price),
+		Status:     entity.ReservationStatus(row.Status),
+		CreatedAt:  row.CreatedAt,
+		ExpiresAt:  row.ExpiresAt,
+		ResolvedAt: row.ResolvedAt.Time,
+	}, nil
+}
+
+func (s *Storage) ListUserReservations(ctx context.Context, userID string) ([]entity.Reservation, error) {
+	const op = "storage.merch.ListUserReservations"
+
+	rows, err := s.queries.ListUserReservations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list user reservations: %w", op, err)
+	}
+
+	reservations := make([]entity.Reservation, len(rows))
+	for i, row := range rows {
+		reservations[i] = entity.Reservation{
+			ID:         row.ID,
+			MerchID:    row.MerchID,
+			UserID:     row.UserID,
+			Item:       row.Item,
+			Price:      int(row.Price),
+			Status:     entity.ReservationStatus(row.Status),
+			CreatedAt:  row.CreatedAt,
+			ExpiresAt:  row.ExpiresAt,
+			ResolvedAt: row.ResolvedAt.Time,
+		}
+	}
+
+	return reservations, nil
+}
+
+// CompleteReservation marks the reservation as purchased, unless it has already expired or been used
+func (s *Storage) CompleteReservation(ctx context.Context, reservationID string, now time.Time) error {
+	const op = "storage.merch.CompleteReservation"
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		rowsAffected, err := s.queries.WithTx(tx).CompleteReservation(ctx, sqlc.CompleteReservationParams{
+			ResolvedAt: pgtype.Timestamptz{Time: now, Valid: true},
+			ID:         reservationID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return storage.ErrReservationNotActive
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, storage.ErrReservationNotActive) {
+			return storage.ErrReservationNotActive
+		}
+		return fmt.Errorf("%s: failed to complete reservation: %w", op, err)
+	}
+
+	return nil
+}
+
+// ExpireReservations marks all active reservations past their deadline as expired and returns them
+func (s *Storage) ExpireReservations(ctx context.Context, now time.Time) ([]entity.Reservation, error) {
+	const op = "storage.merch.ExpireReservations"
+
+	var rows []sqlc.Reservation
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		rows, err = s.queries.WithTx(tx).ExpireReservations(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("%s: failed to expire reservations: %w", op, err)
+	}
+
+	reservations := make([]entity.Reservation, len(rows))
+	for i, row := range rows {
+		reservations[i] = entity.Reservation{
+			ID:         row.ID,
+			MerchID:    row.MerchID,
+			UserID:     row.UserID,
+			Price:      int(row.Price),
+			Status:     entity.ReservationStatus(row.Status),
+			CreatedAt:  row.CreatedAt,
+			ExpiresAt:  row.ExpiresAt,
+			ResolvedAt: row.ResolvedAt.Time,
+		}
+	}
+
+	return reservations, nil
+}
+
+func (s *Storage) DeleteUserWaitlist(ctx context.Context, userID string) error {
+	const op = "storage.merch.DeleteUserWaitlist"
+
+	if err := s.queries.DeleteUserWaitlist(ctx, userID); err != nil {
+		return fmt.Errorf("%s: failed to delete user waitlist: %w", op, err)
+	}
+
+	return nil
+}
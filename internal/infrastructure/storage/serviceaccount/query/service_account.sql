@@ -0,0 +1,41 @@
+-- name: CreateServiceAccount :exec
+INSERT INTO service_accounts (id, name, description, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetServiceAccountByID :one
+SELECT id, name, description, created_by, created_at
+FROM service_accounts
+WHERE id = $1;
+
+-- name: ListServiceAccounts :many
+SELECT id, name, description, created_by, created_at
+FROM service_accounts
+ORDER BY name;
+
+-- name: CreateAPIKey :exec
+INSERT INTO api_keys (id, service_account_id, key_hash, scopes, created_by, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetAPIKeyByHash :one
+SELECT id, service_account_id, key_hash, scopes, created_by, expires_at, last_used_at, created_at, revoked_at
+FROM api_keys
+WHERE key_hash = $1;
+
+-- name: ListAPIKeys :many
+SELECT id, service_account_id, key_hash, scopes, created_by, expires_at, last_used_at, created_at, revoked_at
+FROM api_keys
+WHERE service_account_id = $1
+ORDER BY created_at;
+
+-- name: TouchAPIKey :exec
+UPDATE api_keys
+SET last_used_at = @used_at::timestamptz
+WHERE id = @id
+  AND (last_used_at IS NULL OR last_used_at < @stale_before::timestamptz);
+
+-- name: RevokeAPIKey :execrows
+UPDATE api_keys
+SET revoked_at = @revoked_at::timestamptz
+WHERE id = @id
+  AND service_account_id = @service_account_id
+  AND revoked_at IS NULL;
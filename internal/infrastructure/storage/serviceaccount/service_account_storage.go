@@ -0,0 +1,202 @@
+package serviceaccount
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/serviceaccount/sqlc"
+)
+
+type Storage struct {
+	pool    *pgxpool.Pool
+	txMgr   TransactionManager
+	queries *sqlc.Queries
+}
+
+type TransactionManager interface {
+	ExecWithinTx(ctx context.Context, fn func(tx pgx.Tx) error) error
+}
+
+func NewStorage(pool *pgxpool.Pool, txMgr TransactionManager) *Storage {
+	return &Storage{
+		pool:    pool,
+		txMgr:   txMgr,
+		queries: sqlc.New(pool),
+	}
+}
+
+func (s *Storage) CreateServiceAccount(ctx context.Context, account entity.ServiceAccount) error {
+	const op = "storage.serviceaccount.CreateServiceAccount"
+
+	if err := s.queries.CreateServiceAccount(ctx, sqlc.CreateServiceAccountParams{
+		ID:          account.ID,
+		Name:        account.Name,
+		Description: account.Description,
+		CreatedBy:   account.CreatedBy,
+		CreatedAt:   account.CreatedAt,
+	}); err != nil {
+		if storage.IsUniqueViolation(err) {
+			return storage.ErrServiceAccountExists
+		}
+		return fmt.Errorf("%s: failed to create service account: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetServiceAccountByID(ctx context.Context, serviceAccountID string) (entity.ServiceAccount, error) {
+	const op = "storage.serviceaccount.GetServiceAccountByID"
+
+	row, err := s.queries.GetServiceAccountByID(ctx, serviceAccountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ServiceAccount{}, storage.ErrServiceAccountNotFound
+		}
+		return entity.ServiceAccount{}, fmt.Errorf("%s: failed to get service account: %w", op, err)
+	}
+
+	return toServiceAccount(row), nil
+}
+
+func (s *Storage) ListServiceAccounts(ctx context.Context) ([]entity.ServiceAccount, error) {
+	const op = "storage.serviceaccount.ListServiceAccounts"
+
+	rows, err := s.queries.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list service accounts: %w", op, err)
+	}
+
+	accounts := make([]entity.ServiceAccount, len(rows))
+	for i, row := range rows {
+		accounts[i] = toServiceAccount(row)
+	}
+
+	return accounts, nil
+}
+
+func (s *Storage) CreateAPIKey(ctx context.Context, key entity.APIKey) error {
+	const op = "storage.serviceaccount.CreateAPIKey"
+
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	if err := s.queries.CreateAPIKey(ctx, sqlc.CreateAPIKeyParams{
+		ID:               key.ID,
+		ServiceAccountID: key.ServiceAccountID,
+		KeyHash:          key.KeyHash,
+		Scopes:           scopes,
+		CreatedBy:        key.CreatedBy,
+		ExpiresAt:        pgtype.Timestamptz{Time: key.ExpiresAt, Valid: !key.ExpiresAt.IsZero()},
+		CreatedAt:        key.CreatedAt,
+	}); err != nil {
+		if storage.IsForeignKeyViolation(err) {
+			return storage.ErrServiceAccountNotFound
+		}
+		return fmt.Errorf("%s: failed to create api key: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetAPIKeyByHash(ctx context.Context, keyHash string) (entity.APIKey, error) {
+	const op = "storage.serviceaccount.GetAPIKeyByHash"
+
+	row, err := s.queries.GetAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.APIKey{}, storage.ErrAPIKeyNotFound
+		}
+		return entity.APIKey{}, fmt.Errorf("%s: failed to get api key: %w", op, err)
+	}
+
+	return toAPIKey(row), nil
+}
+
+func (s *Storage) ListAPIKeys(ctx context.Context, serviceAccountID string) ([]entity.APIKey, error) {
+	const op = "storage.serviceaccount.ListAPIKeys"
+
+	rows, err := s.queries.ListAPIKeys(ctx, serviceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list api keys: %w", op, err)
+	}
+
+	keys := make([]entity.APIKey, len(rows))
+	for i, row := range rows {
+		keys[i] = toAPIKey(row)
+	}
+
+	return keys, nil
+}
+
+// TouchAPIKey sets the last-used time, unless it was set after staleBefore
+func (s *Storage) TouchAPIKey(ctx context.Context, keyID string, usedAt, staleBefore time.Time) error {
+	const op = "storage.serviceaccount.TouchAPIKey"
+
+	if err := s.queries.TouchAPIKey(ctx, sqlc.TouchAPIKeyParams{
+		ID:          keyID,
+		UsedAt:      pgtype.Timestamptz{Time: usedAt, Valid: true},
+		StaleBefore: pgtype.Timestamptz{Time: staleBefore, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("%s: failed to touch api key: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) RevokeAPIKey(ctx context.Context, serviceAccountID, keyID string, revokedAt time.Time) error {
+	const op = "storage.serviceaccount.RevokeAPIKey"
+
+	rows, err := s.queries.RevokeAPIKey(ctx, sqlc.RevokeAPIKeyParams{
+		ID:               keyID,
+		ServiceAccountID: serviceAccountID,
+		RevokedAt:        pgtype.Timestamptz{Time: revokedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to revoke api key: %w", op, err)
+	}
+
+	// Revoking a revoked key is reported as not found too, there is no active key to revoke
+	if rows == 0 {
+		return storage.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+func toServiceAccount(row sqlc.ServiceAccount) entity.ServiceAccount {
+	return entity.ServiceAccount{
+		ID:          row.ID,
+		Name:        row.Name,
+		Description: row.Description,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt,
+	}
+}
+
+func toAPIKey(row sqlc.ApiKey) entity.APIKey {
+	scopes := make([]entity.Scope, len(row.Scopes))
+	for i, scope := range row.Scopes {
+		scopes[i] = entity.Scope(scope)
+	}
+
+	return entity.APIKey{
+		ID:               row.ID,
+		ServiceAccountID: row.ServiceAccountID,
+		KeyHash:          row.KeyHash,
+		Scopes:           scopes,
+		CreatedBy:        row.CreatedBy,
+		ExpiresAt:        row.ExpiresAt.Time,
+		LastUsedAt:       row.LastUsedAt.Time,
+		CreatedAt:        row.CreatedAt,
+		RevokedAt:        row.RevokedAt.Time,
+	}
+}
@@ -0,0 +1,108 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/session/sqlc"
+)
+
+func (s *Storage) GetLoginFailures(ctx context.Context, scope entity.LoginScope, subject string) (entity.LoginFailures, error) {
+	const op = "storage.session.GetLoginFailures"
+
+	row, err := s.queries.GetLoginFailures(ctx, sqlc.GetLoginFailuresParams{
+		Scope:   string(scope),
+		Subject: subject,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.LoginFailures{}, storage.ErrLoginFailuresNotFound
+		}
+		return entity.LoginFailures{}, fmt.Errorf("%s: failed to get login failures: %w", op, err)
+	}
+
+	return entity.LoginFailures{
+		Scope:        entity.LoginScope(row.Scope),
+		Subject:      row.Subject,
+		Failures:     int(row.Failures),
+		Locked:       row.Locked,
+		BlockedUntil: row.BlockedUntil,
+		LastFailedAt: row.LastFailedAt,
+	}, nil
+}
+
+// IncrementLoginFailures returns the number of failures within the window, including this one
+func (s *Storage) IncrementLoginFailures(
+	ctx context.Context,
+	scope entity.LoginScope,
+	subject string,
+	failedAt, windowStart time.Time,
+) (int, error) {
+	const op = "storage.session.IncrementLoginFailures"
+
+	failures, err := s.queries.IncrementLoginFailures(ctx, sqlc.IncrementLoginFailuresParams{
+		Scope:       string(scope),
+		Subject:     subject,
+		FailedAt:    pgtype.Timestamptz{Time: failedAt, Valid: true},
+		WindowStart: pgtype.Timestamptz{Time: windowStart, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to increment login failures: %w", op, err)
+	}
+
+	return int(failures), nil
+}
+
+func (s *Storage) BlockLogin(
+	ctx context.Context,
+	scope entity.LoginScope,
+	subject string,
+	blockedUntil time.Time,
+	locked bool,
+) error {
+	const op = "storage.session.BlockLogin"
+
+	if err := s.queries.BlockLogin(ctx, sqlc.BlockLoginParams{
+		BlockedUntil: pgtype.Timestamptz{Time: blockedUntil, Valid: true},
+		Locked:       locked,
+		Scope:        string(scope),
+		Subject:      subject,
+	}); err != nil {
+		return fmt.Errorf("%s: failed to block login: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) DeleteLoginFailures(ctx context.Context, scope entity.LoginScope, subject string) error {
+	const op = "storage.session.DeleteLoginFailures"
+
+	if err := s.queries.DeleteLoginFailures(ctx, sqlc.DeleteLoginFailuresParams{
+		Scope:   string(scope),
+		Subject: subject,
+	}); err != nil {
+		return fmt.Errorf("%s: failed to delete login failures: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) PurgeLoginFailures(ctx context.Context, windowStart, now time.Time) (int, error) {
+	const op = "storage.session.PurgeLoginFailures"
+
+	purged, err := s.queries.PurgeLoginFailures(ctx, sqlc.PurgeLoginFailuresParams{
+		WindowStart: pgtype.Timestamptz{Time: windowStart, Valid: true},
+		Now:         pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to purge login failures: %w", op, err)
+	}
+
+	return int(purged), nil
+}
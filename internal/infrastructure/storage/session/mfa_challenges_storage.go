@@ -0,0 +1,65 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/session/sqlc"
+)
+
+func (s *Storage) CreateMFAChallenge(ctx context.Context, challenge entity.MFAChallenge) error {
+	const op = "storage.session.CreateMFAChallenge"
+
+	if err := s.queries.CreateMFAChallenge(ctx, sqlc.CreateMFAChallengeParams{
+		TokenHash: challenge.TokenHash,
+		UserID:    challenge.UserID,
+		ExpiresAt: challenge.ExpiresAt,
+		CreatedAt: challenge.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("%s: failed to create mfa challenge: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetMFAChallenge returns the challenge, unless it has expired by now
+func (s *Storage) GetMFAChallenge(ctx context.Context, tokenHash string, now time.Time) (entity.MFAChallenge, error) {
+	const op = "storage.session.GetMFAChallenge"
+
+	row, err := s.queries.GetMFAChallenge(ctx, sqlc.GetMFAChallengeParams{
+		TokenHash: tokenHash,
+		Now:       pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.MFAChallenge{}, storage.ErrMFAChallengeNotFound
+		}
+		return entity.MFAChallenge{}, fmt.Errorf("%s: failed to get mfa challenge: %w", op, err)
+	}
+
+	return entity.MFAChallenge{
+		TokenHash: row.TokenHash,
+		UserID:    row.UserID,
+		ExpiresAt: row.ExpiresAt,
+		CreatedAt: row.CreatedAt,
+	}, nil
+}
+
+// DeleteMFAChallenge reports false if the challenge was already gone,
+// so of two concurrent logins with the same challenge only one goes through
+func (s *Storage) DeleteMFAChallenge(ctx context.Context, tokenHash string) (bool, error) {
+	const op = "storage.session.DeleteMFAChallenge"
+
+	rows, err := s.queries.DeleteMFAChallenge(ctx, tokenHash)
+	if err != nil {
+		return false, fmt.Errorf("%s: failed to delete mfa challenge: %w", op, err)
+	}
+
+	return rows > 0, nil
+}
@@ -0,0 +1,54 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/session/sqlc"
+)
+
+func (s *Storage) CreateOIDCLoginState(ctx context.Context, state entity.OIDCLoginState) error {
+	const op = "storage.session.CreateOIDCLoginState"
+
+	if err := s.queries.CreateOIDCLoginState(ctx, sqlc.CreateOIDCLoginStateParams{
+		State:        state.State,
+		Nonce:        state.Nonce,
+		CodeVerifier: state.CodeVerifier,
+		ExpiresAt:    state.ExpiresAt,
+		CreatedAt:    state.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("%s: failed to create oidc login state: %w", op, err)
+	}
+
+	return nil
+}
+
+// ConsumeOIDCLoginState deletes the state and returns it, unless it has expired by now
+func (s *Storage) ConsumeOIDCLoginState(ctx context.Context, state string, now time.Time) (entity.OIDCLoginState, error) {
+	const op = "storage.session.ConsumeOIDCLoginState"
+
+	row, err := s.queries.ConsumeOIDCLoginState(ctx, sqlc.ConsumeOIDCLoginStateParams{
+		State: state,
+		Now:   pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.OIDCLoginState{}, storage.ErrOIDCLoginStateNotFound
+		}
+		return entity.OIDCLoginState{}, fmt.Errorf("%s: failed to consume oidc login state: %w", op, err)
+	}
+
+	return entity.OIDCLoginState{
+		State:        row.State,
+		Nonce:        row.Nonce,
+		CodeVerifier: row.CodeVerifier,
+		ExpiresAt:    row.ExpiresAt,
+		CreatedAt:    row.CreatedAt,
+	}, nil
+}
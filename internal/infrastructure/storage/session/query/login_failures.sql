@@ -0,0 +1,41 @@
+-- name: GetLoginFailures :one
+SELECT scope,
+       subject,
+       failures,
+       locked,
+       blocked_until,
+       last_failed_at
+FROM login_failures
+WHERE scope = $1
+    AND subject = $2;
+
+-- name: IncrementLoginFailures :one
+-- Failures older than the window are forgotten, the count starts over
+INSERT INTO login_failures (scope, subject, failures, locked, blocked_until, last_failed_at)
+VALUES (@scope, @subject, 1, false, @failed_at::timestamptz, @failed_at::timestamptz)
+ON CONFLICT (scope, subject) DO UPDATE
+SET failures = CASE
+        WHEN login_failures.last_failed_at < @window_start::timestamptz THEN 1
+        ELSE login_failures.failures + 1
+    END,
+    locked = login_failures.locked AND login_failures.last_failed_at >= @window_start::timestamptz,
+    last_failed_at = EXCLUDED.last_failed_at
+RETURNING failures;
+
+-- name: BlockLogin :exec
+-- Concurrent failures may be recorded out of order, so a block is only ever extended
+UPDATE login_failures
+SET blocked_until = GREATEST(blocked_until, @blocked_until::timestamptz),
+    locked = locked OR @locked::boolean
+WHERE scope = @scope
+    AND subject = @subject;
+
+-- name: DeleteLoginFailures :exec
+DELETE FROM login_failures
+WHERE scope = $1
+    AND subject = $2;
+
+-- name: PurgeLoginFailures :execrows
+DELETE FROM login_failures
+WHERE last_failed_at < @window_start::timestamptz
+    AND blocked_until <= @now::timestamptz;
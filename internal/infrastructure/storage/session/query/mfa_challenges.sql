@@ -0,0 +1,20 @@
+-- name: CreateMFAChallenge :exec
+INSERT INTO mfa_challenges (token_hash, user_id, expires_at, created_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetMFAChallenge :one
+SELECT token_hash,
+    user_id,
+    expires_at,
+    created_at
+FROM mfa_challenges
+WHERE token_hash = @token_hash
+    AND expires_at > @now::timestamptz;
+
+-- name: DeleteMFAChallenge :execrows
+DELETE FROM mfa_challenges
+WHERE token_hash = $1;
+
+-- name: PurgeMFAChallenges :execrows
+DELETE FROM mfa_challenges
+WHERE expires_at <= @now::timestamptz;
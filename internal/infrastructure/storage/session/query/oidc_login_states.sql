@@ -0,0 +1,18 @@
+-- name: CreateOIDCLoginState :exec
+INSERT INTO oidc_login_states (state, nonce, code_verifier, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ConsumeOIDCLoginState :one
+-- A state is deleted on first use, so a callback can't be replayed
+DELETE FROM oidc_login_states
+WHERE state = @state
+    AND expires_at > @now::timestamptz
+RETURNING state,
+    nonce,
+    code_verifier,
+    expires_at,
+    created_at;
+
+-- name: PurgeOIDCLoginStates :execrows
+DELETE FROM oidc_login_states
+WHERE expires_at <= @now::timestamptz;
@@ -0,0 +1,51 @@
+-- name: CreateRefreshToken :exec
+INSERT INTO refresh_tokens (id, user_id, family_id, token_hash, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: LockRefreshToken :one
+-- Serializes concurrent refreshes with the same token, so only one of them can rotate it
+SELECT id,
+       user_id,
+       family_id,
+       token_hash,
+       expires_at,
+       created_at,
+       rotated_at,
+       revoked_at
+FROM refresh_tokens
+WHERE token_hash = $1
+FOR UPDATE;
+
+-- name: MarkRefreshTokenRotated :execrows
+UPDATE refresh_tokens
+SET rotated_at = @rotated_at::timestamptz
+WHERE id = @id
+    AND rotated_at IS NULL
+    AND revoked_at IS NULL;
+
+-- name: RevokeRefreshTokenFamily :execrows
+UPDATE refresh_tokens
+SET revoked_at = @revoked_at::timestamptz
+WHERE family_id = @family_id
+    AND revoked_at IS NULL;
+
+-- name: RevokeRefreshTokenFamilyByToken :exec
+UPDATE refresh_tokens
+SET revoked_at = @revoked_at::timestamptz
+WHERE family_id = (
+        SELECT rt.family_id
+        FROM refresh_tokens rt
+        WHERE rt.token_hash = @token_hash
+            AND rt.user_id = @user_id
+    )
+    AND revoked_at IS NULL;
+
+-- name: RevokeUserRefreshTokens :exec
+UPDATE refresh_tokens
+SET revoked_at = @revoked_at::timestamptz
+WHERE user_id = @user_id
+    AND revoked_at IS NULL;
+
+-- name: PurgeRefreshTokens :execrows
+DELETE FROM refresh_tokens
+WHERE expires_at <= @now::timestamptz;
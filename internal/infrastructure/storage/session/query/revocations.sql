@@ -0,0 +1,33 @@
+-- name: RevokeToken :exec
+INSERT INTO revoked_tokens (jti, user_id, expires_at, revoked_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (jti) DO NOTHING;
+
+-- name: UpsertSessionRevocation :exec
+INSERT INTO session_revocations (user_id, revoked_before, expires_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET revoked_before = EXCLUDED.revoked_before,
+    expires_at = EXCLUDED.expires_at;
+
+-- name: ListRevokedTokens :many
+SELECT jti,
+       user_id,
+       expires_at
+FROM revoked_tokens
+WHERE expires_at > @now::timestamptz;
+
+-- name: ListSessionRevocations :many
+SELECT user_id,
+       revoked_before,
+       expires_at
+FROM session_revocations
+WHERE expires_at > @now::timestamptz;
+
+-- name: PurgeRevokedTokens :execrows
+DELETE FROM revoked_tokens
+WHERE expires_at <= @now::timestamptz;
+
+-- name: PurgeSessionRevocations :execrows
+DELETE FROM session_revocations
+WHERE expires_at <= @now::timestamptz;
@@ -0,0 +1,150 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/session/sqlc"
+)
+
+func (s *Storage) RevokeToken(ctx context.Context, token entity.AccessToken, revokedAt time.Time) error {
+	const op = "storage.session.RevokeToken"
+
+	params := sqlc.RevokeTokenParams{
+		Jti:       token.ID,
+		UserID:    token.UserID,
+		ExpiresAt: token.ExpiresAt,
+		RevokedAt: revokedAt,
+	}
+
+	if err := s.queries.RevokeToken(ctx, params); err != nil {
+		return fmt.Errorf("%s: failed to revoke token: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) RevokeRefreshTokenFamilyByToken(ctx context.Context, userID, tokenHash string, revokedAt time.Time) error {
+	const op = "storage.session.RevokeRefreshTokenFamilyByToken"
+
+	params := sqlc.RevokeRefreshTokenFamilyByTokenParams{
+		TokenHash: tokenHash,
+		UserID:    userID,
+		RevokedAt: pgtype.Timestamptz{Time: revokedAt, Valid: true},
+	}
+
+	if err := s.queries.RevokeRefreshTokenFamilyByToken(ctx, params); err != nil {
+		return fmt.Errorf("%s: failed to revoke refresh token family: %w", op, err)
+	}
+
+	return nil
+}
+
+// RevokeUserSessions rejects every access token issued so far and revokes all refresh tokens of the user.
+// It must be called within a transaction
+func (s *Storage) RevokeUserSessions(ctx context.Context, revocation entity.SessionRevocation) error {
+	const op = "storage.session.RevokeUserSessions"
+
+	return s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		if err := queries.UpsertSessionRevocation(ctx, sqlc.UpsertSessionRevocationParams{
+			UserID:        revocation.UserID,
+			RevokedBefore: revocation.RevokedBefore,
+			ExpiresAt:     revocation.ExpiresAt,
+		}); err != nil {
+			if storage.IsForeignKeyViolation(err) {
+				return storage.ErrUserNotFound
+			}
+			return fmt.Errorf("%s: failed to revoke sessions: %w", op, err)
+		}
+
+		if err := queries.RevokeUserRefreshTokens(ctx, sqlc.RevokeUserRefreshTokensParams{
+			UserID:    revocation.UserID,
+			RevokedAt: pgtype.Timestamptz{Time: revocation.RevokedBefore, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("%s: failed to revoke refresh tokens: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+func (s *Storage) ListRevocations(ctx context.Context, now time.Time) (entity.Revocations, error) {
+	const op = "storage.session.ListRevocations"
+
+	at := pgtype.Timestamptz{Time: now, Valid: true}
+
+	tokenRows, err := s.queries.ListRevokedTokens(ctx, at)
+	if err != nil {
+		return entity.Revocations{}, fmt.Errorf("%s: failed to list revoked tokens: %w", op, err)
+	}
+
+	sessionRows, err := s.queries.ListSessionRevocations(ctx, at)
+	if err != nil {
+		return entity.Revocations{}, fmt.Errorf("%s: failed to list session revocations: %w", op, err)
+	}
+
+	revocations := entity.Revocations{
+		Tokens:   make([]entity.AccessToken, len(tokenRows)),
+		Sessions: make([]entity.SessionRevocation, len(sessionRows)),
+	}
+
+	for i, row := range tokenRows {
+		revocations.Tokens[i] = entity.AccessToken{
+			ID:        row.Jti,
+			UserID:    row.UserID,
+			ExpiresAt: row.ExpiresAt,
+		}
+	}
+
+	for i, row := range sessionRows {
+		revocations.Sessions[i] = entity.SessionRevocation{
+			UserID:        row.UserID,
+			RevokedBefore: row.RevokedBefore,
+			ExpiresAt:     row.ExpiresAt,
+		}
+	}
+
+	return revocations, nil
+}
+
+// PurgeExpiredSessions deletes refresh tokens and revocations that no longer affect any valid token,
+// and single sign-on and second factor logins that were never completed
+func (s *Storage) PurgeExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	const op = "storage.session.PurgeExpiredSessions"
+
+	at := pgtype.Timestamptz{Time: now, Valid: true}
+
+	refreshTokens, err := s.queries.PurgeRefreshTokens(ctx, at)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to purge refresh tokens: %w", op, err)
+	}
+
+	revokedTokens, err := s.queries.PurgeRevokedTokens(ctx, at)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to purge revoked tokens: %w", op, err)
+	}
+
+	sessionRevocations, err := s.queries.PurgeSessionRevocations(ctx, at)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to purge session revocations: %w", op, err)
+	}
+
+	loginStates, err := s.queries.PurgeOIDCLoginStates(ctx, at)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to purge oidc login states: %w", op, err)
+	}
+
+	mfaChallenges, err := s.queries.PurgeMFAChallenges(ctx, at)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to purge mfa challenges: %w", op, err)
+	}
+
+	return int(refreshTokens + revokedTokens + sessionRevocations + loginStates + mfaChallenges), nil
+}
@@ -0,0 +1,128 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/session/sqlc"
+)
+
+type Storage struct {
+	pool    *pgxpool.Pool
+	txMgr   TransactionManager
+	queries *sqlc.Queries
+}
+
+type TransactionManager interface {
+	ExecWithinTx(ctx context.Context, fn func(tx pgx.Tx) error) error
+}
+
+func NewStorage(pool *pgxpool.Pool, txMgr TransactionManager) *Storage {
+	return &Storage{
+		pool:    pool,
+		txMgr:   txMgr,
+		queries: sqlc.New(pool),
+	}
+}
+
+func (s *Storage) CreateRefreshToken(ctx context.Context, token entity.RefreshToken) error {
+	const op = "storage.session.CreateRefreshToken"
+
+	if err := s.queries.CreateRefreshToken(ctx, toCreateRefreshTokenParams(token)); err != nil {
+		return fmt.Errorf("%s: failed to create refresh token: %w", op, err)
+	}
+
+	return nil
+}
+
+// LockRefreshToken must be called within a transaction
+func (s *Storage) LockRefreshToken(ctx context.Context, tokenHash string) (entity.RefreshToken, error) {
+	const op = "storage.session.LockRefreshToken"
+
+	var row sqlc.RefreshToken
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		row, err = s.queries.WithTx(tx).LockRefreshToken(ctx, tokenHash)
+		return err
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.RefreshToken{}, storage.ErrRefreshTokenNotFound
+		}
+		return entity.RefreshToken{}, fmt.Errorf("%s: failed to lock refresh token: %w", op, err)
+	}
+
+	return entity.RefreshToken{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		FamilyID:  row.FamilyID,
+		TokenHash: row.TokenHash,
+		ExpiresAt: row.ExpiresAt,
+		CreatedAt: row.CreatedAt,
+		RotatedAt: row.RotatedAt.Time,
+		RevokedAt: row.RevokedAt.Time,
+	}, nil
+}
+
+// RotateRefreshToken marks the token as used and stores its replacement. It must be called within a transaction
+func (s *Storage) RotateRefreshToken(ctx context.Context, tokenID string, replacement entity.RefreshToken) error {
+	const op = "storage.session.RotateRefreshToken"
+
+	return s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		rows, err := queries.MarkRefreshTokenRotated(ctx, sqlc.MarkRefreshTokenRotatedParams{
+			ID:        tokenID,
+			RotatedAt: pgtype.Timestamptz{Time: replacement.CreatedAt, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("%s: failed to mark refresh token rotated: %w", op, err)
+		}
+
+		if rows == 0 {
+			return storage.ErrRefreshTokenNotActive
+		}
+
+		if err = queries.CreateRefreshToken(ctx, toCreateRefreshTokenParams(replacement)); err != nil {
+			return fmt.Errorf("%s: failed to create refresh token: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+func (s *Storage) RevokeRefreshTokenFamily(ctx context.Context, familyID string, revokedAt time.Time) error {
+	const op = "storage.session.RevokeRefreshTokenFamily"
+
+	params := sqlc.RevokeRefreshTokenFamilyParams{
+		FamilyID:  familyID,
+		RevokedAt: pgtype.Timestamptz{Time: revokedAt, Valid: true},
+	}
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		_, err := s.queries.WithTx(tx).RevokeRefreshTokenFamily(ctx, params)
+		return err
+	}); err != nil {
+		return fmt.Errorf("%s: failed to revoke refresh token family: %w", op, err)
+	}
+
+	return nil
+}
+
+func toCreateRefreshTokenParams(token entity.RefreshToken) sqlc.CreateRefreshTokenParams {
+	return sqlc.CreateRefreshTokenParams{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		FamilyID:  token.FamilyID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: token.CreatedAt,
+	}
+}
@@ -0,0 +1,79 @@
+-- name: CreateTeam :exec
+INSERT INTO teams (id, name, department, balance, created_by, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetTeamByID :one
+SELECT id, name, department, balance, created_by, created_at, updated_at
+FROM teams
+WHERE id = $1;
+
+-- name: ListTeams :many
+SELECT id, name, department, balance, created_by, created_at, updated_at
+FROM teams
+ORDER BY name;
+
+-- name: ListUserTeams :many
+SELECT t.id, t.name, t.department, t.balance, t.created_by, t.created_at, t.updated_at
+FROM teams t
+    JOIN team_members m ON m.team_id = t.id
+WHERE m.user_id = $1
+ORDER BY t.name;
+
+-- name: UpsertTeamMember :exec
+INSERT INTO team_members (team_id, user_id, role, joined_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (team_id, user_id) DO UPDATE
+    SET role = EXCLUDED.role;
+
+-- name: DeleteTeamMember :execrows
+DELETE FROM team_members
+WHERE team_id = $1
+  AND user_id = $2;
+
+-- name: GetTeamMember :one
+SELECT
+    m.team_id,
+    m.user_id,
+    u.username,
+    COALESCE(p.display_name, '')::varchar AS display_name,
+    m.role,
+    m.joined_at
+FROM team_members m
+    JOIN users u ON u.id = m.user_id AND u.deleted_at IS NULL
+    LEFT JOIN user_profiles p ON p.user_id = m.user_id
+WHERE m.team_id = $1
+  AND m.user_id = $2;
+
+-- name: ListTeamMembers :many
+-- Deactivated users keep their membership rows but aren't listed
+SELECT
+    m.team_id,
+    m.user_id,
+    u.username,
+    COALESCE(p.display_name, '')::varchar AS display_name,
+    m.role,
+    m.joined_at
+FROM team_members m
+    JOIN users u ON u.id = m.user_id AND u.deleted_at IS NULL
+    LEFT JOIN user_profiles p ON p.user_id = m.user_id
+WHERE m.team_id = $1
+ORDER BY m.role, u.username;
+
+-- name: ListTeamTransactions :many
+-- The user party is the sender of a contribution or the receiver of a payout, purchases have none
+SELECT
+    t.id,
+    tt.title AS type,
+    COALESCE(u.username, '')::varchar AS username,
+    COALESCE(p.display_name, '')::varchar AS display_name,
+    COALESCE(u.deleted_at IS NOT NULL, false)::bool AS deactivated,
+    t.amount,
+    t.created_at AS date
+FROM transactions t
+    JOIN transaction_types tt ON tt.id = t.transaction_type_id
+    LEFT JOIN users u ON u.id = COALESCE(t.sender_id, t.receiver_id)
+    LEFT JOIN user_profiles p ON p.user_id = u.id
+WHERE t.sender_team_id = @team_id::varchar
+   OR t.receiver_team_id = @team_id::varchar
+ORDER BY t.created_at DESC
+LIMIT @max_results;
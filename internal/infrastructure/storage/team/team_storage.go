@@ -0,0 +1,225 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/team/sqlc"
+)
+
+type Storage struct {
+	pool    *pgxpool.Pool
+	txMgr   TransactionManager
+	queries *sqlc.Queries
+}
+
+type TransactionManager interface {
+	ExecWithinTx(ctx context.Context, fn func(tx pgx.Tx) error) error
+}
+
+func NewStorage(pool *pgxpool.Pool, txMgr TransactionManager) *Storage {
+	return &Storage{
+		pool:    pool,
+		txMgr:   txMgr,
+		queries: sqlc.New(pool),
+	}
+}
+
+func (s *Storage) CreateTeam(ctx context.Context, team entity.Team) error {
+	const op = "storage.team.CreateTeam"
+
+	if err := s.queries.CreateTeam(ctx, sqlc.CreateTeamParams{
+		ID:         team.ID,
+		Name:       team.Name,
+		Department: team.Department,
+		Balance:    int32(team.Balance),
+		CreatedBy:  team.CreatedBy,
+		CreatedAt:  team.CreatedAt,
+		UpdatedAt:  team.UpdatedAt,
+	}); err != nil {
+		if storage.IsUniqueViolation(err) {
+			return storage.ErrTeamExists
+		}
+		return fmt.Errorf("%s: failed to create team: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetTeamByID(ctx context.Context, teamID string) (entity.Team, error) {
+	const op = "storage.team.GetTeamByID"
+
+	row, err := s.queries.GetTeamByID(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Team{}, storage.ErrTeamNotFound
+		}
+		return entity.Team{}, fmt.Errorf("%s: failed to get team: %w", op, err)
+	}
+
+	return toTeam(row), nil
+}
+
+func (s *Storage) ListTeams(ctx context.Context) ([]entity.Team, error) {
+	const op = "storage.team.ListTeams"
+
+	rows, err := s.queries.ListTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list teams: %w", op, err)
+	}
+
+	return toTeams(rows), nil
+}
+
+func (s *Storage) ListUserTeams(ctx context.Context, userID string) ([]entity.Team, error) {
+	const op = "storage.team.ListUserTeams"
+
+	rows, err := s.queries.ListUserTeams(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list user teams: %w", op, err)
+	}
+
+	return toTeams(rows), nil
+}
+
+// SaveTeamMember adds the user to the team or changes the role of a member
+func (s *Storage) SaveTeamMember(ctx context.Context, member entity.TeamMember) error {
+	const op = "storage.team.SaveTeamMember"
+
+	if err := s.queries.UpsertTeamMember(ctx, sqlc.UpsertTeamMemberParams{
+		TeamID:   member.TeamID,
+		UserID:   member.UserID,
+		Role:     string(member.Role),
+		JoinedAt: member.JoinedAt,
+	}); err != nil {
+		if storage.IsForeignKeyViolation(err) {
+			return storage.ErrTeamNotFound
+		}
+		return fmt.Errorf("%s: failed to save team member: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) DeleteTeamMember(ctx context.Context, teamID, userID string) error {
+	const op = "storage.team.DeleteTeamMember"
+
+	rows, err := s.queries.DeleteTeamMember(ctx, sqlc.DeleteTeamMemberParams{
+		TeamID: teamID,
+		UserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to delete team member: %w", op, err)
+	}
+
+	if rows == 0 {
+		return storage.ErrTeamMemberNotFound
+	}
+
+	return nil
+}
+
+func (s *Storage) GetTeamMember(ctx context.Context, teamID, userID string) (entity.TeamMember, error) {
+	const op = "storage.team.GetTeamMember"
+
+	row, err := s.queries.GetTeamMember(ctx, sqlc.GetTeamMemberParams{
+		TeamID: teamID,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.TeamMember{}, storage.ErrTeamMemberNotFound
+		}
+		return entity.TeamMember{}, fmt.Errorf("%s: failed to get team member: %w", op, err)
+	}
+
+	return entity.TeamMember{
+		TeamID:      row.TeamID,
+		UserID:      row.UserID,
+		Username:    row.Username,
+		DisplayName: row.DisplayName,
+		Role:        entity.TeamRole(row.Role),
+		JoinedAt:    row.JoinedAt,
+	}, nil
+}
+
+func (s *Storage) ListTeamMembers(ctx context.Context, teamID string) ([]entity.TeamMember, error) {
+	const op = "storage.team.ListTeamMembers"
+
+	rows, err := s.queries.ListTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list team members: %w", op, err)
+	}
+
+	members := make([]entity.TeamMember, len(rows))
+	for i, row := range rows {
+		members[i] = entity.TeamMember{
+			TeamID:      row.TeamID,
+			UserID:      row.UserID,
+			Username:    row.Username,
+			DisplayName: row.DisplayName,
+			Role:        entity.TeamRole(row.Role),
+			JoinedAt:    row.JoinedAt,
+		}
+	}
+
+	return members, nil
+}
+
+// ListTeamTransactions returns the latest movements of the team wallet, newest first
+func (s *Storage) ListTeamTransactions(ctx context.Context, teamID string, limit int) ([]entity.TeamTransaction, error) {
+	const op = "storage.team.ListTeamTransactions"
+
+	rows, err := s.queries.ListTeamTransactions(ctx, sqlc.ListTeamTransactionsParams{
+		TeamID:     teamID,
+		MaxResults: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list team transactions: %w", op, err)
+	}
+
+	transactions := make([]entity.TeamTransaction, len(rows))
+	for i, row := range rows {
+		transactions[i] = entity.TeamTransaction{
+			ID:          row.ID,
+			Type:        entity.TransactionType(row.Type),
+			Username:    row.Username,
+			DisplayName: row.DisplayName,
+			Amount:      int(row.Amount),
+			Date:        row.Date,
+		}
+
+		if row.Deactivated {
+			transactions[i].Username = entity.DeactivatedUsername
+			transactions[i].DisplayName = ""
+		}
+	}
+
+	return transactions, nil
+}
+
+func toTeam(row sqlc.Team) entity.Team {
+	return entity.Team{
+		ID:         row.ID,
+		Name:       row.Name,
+		Department: row.Department,
+		Balance:    int(row.Balance),
+		CreatedBy:  row.CreatedBy,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
+
+func toTeams(rows []sqlc.Team) []entity.Team {
+	teams := make([]entity.Team, len(rows))
+	for i, row := range rows {
+		teams[i] = toTeam(row)
+	}
+
+	return teams
+}
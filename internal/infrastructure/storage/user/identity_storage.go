@@ -0,0 +1,56 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/user/sqlc"
+)
+
+func (s *Storage) GetUserIdentity(ctx context.Context, issuer, subject string) (entity.UserIdentity, error) {
+	const op = "storage.user.GetUserIdentity"
+
+	row, err := s.queries.GetUserIdentity(ctx, sqlc.GetUserIdentityParams{
+		Issuer:  issuer,
+		Subject: subject,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.UserIdentity{}, storage.ErrUserIdentityNotFound
+		}
+		return entity.UserIdentity{}, fmt.Errorf("%s: failed to get user identity: %w", op, err)
+	}
+
+	return entity.UserIdentity{
+		Issuer:    row.Issuer,
+		Subject:   row.Subject,
+		UserID:    row.UserID,
+		Email:     row.Email,
+		CreatedAt: row.CreatedAt,
+	}, nil
+}
+
+// CreateUserIdentity fails with ErrUserIdentityExists if the account is already linked
+// or the user is already linked to another account of the issuer
+func (s *Storage) CreateUserIdentity(ctx context.Context, identity entity.UserIdentity) error {
+	const op = "storage.user.CreateUserIdentity"
+
+	if err := s.queries.CreateUserIdentity(ctx, sqlc.CreateUserIdentityParams{
+		Issuer:    identity.Issuer,
+		Subject:   identity.Subject,
+		UserID:    identity.UserID,
+		Email:     identity.Email,
+		CreatedAt: identity.CreatedAt,
+	}); err != nil {
+		if storage.IsUniqueViolation(err) {
+			return storage.ErrUserIdentityExists
+		}
+		return fmt.Errorf("%s: failed to create user identity: %w", op, err)
+	}
+
+	return nil
+}
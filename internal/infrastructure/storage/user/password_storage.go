@@ -0,0 +1,133 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/user/sqlc"
+)
+
+func (s *Storage) GetUserByID(ctx context.Context, userID string) (entity.User, error) {
+	const op = "storage.user.GetUserByID"
+
+	user, err := s.queries.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.User{}, storage.ErrUserNotFound
+		}
+		return entity.User{}, fmt.Errorf("%s: failed to get user: %w", op, err)
+	}
+
+	return entity.User{
+		ID:           user.ID,
+		Username:     user.Username,
+		PasswordHash: user.PasswordHash,
+		Role:         entity.Role(user.Role),
+		Balance:      int(user.Balance),
+		CreatedAt:    user.CreatedAt,
+		UpdatedAt:    user.UpdatedAt,
+	}, nil
+}
+
+// UpdatePasswordHash must be called within a transaction
+func (s *Storage) UpdatePasswordHash(ctx context.Context, userID, passwordHash string, updatedAt time.Time) error {
+	const op = "storage.user.UpdatePasswordHash"
+
+	return s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		rows, err := s.queries.WithTx(tx).UpdatePasswordHash(ctx, sqlc.UpdatePasswordHashParams{
+			ID:           userID,
+			PasswordHash: passwordHash,
+			UpdatedAt:    updatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("%s: failed to update password hash: %w", op, err)
+		}
+
+		if rows == 0 {
+			return storage.ErrUserNotFound
+		}
+
+		return nil
+	})
+}
+
+// ReplacePasswordHash swaps the hash only if it hasn't changed since it was read,
+// so a password changed in the meantime isn't overwritten. It reports whether the hash was replaced
+func (s *Storage) ReplacePasswordHash(ctx context.Context, userID, oldHash, newHash string, updatedAt time.Time) (bool, error) {
+	const op = "storage.user.ReplacePasswordHash"
+
+	rows, err := s.queries.ReplacePasswordHash(ctx, sqlc.ReplacePasswordHashParams{
+		ID:        userID,
+		OldHash:   oldHash,
+		NewHash:   newHash,
+		UpdatedAt: updatedAt,
+	})
+	if err != nil {
+		return false, fmt.Errorf("%s: failed to replace password hash: %w", op, err)
+	}
+
+	return rows > 0, nil
+}
+
+// CreatePasswordResetToken invalidates the unused tokens of the user, so only the latest one works.
+// It must be called within a transaction
+func (s *Storage) CreatePasswordResetToken(ctx context.Context, token entity.PasswordResetToken) error {
+	const op = "storage.user.CreatePasswordResetToken"
+
+	return s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		if err := queries.InvalidatePasswordResetTokens(ctx, sqlc.InvalidatePasswordResetTokensParams{
+			UserID: token.UserID,
+			UsedAt: pgtype.Timestamptz{Time: token.CreatedAt, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("%s: failed to invalidate password reset tokens: %w", op, err)
+		}
+
+		if err := queries.CreatePasswordResetToken(ctx, sqlc.CreatePasswordResetTokenParams{
+			ID:        token.ID,
+			UserID:    token.UserID,
+			TokenHash: token.TokenHash,
+			CreatedBy: token.CreatedBy,
+			ExpiresAt: token.ExpiresAt,
+			CreatedAt: token.CreatedAt,
+		}); err != nil {
+			if storage.IsForeignKeyViolation(err) {
+				return storage.ErrUserNotFound
+			}
+			return fmt.Errorf("%s: failed to create password reset token: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+// UsePasswordResetToken marks the token as used and returns the ID of its user.
+// Unknown, used and expired tokens are not told apart. It must be called within a transaction
+func (s *Storage) UsePasswordResetToken(ctx context.Context, tokenHash string, usedAt time.Time) (string, error) {
+	const op = "storage.user.UsePasswordResetToken"
+
+	var userID string
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		userID, err = s.queries.WithTx(tx).UsePasswordResetToken(ctx, sqlc.UsePasswordResetTokenParams{
+			TokenHash: tokenHash,
+			UsedAt:    pgtype.Timestamptz{Time: usedAt, Valid: true},
+		})
+		return err
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", storage.ErrPasswordResetTokenInvalid
+		}
+		return "", fmt.Errorf("%s: failed to use password reset token: %w", op, err)
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,116 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/user/sqlc"
+)
+
+func (s *Storage) GetUserProfile(ctx context.Context, userID string) (entity.UserProfile, error) {
+	const op = "storage.user.GetUserProfile"
+
+	row, err := s.queries.GetUserProfile(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.UserProfile{}, storage.ErrUserNotFound
+		}
+		return entity.UserProfile{}, fmt.Errorf("%s: failed to get user profile: %w", op, err)
+	}
+
+	return entity.UserProfile{
+		UserID:      row.ID,
+		Username:    row.Username,
+		DisplayName: row.DisplayName,
+		Email:       row.Email,
+		Department:  row.Department,
+		Title:       row.Title,
+		AvatarURL:   row.AvatarUrl,
+		UpdatedAt:   row.UpdatedAt,
+	}, nil
+}
+
+func (s *Storage) UpdateUserProfile(ctx context.Context, userID string, update entity.UserProfileUpdate, updatedAt time.Time) error {
+	const op = "storage.user.UpdateUserProfile"
+
+	rows, err := s.queries.UpdateUserProfile(ctx, sqlc.UpdateUserProfileParams{
+		UserID:      userID,
+		DisplayName: optionalText(update.DisplayName),
+		Email:       optionalText(update.Email),
+		Department:  optionalText(update.Department),
+		Title:       optionalText(update.Title),
+		AvatarUrl:   optionalText(update.AvatarURL),
+		UpdatedAt:   pgtype.Timestamptz{Time: updatedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to update user profile: %w", op, err)
+	}
+
+	if rows == 0 {
+		return storage.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// optionalText passes nil as NULL, so the query keeps the current value
+func optionalText(value *string) pgtype.Text {
+	if value == nil {
+		return pgtype.Text{}
+	}
+
+	return pgtype.Text{String: *value, Valid: true}
+}
+
+func (s *Storage) SearchUsers(ctx context.Context, filter entity.UserFilter) (entity.UserPage, error) {
+	const op = "storage.user.SearchUsers"
+
+	params := sqlc.SearchUsersParams{
+		Query:      filter.Query,
+		Prefix:     likePrefix(filter.Query),
+		PageLimit:  int32(filter.Limit),
+		PageOffset: int32(filter.Offset),
+	}
+
+	if filter.Department != "" {
+		params.Department = pgtype.Text{String: filter.Department, Valid: true}
+	}
+
+	rows, err := s.queries.SearchUsers(ctx, params)
+	if err != nil {
+		return entity.UserPage{}, fmt.Errorf("%s: failed to search users: %w", op, err)
+	}
+
+	page := entity.UserPage{
+		Users: make([]entity.UserProfile, len(rows)),
+	}
+
+	for i, row := range rows {
+		page.Users[i] = entity.UserProfile{
+			UserID:      row.ID,
+			Username:    row.Username,
+			DisplayName: row.DisplayName,
+			Department:  row.Department,
+			Title:       row.Title,
+			AvatarURL:   row.AvatarUrl,
+		}
+		page.Total = int(row.Total)
+	}
+
+	return page, nil
+}
+
+// likePrefix builds an ILIKE pattern matching values that start with the query,
+// wildcards typed by the user are matched literally
+func likePrefix(query string) string {
+	return likeEscaper.Replace(query) + "%"
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
@@ -0,0 +1,13 @@
+-- name: GetUserIdentity :one
+SELECT issuer,
+       subject,
+       user_id,
+       email,
+       created_at
+FROM user_identities
+WHERE issuer = $1
+  AND subject = $2;
+
+-- name: CreateUserIdentity :exec
+INSERT INTO user_identities (issuer, subject, user_id, email, created_at)
+VALUES ($1, $2, $3, $4, $5);
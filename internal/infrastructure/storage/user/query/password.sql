@@ -0,0 +1,45 @@
+-- name: GetUserByID :one
+SELECT u.id,
+       u.username,
+       u.password_hash,
+       COALESCE(r.role, 'employee')::varchar AS role,
+       u.balance,
+       u.created_at,
+       u.updated_at
+FROM users u
+    LEFT JOIN user_roles r ON r.user_id = u.id
+WHERE u.id = $1
+  AND u.deleted_at IS NULL;
+
+-- name: UpdatePasswordHash :execrows
+UPDATE users
+SET password_hash = $2,
+    updated_at = $3
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: ReplacePasswordHash :execrows
+UPDATE users
+SET password_hash = @new_hash,
+    updated_at = @updated_at
+WHERE id = @id
+  AND password_hash = @old_hash
+  AND deleted_at IS NULL;
+
+-- name: CreatePasswordResetToken :exec
+INSERT INTO password_reset_tokens (id, user_id, token_hash, created_by, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: InvalidatePasswordResetTokens :exec
+UPDATE password_reset_tokens
+SET used_at = @used_at::timestamptz
+WHERE user_id = @user_id
+  AND used_at IS NULL;
+
+-- name: UsePasswordResetToken :one
+UPDATE password_reset_tokens
+SET used_at = @used_at::timestamptz
+WHERE token_hash = @token_hash
+  AND used_at IS NULL
+  AND expires_at > @used_at::timestamptz
+RETURNING user_id;
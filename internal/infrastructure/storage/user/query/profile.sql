@@ -0,0 +1,61 @@
+-- name: GetUserProfile :one
+SELECT u.id,
+       u.username,
+       COALESCE(p.display_name, '')::varchar AS display_name,
+       COALESCE(p.email, '')::varchar        AS email,
+       COALESCE(p.department, '')::varchar   AS department,
+       COALESCE(p.title, '')::varchar        AS title,
+       COALESCE(p.avatar_url, '')::varchar   AS avatar_url,
+       COALESCE(p.updated_at, u.created_at) AS updated_at
+FROM users u
+    LEFT JOIN user_profiles p ON p.user_id = u.id
+WHERE u.id = $1
+  AND u.deleted_at IS NULL;
+
+-- name: UpdateUserProfile :execrows
+-- Fields passed as NULL are left as they are
+INSERT INTO user_profiles (user_id, display_name, email, department, title, avatar_url, updated_at)
+SELECT id,
+       COALESCE(sqlc.narg(display_name)::varchar, ''),
+       COALESCE(sqlc.narg(email)::varchar, ''),
+       COALESCE(sqlc.narg(department)::varchar, ''),
+       COALESCE(sqlc.narg(title)::varchar, ''),
+       COALESCE(sqlc.narg(avatar_url)::varchar, ''),
+       @updated_at::timestamptz
+FROM users
+WHERE id = @user_id
+  AND deleted_at IS NULL
+ON CONFLICT (user_id) DO UPDATE
+    SET display_name = COALESCE(sqlc.narg(display_name)::varchar, user_profiles.display_name),
+        email        = COALESCE(sqlc.narg(email)::varchar, user_profiles.email),
+        department   = COALESCE(sqlc.narg(department)::varchar, user_profiles.department),
+        title        = COALESCE(sqlc.narg(title)::varchar, user_profiles.title),
+        avatar_url   = COALESCE(sqlc.narg(avatar_url)::varchar, user_profiles.avatar_url),
+        updated_at   = EXCLUDED.updated_at;
+
+-- name: SearchUsers :many
+-- Prefix matches come first, then the closest fuzzy matches
+SELECT u.id,
+       u.username,
+       COALESCE(p.display_name, '')::varchar AS display_name,
+       COALESCE(p.department, '')::varchar   AS department,
+       COALESCE(p.title, '')::varchar        AS title,
+       COALESCE(p.avatar_url, '')::varchar   AS avatar_url,
+       COUNT(*) OVER () AS total
+FROM users u
+    LEFT JOIN user_profiles p ON p.user_id = u.id
+WHERE u.deleted_at IS NULL
+  AND (sqlc.narg(department)::varchar IS NULL OR lower(p.department) = lower(sqlc.narg(department)::varchar))
+  AND (
+        @query::varchar = ''
+        OR u.username ILIKE @prefix::varchar
+        OR p.display_name ILIKE @prefix::varchar
+        OR u.username % @query::varchar
+        OR p.display_name % @query::varchar
+      )
+ORDER BY
+    COALESCE(u.username ILIKE @prefix::varchar OR p.display_name ILIKE @prefix::varchar, false) DESC,
+    GREATEST(similarity(u.username, @query::varchar), similarity(COALESCE(p.display_name, ''), @query::varchar)) DESC,
+    u.username
+LIMIT @page_limit::int
+OFFSET @page_offset::int;
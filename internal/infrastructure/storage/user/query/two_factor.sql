@@ -0,0 +1,53 @@
+-- name: GetUserTOTP :one
+SELECT user_id,
+       secret_encrypted,
+       last_used_step,
+       confirmed_at,
+       created_at
+FROM user_totp
+WHERE user_id = $1;
+
+-- name: UpsertUserTOTP :execrows
+-- An authenticator that is not confirmed yet is replaced by a new enrollment, a confirmed one is kept
+INSERT INTO user_totp (user_id, secret_encrypted, last_used_step, confirmed_at, created_at)
+VALUES (@user_id, @secret_encrypted, 0, NULL, @created_at)
+ON CONFLICT (user_id) DO UPDATE
+SET secret_encrypted = EXCLUDED.secret_encrypted,
+    last_used_step = 0,
+    created_at = EXCLUDED.created_at
+WHERE user_totp.confirmed_at IS NULL;
+
+-- name: ConfirmUserTOTP :execrows
+UPDATE user_totp
+SET confirmed_at = @confirmed_at,
+    last_used_step = @step
+WHERE user_id = @user_id
+    AND confirmed_at IS NULL
+    AND last_used_step < @step;
+
+-- name: UseTOTPStep :execrows
+-- A code is accepted once, concurrent requests with the same code can't both move the step forward
+UPDATE user_totp
+SET last_used_step = @step
+WHERE user_id = @user_id
+    AND confirmed_at IS NOT NULL
+    AND last_used_step < @step;
+
+-- name: DeleteUserTOTP :exec
+DELETE FROM user_totp
+WHERE user_id = $1;
+
+-- name: CreateRecoveryCode :exec
+INSERT INTO user_recovery_codes (id, user_id, code_hash, created_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: UseRecoveryCode :execrows
+UPDATE user_recovery_codes
+SET used_at = @used_at
+WHERE user_id = @user_id
+    AND code_hash = @code_hash
+    AND used_at IS NULL;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM user_recovery_codes
+WHERE user_id = $1;
@@ -14,16 +14,20 @@ SELECT m.name as type,
 FROM purchases p
     JOIN merch m ON p.merch_id = m.id AND m.deleted_at IS NULL
 WHERE p.user_id = $1
+    AND p.returned_at IS NULL
 GROUP BY m.name;
 
 -- name: GetReceivedTransactions :many
 SELECT
     sender.username as from_user,
+    COALESCE(p.display_name, '')::varchar as from_display_name,
+    (sender.deleted_at IS NOT NULL)::bool as from_deactivated,
     t.receiver_id as to_user,
     t.amount,
     t.created_at as date
 FROM transactions t
-    JOIN users sender ON t.sender_id = sender.id AND sender.deleted_at IS NULL
+    JOIN users sender ON t.sender_id = sender.id
+    LEFT JOIN user_profiles p ON p.user_id = sender.id
 WHERE t.receiver_id = $1
     AND t.transaction_type_id = 0;  -- only coin transfers
 
@@ -31,10 +35,13 @@ WHERE t.receiver_id = $1
 SELECT
     t.sender_id as from_user,
     receiver.username as to_user,
+    COALESCE(p.display_name, '')::varchar as to_display_name,
+    (receiver.deleted_at IS NOT NULL)::bool as to_deactivated,
     t.amount,
     t.created_at as date
 FROM transactions t
-    JOIN users receiver ON t.receiver_id = receiver.id AND receiver.deleted_at IS NULL
+    JOIN users receiver ON t.receiver_id = receiver.id
+    LEFT JOIN user_profiles p ON p.user_id = receiver.id
 WHERE t.sender_id = $1
     AND t.transaction_type_id = 0;  -- only coin transfers
 
@@ -45,12 +52,54 @@ WHERE username = $1
     AND deleted_at IS NULL;
 
 -- name: GetUserByUsername :one
-SELECT id,
-       username,
-       password_hash,
-       balance,
-       created_at,
-       updated_at
+SELECT u.id,
+       u.username,
+       u.password_hash,
+       COALESCE(r.role, 'employee')::varchar AS role,
+       u.balance,
+       u.created_at,
+       u.updated_at
+FROM users u
+    LEFT JOIN user_roles r ON r.user_id = u.id
+WHERE u.username = $1
+  AND u.deleted_at IS NULL;
+-- name: GetUserRole :one
+SELECT COALESCE(r.role, 'employee')::varchar AS role
+FROM users u
+    LEFT JOIN user_roles r ON r.user_id = u.id
+WHERE u.id = $1
+  AND u.deleted_at IS NULL;
+
+-- name: SetUserRole :execrows
+INSERT INTO user_roles (user_id, role, updated_at)
+SELECT id, @role::varchar, @updated_at::timestamptz
 FROM users
-WHERE username = $1
-  AND deleted_at IS NULL;
\ No newline at end of file
+WHERE id = @user_id
+  AND deleted_at IS NULL
+ON CONFLICT (user_id) DO UPDATE
+    SET role = EXCLUDED.role,
+        updated_at = EXCLUDED.updated_at;
+
+-- name: UserWithRoleExists :one
+SELECT EXISTS (
+    SELECT 1
+    FROM user_roles r
+        JOIN users u ON u.id = r.user_id AND u.deleted_at IS NULL
+    WHERE r.role = $1
+);
+
+-- name: DeactivateUser :one
+-- The row is locked before it's updated, so the returned balance is exactly what the user had left
+UPDATE users
+SET deleted_at = @deactivated_at::timestamptz,
+    updated_at = @deactivated_at::timestamptz,
+    balance    = CASE WHEN @keep_balance::bool THEN users.balance ELSE 0 END
+FROM (
+    SELECT u.id, u.balance
+    FROM users u
+    WHERE u.id = @id
+      AND u.deleted_at IS NULL
+    FOR UPDATE
+) AS previous
+WHERE users.id = previous.id
+RETURNING previous.balance;
@@ -11,15 +11,43 @@ import (
 )
 
 type Querier interface {
+	ConfirmUserTOTP(ctx context.Context, arg ConfirmUserTOTPParams) (int64, error)
+	CreatePasswordResetToken(ctx context.Context, arg CreatePasswordResetTokenParams) error
+	CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) error
+	CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) error
+	// The row is locked before it's updated, so the returned balance is exactly what the user had left
+	DeactivateUser(ctx context.Context, arg DeactivateUserParams) (int32, error)
+	DeleteRecoveryCodes(ctx context.Context, userID string) error
+	DeleteUserTOTP(ctx context.Context, userID string) error
 	GetReceivedTransactions(ctx context.Context, receiverID pgtype.Text) ([]GetReceivedTransactionsRow, error)
 	// only coin transfers
-	GetSentTransactions(ctx context.Context, senderID string) ([]GetSentTransactionsRow, error)
+	GetSentTransactions(ctx context.Context, senderID pgtype.Text) ([]GetSentTransactionsRow, error)
 	GetUserBalanceByID(ctx context.Context, id string) (GetUserBalanceByIDRow, error)
+	GetUserByID(ctx context.Context, id string) (GetUserByIDRow, error)
 	GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error)
 	// only coin transfers
 	GetUserIDByUsername(ctx context.Context, username string) (string, error)
+	GetUserIdentity(ctx context.Context, arg GetUserIdentityParams) (UserIdentity, error)
 	GetUserInventory(ctx context.Context, userID string) ([]GetUserInventoryRow, error)
+	GetUserProfile(ctx context.Context, id string) (GetUserProfileRow, error)
+	GetUserRole(ctx context.Context, id string) (string, error)
+	GetUserTOTP(ctx context.Context, userID string) (UserTotp, error)
+	InvalidatePasswordResetTokens(ctx context.Context, arg InvalidatePasswordResetTokensParams) error
+	ReplacePasswordHash(ctx context.Context, arg ReplacePasswordHashParams) (int64, error)
+	// Prefix matches come first, then the closest fuzzy matches
+	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]SearchUsersRow, error)
+	SetUserRole(ctx context.Context, arg SetUserRoleParams) (int64, error)
+	UpdatePasswordHash(ctx context.Context, arg UpdatePasswordHashParams) (int64, error)
+	// Fields passed as NULL are left as they are
+	UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (int64, error)
+	// An authenticator that is not confirmed yet is replaced by a new enrollment, a confirmed one is kept
+	UpsertUserTOTP(ctx context.Context, arg UpsertUserTOTPParams) (int64, error)
+	UsePasswordResetToken(ctx context.Context, arg UsePasswordResetTokenParams) (string, error)
+	UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error)
+	// A code is accepted once, concurrent requests with the same code can't both move the step forward
+	UseTOTPStep(ctx context.Context, arg UseTOTPStepParams) (int64, error)
+	UserWithRoleExists(ctx context.Context, role string) (bool, error)
 }
 
 var _ Querier = (*Queries)(nil)
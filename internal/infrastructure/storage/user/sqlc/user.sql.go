@@ -38,23 +38,58 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 	return err
 }
 
+const deactivateUser = `-- name: DeactivateUser :one
+UPDATE users
+SET deleted_at = $1::timestamptz,
+    updated_at = $1::timestamptz,
+    balance    = CASE WHEN $2::bool THEN users.balance ELSE 0 END
+FROM (
+    SELECT u.id, u.balance
+    FROM users u
+    WHERE u.id = $3
+      AND u.deleted_at IS NULL
+    FOR UPDATE
+) AS previous
+WHERE users.id = previous.id
+RETURNING previous.balance
+`
+
+type DeactivateUserParams struct {
+	DeactivatedAt pgtype.Timestamptz `db:"deactivated_at"`
+	KeepBalance   bool               `db:"keep_balance"`
+	ID            string             `db:"id"`
+}
+
+// The row is locked before it's updated, so the returned balance is exactly what the user had left
+func (q *Queries) DeactivateUser(ctx context.Context, arg DeactivateUserParams) (int32, error) {
+	row := q.db.QueryRow(ctx, deactivateUser, arg.DeactivatedAt, arg.KeepBalance, arg.ID)
+	var balance int32
+	err := row.Scan(&balance)
+	return balance, err
+}
+
 const getReceivedTransactions = `-- name: GetReceivedTransactions :many
 SELECT
     sender.username as from_user,
+    COALESCE(p.display_name, '')::varchar as from_display_name,
+    (sender.deleted_at IS NOT NULL)::bool as from_deactivated,
     t.receiver_id as to_user,
     t.amount,
     t.created_at as date
 FROM transactions t
-    JOIN users sender ON t.sender_id = sender.id AND sender.deleted_at IS NULL
+    JOIN users sender ON t.sender_id = sender.id
+    LEFT JOIN user_profiles p ON p.user_id = sender.id
 WHERE t.receiver_id = $1
     AND t.transaction_type_id = 0
 `
 
 type GetReceivedTransactionsRow struct {
-	FromUser string      `db:"from_user"`
-	ToUser   pgtype.Text `db:"to_user"`
-	Amount   int32       `db:"amount"`
-	Date     time.Time   `db:"date"`
+	FromUser        string      `db:"from_user"`
+	FromDisplayName string      `db:"from_display_name"`
+	FromDeactivated bool        `db:"from_deactivated"`
+	ToUser          pgtype.Text `db:"to_user"`
+	Amount          int32       `db:"amount"`
+	Date            time.Time   `db:"date"`
 }
 
 func (q *Queries) GetReceivedTransactions(ctx context.Context, receiverID pgtype.Text) ([]GetReceivedTransactionsRow, error) {
@@ -68,6 +103,8 @@ func (q *Queries) GetReceivedTransactions(ctx context.Context, receiverID pgtype
 		var i GetReceivedTransactionsRow
 		if err := rows.Scan(
 			&i.FromUser,
+			&i.FromDisplayName,
+			&i.FromDeactivated,
 			&i.ToUser,
 			&i.Amount,
 			&i.Date,
@@ -87,23 +124,28 @@ const getSentTransactions = `-- name: GetSentTransactions :many
 SELECT
     t.sender_id as from_user,
     receiver.username as to_user,
+    COALESCE(p.display_name, '')::varchar as to_display_name,
+    (receiver.deleted_at IS NOT NULL)::bool as to_deactivated,
     t.amount,
     t.created_at as date
 FROM transactions t
-    JOIN users receiver ON t.receiver_id = receiver.id AND receiver.deleted_at IS NULL
+    JOIN users receiver ON t.receiver_id = receiver.id
+    LEFT JOIN user_profiles p ON p.user_id = receiver.id
 WHERE t.sender_id = $1
     AND t.transaction_type_id = 0
 `
 
 type GetSentTransactionsRow struct {
-	FromUser string    `db:"from_user"`
-	ToUser   string    `db:"to_user"`
-	Amount   int32     `db:"amount"`
-	Date     time.Time `db:"date"`
+	FromUser      pgtype.Text `db:"from_user"`
+	ToUser        string      `db:"to_user"`
+	ToDisplayName string      `db:"to_display_name"`
+	ToDeactivated bool        `db:"to_deactivated"`
+	Amount        int32       `db:"amount"`
+	Date          time.Time   `db:"date"`
 }
 
 // only coin transfers
-func (q *Queries) GetSentTransactions(ctx context.Context, senderID string) ([]GetSentTransactionsRow, error) {
+func (q *Queries) GetSentTransactions(ctx context.Context, senderID pgtype.Text) ([]GetSentTransactionsRow, error) {
 	rows, err := q.db.Query(ctx, getSentTransactions, senderID)
 	if err != nil {
 		return nil, err
@@ -115,6 +157,8 @@ func (q *Queries) GetSentTransactions(ctx context.Context, senderID string) ([]G
 		if err := rows.Scan(
 			&i.FromUser,
 			&i.ToUser,
+			&i.ToDisplayName,
+			&i.ToDeactivated,
 			&i.Amount,
 			&i.Date,
 		); err != nil {
@@ -148,21 +192,24 @@ func (q *Queries) GetUserBalanceByID(ctx context.Context, id string) (GetUserBal
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id,
-       username,
-       password_hash,
-       balance,
-       created_at,
-       updated_at
-FROM users
-WHERE username = $1
-  AND deleted_at IS NULL
+SELECT u.id,
+       u.username,
+       u.password_hash,
+       COALESCE(r.role, 'employee')::varchar AS role,
+       u.balance,
+       u.created_at,
+       u.updated_at
+FROM users u
+    LEFT JOIN user_roles r ON r.user_id = u.id
+WHERE u.username = $1
+  AND u.deleted_at IS NULL
 `
 
 type GetUserByUsernameRow struct {
 	ID           string    `db:"id"`
 	Username     string    `db:"username"`
 	PasswordHash string    `db:"password_hash"`
+	Role         string    `db:"role"`
 	Balance      int32     `db:"balance"`
 	CreatedAt    time.Time `db:"created_at"`
 	UpdatedAt    time.Time `db:"updated_at"`
@@ -175,6 +222,7 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (GetUs
 		&i.ID,
 		&i.Username,
 		&i.PasswordHash,
+		&i.Role,
 		&i.Balance,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -204,6 +252,7 @@ SELECT m.name as type,
 FROM purchases p
     JOIN merch m ON p.merch_id = m.id AND m.deleted_at IS NULL
 WHERE p.user_id = $1
+    AND p.returned_at IS NULL
 GROUP BY m.name
 `
 
@@ -231,3 +280,59 @@ func (q *Queries) GetUserInventory(ctx context.Context, userID string) ([]GetUse
 	}
 	return items, nil
 }
+
+const getUserRole = `-- name: GetUserRole :one
+SELECT COALESCE(r.role, 'employee')::varchar AS role
+FROM users u
+    LEFT JOIN user_roles r ON r.user_id = u.id
+WHERE u.id = $1
+  AND u.deleted_at IS NULL
+`
+
+func (q *Queries) GetUserRole(ctx context.Context, id string) (string, error) {
+	row := q.db.QueryRow(ctx, getUserRole, id)
+	var role string
+	err := row.Scan(&role)
+	return role, err
+}
+
+const setUserRole = `-- name: SetUserRole :execrows
+INSERT INTO user_roles (user_id, role, updated_at)
+SELECT id, $1::varchar, $2::timestamptz
+FROM users
+WHERE id = $3
+  AND deleted_at IS NULL
+ON CONFLICT (user_id) DO UPDATE
+    SET role = EXCLUDED.role,
+        updated_at = EXCLUDED.updated_at
+`
+
+type SetUserRoleParams struct {
+	Role      string             `db:"role"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at"`
+	UserID    string             `db:"user_id"`
+}
+
+func (q *Queries) SetUserRole(ctx context.Context, arg SetUserRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserRole, arg.Role, arg.UpdatedAt, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const userWithRoleExists = `-- name: UserWithRoleExists :one
+SELECT EXISTS (
+    SELECT 1
+    FROM user_roles r
+        JOIN users u ON u.id = r.user_id AND u.deleted_at IS NULL
+    WHERE r.role = $1
+)
+`
+
+func (q *Queries) UserWithRoleExists(ctx context.Context, role string) (bool, error) {
+	row := q.db.QueryRow(ctx, userWithRoleExists, role)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
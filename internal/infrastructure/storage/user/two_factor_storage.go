@@ -0,0 +1,167 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage"
+	"github.com/rshelekhov/merch-store/internal/infrastructure/storage/user/sqlc"
+)
+
+func (s *Storage) GetUserTOTP(ctx context.Context, userID string) (entity.TOTPAuthenticator, error) {
+	const op = "storage.user.GetUserTOTP"
+
+	row, err := s.queries.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.TOTPAuthenticator{}, storage.ErrTOTPNotFound
+		}
+		return entity.TOTPAuthenticator{}, fmt.Errorf("%s: failed to get totp authenticator: %w", op, err)
+	}
+
+	authenticator := entity.TOTPAuthenticator{
+		UserID:          row.UserID,
+		SecretEncrypted: row.SecretEncrypted,
+		LastUsedStep:    row.LastUsedStep,
+		CreatedAt:       row.CreatedAt,
+	}
+
+	if row.ConfirmedAt.Valid {
+		authenticator.ConfirmedAt = row.ConfirmedAt.Time
+	}
+
+	return authenticator, nil
+}
+
+// UpsertUserTOTP saves a new authenticator in place of an unconfirmed one.
+// It reports false if the user already has a confirmed authenticator, which is left as is
+func (s *Storage) UpsertUserTOTP(ctx context.Context, authenticator entity.TOTPAuthenticator) (bool, error) {
+	const op = "storage.user.UpsertUserTOTP"
+
+	rows, err := s.queries.UpsertUserTOTP(ctx, sqlc.UpsertUserTOTPParams{
+		UserID:          authenticator.UserID,
+		SecretEncrypted: authenticator.SecretEncrypted,
+		CreatedAt:       authenticator.CreatedAt,
+	})
+	if err != nil {
+		if storage.IsForeignKeyViolation(err) {
+			return false, storage.ErrUserNotFound
+		}
+		return false, fmt.Errorf("%s: failed to save totp authenticator: %w", op, err)
+	}
+
+	return rows > 0, nil
+}
+
+// ConfirmUserTOTP enables the authenticator with the step of the code that confirmed it
+// and replaces the recovery codes of the user. It reports false if the authenticator is already
+// confirmed or the step has been used. It must be called within a transaction
+func (s *Storage) ConfirmUserTOTP(
+	ctx context.Context,
+	userID string,
+	step int64,
+	recoveryCodes []entity.RecoveryCode,
+	confirmedAt time.Time,
+) (bool, error) {
+	const op = "storage.user.ConfirmUserTOTP"
+
+	var confirmed bool
+
+	err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		rows, err := queries.ConfirmUserTOTP(ctx, sqlc.ConfirmUserTOTPParams{
+			ConfirmedAt: pgtype.Timestamptz{Time: confirmedAt, Valid: true},
+			Step:        step,
+			UserID:      userID,
+		})
+		if err != nil {
+			return fmt.Errorf("%s: failed to confirm totp authenticator: %w", op, err)
+		}
+
+		if rows == 0 {
+			return nil
+		}
+
+		if err = queries.DeleteRecoveryCodes(ctx, userID); err != nil {
+			return fmt.Errorf("%s: failed to delete recovery codes: %w", op, err)
+		}
+
+		for _, code := range recoveryCodes {
+			if err = queries.CreateRecoveryCode(ctx, sqlc.CreateRecoveryCodeParams{
+				ID:        code.ID,
+				UserID:    code.UserID,
+				CodeHash:  code.CodeHash,
+				CreatedAt: code.CreatedAt,
+			}); err != nil {
+				return fmt.Errorf("%s: failed to create recovery code: %w", op, err)
+			}
+		}
+
+		confirmed = true
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return confirmed, nil
+}
+
+// UseTOTPStep records the step of an accepted code. It reports false if the step,
+// or a later one, has already been used
+func (s *Storage) UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error) {
+	const op = "storage.user.UseTOTPStep"
+
+	rows, err := s.queries.UseTOTPStep(ctx, sqlc.UseTOTPStepParams{
+		Step:   step,
+		UserID: userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("%s: failed to use totp step: %w", op, err)
+	}
+
+	return rows > 0, nil
+}
+
+// UseRecoveryCode marks the code as used. It reports false if the user has no such unused code
+func (s *Storage) UseRecoveryCode(ctx context.Context, userID, codeHash string, usedAt time.Time) (bool, error) {
+	const op = "storage.user.UseRecoveryCode"
+
+	rows, err := s.queries.UseRecoveryCode(ctx, sqlc.UseRecoveryCodeParams{
+		UsedAt:   pgtype.Timestamptz{Time: usedAt, Valid: true},
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		return false, fmt.Errorf("%s: failed to use recovery code: %w", op, err)
+	}
+
+	return rows > 0, nil
+}
+
+// DeleteUserTOTP removes the authenticator and the recovery codes of the user.
+// It must be called within a transaction
+func (s *Storage) DeleteUserTOTP(ctx context.Context, userID string) error {
+	const op = "storage.user.DeleteUserTOTP"
+
+	return s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		if err := queries.DeleteRecoveryCodes(ctx, userID); err != nil {
+			return fmt.Errorf("%s: failed to delete recovery codes: %w", op, err)
+		}
+
+		if err := queries.DeleteUserTOTP(ctx, userID); err != nil {
+			return fmt.Errorf("%s: failed to delete totp authenticator: %w", op, err)
+		}
+
+		return nil
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
+
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/jackc/pgx/v5"
@@ -15,12 +17,18 @@ import (
 
 type Storage struct {
 	pool    *pgxpool.Pool
+	txMgr   TransactionManager
 	queries *sqlc.Queries
 }
 
-func NewStorage(pool *pgxpool.Pool) *Storage {
+type TransactionManager interface {
+	ExecWithinTx(ctx context.Context, fn func(tx pgx.Tx) error) error
+}
+
+func NewStorage(pool *pgxpool.Pool, txMgr TransactionManager) *Storage {
 	return &Storage{
 		pool:    pool,
+		txMgr:   txMgr,
 		queries: sqlc.New(pool),
 	}
 }
@@ -38,6 +46,9 @@ func (s *Storage) CreateUser(ctx context.Context, user entity.User) error {
 	}
 
 	if err := s.queries.CreateUser(ctx, params); err != nil {
+		if storage.IsUniqueViolation(err) {
+			return storage.ErrUserAlreadyExists
+		}
 		return fmt.Errorf("%s: failed to create user: %w", op, err)
 	}
 
@@ -59,12 +70,84 @@ func (s *Storage) GetUserByName(ctx context.Context, username string) (entity.Us
 		ID:           user.ID,
 		Username:     user.Username,
 		PasswordHash: user.PasswordHash,
+		Role:         entity.Role(user.Role),
 		Balance:      int(user.Balance),
 		CreatedAt:    user.CreatedAt,
 		UpdatedAt:    user.UpdatedAt,
 	}, nil
 }
 
+func (s *Storage) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	const op = "storage.user.GetUserRole"
+
+	role, err := s.queries.GetUserRole(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", storage.ErrUserNotFound
+		}
+		return "", fmt.Errorf("%s: failed to get user role: %w", op, err)
+	}
+
+	return entity.Role(role), nil
+}
+
+func (s *Storage) SetUserRole(ctx context.Context, userID string, role entity.Role, updatedAt time.Time) error {
+	const op = "storage.user.SetUserRole"
+
+	rows, err := s.queries.SetUserRole(ctx, sqlc.SetUserRoleParams{
+		UserID:    userID,
+		Role:      string(role),
+		UpdatedAt: pgtype.Timestamptz{Time: updatedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to set user role: %w", op, err)
+	}
+
+	if rows == 0 {
+		return storage.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// DeactivateUser sets deleted_at and returns the balance the user had left. Unless keepBalance is set
+// the balance is zeroed, the caller moves the coins elsewhere. It must be called within a transaction
+func (s *Storage) DeactivateUser(ctx context.Context, userID string, keepBalance bool, deactivatedAt time.Time) (int, error) {
+	const op = "storage.user.DeactivateUser"
+
+	var balance int32
+
+	if err := s.txMgr.ExecWithinTx(ctx, func(tx pgx.Tx) error {
+		var err error
+
+		balance, err = s.queries.WithTx(tx).DeactivateUser(ctx, sqlc.DeactivateUserParams{
+			ID:            userID,
+			KeepBalance:   keepBalance,
+			DeactivatedAt: pgtype.Timestamptz{Time: deactivatedAt, Valid: true},
+		})
+
+		return err
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, storage.ErrUserNotFound
+		}
+		return 0, fmt.Errorf("%s: failed to deactivate user: %w", op, err)
+	}
+
+	return int(balance), nil
+}
+
+func (s *Storage) UserWithRoleExists(ctx context.Context, role entity.Role) (bool, error) {
+	const op = "storage.user.UserWithRoleExists"
+
+	exists, err := s.queries.UserWithRoleExists(ctx, string(role))
+	if err != nil {
+		return false, fmt.Errorf("%s: failed to check user with role: %w", op, err)
+	}
+
+	return exists, nil
+}
+
 func (s *Storage) GetUserInfoByID(ctx context.Context, userID string) (entity.UserInfo, error) {
 	const op = "storage.user.GetUserInfoByID"
 
@@ -107,7 +190,10 @@ func (s *Storage) getUserInfoByID(ctx context.Context, userID, op string) (entit
 		return entity.UserInfo{}, fmt.Errorf("%s: failed to get received transactions: %w", op, err)
 	}
 
-	sentTxs, err := s.queries.GetSentTransactions(ctx, userID)
+	sentTxs, err := s.queries.GetSentTransactions(ctx, pgtype.Text{
+		String: userID,
+		Valid:  true,
+	})
 	if err != nil {
 		return entity.UserInfo{}, fmt.Errorf("%s: failed to get sent transactions: %w", op, err)
 	}
@@ -133,20 +219,32 @@ func (s *Storage) assembleUserInfo(
 	received := make([]entity.Transaction, len(receivedTxs))
 	for i, tx := range receivedTxs {
 		received[i] = entity.Transaction{
-			FromUser: tx.FromUser,
-			ToUser:   tx.ToUser.String,
-			Amount:   int(tx.Amount),
-			Date:     tx.Date,
+			FromUser:        tx.FromUser,
+			FromDisplayName: tx.FromDisplayName,
+			ToUser:          tx.ToUser.String,
+			Amount:          int(tx.Amount),
+			Date:            tx.Date,
+		}
+
+		if tx.FromDeactivated {
+			received[i].FromUser = entity.DeactivatedUsername
+			received[i].FromDisplayName = ""
 		}
 	}
 
 	sent := make([]entity.Transaction, len(sentTxs))
 	for i, tx := range sentTxs {
 		sent[i] = entity.Transaction{
-			FromUser: tx.FromUser,
-			ToUser:   tx.ToUser,
-			Amount:   int(tx.Amount),
-			Date:     tx.Date,
+			FromUser:      tx.FromUser.String,
+			ToUser:        tx.ToUser,
+			ToDisplayName: tx.ToDisplayName,
+			Amount:        int(tx.Amount),
+			Date:          tx.Date,
+		}
+
+		if tx.ToDeactivated {
+			sent[i].ToUser = entity.DeactivatedUsername
+			sent[i].ToDisplayName = ""
 		}
 	}
 
@@ -0,0 +1,91 @@
+// Package draw implements a commit-reveal random draw.
+//
+// A random seed is generated up front and only its SHA-256 commitment is published.
+// Once the draw is done the seed is revealed, and anyone holding the ordered list of
+// entries can check the commitment and replay Winners to get the same result.
+package draw
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+const seedSize = 32
+
+var ErrInvalidSeed = errors.New("invalid seed")
+
+// NewSeed returns a random hex-encoded seed
+func NewSeed() (string, error) {
+	seed := make([]byte, seedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return "", fmt.Errorf("failed to generate seed: %w", err)
+	}
+
+	return hex.EncodeToString(seed), nil
+}
+
+// Commit returns the hex-encoded SHA-256 of the seed bytes
+func Commit(seed string) (string, error) {
+	raw, err := hex.DecodeString(seed)
+	if err != nil || len(raw) != seedSize {
+		return "", ErrInvalidSeed
+	}
+
+	sum := sha256.Sum256(raw)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Verify reports whether the seed matches the published commitment
+func Verify(seed, commitment string) bool {
+	expected, err := Commit(seed)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal([]byte(expected), []byte(commitment))
+}
+
+// Winners picks up to count distinct owners from the entries and returns the indexes of the
+// winning entries in draw order. Each owner can win only once, no matter how many entries they hold.
+//
+// Round i draws HMAC-SHA256(seed, salt || i) and takes its first 8 bytes modulo the number of
+// entries still in the draw. The entries of the winner are then removed before the next round
+func Winners(seed, salt string, owners []string, count int) ([]int, error) {
+	raw, err := hex.DecodeString(seed)
+	if err != nil || len(raw) != seedSize {
+		return nil, ErrInvalidSeed
+	}
+
+	remaining := make([]int, len(owners))
+	for i := range owners {
+		remaining[i] = i
+	}
+
+	winners := make([]int, 0, count)
+
+	for round := 0; len(winners) < count && len(remaining) > 0; round++ {
+		mac := hmac.New(sha256.New, raw)
+		_, _ = fmt.Fprintf(mac, "%s:%d", salt, round)
+
+		pick := binary.BigEndian.Uint64(mac.Sum(nil)[:8]) % uint64(len(remaining))
+		winner := remaining[pick]
+
+		winners = append(winners, winner)
+
+		kept := remaining[:0]
+		for _, idx := range remaining {
+			if owners[idx] != owners[winner] {
+				kept = append(kept, idx)
+			}
+		}
+		remaining = kept
+	}
+
+	return winners, nil
+}
@@ -0,0 +1,73 @@
+package draw
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitAndVerify(t *testing.T) {
+	seed, err := NewSeed()
+	require.NoError(t, err)
+
+	commitment, err := Commit(seed)
+	require.NoError(t, err)
+
+	require.True(t, Verify(seed, commitment))
+
+	otherSeed, err := NewSeed()
+	require.NoError(t, err)
+	require.False(t, Verify(otherSeed, commitment))
+
+	_, err = Commit("not-a-seed")
+	require.ErrorIs(t, err, ErrInvalidSeed)
+}
+
+func TestWinners(t *testing.T) {
+	seed := strings.Repeat("ab", seedSize)
+	owners := []string{"alice", "bob", "alice", "carol", "bob", "dave"}
+
+	tests := []struct {
+		name          string
+		count         int
+		expectedCount int
+	}{
+		{
+			name:          "Fewer winners than owners",
+			count:         2,
+			expectedCount: 2,
+		},
+		{
+			name:          "More winners than owners",
+			count:         10,
+			expectedCount: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			winners, err := Winners(seed, "raffle-id", owners, tt.count)
+			require.NoError(t, err)
+			require.Len(t, winners, tt.expectedCount)
+
+			seen := make(map[string]bool)
+			for _, idx := range winners {
+				require.False(t, seen[owners[idx]], "owner %s won twice", owners[idx])
+				seen[owners[idx]] = true
+			}
+
+			// The draw must be reproducible from the revealed seed
+			replay, err := Winners(seed, "raffle-id", owners, tt.count)
+			require.NoError(t, err)
+			require.Equal(t, winners, replay)
+		})
+	}
+
+	winners, err := Winners(seed, "raffle-id", nil, 3)
+	require.NoError(t, err)
+	require.Empty(t, winners)
+
+	_, err = Winners("short", "raffle-id", owners, 1)
+	require.ErrorIs(t, err, ErrInvalidSeed)
+}
@@ -0,0 +1,102 @@
+// Package encryption encrypts small secrets for storage with AES-256-GCM. Keys are versioned:
+// values are encrypted with the current key and carry its version, so old keys can be kept
+// for decryption while new values use a new one
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// KeySize is the size of AES-256 keys
+const KeySize = 32
+
+var (
+	ErrUnknownKeyVersion = errors.New("unknown encryption key version")
+	ErrDecryptionFailed  = errors.New("failed to decrypt value")
+)
+
+type Keyring struct {
+	aeads   map[string]cipher.AEAD
+	current string
+}
+
+// NewKeyring takes the keys by version and the version new values are encrypted with
+func NewKeyring(keys map[string][]byte, current string) (*Keyring, error) {
+	if _, ok := keys[current]; !ok {
+		return nil, fmt.Errorf("current key version %q is not among the keys", current)
+	}
+
+	aeads := make(map[string]cipher.AEAD, len(keys))
+
+	for version, key := range keys {
+		if version == "" || strings.Contains(version, ":") {
+			return nil, fmt.Errorf("invalid key version %q", version)
+		}
+
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("key %q must be %d bytes long", version, KeySize)
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+
+		aeads[version] = aead
+	}
+
+	return &Keyring{
+		aeads:   aeads,
+		current: current,
+	}, nil
+}
+
+// Encrypt returns "version:ciphertext". The associated data isn't stored, the same data must be
+// passed to Decrypt. Binding the value to e.g. the ID of its owner stops it from being copied to another row
+func (k *Keyring) Encrypt(plaintext, associatedData []byte) (string, error) {
+	aead := k.aeads[k.current]
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, plaintext, associatedData)
+
+	return k.current + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (k *Keyring) Decrypt(value string, associatedData []byte) ([]byte, error) {
+	version, encoded, ok := strings.Cut(value, ":")
+	if !ok {
+		return nil, ErrDecryptionFailed
+	}
+
+	aead, ok := k.aeads[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKeyVersion, version)
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return nil, ErrDecryptionFailed
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], associatedData)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	return plaintext, nil
+}
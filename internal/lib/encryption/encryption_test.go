@@ -0,0 +1,117 @@
+package encryption_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rshelekhov/merch-store/internal/lib/encryption"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	keyV1 = bytes.Repeat([]byte{1}, encryption.KeySize)
+	keyV2 = bytes.Repeat([]byte{2}, encryption.KeySize)
+)
+
+func TestKeyring_EncryptDecrypt(t *testing.T) {
+	keyring, err := encryption.NewKeyring(map[string][]byte{"v1": keyV1}, "v1")
+	require.NoError(t, err)
+
+	value, err := keyring.Encrypt([]byte("JBSWY3DPEHPK3PXP"), []byte("user-1"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(value, "v1:"))
+	require.NotContains(t, value, "JBSWY3DPEHPK3PXP")
+
+	plaintext, err := keyring.Decrypt(value, []byte("user-1"))
+	require.NoError(t, err)
+	require.Equal(t, "JBSWY3DPEHPK3PXP", string(plaintext))
+
+	another, err := keyring.Encrypt([]byte("JBSWY3DPEHPK3PXP"), []byte("user-1"))
+	require.NoError(t, err)
+	require.NotEqual(t, value, another, "nonces must be random")
+}
+
+func TestKeyring_Decrypt(t *testing.T) {
+	old, err := encryption.NewKeyring(map[string][]byte{"v1": keyV1}, "v1")
+	require.NoError(t, err)
+
+	value, err := old.Encrypt([]byte("secret"), []byte("user-1"))
+	require.NoError(t, err)
+
+	// Flipping a character of the ciphertext must break the authentication tag
+	replacement := "A"
+	if value[10] == 'A' {
+		replacement = "B"
+	}
+	tampered := value[:10] + replacement + value[11:]
+
+	rotated, err := encryption.NewKeyring(map[string][]byte{"v1": keyV1, "v2": keyV2}, "v2")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		keyring        *encryption.Keyring
+		value          string
+		associatedData string
+		expectedError  error
+	}{
+		{
+			name:           "Success – Old key after rotation",
+			keyring:        rotated,
+			value:          value,
+			associatedData: "user-1",
+			expectedError:  nil,
+		},
+		{
+			name:           "Error – Another owner",
+			keyring:        rotated,
+			value:          value,
+			associatedData: "user-2",
+			expectedError:  encryption.ErrDecryptionFailed,
+		},
+		{
+			name:           "Error – Tampered value",
+			keyring:        rotated,
+			value:          tampered,
+			associatedData: "user-1",
+			expectedError:  encryption.ErrDecryptionFailed,
+		},
+		{
+			name:           "Error – Unknown key version",
+			keyring:        rotated,
+			value:          "v3" + strings.TrimPrefix(value, "v1"),
+			associatedData: "user-1",
+			expectedError:  encryption.ErrUnknownKeyVersion,
+		},
+		{
+			name:           "Error – No version",
+			keyring:        rotated,
+			value:          "garbage",
+			associatedData: "user-1",
+			expectedError:  encryption.ErrDecryptionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plaintext, err := tt.keyring.Decrypt(tt.value, []byte(tt.associatedData))
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "secret", string(plaintext))
+		})
+	}
+}
+
+func TestNewKeyring(t *testing.T) {
+	_, err := encryption.NewKeyring(map[string][]byte{"v1": keyV1}, "v2")
+	require.Error(t, err)
+
+	_, err = encryption.NewKeyring(map[string][]byte{"v1": []byte("too short")}, "v1")
+	require.Error(t, err)
+}
@@ -0,0 +1,329 @@
+// Package jwk holds the keys used to sign and verify access tokens.
+//
+// HS256 uses a single shared secret. RS256 and EdDSA use a set of keys loaded from PEM files
+// and identified by kid: one private key signs new tokens, the others only verify tokens
+// issued before a rotation. Public parts of all asymmetric keys are published as a JWK Set,
+// so other services can verify tokens without the secret.
+//
+// Rotation with an overlap window:
+//  1. Add the new private key, keep signing with the old one. The new key gets published.
+//  2. Once verifiers have refreshed the JWK Set, make the new key the signing key.
+//  3. Move the old key to the public keys and remove it after the access token TTL has passed.
+package jwk
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+
+	// KeyIDHeader is the JWT header with the id of the signing key
+	KeyIDHeader = "kid"
+
+	minRSAKeyBits = 2048
+)
+
+var (
+	ErrUnexpectedSigningMethod = errors.New("unexpected signing method")
+	ErrKeyIDMissing            = errors.New("kid header is missing")
+	ErrUnknownKeyID            = errors.New("unknown kid")
+)
+
+type Config struct {
+	// Algorithm is one of HS256, RS256 or EdDSA
+	Algorithm string
+	// Secret is the HS256 shared secret
+	Secret string
+	// SigningKeyID selects the private key used for new tokens. It may be empty if there is only one
+	SigningKeyID string
+	// PrivateKeys are "kid=path" entries pointing to PEM-encoded private keys
+	PrivateKeys []string
+	// PublicKeys are "kid=path" entries pointing to PEM-encoded public keys of retired keys,
+	// they are only used to verify tokens during the overlap window
+	PublicKeys []string
+}
+
+type Set struct {
+	method  jwt.SigningMethod
+	secret  []byte
+	signing *key
+	keys    map[string]*key
+}
+
+type key struct {
+	id      string
+	private crypto.PrivateKey
+	public  crypto.PublicKey
+}
+
+// PublicKey is a public key in the JWK format (RFC 7517)
+type PublicKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	// RSA
+	Modulus  string `json:"n,omitempty"`
+	Exponent string `json:"e,omitempty"`
+	// Ed25519
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+func New(cfg Config) (*Set, error) {
+	switch cfg.Algorithm {
+	case AlgorithmHS256:
+		return newSymmetric(cfg)
+	case AlgorithmRS256, AlgorithmEdDSA:
+		return newAsymmetric(cfg)
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", cfg.Algorithm)
+	}
+}
+
+func newSymmetric(cfg Config) (*Set, error) {
+	if cfg.Secret == "" {
+		return nil, errors.New("secret is required for HS256")
+	}
+
+	if len(cfg.PrivateKeys) > 0 || len(cfg.PublicKeys) > 0 {
+		return nil, errors.New("key files are not used with HS256, set an asymmetric algorithm")
+	}
+
+	return &Set{
+		method: jwt.SigningMethodHS256,
+		secret: []byte(cfg.Secret),
+	}, nil
+}
+
+func newAsymmetric(cfg Config) (*Set, error) {
+	set := &Set{
+		method: jwt.GetSigningMethod(cfg.Algorithm),
+		keys:   make(map[string]*key),
+	}
+
+	for _, entry := range cfg.PrivateKeys {
+		k, err := loadKey(entry, cfg.Algorithm, parsePrivateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = set.add(k); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, entry := range cfg.PublicKeys {
+		k, err := loadKey(entry, cfg.Algorithm, parsePublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = set.add(k); err != nil {
+			return nil, err
+		}
+	}
+
+	signingKeyID := cfg.SigningKeyID
+	if signingKeyID == "" && len(cfg.PrivateKeys) == 1 {
+		signingKeyID, _, _ = strings.Cut(cfg.PrivateKeys[0], "=")
+	}
+
+	signing, ok := set.keys[signingKeyID]
+	if !ok || signing.private == nil {
+		return nil, fmt.Errorf("signing key %q is not among the private keys", signingKeyID)
+	}
+
+	set.signing = signing
+
+	return set, nil
+}
+
+func (s *Set) add(k *key) error {
+	if _, ok := s.keys[k.id]; ok {
+		return fmt.Errorf("duplicate kid %q", k.id)
+	}
+
+	s.keys[k.id] = k
+
+	return nil
+}
+
+type parseFunc func(data []byte, algorithm string) (crypto.PrivateKey, crypto.PublicKey, error)
+
+func loadKey(entry, algorithm string, parse parseFunc) (*key, error) {
+	id, path, ok := strings.Cut(entry, "=")
+	id, path = strings.TrimSpace(id), strings.TrimSpace(path)
+	if !ok || id == "" || path == "" {
+		return nil, fmt.Errorf("invalid key entry %q, expected kid=path", entry)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key %q: %w", id, err)
+	}
+
+	private, public, err := parse(data, algorithm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key %q: %w", id, err)
+	}
+
+	return &key{
+		id:      id,
+		private: private,
+		public:  public,
+	}, nil
+}
+
+func parsePrivateKey(data []byte, algorithm string) (crypto.PrivateKey, crypto.PublicKey, error) {
+	if algorithm == AlgorithmRS256 {
+		private, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err = checkRSAKeySize(&private.PublicKey); err != nil {
+			return nil, nil, err
+		}
+
+		return private, &private.PublicKey, nil
+	}
+
+	private, err := jwt.ParseEdPrivateKeyFromPEM(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	edPrivate, ok := private.(ed25519.PrivateKey)
+	if !ok {
+		return nil, nil, errors.New("not an Ed25519 private key")
+	}
+
+	return edPrivate, edPrivate.Public(), nil
+}
+
+func parsePublicKey(data []byte, algorithm string) (crypto.PrivateKey, crypto.PublicKey, error) {
+	if algorithm == AlgorithmRS256 {
+		public, err := jwt.ParseRSAPublicKeyFromPEM(data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err = checkRSAKeySize(public); err != nil {
+			return nil, nil, err
+		}
+
+		return nil, public, nil
+	}
+
+	public, err := jwt.ParseEdPublicKeyFromPEM(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := public.(ed25519.PublicKey); !ok {
+		return nil, nil, errors.New("not an Ed25519 public key")
+	}
+
+	return nil, public, nil
+}
+
+func checkRSAKeySize(public *rsa.PublicKey) error {
+	if public.N.BitLen() < minRSAKeyBits {
+		return fmt.Errorf("RSA key must be at least %d bits", minRSAKeyBits)
+	}
+
+	return nil
+}
+
+// Sign signs the claims with the current signing key
+func (s *Set) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.method, claims)
+
+	if s.signing == nil {
+		return token.SignedString(s.secret)
+	}
+
+	token.Header[KeyIDHeader] = s.signing.id
+
+	return token.SignedString(s.signing.private)
+}
+
+// Keyfunc returns the key to verify the token with. It's meant to be passed to jwt.Parse
+func (s *Set) Keyfunc(token *jwt.Token) (any, error) {
+	if token.Method.Alg() != s.method.Alg() {
+		return nil, ErrUnexpectedSigningMethod
+	}
+
+	if s.signing == nil {
+		return s.secret, nil
+	}
+
+	keyID, _ := token.Header[KeyIDHeader].(string)
+	if keyID == "" {
+		return nil, ErrKeyIDMissing
+	}
+
+	k, ok := s.keys[keyID]
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+
+	return k.public, nil
+}
+
+// Methods returns the signing methods accepted by Keyfunc
+func (s *Set) Methods() []string {
+	return []string{s.method.Alg()}
+}
+
+// PublicKeys returns all verification keys sorted by kid. It's empty for HS256,
+// since the secret can't be published
+func (s *Set) PublicKeys() []PublicKey {
+	keys := make([]PublicKey, 0, len(s.keys))
+
+	for _, k := range s.keys {
+		keys = append(keys, s.toPublicKey(k))
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].KeyID < keys[j].KeyID
+	})
+
+	return keys
+}
+
+func (s *Set) toPublicKey(k *key) PublicKey {
+	publicKey := PublicKey{
+		Use:       "sig",
+		Algorithm: s.method.Alg(),
+		KeyID:     k.id,
+	}
+
+	switch public := k.public.(type) {
+	case *rsa.PublicKey:
+		publicKey.KeyType = "RSA"
+		publicKey.Modulus = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		publicKey.Exponent = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		publicKey.KeyType = "OKP"
+		publicKey.Curve = "Ed25519"
+		publicKey.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+
+	return publicKey
+}
@@ -0,0 +1,298 @@
+package jwk
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSet_SignAndVerify(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{
+			name: "HS256",
+			cfg: Config{
+				Algorithm: AlgorithmHS256,
+				Secret:    "secret",
+			},
+		},
+		{
+			name: "RS256",
+			cfg: Config{
+				Algorithm:   AlgorithmRS256,
+				PrivateKeys: []string{"rsa-1=" + writeRSAKey(t, dir, "rsa-1")},
+			},
+		},
+		{
+			name: "EdDSA",
+			cfg: Config{
+				Algorithm:   AlgorithmEdDSA,
+				PrivateKeys: []string{"ed-1=" + writeEd25519Key(t, dir, "ed-1")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := New(tt.cfg)
+			require.NoError(t, err)
+
+			tokenString, err := set.Sign(testClaims())
+			require.NoError(t, err)
+
+			token, err := jwt.Parse(tokenString, set.Keyfunc, jwt.WithValidMethods(set.Methods()))
+			require.NoError(t, err)
+			require.True(t, token.Valid)
+			require.Equal(t, tt.cfg.Algorithm, token.Method.Alg())
+		})
+	}
+}
+
+func TestSet_Rotation(t *testing.T) {
+	dir := t.TempDir()
+
+	oldKey := writeRSAKey(t, dir, "old")
+	newKey := writeRSAKey(t, dir, "new")
+
+	before, err := New(Config{
+		Algorithm:   AlgorithmRS256,
+		PrivateKeys: []string{"old=" + oldKey},
+	})
+	require.NoError(t, err)
+
+	oldToken, err := before.Sign(testClaims())
+	require.NoError(t, err)
+
+	// The old key is retired, only its public part is kept for the overlap window
+	after, err := New(Config{
+		Algorithm:    AlgorithmRS256,
+		SigningKeyID: "new",
+		PrivateKeys:  []string{"new=" + newKey},
+		PublicKeys:   []string{"old=" + writeRSAPublicKey(t, dir, "old", oldKey)},
+	})
+	require.NoError(t, err)
+
+	newToken, err := after.Sign(testClaims())
+	require.NoError(t, err)
+
+	for _, tokenString := range []string{oldToken, newToken} {
+		token, err := jwt.Parse(tokenString, after.Keyfunc, jwt.WithValidMethods(after.Methods()))
+		require.NoError(t, err)
+		require.True(t, token.Valid)
+	}
+
+	// Tokens signed with the new key are unknown to instances that haven't rotated yet
+	_, err = jwt.Parse(newToken, before.Keyfunc, jwt.WithValidMethods(before.Methods()))
+	require.ErrorIs(t, err, ErrUnknownKeyID)
+
+	publicKeys := after.PublicKeys()
+	require.Len(t, publicKeys, 2)
+	require.Equal(t, "new", publicKeys[0].KeyID)
+	require.Equal(t, "old", publicKeys[1].KeyID)
+}
+
+func TestSet_Keyfunc(t *testing.T) {
+	dir := t.TempDir()
+
+	set, err := New(Config{
+		Algorithm:   AlgorithmRS256,
+		PrivateKeys: []string{"rsa-1=" + writeRSAKey(t, dir, "rsa-1")},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		token         *jwt.Token
+		expectedError error
+	}{
+		{
+			name:          "Unexpected signing method",
+			token:         &jwt.Token{Method: jwt.SigningMethodHS256, Header: map[string]any{KeyIDHeader: "rsa-1"}},
+			expectedError: ErrUnexpectedSigningMethod,
+		},
+		{
+			name:          "Missing kid",
+			token:         &jwt.Token{Method: jwt.SigningMethodRS256, Header: map[string]any{}},
+			expectedError: ErrKeyIDMissing,
+		},
+		{
+			name:          "Unknown kid",
+			token:         &jwt.Token{Method: jwt.SigningMethodRS256, Header: map[string]any{KeyIDHeader: "rsa-2"}},
+			expectedError: ErrUnknownKeyID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := set.Keyfunc(tt.token)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestSet_PublicKeys(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaSet, err := New(Config{
+		Algorithm:   AlgorithmRS256,
+		PrivateKeys: []string{"rsa-1=" + writeRSAKey(t, dir, "rsa-1")},
+	})
+	require.NoError(t, err)
+
+	rsaKeys := rsaSet.PublicKeys()
+	require.Len(t, rsaKeys, 1)
+	require.Equal(t, "RSA", rsaKeys[0].KeyType)
+	require.Equal(t, "RS256", rsaKeys[0].Algorithm)
+	require.Equal(t, "AQAB", rsaKeys[0].Exponent)
+	require.NotEmpty(t, rsaKeys[0].Modulus)
+
+	edSet, err := New(Config{
+		Algorithm:   AlgorithmEdDSA,
+		PrivateKeys: []string{"ed-1=" + writeEd25519Key(t, dir, "ed-1")},
+	})
+	require.NoError(t, err)
+
+	edKeys := edSet.PublicKeys()
+	require.Len(t, edKeys, 1)
+	require.Equal(t, "OKP", edKeys[0].KeyType)
+	require.Equal(t, "Ed25519", edKeys[0].Curve)
+	require.NotEmpty(t, edKeys[0].X)
+
+	hsSet, err := New(Config{Algorithm: AlgorithmHS256, Secret: "secret"})
+	require.NoError(t, err)
+	require.Empty(t, hsSet.PublicKeys())
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaKey := writeRSAKey(t, dir, "rsa-1")
+	edKey := writeEd25519Key(t, dir, "ed-1")
+
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{
+			name: "Unsupported algorithm",
+			cfg:  Config{Algorithm: "none"},
+		},
+		{
+			name: "HS256 without secret",
+			cfg:  Config{Algorithm: AlgorithmHS256},
+		},
+		{
+			name: "HS256 with key files",
+			cfg:  Config{Algorithm: AlgorithmHS256, Secret: "secret", PrivateKeys: []string{"rsa-1=" + rsaKey}},
+		},
+		{
+			name: "No private keys",
+			cfg:  Config{Algorithm: AlgorithmRS256},
+		},
+		{
+			name: "Ambiguous signing key",
+			cfg:  Config{Algorithm: AlgorithmRS256, PrivateKeys: []string{"a=" + rsaKey, "b=" + rsaKey}},
+		},
+		{
+			name: "Signing key is public only",
+			cfg: Config{
+				Algorithm:    AlgorithmRS256,
+				SigningKeyID: "old",
+				PrivateKeys:  []string{"new=" + rsaKey},
+				PublicKeys:   []string{"old=" + writeRSAPublicKey(t, dir, "old", rsaKey)},
+			},
+		},
+		{
+			name: "Duplicate kid",
+			cfg:  Config{Algorithm: AlgorithmRS256, SigningKeyID: "a", PrivateKeys: []string{"a=" + rsaKey, "a=" + rsaKey}},
+		},
+		{
+			name: "Malformed entry",
+			cfg:  Config{Algorithm: AlgorithmRS256, PrivateKeys: []string{rsaKey}},
+		},
+		{
+			name: "Missing file",
+			cfg:  Config{Algorithm: AlgorithmRS256, PrivateKeys: []string{"a=" + filepath.Join(dir, "missing.pem")}},
+		},
+		{
+			name: "Key type doesn't match algorithm",
+			cfg:  Config{Algorithm: AlgorithmRS256, PrivateKeys: []string{"ed-1=" + edKey}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.cfg)
+			require.Error(t, err)
+		})
+	}
+}
+
+func testClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"uid": "test-user-id",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func writeRSAKey(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	private, err := rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+
+	return writePEM(t, dir, name+".pem", "PRIVATE KEY", der)
+}
+
+func writeRSAPublicKey(t *testing.T, dir, name, privatePath string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(privatePath)
+	require.NoError(t, err)
+
+	private, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&private.PublicKey)
+	require.NoError(t, err)
+
+	return writePEM(t, dir, name+".pub.pem", "PUBLIC KEY", der)
+}
+
+func writeEd25519Key(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+
+	return writePEM(t, dir, name+".pem", "PRIVATE KEY", der)
+}
+
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600)
+	require.NoError(t, err)
+
+	return path
+}
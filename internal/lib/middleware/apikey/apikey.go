@@ -0,0 +1,53 @@
+package apikey
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+// Scheme is the authorization scheme of API keys: "Authorization: ApiKey <key>"
+const Scheme = "ApiKey"
+
+type manager struct {
+	authenticator Authenticator
+}
+
+type (
+	Manager interface {
+		HTTPMiddleware(next http.Handler) http.Handler
+	}
+
+	Authenticator interface {
+		AuthenticateAPIKey(ctx context.Context, apiKey string) (entity.APIKey, error)
+	}
+)
+
+func NewManager(authenticator Authenticator) Manager {
+	return &manager{
+		authenticator: authenticator,
+	}
+}
+
+// HasAPIKey reports whether the request is authorized with an API key rather than a bearer token
+func HasAPIKey(r *http.Request) bool {
+	_, ok := fromHeader(r)
+	return ok
+}
+
+func fromHeader(r *http.Request) (string, bool) {
+	scheme, apiKey, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, Scheme) {
+		return "", false
+	}
+
+	return strings.TrimSpace(apiKey), true
+}
+
+func (m *manager) toContext(ctx context.Context, key entity.APIKey) context.Context {
+	ctx = context.WithValue(ctx, domain.ServiceAccountIDKey, key.ServiceAccountID)
+	return context.WithValue(ctx, domain.APIKeyKey, key)
+}
@@ -0,0 +1,42 @@
+package apikey
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/rshelekhov/merch-store/internal/domain"
+)
+
+func (m *manager) HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiKey, ok := fromHeader(r)
+		if !ok || apiKey == "" {
+			handleResponseError(w, r, http.StatusUnauthorized, "invalid authorization header in http request")
+			return
+		}
+
+		key, err := m.authenticator.AuthenticateAPIKey(r.Context(), apiKey)
+		if err != nil {
+			if errors.Is(err, domain.ErrInvalidAPIKey) {
+				handleResponseError(w, r, http.StatusUnauthorized, domain.ErrInvalidAPIKey.Error())
+				return
+			}
+
+			handleResponseError(w, r, http.StatusInternalServerError, domain.ErrFailedToAuthenticateAPIKey.Error())
+			return
+		}
+
+		ctx := m.toContext(r.Context(), key)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func handleResponseError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	render.Status(r, status)
+	render.JSON(w, r, ErrorResponse{Error: message})
+}
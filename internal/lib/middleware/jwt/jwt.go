@@ -3,26 +3,42 @@ package jwt
 import (
 	"context"
 	"net/http"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
 )
 
 type manager struct {
-	secret string
+	keys        KeySet
+	revocations RevocationChecker
 }
 
 type (
 	Manager interface {
 		HTTPMiddleware(next http.Handler) http.Handler
 	}
+
+	// KeySet selects the verification key by the kid header, see jwk.Set
+	KeySet interface {
+		Keyfunc(token *jwt.Token) (any, error)
+		Methods() []string
+	}
+
+	RevocationChecker interface {
+		IsTokenRevoked(tokenID, userID string, issuedAt time.Time) bool
+	}
 )
 
-func NewManager(secret string) Manager {
+func NewManager(keys KeySet, revocations RevocationChecker) Manager {
 	return &manager{
-		secret: secret,
+		keys:        keys,
+		revocations: revocations,
 	}
 }
 
-func (m *manager) toContext(ctx context.Context, value string) context.Context {
-	return context.WithValue(ctx, domain.UserIDKey, value)
+func (m *manager) toContext(ctx context.Context, token entity.AccessToken) context.Context {
+	ctx = context.WithValue(ctx, domain.UserIDKey, token.UserID)
+	return context.WithValue(ctx, domain.AccessTokenKey, token)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/go-chi/render"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
 )
 
 func (m *manager) HTTPMiddleware(next http.Handler) http.Handler {
@@ -25,12 +26,7 @@ func (m *manager) HTTPMiddleware(next http.Handler) http.Handler {
 		}
 
 		claims := jwt.MapClaims{}
-		token, err := jwt.ParseWithClaims(tokenStr, claims, func(t *jwt.Token) (interface{}, error) {
-			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, errors.New("unexpected signing method")
-			}
-			return []byte(m.secret), nil
-		})
+		token, err := jwt.ParseWithClaims(tokenStr, claims, m.keys.Keyfunc, jwt.WithValidMethods(m.keys.Methods()))
 		if err != nil {
 			handleResponseError(w, err.Error())
 			return
@@ -47,11 +43,52 @@ func (m *manager) HTTPMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		ctx := m.toContext(r.Context(), userID)
+		accessToken, err := toAccessToken(claims, userID)
+		if err != nil {
+			handleResponseError(w, "invalid token claims")
+			return
+		}
+
+		if m.revocations.IsTokenRevoked(accessToken.ID, accessToken.UserID, accessToken.IssuedAt) {
+			handleResponseError(w, "token has been revoked")
+			return
+		}
+
+		ctx := m.toContext(r.Context(), accessToken)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// toAccessToken reads the claims needed for revocation. Tokens issued before jti was added
+// don't have it, they can still be revoked together with all sessions of the user
+func toAccessToken(claims jwt.MapClaims, userID string) (entity.AccessToken, error) {
+	issuedAt, err := claims.GetIssuedAt()
+	if err != nil || issuedAt == nil {
+		return entity.AccessToken{}, errors.New("iat claim is missing")
+	}
+
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil || expiresAt == nil {
+		return entity.AccessToken{}, errors.New("exp claim is missing")
+	}
+
+	tokenID, _ := claims[domain.TokenIDKey].(string)
+
+	// Tokens issued before roles were added belong to employees
+	role := entity.RoleEmployee
+	if claim, ok := claims[domain.RoleKey].(string); ok && claim != "" {
+		role = entity.Role(claim)
+	}
+
+	return entity.AccessToken{
+		ID:        tokenID,
+		UserID:    userID,
+		Role:      role,
+		IssuedAt:  issuedAt.Time,
+		ExpiresAt: expiresAt.Time,
+	}, nil
+}
+
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
@@ -0,0 +1,97 @@
+package rbac
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/rshelekhov/merch-store/internal/domain"
+	"github.com/rshelekhov/merch-store/internal/domain/entity"
+)
+
+type manager struct {
+	log *slog.Logger
+}
+
+type (
+	Manager interface {
+		Require(roles ...entity.Role) func(next http.Handler) http.Handler
+		RequireScope(scope entity.Scope, roles ...entity.Role) func(next http.Handler) http.Handler
+	}
+)
+
+func NewManager(log *slog.Logger) Manager {
+	return &manager{
+		log: log,
+	}
+}
+
+// Require allows the request if the user has one of the roles, superadmins are always allowed.
+// It must be used after the JWT middleware, since it relies on the access token from the context
+func (m *manager) Require(roles ...entity.Role) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := r.Context().Value(domain.AccessTokenKey).(entity.AccessToken)
+			if !ok {
+				handleResponseError(w, r, http.StatusUnauthorized, domain.ErrAccessTokenNotFoundInContext.Error())
+				return
+			}
+
+			if !token.Role.HasAnyOf(roles...) {
+				m.log.Warn("access denied",
+					slog.String("userID", token.UserID),
+					slog.String("role", string(token.Role)),
+					slog.String("path", r.URL.Path),
+				)
+				handleResponseError(w, r, http.StatusForbidden, "insufficient role")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireScope opens a route to service accounts whose API key has the scope. Users are allowed
+// if they have one of the roles, or always if no roles are given. It must be used after
+// a middleware that accepts both access tokens and API keys
+func (m *manager) RequireScope(scope entity.Scope, roles ...entity.Role) func(next http.Handler) http.Handler {
+	requireRole := m.Require(roles...)
+
+	return func(next http.Handler) http.Handler {
+		userNext := next
+		if len(roles) > 0 {
+			userNext = requireRole(next)
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key, ok := r.Context().Value(domain.APIKeyKey).(entity.APIKey)
+			if !ok {
+				userNext.ServeHTTP(w, r)
+				return
+			}
+
+			if !key.HasScope(scope) {
+				m.log.Warn("access denied",
+					slog.String("serviceAccountID", key.ServiceAccountID),
+					slog.String("keyID", key.ID),
+					slog.String("scope", string(scope)),
+					slog.String("path", r.URL.Path),
+				)
+				handleResponseError(w, r, http.StatusForbidden, "insufficient scope")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func handleResponseError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	render.Status(r, status)
+	render.JSON(w, r, ErrorResponse{Error: message})
+}
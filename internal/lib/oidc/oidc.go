@@ -0,0 +1,256 @@
+// Package oidc is a client of an OpenID Connect provider for the authorization code flow with PKCE.
+//
+// The provider metadata is discovered from the issuer on first use and cached. ID tokens are verified
+// against the provider's JWK Set, which is fetched again when a token is signed with an unknown kid,
+// so key rotations at the provider are picked up without a restart.
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DiscoveryPath is appended to the issuer URL to get the provider metadata
+	DiscoveryPath = "/.well-known/openid-configuration"
+
+	// CodeChallengeMethodS256 is the only PKCE method used, plain challenges give no protection
+	CodeChallengeMethodS256 = "S256"
+
+	ScopeOpenID = "openid"
+
+	defaultHTTPTimeout = 10 * time.Second
+
+	// maxResponseSize limits what is read from the provider
+	maxResponseSize = 1 << 20
+)
+
+var (
+	ErrDiscoveryFailed = errors.New("failed to discover provider metadata")
+	ErrIssuerMismatch  = errors.New("issuer in provider metadata doesn't match the configured one")
+	ErrExchangeFailed  = errors.New("failed to exchange authorization code")
+	// ErrCodeRejected is returned when the provider refuses the code, e.g. it's expired or already used
+	ErrCodeRejected    = errors.New("authorization code rejected by the provider")
+	ErrIDTokenMissing  = errors.New("token response has no id_token")
+	ErrInvalidIDToken  = errors.New("invalid id token")
+	ErrJWKSFetchFailed = errors.New("failed to fetch provider keys")
+)
+
+type Config struct {
+	// IssuerURL is the issuer identifier of the provider, it must match the iss claim of ID tokens
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is where the provider sends the user back with the authorization code
+	RedirectURL string
+	// Scopes are requested in addition to openid
+	Scopes []string
+	// HTTPClient is used for all requests to the provider, a client with a 10s timeout is used if it's nil
+	HTTPClient *http.Client
+}
+
+type Provider struct {
+	cfg    Config
+	client *http.Client
+
+	mu       sync.Mutex
+	metadata *metadata
+	keys     keySet
+}
+
+type metadata struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	SigningAlgorithms     []string `json:"id_token_signing_alg_values_supported"`
+}
+
+type keySet struct {
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+func New(cfg Config) (*Provider, error) {
+	if cfg.IssuerURL == "" {
+		return nil, errors.New("issuer url is required")
+	}
+
+	if cfg.ClientID == "" {
+		return nil, errors.New("client id is required")
+	}
+
+	if _, err := url.ParseRequestURI(cfg.RedirectURL); err != nil {
+		return nil, fmt.Errorf("invalid redirect url: %w", err)
+	}
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: defaultHTTPTimeout}
+	}
+
+	return &Provider{
+		cfg:    cfg,
+		client: client,
+	}, nil
+}
+
+// AuthCodeURL returns the URL to send the user to. The state and nonce must be random per login,
+// the code challenge is derived from the verifier, which is only sent to the provider in Exchange
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(md.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid authorization endpoint: %w", ErrDiscoveryFailed, err)
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.cfg.ClientID)
+	query.Set("redirect_uri", p.cfg.RedirectURL)
+	query.Set("scope", p.scope())
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", CodeChallenge(codeVerifier))
+	query.Set("code_challenge_method", CodeChallengeMethodS256)
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+func (p *Provider) scope() string {
+	scopes := []string{ScopeOpenID}
+	for _, scope := range p.cfg.Scopes {
+		if scope != "" && scope != ScopeOpenID {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return strings.Join(scopes, " ")
+}
+
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Exchange redeems the authorization code and returns the raw ID token. The ID token must be
+// verified with VerifyIDToken before it's trusted
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier string) (string, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"code_verifier": {codeVerifier},
+	}
+
+	// Public clients identify themselves in the form, confidential ones with basic auth
+	if p.cfg.ClientSecret == "" {
+		form.Set("client_id", p.cfg.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrExchangeFailed, err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrExchangeFailed, err)
+	}
+	defer resp.Body.Close()
+
+	var token tokenResponse
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&token); err != nil {
+		return "", fmt.Errorf("%w: status %d: failed to decode response: %w", ErrExchangeFailed, resp.StatusCode, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		// RFC 6749 5.2: a bad code or verifier is reported as invalid_grant
+		if token.Error == "invalid_grant" {
+			return "", fmt.Errorf("%w: %s", ErrCodeRejected, token.ErrorDescription)
+		}
+
+		return "", fmt.Errorf("%w: status %d: %s %s", ErrExchangeFailed, resp.StatusCode, token.Error, token.ErrorDescription)
+	}
+
+	if token.IDToken == "" {
+		return "", ErrIDTokenMissing
+	}
+
+	return token.IDToken, nil
+}
+
+// discover fetches the provider metadata once, a failed discovery is retried on the next call
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	md := &metadata{}
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.cfg.IssuerURL, "/")+DiscoveryPath, md); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDiscoveryFailed, err)
+	}
+
+	if md.Issuer != p.cfg.IssuerURL {
+		return nil, fmt.Errorf("%w: got %q", ErrIssuerMismatch, md.Issuer)
+	}
+
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: authorization, token and jwks endpoints are required", ErrDiscoveryFailed)
+	}
+
+	p.metadata = md
+
+	return md, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, endpoint string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, endpoint)
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v)
+}
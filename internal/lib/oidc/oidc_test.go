@@ -0,0 +1,331 @@
+package oidc_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rshelekhov/merch-store/internal/lib/oidc"
+	"github.com/rshelekhov/merch-store/internal/lib/oidc/oidctest"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID     = "merch-store"
+	testClientSecret = "test-client-secret"
+	testRedirectURL  = "http://localhost:8080/api/auth/oidc/callback"
+)
+
+var testUser = oidctest.User{
+	Subject:           "00u1a2b3c4",
+	Email:             "john.doe@example.com",
+	EmailVerified:     true,
+	PreferredUsername: "john.doe",
+	Name:              "John Doe",
+}
+
+func newTestProvider(t *testing.T, idp *oidctest.Server) *oidc.Provider {
+	t.Helper()
+
+	provider, err := oidc.New(oidc.Config{
+		IssuerURL:    idp.Issuer(),
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		RedirectURL:  testRedirectURL,
+		Scopes:       []string{"email", "profile"},
+	})
+	require.NoError(t, err)
+
+	return provider
+}
+
+type login struct {
+	code         string
+	nonce        string
+	codeVerifier string
+}
+
+func startLogin(t *testing.T, provider *oidc.Provider, idp *oidctest.Server) login {
+	t.Helper()
+
+	ctx := context.Background()
+
+	state, err := oidc.RandomValue()
+	require.NoError(t, err)
+	nonce, err := oidc.RandomValue()
+	require.NoError(t, err)
+	codeVerifier, err := oidc.RandomValue()
+	require.NoError(t, err)
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	require.NoError(t, err)
+
+	code, returnedState, err := idp.Login(authURL, testUser)
+	require.NoError(t, err)
+	require.Equal(t, state, returnedState)
+
+	return login{
+		code:         code,
+		nonce:        nonce,
+		codeVerifier: codeVerifier,
+	}
+}
+
+func TestProvider_AuthCodeURL(t *testing.T) {
+	idp := oidctest.NewServer(t, testClientID, testClientSecret)
+	provider := newTestProvider(t, idp)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "test-state", "test-nonce", "test-verifier")
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+
+	query := parsed.Query()
+	require.Equal(t, idp.Issuer()+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	require.Equal(t, "code", query.Get("response_type"))
+	require.Equal(t, testClientID, query.Get("client_id"))
+	require.Equal(t, testRedirectURL, query.Get("redirect_uri"))
+	require.Equal(t, "openid email profile", query.Get("scope"))
+	require.Equal(t, "test-state", query.Get("state"))
+	require.Equal(t, "test-nonce", query.Get("nonce"))
+	require.Equal(t, oidc.CodeChallenge("test-verifier"), query.Get("code_challenge"))
+	require.Equal(t, oidc.CodeChallengeMethodS256, query.Get("code_challenge_method"))
+}
+
+func TestProvider_Login(t *testing.T) {
+	ctx := context.Background()
+
+	for _, clientSecret := range []string{testClientSecret, ""} {
+		name := "Confidential client"
+		if clientSecret == "" {
+			name = "Public client"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			idp := oidctest.NewServer(t, testClientID, clientSecret)
+			provider := newTestProvider(t, idp)
+
+			l := startLogin(t, provider, idp)
+
+			rawIDToken, err := provider.Exchange(ctx, l.code, l.codeVerifier)
+			require.NoError(t, err)
+
+			idToken, err := provider.VerifyIDToken(ctx, rawIDToken, l.nonce)
+			require.NoError(t, err)
+			require.Equal(t, idp.Issuer(), idToken.Issuer)
+			require.Equal(t, testUser.Subject, idToken.Subject)
+			require.Equal(t, testUser.Email, idToken.Email)
+			require.True(t, idToken.EmailVerified)
+			require.Equal(t, testUser.PreferredUsername, idToken.PreferredUsername)
+			require.Equal(t, testUser.Name, idToken.Name)
+		})
+	}
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		exchange      func(provider *oidc.Provider, l login) error
+		expectedError error
+	}{
+		{
+			name: "Error – Wrong code verifier",
+			exchange: func(provider *oidc.Provider, l login) error {
+				_, err := provider.Exchange(ctx, l.code, "wrong-verifier")
+				return err
+			},
+			expectedError: oidc.ErrCodeRejected,
+		},
+		{
+			name: "Error – Code used twice",
+			exchange: func(provider *oidc.Provider, l login) error {
+				if _, err := provider.Exchange(ctx, l.code, l.codeVerifier); err != nil {
+					return err
+				}
+
+				_, err := provider.Exchange(ctx, l.code, l.codeVerifier)
+				return err
+			},
+			expectedError: oidc.ErrCodeRejected,
+		},
+		{
+			name: "Error – Unknown code",
+			exchange: func(provider *oidc.Provider, l login) error {
+				_, err := provider.Exchange(ctx, "unknown-code", l.codeVerifier)
+				return err
+			},
+			expectedError: oidc.ErrCodeRejected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := oidctest.NewServer(t, testClientID, testClientSecret)
+			provider := newTestProvider(t, idp)
+
+			l := startLogin(t, provider, idp)
+
+			err := tt.exchange(provider, l)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestProvider_Exchange_WrongClientSecret(t *testing.T) {
+	idp := oidctest.NewServer(t, testClientID, testClientSecret)
+
+	provider, err := oidc.New(oidc.Config{
+		IssuerURL:    idp.Issuer(),
+		ClientID:     testClientID,
+		ClientSecret: "wrong-secret",
+		RedirectURL:  testRedirectURL,
+	})
+	require.NoError(t, err)
+
+	l := startLogin(t, provider, idp)
+
+	_, err = provider.Exchange(context.Background(), l.code, l.codeVerifier)
+	require.ErrorIs(t, err, oidc.ErrExchangeFailed)
+	require.NotErrorIs(t, err, oidc.ErrCodeRejected)
+}
+
+func TestProvider_VerifyIDToken(t *testing.T) {
+	ctx := context.Background()
+
+	idp := oidctest.NewServer(t, testClientID, testClientSecret)
+	provider := newTestProvider(t, idp)
+
+	validClaims := func() jwt.MapClaims {
+		now := time.Now()
+
+		return jwt.MapClaims{
+			"iss":   idp.Issuer(),
+			"sub":   testUser.Subject,
+			"aud":   testClientID,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+			"nonce": "test-nonce",
+		}
+	}
+
+	tests := []struct {
+		name          string
+		claims        func() jwt.MapClaims
+		expectedError error
+	}{
+		{
+			name:          "Success",
+			claims:        validClaims,
+			expectedError: nil,
+		},
+		{
+			name: "Success – email_verified as a string",
+			claims: func() jwt.MapClaims {
+				claims := validClaims()
+				claims["email_verified"] = "true"
+				return claims
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success – Several audiences with us as the authorized party",
+			claims: func() jwt.MapClaims {
+				claims := validClaims()
+				claims["aud"] = []string{testClientID, "another-client"}
+				claims["azp"] = testClientID
+				return claims
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error – Wrong nonce",
+			claims: func() jwt.MapClaims {
+				claims := validClaims()
+				claims["nonce"] = "another-nonce"
+				return claims
+			},
+			expectedError: oidc.ErrInvalidIDToken,
+		},
+		{
+			name: "Error – Wrong issuer",
+			claims: func() jwt.MapClaims {
+				claims := validClaims()
+				claims["iss"] = "https://evil.example.com"
+				return claims
+			},
+			expectedError: oidc.ErrInvalidIDToken,
+		},
+		{
+			name: "Error – Issued for another client",
+			claims: func() jwt.MapClaims {
+				claims := validClaims()
+				claims["aud"] = "another-client"
+				return claims
+			},
+			expectedError: oidc.ErrInvalidIDToken,
+		},
+		{
+			name: "Error – Several audiences without the authorized party",
+			claims: func() jwt.MapClaims {
+				claims := validClaims()
+				claims["aud"] = []string{testClientID, "another-client"}
+				return claims
+			},
+			expectedError: oidc.ErrInvalidIDToken,
+		},
+		{
+			name: "Error – Expired",
+			claims: func() jwt.MapClaims {
+				claims := validClaims()
+				claims["iat"] = time.Now().Add(-3 * time.Hour).Unix()
+				claims["exp"] = time.Now().Add(-2 * time.Hour).Unix()
+				return claims
+			},
+			expectedError: oidc.ErrInvalidIDToken,
+		},
+		{
+			name: "Error – No subject",
+			claims: func() jwt.MapClaims {
+				claims := validClaims()
+				delete(claims, "sub")
+				return claims
+			},
+			expectedError: oidc.ErrInvalidIDToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawIDToken, err := idp.SignIDToken(tt.claims())
+			require.NoError(t, err)
+
+			_, err = provider.VerifyIDToken(ctx, rawIDToken, "test-nonce")
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProvider_Discovery(t *testing.T) {
+	idp := oidctest.NewServer(t, testClientID, testClientSecret)
+
+	// The issuer must match exactly, a trailing slash makes it another issuer
+	provider, err := oidc.New(oidc.Config{
+		IssuerURL:   idp.Issuer() + "/",
+		ClientID:    testClientID,
+		RedirectURL: testRedirectURL,
+	})
+	require.NoError(t, err)
+
+	_, err = provider.AuthCodeURL(context.Background(), "test-state", "test-nonce", "test-verifier")
+	require.ErrorIs(t, err, oidc.ErrIssuerMismatch)
+}
@@ -0,0 +1,27 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// randomValueLength gives 256 bits of entropy, the verifier is 43 characters long,
+// the minimum allowed by RFC 7636
+const randomValueLength = 32
+
+// RandomValue returns a random URL-safe string, suitable for the state, the nonce and the code verifier
+func RandomValue() (string, error) {
+	value := make([]byte, randomValueLength)
+	if _, err := rand.Read(value); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(value), nil
+}
+
+// CodeChallenge derives the S256 PKCE challenge from the verifier
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,55 @@
+package oidc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rshelekhov/merch-store/internal/lib/oidc/oidctest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_VerifyIDToken_KeyRotation(t *testing.T) {
+	ctx := context.Background()
+
+	idp := oidctest.NewServer(t, "merch-store", "")
+
+	provider, err := New(Config{
+		IssuerURL:   idp.Issuer(),
+		ClientID:    idp.ClientID,
+		RedirectURL: "http://localhost:8080/api/auth/oidc/callback",
+	})
+	require.NoError(t, err)
+
+	signIDToken := func() string {
+		now := time.Now()
+
+		rawIDToken, err := idp.SignIDToken(jwt.MapClaims{
+			"iss":   idp.Issuer(),
+			"sub":   "test-subject",
+			"aud":   idp.ClientID,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+			"nonce": "test-nonce",
+		})
+		require.NoError(t, err)
+
+		return rawIDToken
+	}
+
+	_, err = provider.VerifyIDToken(ctx, signIDToken(), "test-nonce")
+	require.NoError(t, err)
+
+	require.NoError(t, idp.RotateKey())
+	rotated := signIDToken()
+
+	// Right after a fetch the keys are not fetched again, whatever kid comes in
+	_, err = provider.VerifyIDToken(ctx, rotated, "test-nonce")
+	require.ErrorIs(t, err, ErrInvalidIDToken)
+
+	provider.keys.fetchedAt = time.Now().Add(-minKeyRefreshInterval)
+
+	_, err = provider.VerifyIDToken(ctx, rotated, "test-nonce")
+	require.NoError(t, err)
+}
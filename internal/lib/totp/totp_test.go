@@ -0,0 +1,124 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/rshelekhov/merch-store/internal/lib/totp"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 seed of the test vectors in RFC 6238, appendix B
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCode_RFC6238(t *testing.T) {
+	// The RFC lists 8 digit codes, ours are their last 6 digits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+		{unix: 20000000000, code: "353130"},
+	}
+
+	for _, tt := range tests {
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(tt.unix, 0)))
+		require.NoError(t, err)
+		require.Equal(t, tt.code, code, "time %d", tt.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := totp.Step(now)
+
+	previous, err := totp.Code(rfcSecret, step-1)
+	require.NoError(t, err)
+
+	tooOld, err := totp.Code(rfcSecret, step-2)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		code         string
+		expectedOK   bool
+		expectedStep int64
+	}{
+		{
+			name:         "Success – Current step",
+			code:         "050471",
+			expectedOK:   true,
+			expectedStep: step,
+		},
+		{
+			name:         "Success – Previous step within the skew",
+			code:         previous,
+			expectedOK:   true,
+			expectedStep: step - 1,
+		},
+		{
+			name:       "Error – Outside the skew",
+			code:       tooOld,
+			expectedOK: false,
+		},
+		{
+			name:       "Error – Wrong code",
+			code:       "123456",
+			expectedOK: false,
+		},
+		{
+			name:       "Error – Wrong length",
+			code:       "50471",
+			expectedOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchedStep, ok, err := totp.Validate(rfcSecret, tt.code, now, 1)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedOK, ok)
+
+			if tt.expectedOK {
+				require.Equal(t, tt.expectedStep, matchedStep)
+			}
+		})
+	}
+}
+
+func TestValidate_InvalidSecret(t *testing.T) {
+	_, _, err := totp.Validate("not base32!", "123456", time.Now(), 1)
+	require.ErrorIs(t, err, totp.ErrInvalidSecret)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	require.Len(t, secret, 32)
+
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+
+	_, ok, err := totp.Validate(secret, code, time.Now(), 1)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri, err := url.Parse(totp.ProvisioningURI("Merch Store", "john.doe", "JBSWY3DPEHPK3PXP"))
+	require.NoError(t, err)
+
+	require.Equal(t, "otpauth", uri.Scheme)
+	require.Equal(t, "totp", uri.Host)
+	require.Equal(t, "/Merch Store:john.doe", uri.Path)
+	require.Equal(t, "JBSWY3DPEHPK3PXP", uri.Query().Get("secret"))
+	require.Equal(t, "Merch Store", uri.Query().Get("issuer"))
+	require.Equal(t, "6", uri.Query().Get("digits"))
+	require.Equal(t, "30", uri.Query().Get("period"))
+}
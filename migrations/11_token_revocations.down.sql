@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_refresh_tokens_expires_at;
+
+DROP TABLE IF EXISTS session_revocations CASCADE;
+DROP TABLE IF EXISTS revoked_tokens CASCADE;
@@ -0,0 +1,21 @@
+-- Access tokens revoked one by one, e.g. on logout. Kept until the token expires on its own
+CREATE TABLE IF NOT EXISTS revoked_tokens
+(
+    jti        CHARACTER VARYING PRIMARY KEY,
+    user_id    CHARACTER VARYING NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens (expires_at);
+
+-- Every access token of the user issued before revoked_before is rejected.
+-- Kept until the last of these tokens expires
+CREATE TABLE IF NOT EXISTS session_revocations
+(
+    user_id        CHARACTER VARYING PRIMARY KEY REFERENCES users (id),
+    revoked_before TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at     TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_expires_at ON refresh_tokens (expires_at);
@@ -0,0 +1,10 @@
+-- Users without a row here are employees. Roles are embedded in access tokens,
+-- so a change takes effect once the user's sessions are revoked
+CREATE TABLE IF NOT EXISTS user_roles
+(
+    user_id    CHARACTER VARYING PRIMARY KEY REFERENCES users (id),
+    role       CHARACTER VARYING NOT NULL CHECK (role IN ('employee', 'store-admin', 'finance', 'superadmin')),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_roles_role ON user_roles (role);
@@ -0,0 +1,14 @@
+-- One-time tokens issued by admins to let a user set a new password.
+-- Only the hash of the token is stored
+CREATE TABLE IF NOT EXISTS password_reset_tokens
+(
+    id         CHARACTER VARYING PRIMARY KEY,
+    user_id    CHARACTER VARYING NOT NULL REFERENCES users (id),
+    token_hash CHARACTER VARYING NOT NULL UNIQUE,
+    created_by CHARACTER VARYING NOT NULL REFERENCES users (id),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    used_at    TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_user_id ON password_reset_tokens (user_id) WHERE used_at IS NULL;
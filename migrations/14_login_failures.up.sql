@@ -0,0 +1,14 @@
+-- Failed login attempts per username and per client IP. A row is blocked until blocked_until,
+-- either for a short backoff or, once locked is set, for the whole lockout
+CREATE TABLE IF NOT EXISTS login_failures
+(
+    scope          CHARACTER VARYING NOT NULL CHECK (scope IN ('username', 'ip')),
+    subject        CHARACTER VARYING NOT NULL,
+    failures       INTEGER NOT NULL,
+    locked         BOOLEAN NOT NULL DEFAULT false,
+    blocked_until  TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_failed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (scope, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_failures_last_failed_at ON login_failures (last_failed_at);
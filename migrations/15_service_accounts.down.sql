@@ -0,0 +1,5 @@
+DELETE FROM transactions WHERE transaction_type_id = 6;
+DELETE FROM transaction_types WHERE id = 6;
+
+DROP TABLE IF EXISTS api_keys CASCADE;
+DROP TABLE IF EXISTS service_accounts CASCADE;
@@ -0,0 +1,30 @@
+-- Service accounts let bots and other systems call the API without a user.
+-- They authenticate with API keys, which are stored by the hash only and restricted to scopes
+CREATE TABLE IF NOT EXISTS service_accounts
+(
+    id          CHARACTER VARYING PRIMARY KEY,
+    name        CHARACTER VARYING NOT NULL UNIQUE,
+    description CHARACTER VARYING NOT NULL DEFAULT '',
+    created_by  CHARACTER VARYING NOT NULL REFERENCES users (id),
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+-- A NULL expires_at means the key doesn't expire. last_used_at is updated at most once a minute
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id                 CHARACTER VARYING PRIMARY KEY,
+    service_account_id CHARACTER VARYING NOT NULL REFERENCES service_accounts (id),
+    key_hash           CHARACTER VARYING NOT NULL UNIQUE,
+    scopes             CHARACTER VARYING[] NOT NULL,
+    created_by         CHARACTER VARYING NOT NULL REFERENCES users (id),
+    expires_at         TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    last_used_at       TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    created_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    revoked_at         TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_service_account ON api_keys (service_account_id, created_at);
+
+-- Grants are paid by the store, so like refunds they have a receiver but no sender
+INSERT INTO transaction_types (id, title)
+VALUES (6, 'grant_coins');
@@ -0,0 +1,25 @@
+-- Single sign-on logins in progress, between the redirect to the provider and the callback.
+-- A state can be used once, the PKCE code verifier never leaves the server
+CREATE TABLE IF NOT EXISTS oidc_login_states
+(
+    state         CHARACTER VARYING PRIMARY KEY,
+    nonce         CHARACTER VARYING NOT NULL,
+    code_verifier CHARACTER VARYING NOT NULL,
+    expires_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_oidc_login_states_expires_at ON oidc_login_states (expires_at);
+
+-- Accounts at OpenID Connect providers linked to local users. An account is identified by the issuer
+-- and the sub claim, a user can have one account per issuer
+CREATE TABLE IF NOT EXISTS user_identities
+(
+    issuer     CHARACTER VARYING NOT NULL,
+    subject    CHARACTER VARYING NOT NULL,
+    user_id    CHARACTER VARYING NOT NULL REFERENCES users (id),
+    email      CHARACTER VARYING NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (issuer, subject),
+    UNIQUE (issuer, user_id)
+);
@@ -0,0 +1,11 @@
+-- Users without a row here have an empty profile
+CREATE TABLE IF NOT EXISTS user_profiles
+(
+    user_id      CHARACTER VARYING PRIMARY KEY REFERENCES users (id),
+    display_name CHARACTER VARYING NOT NULL DEFAULT '',
+    email        CHARACTER VARYING NOT NULL DEFAULT '',
+    department   CHARACTER VARYING NOT NULL DEFAULT '',
+    title        CHARACTER VARYING NOT NULL DEFAULT '',
+    avatar_url   CHARACTER VARYING NOT NULL DEFAULT '',
+    updated_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
@@ -0,0 +1,5 @@
+-- The user directory matches usernames and display names by prefix and by similarity,
+-- both are served by trigram indexes. pg_trgm is created in 3_merch_catalog
+CREATE INDEX IF NOT EXISTS idx_users_username_trgm ON users USING GIN (username gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_user_profiles_display_name_trgm ON user_profiles USING GIN (display_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_user_profiles_department ON user_profiles (lower(department));
@@ -0,0 +1,2 @@
+DELETE FROM transactions WHERE transaction_type_id = 7;
+DELETE FROM transaction_types WHERE id = 7;
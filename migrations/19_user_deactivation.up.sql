@@ -0,0 +1,4 @@
+-- Coins left on a deactivated account can be forfeited to the store,
+-- so like purchases the forfeit has a sender but no receiver
+INSERT INTO transaction_types (id, title)
+VALUES (7, 'forfeit_coins');
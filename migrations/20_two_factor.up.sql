@@ -0,0 +1,34 @@
+-- TOTP authenticators of users. The secret is encrypted with TOTP_ENCRYPTION_KEYS and bound to the user.
+-- An authenticator is only required once the user has proven it works (confirmed_at), and a code
+-- can't be used twice: it must belong to a later step than last_used_step
+CREATE TABLE IF NOT EXISTS user_totp
+(
+    user_id          CHARACTER VARYING PRIMARY KEY REFERENCES users (id),
+    secret_encrypted CHARACTER VARYING NOT NULL,
+    last_used_step   BIGINT NOT NULL DEFAULT 0,
+    confirmed_at     TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+-- One-time recovery codes for a lost authenticator, stored by the hash only
+CREATE TABLE IF NOT EXISTS user_recovery_codes
+(
+    id         CHARACTER VARYING PRIMARY KEY,
+    user_id    CHARACTER VARYING NOT NULL REFERENCES users (id),
+    code_hash  CHARACTER VARYING NOT NULL,
+    used_at    TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_recovery_codes_user ON user_recovery_codes (user_id) WHERE used_at IS NULL;
+
+-- Logins waiting for the second factor, between /api/auth and /api/auth/2fa
+CREATE TABLE IF NOT EXISTS mfa_challenges
+(
+    token_hash CHARACTER VARYING PRIMARY KEY,
+    user_id    CHARACTER VARYING NOT NULL REFERENCES users (id),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_mfa_challenges_expires_at ON mfa_challenges (expires_at);
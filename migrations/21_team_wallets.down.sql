@@ -0,0 +1,11 @@
+DELETE FROM transactions WHERE transaction_type_id IN (8, 9, 10);
+DELETE FROM transaction_types WHERE id IN (8, 9, 10);
+
+DROP INDEX IF EXISTS idx_transactions_receiver_team;
+DROP INDEX IF EXISTS idx_transactions_sender_team;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS receiver_team_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS sender_team_id;
+
+DROP TABLE IF EXISTS team_members CASCADE;
+DROP TABLE IF EXISTS teams CASCADE;
@@ -0,0 +1,37 @@
+-- Teams have a shared wallet for team events. Members contribute to it,
+-- managers pay members out of it or buy merch for the team
+CREATE TABLE IF NOT EXISTS teams
+(
+    id         CHARACTER VARYING PRIMARY KEY,
+    name       CHARACTER VARYING NOT NULL UNIQUE,
+    department CHARACTER VARYING NOT NULL DEFAULT '',
+    balance    INT NOT NULL DEFAULT 0 CHECK (balance >= 0),
+    created_by CHARACTER VARYING NOT NULL REFERENCES users (id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS team_members
+(
+    team_id   CHARACTER VARYING NOT NULL REFERENCES teams (id),
+    user_id   CHARACTER VARYING NOT NULL REFERENCES users (id),
+    role      CHARACTER VARYING NOT NULL,
+    joined_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_members_user ON team_members (user_id);
+
+-- A team wallet is a party of a transaction instead of a user,
+-- so a contribution has a sender and a receiving team, a payout a sending team and a receiver
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS sender_team_id CHARACTER VARYING DEFAULT NULL REFERENCES teams (id);
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS receiver_team_id CHARACTER VARYING DEFAULT NULL REFERENCES teams (id);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_sender_team ON transactions (sender_team_id) WHERE sender_team_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_receiver_team ON transactions (receiver_team_id) WHERE receiver_team_id IS NOT NULL;
+
+INSERT INTO transaction_types (id, title)
+VALUES
+    (8, 'team_contribution'),
+    (9, 'team_payout'),
+    (10, 'team_purchase');
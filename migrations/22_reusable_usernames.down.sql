@@ -0,0 +1,2 @@
+-- Fails if a username was taken again after its user had been deactivated
+ALTER TABLE users ADD CONSTRAINT users_username_key UNIQUE (username);
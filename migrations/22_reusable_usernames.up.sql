@@ -0,0 +1,2 @@
+-- Usernames of deactivated users can be taken again, idx_active_users keeps them unique among active users
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_username_key;
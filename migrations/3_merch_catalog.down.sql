@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS merch_tags CASCADE;
+
+DROP INDEX IF EXISTS idx_merch_category;
+DROP INDEX IF EXISTS idx_merch_fts;
+DROP INDEX IF EXISTS idx_merch_name_trgm;
+DROP INDEX IF EXISTS idx_merch_description_trgm;
+
+ALTER TABLE merch DROP COLUMN IF EXISTS category_id;
+ALTER TABLE merch DROP COLUMN IF EXISTS description;
+
+DROP TABLE IF EXISTS categories CASCADE;
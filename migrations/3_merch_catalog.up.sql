@@ -0,0 +1,69 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE IF NOT EXISTS categories
+(
+    id         CHARACTER VARYING PRIMARY KEY,
+    name       CHARACTER VARYING UNIQUE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+ALTER TABLE merch ADD COLUMN IF NOT EXISTS description CHARACTER VARYING NOT NULL DEFAULT '';
+ALTER TABLE merch ADD COLUMN IF NOT EXISTS category_id CHARACTER VARYING DEFAULT NULL;
+
+CREATE TABLE IF NOT EXISTS merch_tags
+(
+    merch_id CHARACTER VARYING NOT NULL,
+    tag      CHARACTER VARYING NOT NULL,
+    PRIMARY KEY (merch_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_merch_tags_tag ON merch_tags (tag);
+CREATE INDEX IF NOT EXISTS idx_merch_category ON merch (category_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_merch_fts ON merch USING GIN (to_tsvector('simple', name || ' ' || description));
+CREATE INDEX IF NOT EXISTS idx_merch_name_trgm ON merch USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_merch_description_trgm ON merch USING GIN (description gin_trgm_ops);
+
+ALTER TABLE merch ADD FOREIGN KEY (category_id) REFERENCES categories(id);
+ALTER TABLE merch_tags ADD FOREIGN KEY (merch_id) REFERENCES merch(id) ON DELETE CASCADE;
+
+INSERT INTO categories (id, name)
+VALUES
+    (gen_random_uuid(), 'clothing'),
+    (gen_random_uuid(), 'accessories'),
+    (gen_random_uuid(), 'stationery'),
+    (gen_random_uuid(), 'electronics');
+
+UPDATE merch
+SET category_id = (SELECT id FROM categories WHERE categories.name = seed.category),
+    description = seed.description
+FROM (
+    VALUES
+        ('t-shirt', 'clothing', 'Cotton t-shirt with the company logo'),
+        ('hoody', 'clothing', 'Warm hoody with the company logo'),
+        ('pink-hoody', 'clothing', 'Limited edition pink hoody'),
+        ('socks', 'clothing', 'Colorful socks with a pattern'),
+        ('cup', 'accessories', 'Ceramic cup for tea or coffee'),
+        ('umbrella', 'accessories', 'Large umbrella that survives the wind'),
+        ('wallet', 'accessories', 'Leather wallet for cards and cash'),
+        ('book', 'stationery', 'Notebook with a hard cover'),
+        ('pen', 'stationery', 'Ballpoint pen with blue ink'),
+        ('powerbank', 'electronics', 'Portable charger for phones and laptops')
+) AS seed (name, category, description)
+WHERE merch.name = seed.name;
+
+INSERT INTO merch_tags (merch_id, tag)
+SELECT merch.id, seed.tag
+FROM merch
+    JOIN (
+        VALUES
+            ('t-shirt', 'logo'),
+            ('hoody', 'logo'),
+            ('hoody', 'warm'),
+            ('pink-hoody', 'limited'),
+            ('pink-hoody', 'warm'),
+            ('socks', 'warm'),
+            ('cup', 'kitchen'),
+            ('umbrella', 'outdoor'),
+            ('powerbank', 'travel'),
+            ('umbrella', 'travel')
+    ) AS seed (name, tag) ON merch.name = seed.name;
@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS merch_returns CASCADE;
+
+DELETE FROM transactions WHERE transaction_type_id = 2;
+DELETE FROM transaction_types WHERE id = 2;
+
+ALTER TABLE transactions ALTER COLUMN sender_id SET NOT NULL;
+
+ALTER TABLE merch DROP COLUMN IF EXISTS stock;
+
+DROP INDEX IF EXISTS idx_purchases_user;
+DROP INDEX IF EXISTS idx_transactions_sender;
+DROP INDEX IF EXISTS idx_transactions_receiver;
+
+ALTER TABLE purchases DROP COLUMN IF EXISTS returned_at;
+ALTER TABLE purchases DROP COLUMN IF EXISTS price;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_active_purchases ON purchases (user_id, merch_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_active_transactions ON transactions (sender_id, receiver_id, transaction_type_id, amount);
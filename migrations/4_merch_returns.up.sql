@@ -0,0 +1,51 @@
+-- A purchase is now a unit of inventory that can be returned (and bought again later),
+-- and refunds of the same amount to the same user are legitimate repeated transactions,
+-- so these uniqueness constraints no longer hold.
+DROP INDEX IF EXISTS idx_active_purchases;
+DROP INDEX IF EXISTS idx_active_transactions;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_sender ON transactions (sender_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_receiver ON transactions (receiver_id);
+
+-- Price actually paid for the item, so refunds don't depend on the current catalog price
+ALTER TABLE purchases ADD COLUMN IF NOT EXISTS price INT NOT NULL DEFAULT 0;
+ALTER TABLE purchases ADD COLUMN IF NOT EXISTS returned_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_purchases_user ON purchases (user_id) WHERE returned_at IS NULL;
+
+UPDATE purchases
+SET price = merch.price
+FROM merch
+WHERE purchases.merch_id = merch.id;
+
+-- NULL means the item is not stock tracked and can be bought without limits
+ALTER TABLE merch ADD COLUMN IF NOT EXISTS stock INT DEFAULT NULL CHECK (stock >= 0);
+
+-- Refunds are paid by the store, so they have a receiver but no sender
+ALTER TABLE transactions ALTER COLUMN sender_id DROP NOT NULL;
+
+INSERT INTO transaction_types (id, title)
+VALUES (2, 'refund_merch');
+
+CREATE TABLE IF NOT EXISTS merch_returns
+(
+    id            CHARACTER VARYING PRIMARY KEY,
+    purchase_id   CHARACTER VARYING NOT NULL,
+    user_id       CHARACTER VARYING NOT NULL,
+    merch_id      CHARACTER VARYING NOT NULL,
+    refund_amount INT NOT NULL CHECK (refund_amount >= 0),
+    reason        CHARACTER VARYING NOT NULL DEFAULT '',
+    status        CHARACTER VARYING NOT NULL,
+    resolved_by   CHARACTER VARYING DEFAULT NULL,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    resolved_at   TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+
+-- Only one open or approved return per purchase, rejected ones can be requested again
+CREATE UNIQUE INDEX IF NOT EXISTS idx_active_merch_returns ON merch_returns (purchase_id) WHERE status <> 'rejected';
+CREATE INDEX IF NOT EXISTS idx_merch_returns_status ON merch_returns (status, created_at);
+
+ALTER TABLE merch_returns ADD FOREIGN KEY (purchase_id) REFERENCES purchases(id);
+ALTER TABLE merch_returns ADD FOREIGN KEY (user_id) REFERENCES users(id);
+ALTER TABLE merch_returns ADD FOREIGN KEY (merch_id) REFERENCES merch(id);
+ALTER TABLE merch_returns ADD FOREIGN KEY (resolved_by) REFERENCES users(id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS merch_images;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS merch_images
+(
+    id            CHARACTER VARYING PRIMARY KEY,
+    merch_id      CHARACTER VARYING NOT NULL,
+    content_type  CHARACTER VARYING NOT NULL,
+    image_key     CHARACTER VARYING NOT NULL,
+    thumbnail_key CHARACTER VARYING NOT NULL,
+    width         INT NOT NULL,
+    height        INT NOT NULL,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_merch_images_merch ON merch_images (merch_id, created_at);
+
+ALTER TABLE merch_images ADD FOREIGN KEY (merch_id) REFERENCES merch(id) ON DELETE CASCADE;
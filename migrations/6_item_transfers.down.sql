@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_purchases_user_merch;
+
+ALTER TABLE purchases DROP COLUMN IF EXISTS last_transfer_id;
+
+DROP TABLE IF EXISTS item_transfers;
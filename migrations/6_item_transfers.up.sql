@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS item_transfers
+(
+    id          CHARACTER VARYING PRIMARY KEY,
+    sender_id   CHARACTER VARYING NOT NULL,
+    receiver_id CHARACTER VARYING NOT NULL,
+    merch_id    CHARACTER VARYING NOT NULL,
+    quantity    INT NOT NULL CHECK (quantity > 0),
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_transfers_sender ON item_transfers (sender_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_item_transfers_receiver ON item_transfers (receiver_id, created_at);
+
+-- The last transfer that moved the purchased item to its current owner.
+-- NULL means the item is still owned by the user who bought it
+ALTER TABLE purchases ADD COLUMN IF NOT EXISTS last_transfer_id CHARACTER VARYING DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_purchases_user_merch ON purchases (user_id, merch_id, created_at) WHERE returned_at IS NULL;
+
+ALTER TABLE item_transfers ADD FOREIGN KEY (sender_id) REFERENCES users(id);
+ALTER TABLE item_transfers ADD FOREIGN KEY (receiver_id) REFERENCES users(id);
+ALTER TABLE item_transfers ADD FOREIGN KEY (merch_id) REFERENCES merch(id);
+ALTER TABLE purchases ADD FOREIGN KEY (last_transfer_id) REFERENCES item_transfers(id);
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS waitlist_entries
+(
+    id         CHARACTER VARYING PRIMARY KEY,
+    merch_id   CHARACTER VARYING NOT NULL,
+    user_id    CHARACTER VARYING NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_waitlist_entries_user ON waitlist_entries (merch_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_queue ON waitlist_entries (merch_id, created_at);
+
+CREATE TABLE IF NOT EXISTS reservations
+(
+    id          CHARACTER VARYING PRIMARY KEY,
+    merch_id    CHARACTER VARYING NOT NULL,
+    user_id     CHARACTER VARYING NOT NULL,
+    price       INT NOT NULL CHECK (price > 0),
+    status      CHARACTER VARYING NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    resolved_at TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_reservations_user ON reservations (user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_active_reservations_expiry ON reservations (expires_at) WHERE status = 'active';
+
+ALTER TABLE waitlist_entries ADD FOREIGN KEY (merch_id) REFERENCES merch(id);
+ALTER TABLE waitlist_entries ADD FOREIGN KEY (user_id) REFERENCES users(id);
+ALTER TABLE reservations ADD FOREIGN KEY (merch_id) REFERENCES merch(id);
+ALTER TABLE reservations ADD FOREIGN KEY (user_id) REFERENCES users(id);
@@ -0,0 +1,7 @@
+ALTER TABLE IF EXISTS auctions DROP COLUMN IF EXISTS highest_bid_id;
+
+DROP TABLE IF EXISTS auction_bids CASCADE;
+DROP TABLE IF EXISTS auctions CASCADE;
+
+DELETE FROM transactions WHERE transaction_type_id IN (3, 4);
+DELETE FROM transaction_types WHERE id IN (3, 4);
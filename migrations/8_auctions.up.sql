@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS auctions
+(
+    id             CHARACTER VARYING PRIMARY KEY,
+    merch_id       CHARACTER VARYING NOT NULL,
+    created_by     CHARACTER VARYING NOT NULL,
+    reserve_price  INT NOT NULL CHECK (reserve_price > 0),
+    status         CHARACTER VARYING NOT NULL,
+    highest_bid_id CHARACTER VARYING DEFAULT NULL,
+    starts_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    closed_at      TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_open_auctions_end ON auctions (ends_at) WHERE status = 'open';
+
+-- Every bid holds the coins in escrow until it is either outbid (refunded) or wins (captured)
+CREATE TABLE IF NOT EXISTS auction_bids
+(
+    id          CHARACTER VARYING PRIMARY KEY,
+    auction_id  CHARACTER VARYING NOT NULL,
+    user_id     CHARACTER VARYING NOT NULL,
+    amount      INT NOT NULL CHECK (amount > 0),
+    status      CHARACTER VARYING NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    resolved_at TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_auction_bids_auction ON auction_bids (auction_id, created_at);
+
+ALTER TABLE auctions ADD FOREIGN KEY (merch_id) REFERENCES merch(id);
+ALTER TABLE auctions ADD FOREIGN KEY (created_by) REFERENCES users(id);
+ALTER TABLE auctions ADD FOREIGN KEY (highest_bid_id) REFERENCES auction_bids(id);
+ALTER TABLE auction_bids ADD FOREIGN KEY (auction_id) REFERENCES auctions(id);
+ALTER TABLE auction_bids ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+-- Escrow movements are paid to and from the store, so like purchases and refunds they have a single party
+INSERT INTO transaction_types (id, title)
+VALUES
+    (3, 'auction_hold'),
+    (4, 'auction_refund');
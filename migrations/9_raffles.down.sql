@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS raffle_winners CASCADE;
+DROP TABLE IF EXISTS raffle_tickets CASCADE;
+DROP TABLE IF EXISTS raffles CASCADE;
+
+DELETE FROM transactions WHERE transaction_type_id = 5;
+DELETE FROM transaction_types WHERE id = 5;
@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS raffles
+(
+    id                   CHARACTER VARYING PRIMARY KEY,
+    merch_id             CHARACTER VARYING NOT NULL,
+    created_by           CHARACTER VARYING NOT NULL,
+    ticket_price         INT NOT NULL CHECK (ticket_price > 0),
+    max_tickets_per_user INT NOT NULL CHECK (max_tickets_per_user > 0),
+    winners_count        INT NOT NULL CHECK (winners_count > 0),
+    status               CHARACTER VARYING NOT NULL,
+    -- SHA-256 of the seed, published when the raffle is created.
+    -- The seed itself is only revealed after the draw, so anyone can check it matches
+    seed_commitment      CHARACTER VARYING NOT NULL,
+    seed                 CHARACTER VARYING NOT NULL,
+    draw_at              TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at           TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    drawn_at             TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_open_raffles_draw ON raffles (draw_at) WHERE status = 'open';
+
+CREATE TABLE IF NOT EXISTS raffle_tickets
+(
+    id         CHARACTER VARYING PRIMARY KEY,
+    raffle_id  CHARACTER VARYING NOT NULL,
+    user_id    CHARACTER VARYING NOT NULL,
+    number     INT NOT NULL CHECK (number > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_raffle_tickets_number ON raffle_tickets (raffle_id, number);
+CREATE INDEX IF NOT EXISTS idx_raffle_tickets_user ON raffle_tickets (raffle_id, user_id);
+
+CREATE TABLE IF NOT EXISTS raffle_winners
+(
+    raffle_id CHARACTER VARYING NOT NULL,
+    ticket_id CHARACTER VARYING NOT NULL,
+    user_id   CHARACTER VARYING NOT NULL,
+    position  INT NOT NULL CHECK (position > 0),
+    PRIMARY KEY (raffle_id, position)
+);
+
+ALTER TABLE raffles ADD FOREIGN KEY (merch_id) REFERENCES merch(id);
+ALTER TABLE raffles ADD FOREIGN KEY (created_by) REFERENCES users(id);
+ALTER TABLE raffle_tickets ADD FOREIGN KEY (raffle_id) REFERENCES raffles(id);
+ALTER TABLE raffle_tickets ADD FOREIGN KEY (user_id) REFERENCES users(id);
+ALTER TABLE raffle_winners ADD FOREIGN KEY (raffle_id) REFERENCES raffles(id);
+ALTER TABLE raffle_winners ADD FOREIGN KEY (ticket_id) REFERENCES raffle_tickets(id);
+ALTER TABLE raffle_winners ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+INSERT INTO transaction_types (id, title)
+VALUES (5, 'raffle_ticket');